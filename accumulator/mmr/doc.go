@@ -0,0 +1,22 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package mmr provides a Merkle Mountain Range, an append-only accumulator of
+// a list of leaves.
+//
+// The MMR of n leaves is the list of the perfect binary Merkle trees of the
+// binary decomposition of n, from the largest to the smallest. Their roots are
+// the peaks, and the root of the MMR is obtained by bagging the peaks from the
+// right:
+//
+//	root = H(p₀, H(p₁, … H(pₖ₋₂, pₖ₋₁)))
+//
+// Appending a leaf only merges the peaks of equal height, so that the nodes of
+// an MMR are the nodes of the MMRs of its prefixes. This allows inclusion
+// proofs against the root of any previous size of the MMR, and consistency
+// proofs that an MMR extends a previous one.
+//
+// The leaves and nodes are hashed by a Hasher, built from a hash.Hash with
+// NewHasher, or from a 2-to-1 compression function, e.g. a field-native one,
+// with NewCompressionHasher.
+package mmr
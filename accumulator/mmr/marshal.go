@@ -0,0 +1,149 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package mmr
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// bounds on the lists of digests read, against malformed inputs
+const (
+	maxDigestSize = 1024
+	maxNbDigests  = 1 << 16
+)
+
+var errInvalidEncoding = errors.New("invalid encoding of a list of digests")
+
+// WriteTo writes the size as a uint64 and the digests.
+func (p *Peaks) WriteTo(w io.Writer) (int64, error) {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], p.Size)
+	n, err := w.Write(buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	m, err := writeDigests(w, p.Digests)
+	return int64(n) + m, err
+}
+
+// ReadFrom reads peaks written by WriteTo.
+func (p *Peaks) ReadFrom(r io.Reader) (int64, error) {
+	var buf [8]byte
+	n, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	p.Size = binary.BigEndian.Uint64(buf[:])
+	var m int64
+	p.Digests, m, err = readDigests(r)
+	return int64(n) + m, err
+}
+
+// WriteTo writes the path and the peaks.
+func (p *InclusionProof) WriteTo(w io.Writer) (int64, error) {
+	return writeAll(w, p.Path, p.Peaks)
+}
+
+// ReadFrom reads a proof written by WriteTo.
+func (p *InclusionProof) ReadFrom(r io.Reader) (int64, error) {
+	return readAll(r, &p.Path, &p.Peaks)
+}
+
+// WriteTo writes the old peaks and the nodes.
+func (p *ConsistencyProof) WriteTo(w io.Writer) (int64, error) {
+	return writeAll(w, p.OldPeaks, p.Nodes)
+}
+
+// ReadFrom reads a proof written by WriteTo.
+func (p *ConsistencyProof) ReadFrom(r io.Reader) (int64, error) {
+	return readAll(r, &p.OldPeaks, &p.Nodes)
+}
+
+func writeAll(w io.Writer, lists ...[][]byte) (int64, error) {
+	var written int64
+	for _, l := range lists {
+		n, err := writeDigests(w, l)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+func readAll(r io.Reader, lists ...*[][]byte) (int64, error) {
+	var read int64
+	for _, l := range lists {
+		var n int64
+		var err error
+		*l, n, err = readDigests(r)
+		read += n
+		if err != nil {
+			return read, err
+		}
+	}
+	return read, nil
+}
+
+// writeDigests writes the number of digests and their size as uint32, followed
+// by the digests.
+func writeDigests(w io.Writer, digests [][]byte) (int64, error) {
+	var size int
+	if len(digests) > 0 {
+		size = len(digests[0])
+	}
+	for _, d := range digests {
+		if len(d) != size {
+			return 0, errInvalidEncoding
+		}
+	}
+
+	var buf [8]byte
+	binary.BigEndian.PutUint32(buf[:4], uint32(len(digests)))
+	binary.BigEndian.PutUint32(buf[4:], uint32(size))
+	n, err := w.Write(buf[:])
+	written := int64(n)
+	if err != nil {
+		return written, err
+	}
+	for _, d := range digests {
+		n, err = w.Write(d)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// readDigests reads digests written by writeDigests.
+func readDigests(r io.Reader) ([][]byte, int64, error) {
+	var buf [8]byte
+	n, err := io.ReadFull(r, buf[:])
+	read := int64(n)
+	if err != nil {
+		return nil, read, err
+	}
+	nbDigests := binary.BigEndian.Uint32(buf[:4])
+	size := binary.BigEndian.Uint32(buf[4:])
+	if nbDigests == 0 {
+		return nil, read, nil
+	}
+	if nbDigests > maxNbDigests || size == 0 || size > maxDigestSize {
+		return nil, read, errInvalidEncoding
+	}
+
+	res := make([][]byte, nbDigests)
+	for i := range res {
+		res[i] = make([]byte, size)
+		n, err = io.ReadFull(r, res[i])
+		read += int64(n)
+		if err != nil {
+			return nil, read, err
+		}
+	}
+	return res, read, nil
+}
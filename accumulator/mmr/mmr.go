@@ -0,0 +1,265 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package mmr
+
+import (
+	"bytes"
+	"errors"
+	"hash"
+	"io"
+	"math/bits"
+)
+
+var (
+	ErrInvalidLeaf  = errors.New("the leaf is not a valid digest")
+	ErrInvalidSize  = errors.New("invalid size of the MMR")
+	ErrInvalidIndex = errors.New("the index must be smaller than the size of the MMR")
+	ErrInvalidProof = errors.New("malformed proof")
+	ErrVerifyProof  = errors.New("can't verify proof")
+)
+
+// Hasher hashes the leaves and the nodes of an MMR.
+type Hasher interface {
+	// Leaf returns the digest of a leaf.
+	Leaf(data []byte) ([]byte, error)
+
+	// Node returns the digest of a node of given children.
+	Node(left, right []byte) []byte
+}
+
+// NewHasher returns the Hasher of h following RFC 6962: the digest of a leaf is
+// H(0x00 ‖ data) and the digest of a node H(0x01 ‖ left ‖ right). The prefixes
+// and the inputs are written separately, so that a field-native hash function
+// such as MiMC can be used as long as the leaves are valid inputs.
+func NewHasher(h hash.Hash) Hasher {
+	return &hashHasher{h: h}
+}
+
+type hashHasher struct {
+	h hash.Hash
+}
+
+func (h *hashHasher) Leaf(data []byte) ([]byte, error) {
+	h.h.Reset()
+	if _, err := h.h.Write([]byte{0x00}); err != nil {
+		return nil, err
+	}
+	if _, err := h.h.Write(data); err != nil {
+		return nil, err
+	}
+	return h.h.Sum(nil), nil
+}
+
+func (h *hashHasher) Node(left, right []byte) []byte {
+	h.h.Reset()
+	for _, d := range [][]byte{{0x01}, left, right} {
+		// the digests are valid inputs of the hash function
+		if _, err := h.h.Write(d); err != nil {
+			panic(err)
+		}
+	}
+	return h.h.Sum(nil)
+}
+
+// NewCompressionHasher returns the Hasher whose leaves are digests of given
+// size, kept as is, and whose nodes are compressed with compress, e.g. a
+// field-native compression function.
+func NewCompressionHasher(size int, compress func(left, right []byte) []byte) Hasher {
+	return &compressionHasher{size: size, compress: compress}
+}
+
+type compressionHasher struct {
+	size     int
+	compress func(left, right []byte) []byte
+}
+
+func (h *compressionHasher) Leaf(data []byte) ([]byte, error) {
+	if len(data) != h.size {
+		return nil, ErrInvalidLeaf
+	}
+	return bytes.Clone(data), nil
+}
+
+func (h *compressionHasher) Node(left, right []byte) []byte {
+	return h.compress(left, right)
+}
+
+// MMR Merkle Mountain Range, keeping all its nodes in memory.
+type MMR struct {
+	hasher Hasher
+	size   uint64
+
+	// levels[h][j] root of the perfect tree of height h of the leaves
+	// [j2ʰ, (j+1)2ʰ)
+	levels [][][]byte
+}
+
+// New returns an empty MMR.
+func New(h Hasher) *MMR {
+	return &MMR{hasher: h}
+}
+
+// Append appends a leaf to the MMR.
+func (m *MMR) Append(data []byte) error {
+	digest, err := m.hasher.Leaf(data)
+	if err != nil {
+		return err
+	}
+	for h := 0; ; h++ {
+		if h == len(m.levels) {
+			m.levels = append(m.levels, nil)
+		}
+		m.levels[h] = append(m.levels[h], digest)
+		j := len(m.levels[h]) - 1
+		if j%2 == 0 {
+			break
+		}
+		digest = m.hasher.Node(m.levels[h][j-1], m.levels[h][j])
+	}
+	m.size++
+	return nil
+}
+
+// ReadAll reads segments of size segmentSize and appends them to the MMR until
+// EOF is reached. No padding is added to the data, so the last leaf may be
+// smaller than segmentSize.
+func (m *MMR) ReadAll(r io.Reader, segmentSize int) error {
+	return readSegments(r, segmentSize, m.Append)
+}
+
+// Size returns the number of leaves of the MMR.
+func (m *MMR) Size() uint64 {
+	return m.size
+}
+
+// Root returns the root of the MMR, or nil if the MMR is empty.
+func (m *MMR) Root() []byte {
+	p, _ := m.PeaksAt(m.size)
+	return p.Root(m.hasher)
+}
+
+// RootAt returns the root of the MMR of the first size leaves.
+func (m *MMR) RootAt(size uint64) ([]byte, error) {
+	p, err := m.PeaksAt(size)
+	if err != nil {
+		return nil, err
+	}
+	return p.Root(m.hasher), nil
+}
+
+// Peaks returns the peaks of the MMR.
+func (m *MMR) Peaks() Peaks {
+	p, _ := m.PeaksAt(m.size)
+	return p
+}
+
+// PeaksAt returns the peaks of the MMR of the first size leaves.
+func (m *MMR) PeaksAt(size uint64) (Peaks, error) {
+	if size > m.size {
+		return Peaks{}, ErrInvalidSize
+	}
+	res := Peaks{Size: size}
+	for _, n := range peakNodes(size) {
+		res.Digests = append(res.Digests, m.levels[n.height][n.index])
+	}
+	return res, nil
+}
+
+// Peaks peaks of an MMR, from the highest to the lowest, which is enough to
+// compute its root and to append leaves to it.
+//
+// implements io.ReaderFrom and io.WriterTo
+type Peaks struct {
+	// Size number of leaves of the MMR
+	Size uint64
+
+	Digests [][]byte
+}
+
+// Append appends a leaf to the MMR of the peaks.
+func (p *Peaks) Append(h Hasher, data []byte) error {
+	digest, err := h.Leaf(data)
+	if err != nil {
+		return err
+	}
+	// the peaks of height smaller than the number of trailing ones of the size
+	// are merged with the new leaf
+	for i := 0; i < bits.TrailingZeros64(^p.Size); i++ {
+		digest = h.Node(p.Digests[len(p.Digests)-1], digest)
+		p.Digests = p.Digests[:len(p.Digests)-1]
+	}
+	p.Digests = append(p.Digests, digest)
+	p.Size++
+	return nil
+}
+
+// Root returns the root of the MMR of the peaks, or nil if the MMR is empty.
+func (p *Peaks) Root(h Hasher) []byte {
+	return bag(h, p.Digests)
+}
+
+// ReaderRoot returns the root of the MMR of the data read from the reader,
+// where each leaf is segmentSize long except the last one, which is not padded
+// out. Only the peaks are kept in memory.
+func ReaderRoot(r io.Reader, h Hasher, segmentSize int) ([]byte, error) {
+	var p Peaks
+	err := readSegments(r, segmentSize, func(data []byte) error {
+		return p.Append(h, data)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.Root(h), nil
+}
+
+func readSegments(r io.Reader, segmentSize int, f func([]byte) error) error {
+	for {
+		segment := make([]byte, segmentSize)
+		n, err := io.ReadFull(r, segment)
+		if err == io.EOF {
+			return nil
+		} else if err == io.ErrUnexpectedEOF {
+			// last segment, the next call will return io.EOF
+			segment = segment[:n]
+		} else if err != nil {
+			return err
+		}
+		if err := f(segment); err != nil {
+			return err
+		}
+	}
+}
+
+// bag returns the root of the MMR of given peaks, bagged from the right.
+func bag(h Hasher, peaks [][]byte) []byte {
+	if len(peaks) == 0 {
+		return nil
+	}
+	res := peaks[len(peaks)-1]
+	for i := len(peaks) - 2; i >= 0; i-- {
+		res = h.Node(peaks[i], res)
+	}
+	return res
+}
+
+// node position of a node of an MMR: the root of the perfect tree of height
+// height of the leaves [index·2ʰ, (index+1)·2ʰ).
+type node struct {
+	height int
+	index  uint64
+}
+
+// peakNodes returns the positions of the peaks of the MMR of size leaves, from
+// the highest.
+func peakNodes(size uint64) []node {
+	res := make([]node, 0, bits.OnesCount64(size))
+	var start uint64
+	for h := 63; h >= 0; h-- {
+		if (size>>h)&1 == 1 {
+			res = append(res, node{height: h, index: start >> h})
+			start += 1 << h
+		}
+	}
+	return res
+}
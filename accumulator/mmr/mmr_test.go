@@ -0,0 +1,297 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package mmr
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/merkletree"
+	_ "github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/poseidon2"
+	"github.com/consensys/gnark-crypto/hash"
+	"github.com/stretchr/testify/require"
+)
+
+func randomLeaves(n, size int) [][]byte {
+	res := make([][]byte, n)
+	for i := range res {
+		res[i] = make([]byte, size)
+		if _, err := rand.Read(res[i]); err != nil {
+			panic(err)
+		}
+	}
+	return res
+}
+
+func randomFrLeaves(n int) [][]byte {
+	res := make([][]byte, n)
+	for i := range res {
+		var e fr.Element
+		e.SetRandom()
+		b := e.Bytes()
+		res[i] = b[:]
+	}
+	return res
+}
+
+// referenceRoot computes the root of the MMR from the definition.
+func referenceRoot(h Hasher, leaves [][]byte) []byte {
+	var tree func(leaves [][]byte) []byte
+	tree = func(leaves [][]byte) []byte {
+		if len(leaves) == 1 {
+			d, err := h.Leaf(leaves[0])
+			if err != nil {
+				panic(err)
+			}
+			return d
+		}
+		return h.Node(tree(leaves[:len(leaves)/2]), tree(leaves[len(leaves)/2:]))
+	}
+	var peaks [][]byte
+	for _, n := range peakNodes(uint64(len(leaves))) {
+		start := n.index << n.height
+		peaks = append(peaks, tree(leaves[start:start+1<<n.height]))
+	}
+	return bag(h, peaks)
+}
+
+// newPoseidon2Hasher returns a Hasher from the field-native Poseidon2
+// compression of the Merkle trees of BN254.
+func newPoseidon2Hasher() Hasher {
+	p := poseidon2.NewHash(2*merkletree.DigestSize, 6, 50, "seed")
+	c, err := merkletree.NewPermutationCompression(&p)
+	if err != nil {
+		panic(err)
+	}
+	return NewCompressionHasher(merkletree.DigestSize*fr.Bytes, func(left, right []byte) []byte {
+		var l, r merkletree.Digest
+		for i := range l {
+			l[i].SetBytes(left[i*fr.Bytes : (i+1)*fr.Bytes])
+			r[i].SetBytes(right[i*fr.Bytes : (i+1)*fr.Bytes])
+		}
+		d := c.Compress(&l, &r)
+		res := make([]byte, 0, len(left))
+		for i := range d {
+			b := d[i].Bytes()
+			res = append(res, b[:]...)
+		}
+		return res
+	})
+}
+
+var testHashers = []struct {
+	name   string
+	h      Hasher
+	leaves func(n int) [][]byte
+}{
+	{"sha256", NewHasher(sha256.New()), func(n int) [][]byte { return randomLeaves(n, 50) }},
+	{"mimc_bn254", NewHasher(hash.MIMC_BN254.New()), randomFrLeaves},
+	{"poseidon2_bn254", newPoseidon2Hasher(), randomFrLeaves},
+}
+
+func TestRoot(t *testing.T) {
+	for _, tc := range testHashers {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			leaves := tc.leaves(40)
+			m := New(tc.h)
+			assert.Nil(m.Root())
+			var p Peaks
+			for i := range leaves {
+				assert.NoError(m.Append(leaves[i]))
+				assert.NoError(p.Append(tc.h, leaves[i]))
+				expected := referenceRoot(tc.h, leaves[:i+1])
+				assert.Equal(expected, m.Root())
+				assert.Equal(expected, p.Root(tc.h))
+				assert.Equal(m.Peaks(), p)
+			}
+			for size := uint64(1); size <= m.Size(); size++ {
+				root, err := m.RootAt(size)
+				assert.NoError(err)
+				assert.Equal(referenceRoot(tc.h, leaves[:size]), root)
+			}
+			_, err := m.RootAt(41)
+			assert.ErrorIs(err, ErrInvalidSize)
+		})
+	}
+}
+
+func TestReaderRoot(t *testing.T) {
+	assert := require.New(t)
+
+	h := NewHasher(sha256.New())
+	data := randomLeaves(1, 1000)[0]
+	for _, segmentSize := range []int{1, 64, 100, 333, 1000, 2000} {
+		var leaves [][]byte
+		for i := 0; i < len(data); i += segmentSize {
+			leaves = append(leaves, data[i:min(i+segmentSize, len(data))])
+		}
+		root, err := ReaderRoot(bytes.NewReader(data), h, segmentSize)
+		assert.NoError(err)
+		assert.Equal(referenceRoot(h, leaves), root)
+
+		m := New(h)
+		assert.NoError(m.ReadAll(bytes.NewReader(data), segmentSize))
+		assert.Equal(uint64(len(leaves)), m.Size())
+		assert.Equal(root, m.Root())
+	}
+
+	// field-native compression with leaves of the wrong size
+	_, err := ReaderRoot(bytes.NewReader(data), newPoseidon2Hasher(), 33)
+	assert.ErrorIs(err, ErrInvalidLeaf)
+}
+
+func TestInclusionProof(t *testing.T) {
+	for _, tc := range testHashers {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			leaves := tc.leaves(21)
+			m := New(tc.h)
+			for i := range leaves {
+				assert.NoError(m.Append(leaves[i]))
+			}
+
+			// proofs against all the previous roots
+			for size := uint64(1); size <= m.Size(); size++ {
+				root, err := m.RootAt(size)
+				assert.NoError(err)
+				for index := uint64(0); index < size; index++ {
+					proof, err := m.Prove(index, size)
+					assert.NoError(err)
+					assert.NoError(VerifyInclusion(tc.h, root, size, index, leaves[index], &proof))
+
+					other := leaves[(index+1)%size]
+					if size > 1 {
+						assert.ErrorIs(VerifyInclusion(tc.h, root, size, index, other, &proof), ErrVerifyProof)
+					}
+					if index+1 < size && len(proof.Path) > 0 {
+						assert.Error(VerifyInclusion(tc.h, root, size, index+1, leaves[index], &proof))
+					}
+				}
+			}
+
+			root := m.Root()
+			proof, err := m.Prove(5, m.Size())
+			assert.NoError(err)
+			proof.Path = proof.Path[1:]
+			assert.ErrorIs(VerifyInclusion(tc.h, root, m.Size(), 5, leaves[5], &proof), ErrInvalidProof)
+			_, err = m.Prove(21, 21)
+			assert.ErrorIs(err, ErrInvalidIndex)
+			_, err = m.Prove(0, 22)
+			assert.ErrorIs(err, ErrInvalidSize)
+		})
+	}
+}
+
+func TestConsistencyProof(t *testing.T) {
+	for _, tc := range testHashers {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			leaves := tc.leaves(21)
+			m := New(tc.h)
+			for i := range leaves {
+				assert.NoError(m.Append(leaves[i]))
+			}
+
+			for newSize := uint64(1); newSize <= m.Size(); newSize++ {
+				newRoot, err := m.RootAt(newSize)
+				assert.NoError(err)
+				for oldSize := uint64(1); oldSize <= newSize; oldSize++ {
+					oldRoot, err := m.RootAt(oldSize)
+					assert.NoError(err)
+					proof, err := m.ProveConsistency(oldSize, newSize)
+					assert.NoError(err)
+					assert.NoError(VerifyConsistency(tc.h, oldSize, newSize, oldRoot, newRoot, &proof))
+
+					// a different previous MMR
+					if oldSize > 1 {
+						otherRoot, err := m.RootAt(oldSize - 1)
+						assert.NoError(err)
+						assert.Error(VerifyConsistency(tc.h, oldSize, newSize, otherRoot, newRoot, &proof))
+					}
+					if len(proof.Nodes) > 0 {
+						proof.Nodes[0] = proof.OldPeaks[0]
+						assert.ErrorIs(VerifyConsistency(tc.h, oldSize, newSize, oldRoot, newRoot, &proof), ErrVerifyProof)
+					}
+				}
+			}
+
+			// an MMR which does not extend the previous one
+			other := New(tc.h)
+			otherLeaves := append(tc.leaves(1), leaves[1:]...)
+			for i := range otherLeaves {
+				assert.NoError(other.Append(otherLeaves[i]))
+			}
+			oldRoot, err := m.RootAt(7)
+			assert.NoError(err)
+			proof, err := other.ProveConsistency(7, 21)
+			assert.NoError(err)
+			assert.ErrorIs(VerifyConsistency(tc.h, 7, 21, oldRoot, other.Root(), &proof), ErrVerifyProof)
+
+			_, err = m.ProveConsistency(0, 3)
+			assert.ErrorIs(err, ErrInvalidSize)
+			_, err = m.ProveConsistency(4, 3)
+			assert.ErrorIs(err, ErrInvalidSize)
+		})
+	}
+}
+
+func TestSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	h := NewHasher(sha256.New())
+	m := New(h)
+	for _, l := range randomLeaves(13, 20) {
+		assert.NoError(m.Append(l))
+	}
+
+	peaks := m.Peaks()
+	var buf bytes.Buffer
+	written, err := peaks.WriteTo(&buf)
+	assert.NoError(err)
+	var decodedPeaks Peaks
+	read, err := decodedPeaks.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(peaks, decodedPeaks)
+
+	proof, err := m.Prove(9, 13)
+	assert.NoError(err)
+	buf.Reset()
+	written, err = proof.WriteTo(&buf)
+	assert.NoError(err)
+	var decodedProof InclusionProof
+	read, err = decodedProof.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(proof, decodedProof)
+
+	consistency, err := m.ProveConsistency(5, 13)
+	assert.NoError(err)
+	buf.Reset()
+	written, err = consistency.WriteTo(&buf)
+	assert.NoError(err)
+	var decodedConsistency ConsistencyProof
+	read, err = decodedConsistency.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(consistency, decodedConsistency)
+}
+
+func BenchmarkAppend(b *testing.B) {
+	h := NewHasher(sha256.New())
+	leaves := randomLeaves(1024, 32)
+	m := New(h)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m.Append(leaves[i%len(leaves)])
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package mmr
+
+import (
+	"bytes"
+	"math/bits"
+)
+
+// InclusionProof proof that a leaf belongs to an MMR.
+//
+// implements io.ReaderFrom and io.WriterTo
+type InclusionProof struct {
+	// Path siblings of the path from the leaf to its peak
+	Path [][]byte
+
+	// Peaks the other peaks of the MMR, from the highest
+	Peaks [][]byte
+}
+
+// ConsistencyProof proof that an MMR extends a previous one.
+//
+// implements io.ReaderFrom and io.WriterTo
+type ConsistencyProof struct {
+	// OldPeaks peaks of the previous MMR
+	OldPeaks [][]byte
+
+	// Nodes nodes of the MMR which, together with the old peaks, give its
+	// peaks, in the order of a depth-first traversal from the left
+	Nodes [][]byte
+}
+
+// Prove returns a proof that the leaf of given index belongs to the MMR of the
+// first size leaves.
+func (m *MMR) Prove(index, size uint64) (InclusionProof, error) {
+	if size == 0 || size > m.size {
+		return InclusionProof{}, ErrInvalidSize
+	}
+	if index >= size {
+		return InclusionProof{}, ErrInvalidIndex
+	}
+
+	var proof InclusionProof
+	peaks, i := peakNodes(size), peakOf(index, size)
+	for l := 0; l < peaks[i].height; l++ {
+		proof.Path = append(proof.Path, m.levels[l][(index>>l)^1])
+	}
+	for k, n := range peaks {
+		if k != i {
+			proof.Peaks = append(proof.Peaks, m.levels[n.height][n.index])
+		}
+	}
+	return proof, nil
+}
+
+// VerifyInclusion verifies that data is the leaf of given index of the MMR of
+// given size and root.
+func VerifyInclusion(h Hasher, root []byte, size, index uint64, data []byte, proof *InclusionProof) error {
+	if size == 0 {
+		return ErrInvalidSize
+	}
+	if index >= size {
+		return ErrInvalidIndex
+	}
+	peaks, i := peakNodes(size), peakOf(index, size)
+	if len(proof.Path) != peaks[i].height || len(proof.Peaks) != len(peaks)-1 {
+		return ErrInvalidProof
+	}
+
+	digest, err := h.Leaf(data)
+	if err != nil {
+		return err
+	}
+	for l, sibling := range proof.Path {
+		if (index>>l)&1 == 0 {
+			digest = h.Node(digest, sibling)
+		} else {
+			digest = h.Node(sibling, digest)
+		}
+	}
+
+	digests := make([][]byte, 0, len(peaks))
+	digests = append(digests, proof.Peaks[:i]...)
+	digests = append(digests, digest)
+	digests = append(digests, proof.Peaks[i:]...)
+	if !bytes.Equal(bag(h, digests), root) {
+		return ErrVerifyProof
+	}
+	return nil
+}
+
+// ProveConsistency returns a proof that the MMR of the first newSize leaves
+// extends the MMR of the first oldSize leaves.
+func (m *MMR) ProveConsistency(oldSize, newSize uint64) (ConsistencyProof, error) {
+	if oldSize == 0 || oldSize > newSize || newSize > m.size {
+		return ConsistencyProof{}, ErrInvalidSize
+	}
+
+	var proof ConsistencyProof
+	for _, n := range peakNodes(oldSize) {
+		proof.OldPeaks = append(proof.OldPeaks, m.levels[n.height][n.index])
+	}
+
+	// collect appends the nodes entirely on the right of the old leaves whose
+	// parents are not
+	var collect func(n node)
+	collect = func(n node) {
+		start, end := n.index<<n.height, (n.index+1)<<n.height
+		if end <= oldSize {
+			// an old peak
+			return
+		}
+		if start >= oldSize {
+			proof.Nodes = append(proof.Nodes, m.levels[n.height][n.index])
+			return
+		}
+		collect(node{height: n.height - 1, index: 2 * n.index})
+		collect(node{height: n.height - 1, index: 2*n.index + 1})
+	}
+	for _, n := range peakNodes(newSize) {
+		collect(n)
+	}
+	return proof, nil
+}
+
+// VerifyConsistency verifies that the MMR of newSize leaves and root newRoot
+// extends the MMR of oldSize leaves and root oldRoot.
+func VerifyConsistency(h Hasher, oldSize, newSize uint64, oldRoot, newRoot []byte, proof *ConsistencyProof) error {
+	if oldSize == 0 || oldSize > newSize {
+		return ErrInvalidSize
+	}
+	oldPeaks := peakNodes(oldSize)
+	if len(proof.OldPeaks) != len(oldPeaks) {
+		return ErrInvalidProof
+	}
+	if !bytes.Equal(bag(h, proof.OldPeaks), oldRoot) {
+		return ErrVerifyProof
+	}
+
+	// the new peaks are computed following the traversal of the prover, which
+	// meets the old peaks from the left
+	nbOld, nodes := 0, proof.Nodes
+	var compute func(n node) ([]byte, error)
+	compute = func(n node) ([]byte, error) {
+		start, end := n.index<<n.height, (n.index+1)<<n.height
+		if end <= oldSize {
+			if nbOld == len(oldPeaks) || oldPeaks[nbOld] != n {
+				return nil, ErrInvalidProof
+			}
+			nbOld++
+			return proof.OldPeaks[nbOld-1], nil
+		}
+		if start >= oldSize {
+			if len(nodes) == 0 {
+				return nil, ErrInvalidProof
+			}
+			res := nodes[0]
+			nodes = nodes[1:]
+			return res, nil
+		}
+		left, err := compute(node{height: n.height - 1, index: 2 * n.index})
+		if err != nil {
+			return nil, err
+		}
+		right, err := compute(node{height: n.height - 1, index: 2*n.index + 1})
+		if err != nil {
+			return nil, err
+		}
+		return h.Node(left, right), nil
+	}
+
+	newPeaks := peakNodes(newSize)
+	digests := make([][]byte, len(newPeaks))
+	for i, n := range newPeaks {
+		var err error
+		if digests[i], err = compute(n); err != nil {
+			return err
+		}
+	}
+	if nbOld != len(oldPeaks) || len(nodes) != 0 {
+		return ErrInvalidProof
+	}
+	if !bytes.Equal(bag(h, digests), newRoot) {
+		return ErrVerifyProof
+	}
+	return nil
+}
+
+// peakOf returns the index of the peak, in peakNodes(size), of the tree
+// containing the leaf of given index.
+func peakOf(index, size uint64) int {
+	// the peaks are given by the bits of size, and the leaf belongs to the
+	// tree of the highest bit where index and size differ
+	return bits.OnesCount64(size>>(63-bits.LeadingZeros64(index^size))) - 1
+}
@@ -0,0 +1,26 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package smt provides a sparse Merkle tree, that is, a binary Merkle tree of
+// depth 256 committing to a map from 256-bit keys to values.
+//
+// The path of a key is given by its bits, from the most significant bit of
+// its first byte. A node whose subtree has no key has the default digest of
+// its height:
+//
+//	d₀ = 0…0, dᵢ₊₁ = H(dᵢ ‖ dᵢ)
+//
+// and a leaf holding a value v for a key k = k₀ ‖ k₁ has the digest
+// H(k₀ ‖ k₁ ‖ v), where k₀, k₁ are the 128-bit halves of k, written separately
+// to the hash function so that they are valid inputs of field-native hash
+// functions such as MiMC. The same holds for the values, which must be valid
+// inputs of the hash function.
+//
+// The same proof structure serves as an inclusion proof, of the value of a
+// key, and as an exclusion proof, of the absence of a key, in which case the
+// leaf is the default one. Proofs are compressed: only the siblings which are
+// not default digests are included, the others being flagged in a bitmap.
+//
+// The nodes which are not default digests and the values are kept in a Store,
+// so that the tree only uses memory or storage for the keys it holds.
+package smt
@@ -0,0 +1,190 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package smt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"io"
+	"math/bits"
+)
+
+var (
+	ErrInvalidProof = errors.New("malformed proof")
+	ErrVerifyProof  = errors.New("can't verify proof")
+)
+
+// maxDigestSize bound on the size of the digests read by Proof.ReadFrom
+const maxDigestSize = 1024
+
+// Proof proof of inclusion of the value of a key, or of exclusion of a key.
+//
+// implements io.ReaderFrom and io.WriterTo
+type Proof struct {
+	// Bitmap the bit i, (Bitmap[i/8] >> (i%8)) & 1, is set if the sibling at
+	// height i of the path of the key is not a default digest
+	Bitmap [Depth / 8]byte
+
+	// Siblings siblings which are not default digests, by increasing height
+	Siblings [][]byte
+}
+
+// Verifier verifies proofs for trees of a given hash function.
+type Verifier struct {
+	hasher
+}
+
+// NewVerifier returns a verifier for the trees of hash function h.
+func NewVerifier(h hash.Hash) *Verifier {
+	return &Verifier{hasher: newHasher(h)}
+}
+
+// VerifyInclusion verifies that the value of key is value in the tree of given
+// root.
+func (v *Verifier) VerifyInclusion(root, key, value []byte, proof *Proof) error {
+	if len(value) == 0 {
+		return ErrVerifyProof
+	}
+	return v.verify(root, key, value, proof)
+}
+
+// VerifyExclusion verifies that key is absent from the tree of given root.
+func (v *Verifier) VerifyExclusion(root, key []byte, proof *Proof) error {
+	return v.verify(root, key, nil, proof)
+}
+
+// verify verifies the proof of the leaf of key holding value, or of the
+// default leaf if value is empty.
+func (h *hasher) verify(root, key, value []byte, proof *Proof) error {
+	if len(key) != KeySize {
+		return ErrInvalidKey
+	}
+	nbSiblings := 0
+	for i := range proof.Bitmap {
+		nbSiblings += bits.OnesCount8(proof.Bitmap[i])
+	}
+	if nbSiblings != len(proof.Siblings) {
+		return ErrInvalidProof
+	}
+
+	digest := h.defaults[0]
+	if len(value) != 0 {
+		var err error
+		if digest, err = h.leaf(key, value); err != nil {
+			return err
+		}
+	}
+	siblings := proof.Siblings
+	for i := 0; i < Depth; i++ {
+		sibling := h.defaults[i]
+		if (proof.Bitmap[i/8]>>(i%8))&1 == 1 {
+			sibling, siblings = siblings[0], siblings[1:]
+			if len(sibling) != len(digest) {
+				return ErrInvalidProof
+			}
+		}
+		var err error
+		if bit(key, Depth-1-i) == 0 {
+			digest, err = h.node(digest, sibling)
+		} else {
+			digest, err = h.node(sibling, digest)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if !bytes.Equal(digest, root) {
+		return ErrVerifyProof
+	}
+	return nil
+}
+
+// VerifyInclusion verifies that the value of key is value in the tree of given
+// root, with the hash function of t.
+func (t *Tree) VerifyInclusion(root, key, value []byte, proof *Proof) error {
+	if len(value) == 0 {
+		return ErrVerifyProof
+	}
+	return t.verify(root, key, value, proof)
+}
+
+// VerifyExclusion verifies that key is absent from the tree of given root, with
+// the hash function of t.
+func (t *Tree) VerifyExclusion(root, key []byte, proof *Proof) error {
+	return t.verify(root, key, nil, proof)
+}
+
+// WriteTo writes the bitmap, the size of the digests as a uint32 and the
+// siblings.
+func (p *Proof) WriteTo(w io.Writer) (int64, error) {
+	var size uint32
+	if len(p.Siblings) > 0 {
+		size = uint32(len(p.Siblings[0]))
+	}
+	for _, s := range p.Siblings {
+		if len(s) != int(size) {
+			return 0, ErrInvalidProof
+		}
+	}
+
+	n, err := w.Write(p.Bitmap[:])
+	written := int64(n)
+	if err != nil {
+		return written, err
+	}
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], size)
+	n, err = w.Write(buf[:])
+	written += int64(n)
+	if err != nil {
+		return written, err
+	}
+	for _, s := range p.Siblings {
+		n, err = w.Write(s)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// ReadFrom reads a proof written by WriteTo.
+func (p *Proof) ReadFrom(r io.Reader) (int64, error) {
+	n, err := io.ReadFull(r, p.Bitmap[:])
+	read := int64(n)
+	if err != nil {
+		return read, err
+	}
+	var buf [4]byte
+	n, err = io.ReadFull(r, buf[:])
+	read += int64(n)
+	if err != nil {
+		return read, err
+	}
+	size := binary.BigEndian.Uint32(buf[:])
+
+	nbSiblings := 0
+	for i := range p.Bitmap {
+		nbSiblings += bits.OnesCount8(p.Bitmap[i])
+	}
+	if nbSiblings > 0 && (size == 0 || size > maxDigestSize) {
+		return read, ErrInvalidProof
+	}
+	p.Siblings = nil
+	if nbSiblings > 0 {
+		p.Siblings = make([][]byte, nbSiblings)
+	}
+	for i := range p.Siblings {
+		p.Siblings[i] = make([]byte, size)
+		n, err = io.ReadFull(r, p.Siblings[i])
+		read += int64(n)
+		if err != nil {
+			return read, err
+		}
+	}
+	return read, nil
+}
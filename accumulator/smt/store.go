@@ -0,0 +1,64 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package smt
+
+import "sync"
+
+// Store key-value store backing a tree. The tree stores the digests of the
+// nodes which are not default digests and the values of the keys.
+//
+// A Store may be persistent, in which case a tree can be reopened with New
+// from the same store and hash function.
+type Store interface {
+	// Get returns the value of key and true, or false if the key is absent.
+	Get(key []byte) (value []byte, found bool, err error)
+
+	// Set sets the value of key. The store may keep value without copying it.
+	Set(key, value []byte) error
+
+	// Delete deletes key. Deleting an absent key is not an error.
+	Delete(key []byte) error
+}
+
+// MemoryStore in-memory Store, safe for concurrent use.
+type MemoryStore struct {
+	lock sync.RWMutex
+	m    map[string][]byte
+}
+
+// NewMemoryStore returns an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{m: make(map[string][]byte)}
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(key []byte) ([]byte, bool, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	v, ok := s.m[string(key)]
+	return v, ok, nil
+}
+
+// Set implements Store.
+func (s *MemoryStore) Set(key, value []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.m[string(key)] = value
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(key []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.m, string(key))
+	return nil
+}
+
+// Len returns the number of entries of the store.
+func (s *MemoryStore) Len() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return len(s.m)
+}
@@ -0,0 +1,290 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package smt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"sort"
+)
+
+const (
+	// KeySize size of the keys in bytes.
+	KeySize = 32
+
+	// Depth depth of the trees.
+	Depth = 8 * KeySize
+)
+
+var (
+	ErrInvalidKey   = errors.New("the keys must be of 32 bytes")
+	ErrDuplicateKey = errors.New("duplicate key in batch")
+	ErrNbValues     = errors.New("the numbers of keys and values differ")
+)
+
+// prefixes of the keys of the store
+const (
+	nodePrefix  = 'n'
+	valuePrefix = 'v'
+)
+
+// hasher hash function with the default digests of the subtrees.
+type hasher struct {
+	h hash.Hash
+
+	// defaults[i] digest of an empty subtree of height i
+	defaults [Depth + 1][]byte
+}
+
+func newHasher(h hash.Hash) hasher {
+	res := hasher{h: h}
+	res.defaults[0] = make([]byte, h.Size())
+	for i := 1; i <= Depth; i++ {
+		var err error
+		if res.defaults[i], err = res.node(res.defaults[i-1], res.defaults[i-1]); err != nil {
+			// the zero digest and the digests of h are valid inputs of h
+			panic(err)
+		}
+	}
+	return res
+}
+
+// sum returns H(data[0] ‖ data[1] ‖ …), the slices being written separately.
+// The Hash interface specifies that Write never returns an error, but
+// field-native hash functions reject non-canonical inputs.
+func (h *hasher) sum(data ...[]byte) ([]byte, error) {
+	h.h.Reset()
+	for _, d := range data {
+		if _, err := h.h.Write(d); err != nil {
+			return nil, err
+		}
+	}
+	return h.h.Sum(nil), nil
+}
+
+// node returns the digest of a node of given children.
+func (h *hasher) node(left, right []byte) ([]byte, error) {
+	return h.sum(left, right)
+}
+
+// leaf returns the digest of the leaf of key holding value.
+func (h *hasher) leaf(key, value []byte) ([]byte, error) {
+	return h.sum(key[:KeySize/2], key[KeySize/2:], value)
+}
+
+// Tree sparse Merkle tree of a key-value map, backed by a Store.
+//
+// A Tree is not safe for concurrent use.
+type Tree struct {
+	hasher
+	store Store
+}
+
+// New returns the tree of hash function h backed by store, which is either
+// empty or holds a tree previously built with the same hash function.
+func New(h hash.Hash, store Store) *Tree {
+	return &Tree{
+		hasher: newHasher(h),
+		store:  store,
+	}
+}
+
+// Root returns the root of the tree.
+func (t *Tree) Root() ([]byte, error) {
+	return t.getNode(Depth, make([]byte, KeySize))
+}
+
+// Get returns the value of key, or nil if the key is absent.
+func (t *Tree) Get(key []byte) ([]byte, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	v, found, err := t.store.Get(valueKey(key))
+	if err != nil || !found {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Set sets the value of key. An empty value deletes the key.
+func (t *Tree) Set(key, value []byte) error {
+	return t.Update([][]byte{key}, [][]byte{value})
+}
+
+// Delete deletes key. Deleting an absent key is not an error.
+func (t *Tree) Delete(key []byte) error {
+	return t.Update([][]byte{key}, [][]byte{nil})
+}
+
+// Update sets the values of keys as a batch, an empty value deleting its key.
+// The nodes on the paths of several keys are computed and stored once. The
+// leaves are hashed before the store is modified, so that a value rejected by
+// the hash function leaves the tree unchanged.
+func (t *Tree) Update(keys, values [][]byte) error {
+	if len(keys) != len(values) {
+		return ErrNbValues
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	order := make([]int, len(keys))
+	for i := range order {
+		if len(keys[i]) != KeySize {
+			return ErrInvalidKey
+		}
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return bytes.Compare(keys[order[i]], keys[order[j]]) < 0
+	})
+	sortedKeys := make([][]byte, len(keys))
+	sortedValues := make([][]byte, len(keys))
+	for i, j := range order {
+		sortedKeys[i], sortedValues[i] = keys[j], values[j]
+		if i > 0 && bytes.Equal(sortedKeys[i], sortedKeys[i-1]) {
+			return ErrDuplicateKey
+		}
+	}
+	leaves := make([][]byte, len(keys))
+	for i := range leaves {
+		leaves[i] = t.defaults[0]
+		if len(sortedValues[i]) != 0 {
+			var err error
+			if leaves[i], err = t.leaf(sortedKeys[i], sortedValues[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := t.update(Depth, sortedKeys, sortedValues, leaves)
+	return err
+}
+
+// update updates the subtree of given height containing the sorted keys, the
+// digests of their leaves being given, and returns its digest.
+func (t *Tree) update(height int, keys, values, leaves [][]byte) ([]byte, error) {
+	if height == 0 {
+		return leaves[0], t.updateLeaf(keys[0], values[0], leaves[0])
+	}
+
+	// the keys going to the left child, whose bit at the depth of the children
+	// is 0, come first
+	d := Depth - height
+	split := sort.Search(len(keys), func(i int) bool {
+		return bit(keys[i], d) == 1
+	})
+
+	var left, right []byte
+	var err error
+	if split > 0 {
+		left, err = t.update(height-1, keys[:split], values[:split], leaves[:split])
+	} else {
+		left, err = t.getNode(height-1, flip(keys[0], d))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if split < len(keys) {
+		right, err = t.update(height-1, keys[split:], values[split:], leaves[split:])
+	} else {
+		right, err = t.getNode(height-1, flip(keys[0], d))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := t.node(left, right)
+	if err != nil {
+		return nil, err
+	}
+	return digest, t.setNode(height, keys[0], digest)
+}
+
+// updateLeaf stores value and the digest of its leaf.
+func (t *Tree) updateLeaf(key, value, digest []byte) error {
+	if len(value) == 0 {
+		if err := t.store.Delete(valueKey(key)); err != nil {
+			return err
+		}
+	} else if err := t.store.Set(valueKey(key), bytes.Clone(value)); err != nil {
+		return err
+	}
+	return t.setNode(0, key, digest)
+}
+
+// getNode returns the digest of the node of given height on the path of key.
+func (t *Tree) getNode(height int, key []byte) ([]byte, error) {
+	digest, found, err := t.store.Get(nodeKey(height, key))
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return t.defaults[height], nil
+	}
+	return digest, nil
+}
+
+// setNode sets the digest of the node of given height on the path of key,
+// default digests being deleted from the store.
+func (t *Tree) setNode(height int, key, digest []byte) error {
+	if bytes.Equal(digest, t.defaults[height]) {
+		return t.store.Delete(nodeKey(height, key))
+	}
+	return t.store.Set(nodeKey(height, key), digest)
+}
+
+// Prove returns the value of key, or nil if the key is absent, with a proof
+// of inclusion of the value, or of exclusion of the key.
+func (t *Tree) Prove(key []byte) ([]byte, Proof, error) {
+	var proof Proof
+	value, err := t.Get(key)
+	if err != nil {
+		return nil, proof, err
+	}
+	for i := 0; i < Depth; i++ {
+		sibling, err := t.getNode(i, flip(key, Depth-1-i))
+		if err != nil {
+			return nil, proof, err
+		}
+		if !bytes.Equal(sibling, t.defaults[i]) {
+			proof.Bitmap[i/8] |= 1 << (i % 8)
+			proof.Siblings = append(proof.Siblings, sibling)
+		}
+	}
+	return value, proof, nil
+}
+
+// nodeKey returns the key in the store of the node of given height on the path
+// of key: the height followed by the first Depth - height bits of key.
+func nodeKey(height int, key []byte) []byte {
+	res := make([]byte, 3+KeySize)
+	res[0] = nodePrefix
+	binary.BigEndian.PutUint16(res[1:3], uint16(height))
+	path := res[3:]
+	copy(path, key)
+	for d := Depth - height; d < Depth; d++ {
+		path[d/8] &^= 1 << (7 - d%8)
+	}
+	return res
+}
+
+// valueKey returns the key in the store of the value of key.
+func valueKey(key []byte) []byte {
+	return append([]byte{valuePrefix}, key...)
+}
+
+// bit returns the bit of key at depth d, from the most significant bit of the
+// first byte.
+func bit(key []byte, d int) uint8 {
+	return (key[d/8] >> (7 - d%8)) & 1
+}
+
+// flip returns a copy of key with the bit at depth d flipped.
+func flip(key []byte, d int) []byte {
+	res := bytes.Clone(key)
+	res[d/8] ^= 1 << (7 - d%8)
+	return res
+}
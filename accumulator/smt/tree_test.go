@@ -0,0 +1,308 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package smt
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	gohash "hash"
+	"maps"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	_ "github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+	"github.com/consensys/gnark-crypto/hash"
+	"github.com/stretchr/testify/require"
+)
+
+// testHashes hash functions of the tests, with a function returning random
+// values which are valid inputs of the hash function.
+var testHashes = []struct {
+	name   string
+	h      func() gohash.Hash
+	values func(n int) [][]byte
+}{
+	{"sha256", sha256.New, randomBytes(40)},
+	{"mimc_bn254", hash.MIMC_BN254.New, randomFrElements},
+}
+
+func randomBytes(size int) func(n int) [][]byte {
+	return func(n int) [][]byte {
+		res := make([][]byte, n)
+		for i := range res {
+			res[i] = make([]byte, size)
+			if _, err := rand.Read(res[i]); err != nil {
+				panic(err)
+			}
+		}
+		return res
+	}
+}
+
+func randomFrElements(n int) [][]byte {
+	res := make([][]byte, n)
+	for i := range res {
+		var e fr.Element
+		e.SetRandom()
+		b := e.Bytes()
+		res[i] = b[:]
+	}
+	return res
+}
+
+var randomKeys = randomBytes(KeySize)
+
+func TestTree(t *testing.T) {
+	for _, tc := range testHashes {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			store := NewMemoryStore()
+			tree := New(tc.h(), store)
+			root, err := tree.Root()
+			assert.NoError(err)
+			assert.Equal(tree.defaults[Depth], root)
+
+			keys, values := randomKeys(20), tc.values(20)
+			for i := range keys {
+				assert.NoError(tree.Set(keys[i], values[i]))
+			}
+			for i := range keys {
+				v, err := tree.Get(keys[i])
+				assert.NoError(err)
+				assert.Equal(values[i], v)
+			}
+			v, err := tree.Get(randomKeys(1)[0])
+			assert.NoError(err)
+			assert.Nil(v)
+
+			// the tree is reopened from its store
+			root, err = tree.Root()
+			assert.NoError(err)
+			reopened, err := New(tc.h(), store).Root()
+			assert.NoError(err)
+			assert.Equal(root, reopened)
+
+			// the root only depends on the content of the map
+			other := New(tc.h(), NewMemoryStore())
+			for i := len(keys) - 1; i >= 0; i-- {
+				assert.NoError(other.Set(keys[i], values[i]))
+			}
+			otherRoot, err := other.Root()
+			assert.NoError(err)
+			assert.Equal(root, otherRoot)
+
+			// overwrite and delete all the keys
+			assert.NoError(tree.Set(keys[0], values[1]))
+			newRoot, err := tree.Root()
+			assert.NoError(err)
+			assert.NotEqual(root, newRoot)
+			for i := range keys {
+				assert.NoError(tree.Delete(keys[i]))
+			}
+			root, err = tree.Root()
+			assert.NoError(err)
+			assert.Equal(tree.defaults[Depth], root)
+			assert.Equal(0, store.Len(), "the store must only hold non-default nodes")
+
+			assert.ErrorIs(tree.Set(keys[0][1:], values[0]), ErrInvalidKey)
+			_, err = tree.Get(keys[0][1:])
+			assert.ErrorIs(err, ErrInvalidKey)
+		})
+	}
+}
+
+func TestBatchUpdate(t *testing.T) {
+	for _, tc := range testHashes {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			keys, values := randomKeys(50), tc.values(50)
+
+			// keys sharing long prefixes
+			keys[1] = bytes.Clone(keys[0])
+			keys[1][KeySize-1] ^= 1
+			keys[2] = bytes.Clone(keys[0])
+			keys[2][0] ^= 0x80
+
+			batch := New(tc.h(), NewMemoryStore())
+			assert.NoError(batch.Update(keys, values))
+			sequential := New(tc.h(), NewMemoryStore())
+			for i := range keys {
+				assert.NoError(sequential.Set(keys[i], values[i]))
+			}
+			batchRoot, err := batch.Root()
+			assert.NoError(err)
+			sequentialRoot, err := sequential.Root()
+			assert.NoError(err)
+			assert.Equal(sequentialRoot, batchRoot)
+
+			// batch of inserts, updates and deletes
+			updatedKeys := append(randomKeys(10), keys[:20]...)
+			updatedValues := append(tc.values(15), make([][]byte, 15)...)
+			assert.NoError(batch.Update(updatedKeys, updatedValues))
+			for i := range updatedKeys {
+				assert.NoError(sequential.Set(updatedKeys[i], updatedValues[i]))
+			}
+			batchRoot, err = batch.Root()
+			assert.NoError(err)
+			sequentialRoot, err = sequential.Root()
+			assert.NoError(err)
+			assert.Equal(sequentialRoot, batchRoot)
+			for i := range updatedKeys {
+				v, err := batch.Get(updatedKeys[i])
+				assert.NoError(err)
+				if len(updatedValues[i]) == 0 {
+					assert.Nil(v)
+				} else {
+					assert.Equal(updatedValues[i], v)
+				}
+			}
+
+			assert.ErrorIs(batch.Update(append(keys[:2:2], keys[0]), tc.values(3)), ErrDuplicateKey)
+			assert.ErrorIs(batch.Update(keys[:2], tc.values(3)), ErrNbValues)
+		})
+	}
+}
+
+func TestNonCanonicalValue(t *testing.T) {
+	assert := require.New(t)
+
+	store := NewMemoryStore()
+	tree := New(hash.MIMC_BN254.New(), store)
+	keys, values := randomKeys(4), randomFrElements(4)
+	assert.NoError(tree.Update(keys[:2], values[:2]))
+	root, err := tree.Root()
+	assert.NoError(err)
+	snapshot := maps.Clone(store.m)
+
+	// the modulus is not the canonical encoding of an fr.Element: the batch is
+	// rejected before the store is modified
+	modulus := fr.Modulus().FillBytes(make([]byte, fr.Bytes))
+	assert.Error(tree.Set(keys[2], modulus))
+	assert.Error(tree.Update(keys[1:], [][]byte{values[2], values[3], modulus}))
+	assert.Equal(snapshot, store.m)
+	newRoot, err := tree.Root()
+	assert.NoError(err)
+	assert.Equal(root, newRoot)
+
+	// the verifier rejects the value instead of panicking
+	_, proof, err := tree.Prove(keys[2])
+	assert.NoError(err)
+	assert.Error(tree.VerifyInclusion(root, keys[2], modulus, &proof))
+	proof.Bitmap[0] |= 1
+	proof.Siblings = append([][]byte{modulus}, proof.Siblings...)
+	assert.Error(tree.VerifyExclusion(root, keys[2], &proof))
+}
+
+func TestProof(t *testing.T) {
+	for _, tc := range testHashes {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			tree := New(tc.h(), NewMemoryStore())
+			verifier := NewVerifier(tc.h())
+			keys, values := randomKeys(30), tc.values(30)
+			assert.NoError(tree.Update(keys, values))
+			root, err := tree.Root()
+			assert.NoError(err)
+
+			for i := range keys {
+				v, proof, err := tree.Prove(keys[i])
+				assert.NoError(err)
+				assert.Equal(values[i], v)
+				assert.NoError(verifier.VerifyInclusion(root, keys[i], values[i], &proof))
+				assert.NoError(tree.VerifyInclusion(root, keys[i], values[i], &proof))
+
+				// the proof is compressed: with 30 random keys, the siblings
+				// above height ~log₂(30) are not default digests
+				assert.Less(len(proof.Siblings), 20)
+
+				assert.ErrorIs(verifier.VerifyInclusion(root, keys[i], values[(i+1)%len(values)], &proof), ErrVerifyProof)
+				assert.ErrorIs(verifier.VerifyInclusion(root, keys[(i+1)%len(keys)], values[i], &proof), ErrVerifyProof)
+				assert.ErrorIs(verifier.VerifyExclusion(root, keys[i], &proof), ErrVerifyProof)
+			}
+
+			for _, key := range randomKeys(10) {
+				v, proof, err := tree.Prove(key)
+				assert.NoError(err)
+				assert.Nil(v)
+				assert.NoError(verifier.VerifyExclusion(root, key, &proof))
+				assert.ErrorIs(verifier.VerifyInclusion(root, key, values[0], &proof), ErrVerifyProof)
+			}
+
+			// tampered proofs
+			_, proof, err := tree.Prove(keys[0])
+			assert.NoError(err)
+			proof.Siblings[0] = bytes.Clone(proof.Siblings[0])
+			proof.Siblings[0][len(proof.Siblings[0])-1] ^= 1
+			assert.ErrorIs(verifier.VerifyInclusion(root, keys[0], values[0], &proof), ErrVerifyProof)
+			proof.Siblings = proof.Siblings[1:]
+			assert.ErrorIs(verifier.VerifyInclusion(root, keys[0], values[0], &proof), ErrInvalidProof)
+
+			// a proof in the empty tree
+			empty := New(tc.h(), NewMemoryStore())
+			emptyRoot, err := empty.Root()
+			assert.NoError(err)
+			_, proof, err = empty.Prove(keys[0])
+			assert.NoError(err)
+			assert.Empty(proof.Siblings)
+			assert.NoError(verifier.VerifyExclusion(emptyRoot, keys[0], &proof))
+		})
+	}
+}
+
+func TestSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	tree := New(sha256.New(), NewMemoryStore())
+	assert.NoError(tree.Update(randomKeys(10), randomBytes(32)(10)))
+	for _, key := range append(randomKeys(1), make([]byte, KeySize)) {
+		_, proof, err := tree.Prove(key)
+		assert.NoError(err)
+
+		var buf bytes.Buffer
+		written, err := proof.WriteTo(&buf)
+		assert.NoError(err)
+		assert.Equal(int64(buf.Len()), written)
+
+		var decoded Proof
+		read, err := decoded.ReadFrom(&buf)
+		assert.NoError(err)
+		assert.Equal(written, read)
+		assert.Equal(proof, decoded)
+	}
+
+	_, proof, err := New(sha256.New(), NewMemoryStore()).Prove(make([]byte, KeySize))
+	assert.NoError(err)
+	var buf bytes.Buffer
+	_, err = proof.WriteTo(&buf)
+	assert.NoError(err)
+	var decoded Proof
+	_, err = decoded.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(proof, decoded)
+}
+
+func BenchmarkUpdate(b *testing.B) {
+	tree := New(hash.MIMC_BN254.New(), NewMemoryStore())
+	keys, values := randomKeys(1000), randomFrElements(1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = tree.Update(keys, values)
+	}
+}
+
+func BenchmarkProve(b *testing.B) {
+	tree := New(hash.MIMC_BN254.New(), NewMemoryStore())
+	keys, values := randomKeys(1000), randomFrElements(1000)
+	if err := tree.Update(keys, values); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = tree.Prove(keys[i%len(keys)])
+	}
+}
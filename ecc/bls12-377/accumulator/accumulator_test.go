@@ -0,0 +1,309 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package accumulator
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/kzg"
+	"github.com/stretchr/testify/require"
+)
+
+// SRS re-used across tests of the accumulator
+var testSrs *SRS
+
+func init() {
+	var err error
+	testSrs, err = NewSRS(64, 8, big.NewInt(-42))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func randomElements(n int) []fr.Element {
+	res := make([]fr.Element, n)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+func TestMembership(t *testing.T) {
+	assert := require.New(t)
+
+	set := randomElements(20)
+	acc, err := New(testSrs.Pk, set)
+	assert.NoError(err)
+	assert.Equal(20, acc.Size())
+
+	for i := range set {
+		w, err := acc.MembershipWitness(set[i])
+		assert.NoError(err)
+		assert.NoError(VerifyMembership(acc.Value, &w, testSrs.Vk))
+
+		w.Element.SetRandom()
+		assert.ErrorIs(VerifyMembership(acc.Value, &w, testSrs.Vk), ErrVerifyMembership)
+	}
+
+	_, err = acc.MembershipWitness(randomElements(1)[0])
+	assert.ErrorIs(err, ErrElementNotInSet)
+
+	// the empty set
+	empty, err := New(testSrs.Pk, nil)
+	assert.NoError(err)
+	_, err = empty.MembershipWitness(set[0])
+	assert.ErrorIs(err, ErrElementNotInSet)
+}
+
+func TestNonMembership(t *testing.T) {
+	assert := require.New(t)
+
+	set := randomElements(20)
+	acc, err := New(testSrs.Pk, set)
+	assert.NoError(err)
+
+	for _, e := range randomElements(5) {
+		w, err := acc.NonMembershipWitness(e)
+		assert.NoError(err)
+		assert.NoError(VerifyNonMembership(acc.Value, &w, testSrs.Vk))
+
+		// a witness for an element of the set
+		w.Element = set[0]
+		assert.ErrorIs(VerifyNonMembership(acc.Value, &w, testSrs.Vk), ErrVerifyNonMembership)
+	}
+
+	_, err = acc.NonMembershipWitness(set[3])
+	assert.ErrorIs(err, ErrElementInSet)
+
+	// the empty set
+	empty, err := New(testSrs.Pk, nil)
+	assert.NoError(err)
+	w, err := empty.NonMembershipWitness(set[0])
+	assert.NoError(err)
+	assert.NoError(VerifyNonMembership(empty.Value, &w, testSrs.Vk))
+}
+
+func TestKzgVerifyingKey(t *testing.T) {
+	assert := require.New(t)
+
+	kzgSrs, err := kzg.NewSRS(16, big.NewInt(-42))
+	assert.NoError(err)
+	vk := NewVerifyingKey(kzgSrs.Vk)
+	assert.Equal(testSrs.Vk.G2[:2], vk.G2)
+
+	set := randomElements(5)
+	acc, err := New(kzgSrs.Pk, set)
+	assert.NoError(err)
+	w, err := acc.MembershipWitness(set[2])
+	assert.NoError(err)
+	assert.NoError(VerifyMembership(acc.Value, &w, vk))
+
+	proof, err := acc.ProveMembership(set[:2])
+	assert.NoError(err)
+	assert.ErrorIs(BatchVerifyMembership(acc.Value, set[:2], &proof, vk), ErrBatchTooLarge)
+}
+
+func TestBatchMembership(t *testing.T) {
+	assert := require.New(t)
+
+	set := randomElements(30)
+	acc, err := New(testSrs.Pk, set)
+	assert.NoError(err)
+
+	for _, n := range []int{1, 2, 5, 8} {
+		proof, err := acc.ProveMembership(set[:n])
+		assert.NoError(err)
+		assert.NoError(BatchVerifyMembership(acc.Value, set[:n], &proof, testSrs.Vk))
+
+		// other elements
+		assert.Error(BatchVerifyMembership(acc.Value, set[1:n+1], &proof, testSrs.Vk))
+	}
+
+	_, err = acc.ProveMembership([]fr.Element{set[0], set[0]})
+	assert.ErrorIs(err, ErrDuplicateElement)
+	_, err = acc.ProveMembership(append(randomElements(1), set[0]))
+	assert.ErrorIs(err, ErrElementNotInSet)
+
+	proof, err := acc.ProveMembership(set[:9])
+	assert.NoError(err)
+	assert.ErrorIs(BatchVerifyMembership(acc.Value, set[:9], &proof, testSrs.Vk), ErrBatchTooLarge)
+}
+
+func TestBatchNonMembership(t *testing.T) {
+	assert := require.New(t)
+
+	set := randomElements(30)
+	acc, err := New(testSrs.Pk, set)
+	assert.NoError(err)
+
+	others := randomElements(8)
+	for _, n := range []int{1, 2, 5, 8} {
+		proof, err := acc.ProveNonMembership(others[:n], testSrs.Vk)
+		assert.NoError(err)
+		assert.NoError(BatchVerifyNonMembership(acc.Value, others[:n], &proof, testSrs.Vk))
+
+		// an element of the set
+		elements := append([]fr.Element{set[0]}, others[1:n]...)
+		assert.Error(BatchVerifyNonMembership(acc.Value, elements, &proof, testSrs.Vk))
+	}
+
+	_, err = acc.ProveNonMembership(append(randomElements(2), set[5]), testSrs.Vk)
+	assert.ErrorIs(err, ErrElementInSet)
+	_, err = acc.ProveNonMembership(randomElements(9), testSrs.Vk)
+	assert.ErrorIs(err, ErrBatchTooLarge)
+}
+
+func TestWitnessUpdate(t *testing.T) {
+	assert := require.New(t)
+
+	set := randomElements(10)
+	acc, err := New(testSrs.Pk, set)
+	assert.NoError(err)
+
+	member, err := acc.MembershipWitness(set[0])
+	assert.NoError(err)
+	nonMember, err := acc.NonMembershipWitness(randomElements(1)[0])
+	assert.NoError(err)
+
+	check := func() {
+		assert.NoError(VerifyMembership(acc.Value, &member, testSrs.Vk))
+		assert.NoError(VerifyNonMembership(acc.Value, &nonMember, testSrs.Vk))
+	}
+
+	// batch addition
+	added := randomElements(4)
+	u, err := acc.Add(added...)
+	assert.NoError(err)
+	assert.NoError(member.Update(&u))
+	assert.NoError(nonMember.Update(&u))
+	check()
+
+	// batch deletion
+	u, err = acc.Delete(set[3], added[1], set[7])
+	assert.NoError(err)
+	value, err := u.Value()
+	assert.NoError(err)
+	assert.Equal(acc.Value, value)
+	assert.NoError(member.Update(&u))
+	assert.NoError(nonMember.Update(&u))
+	check()
+
+	// single addition
+	u, err = acc.Add(randomElements(1)...)
+	assert.NoError(err)
+	assert.NoError(member.Update(&u))
+	assert.NoError(nonMember.Update(&u))
+	check()
+
+	// the witnesses match fresh ones
+	fresh, err := acc.MembershipWitness(set[0])
+	assert.NoError(err)
+	assert.Equal(fresh, member)
+
+	// the element of the non-membership witness is added, and the element of
+	// the membership witness deleted
+	u, err = acc.Add(nonMember.Element)
+	assert.NoError(err)
+	assert.ErrorIs(nonMember.Update(&u), ErrElementInSet)
+	u, err = acc.Delete(set[0], set[1])
+	assert.NoError(err)
+	assert.ErrorIs(member.Update(&u), ErrElementNotInSet)
+
+	_, err = acc.Add(set[2])
+	assert.ErrorIs(err, ErrElementInSet)
+	_, err = acc.Delete(set[0])
+	assert.ErrorIs(err, ErrElementNotInSet)
+	_, err = acc.Add(randomElements(64)...)
+	assert.ErrorIs(err, ErrSetTooLarge)
+}
+
+func TestSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	set := randomElements(10)
+	acc, err := New(testSrs.Pk, set)
+	assert.NoError(err)
+
+	var buf bytes.Buffer
+	w, err := acc.NonMembershipWitness(randomElements(1)[0])
+	assert.NoError(err)
+	written, err := w.WriteTo(&buf)
+	assert.NoError(err)
+	var decodedW NonMembershipWitness
+	read, err := decodedW.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(w, decodedW)
+
+	buf.Reset()
+	proof, err := acc.ProveNonMembership(randomElements(3), testSrs.Vk)
+	assert.NoError(err)
+	written, err = proof.WriteTo(&buf)
+	assert.NoError(err)
+	var decodedProof BatchNonMembershipProof
+	read, err = decodedProof.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(proof, decodedProof)
+
+	buf.Reset()
+	u, err := acc.Delete(set[:3]...)
+	assert.NoError(err)
+	written, err = u.WriteTo(&buf)
+	assert.NoError(err)
+	var decodedU Update
+	read, err = decodedU.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(u.Deleted, decodedU.Deleted)
+	assert.Empty(decodedU.Added)
+	assert.Equal(u.U, decodedU.U)
+
+	buf.Reset()
+	written, err = testSrs.Vk.WriteTo(&buf)
+	assert.NoError(err)
+	var vk VerifyingKey
+	read, err = vk.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(testSrs.Vk, vk)
+}
+
+func BenchmarkMembershipWitness(b *testing.B) {
+	set := randomElements(63)
+	acc, err := New(testSrs.Pk, set)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = acc.MembershipWitness(set[i%len(set)])
+	}
+}
+
+func BenchmarkWitnessUpdate(b *testing.B) {
+	set := randomElements(32)
+	acc, err := New(testSrs.Pk, set)
+	if err != nil {
+		b.Fatal(err)
+	}
+	w, err := acc.MembershipWitness(set[0])
+	if err != nil {
+		b.Fatal(err)
+	}
+	u, err := acc.Add(randomElements(8)...)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp := w
+		_ = tmp.Update(&u)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bulletproofs
+
+import (
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+)
+
+// ReadFrom decodes Generators data from reader.
+func (gens *Generators) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r, &gens.G, &gens.H, &gens.Gs, &gens.Hs, &gens.U)
+}
+
+// WriteTo writes binary encoding of Generators
+func (gens *Generators) WriteTo(w io.Writer) (int64, error) {
+	return encode(w, &gens.G, &gens.H, gens.Gs, gens.Hs, &gens.U)
+}
+
+// ReadFrom decodes InnerProductProof data from reader.
+func (proof *InnerProductProof) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r, &proof.L, &proof.R, &proof.A, &proof.B)
+}
+
+// WriteTo writes binary encoding of a InnerProductProof
+func (proof *InnerProductProof) WriteTo(w io.Writer) (int64, error) {
+	return encode(w, proof.L, proof.R, &proof.A, &proof.B)
+}
+
+// ReadFrom decodes RangeProof data from reader.
+func (proof *RangeProof) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r, &proof.A, &proof.S, &proof.T1, &proof.T2, &proof.TauX, &proof.Mu, &proof.T, &proof.InnerProduct)
+}
+
+// WriteTo writes binary encoding of a RangeProof
+func (proof *RangeProof) WriteTo(w io.Writer) (int64, error) {
+	return encode(w, &proof.A, &proof.S, &proof.T1, &proof.T2, &proof.TauX, &proof.Mu, &proof.T, &proof.InnerProduct)
+}
+
+func encode(w io.Writer, toEncode ...interface{}) (int64, error) {
+
+	enc := bls12377.NewEncoder(w)
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+func decode(r io.Reader, toDecode ...interface{}) (int64, error) {
+
+	dec := bls12377.NewDecoder(r)
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
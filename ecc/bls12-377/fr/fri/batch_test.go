@@ -0,0 +1,214 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchFRI(t *testing.T) {
+	const size = 256
+	for _, k := range []int{2, 4, 8} {
+		t.Run(fmt.Sprintf("k=%d", k), func(t *testing.T) {
+			assert := require.New(t)
+
+			f, err := NewFRI(size, sha256.New(), Config{FoldingFactor: k, BlowupFactor: 4, NbQueries: 8, FinalDegree: 1, GrindingBits: 2})
+			assert.NoError(err)
+
+			// polynomials of every degree bound folded by the FRI, some of them
+			// smaller than their degree bound
+			var polynomials [][]fr.Element
+			var degreeBounds []uint64
+			for r := 0; r < f.NbRounds(); r++ {
+				n := f.degreeBounds[r]
+				polynomials = append(polynomials, randomCoefficients(int(n)), randomCoefficients(int(n+1)/2))
+				degreeBounds = append(degreeBounds, n, n)
+			}
+			c, err := f.BatchCommit(polynomials, degreeBounds)
+			assert.NoError(err)
+
+			var z fr.Element
+			z.SetRandom()
+			proof, err := f.BatchProve(c, z)
+			assert.NoError(err)
+			assert.NoError(f.BatchVerify(&c.Digest, z, &proof))
+			for i, p := range polynomials {
+				assert.True(proof.Evaluations[i].Equal(ptr(evaluate(p, z))))
+			}
+
+			// a single polynomial
+			c, err = f.BatchCommit(polynomials[:1], degreeBounds[:1])
+			assert.NoError(err)
+			proof, err = f.BatchProve(c, z)
+			assert.NoError(err)
+			assert.NoError(f.BatchVerify(&c.Digest, z, &proof))
+		})
+	}
+}
+
+func ptr(e fr.Element) *fr.Element {
+	return &e
+}
+
+func TestBatchFRISoundness(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 128
+	f, err := NewFRI(size, sha256.New(), Config{FoldingFactor: 4, BlowupFactor: 4, NbQueries: 16, FinalDegree: 1})
+	assert.NoError(err)
+	polynomials := [][]fr.Element{randomCoefficients(size), randomCoefficients(size / 4), randomCoefficients(size / 4)}
+	degreeBounds := []uint64{size, size / 4, size / 4}
+	c, err := f.BatchCommit(polynomials, degreeBounds)
+	assert.NoError(err)
+
+	var z fr.Element
+	z.SetRandom()
+	proof, err := f.BatchProve(c, z)
+	assert.NoError(err)
+	assert.NoError(f.BatchVerify(&c.Digest, z, &proof))
+
+	tamper := func(f func(proof *BatchProof)) *BatchProof {
+		tampered := proof
+		tampered.Evaluations = append([]fr.Element{}, proof.Evaluations...)
+		tampered.Roots = append([][]byte{}, proof.Roots...)
+		tampered.FinalPolynomial = append([]fr.Element{}, proof.FinalPolynomial...)
+		tampered.GroupQueries = cloneOpenings(proof.GroupQueries)
+		tampered.Queries = cloneOpenings(proof.Queries)
+		f(&tampered)
+		return &tampered
+	}
+
+	// the Fiat Shamir challenges depend on the evaluations
+	assert.Error(f.BatchVerify(&c.Digest, z, tamper(func(proof *BatchProof) { proof.Evaluations[2].SetRandom() })))
+	var other fr.Element
+	other.SetRandom()
+	assert.Error(f.BatchVerify(&c.Digest, other, &proof))
+
+	// wrong evaluations are caught by the proximity test of the DEEP quotients
+	for i := range polynomials {
+		evaluations := append([]fr.Element{}, proof.Evaluations...)
+		evaluations[i].SetRandom()
+		wrong, err := f.batchProve(c, z, evaluations)
+		assert.NoError(err)
+		assert.ErrorIs(f.BatchVerify(&c.Digest, z, &wrong), ErrProximityTestFolding)
+	}
+
+	assert.ErrorIs(f.BatchVerify(&c.Digest, z, tamper(func(proof *BatchProof) { proof.GroupQueries[1][1].Values[3].SetRandom() })), ErrMerklePath)
+	assert.ErrorIs(f.BatchVerify(&c.Digest, z, tamper(func(proof *BatchProof) { proof.Queries[2][0].Values[1].SetRandom() })), ErrMerklePath)
+	assert.Error(f.BatchVerify(&c.Digest, z, tamper(func(proof *BatchProof) { proof.FinalPolynomial[0].SetRandom() })))
+	assert.ErrorIs(f.BatchVerify(&c.Digest, z, tamper(func(proof *BatchProof) { proof.Evaluations = proof.Evaluations[1:] })), ErrNbEvaluations)
+	assert.ErrorIs(f.BatchVerify(&c.Digest, z, tamper(func(proof *BatchProof) { proof.GroupQueries[0][0].Path = nil })), ErrProofShape)
+
+	// other commitment
+	c2, err := f.BatchCommit([][]fr.Element{randomCoefficients(size), polynomials[1], polynomials[2]}, degreeBounds)
+	assert.NoError(err)
+	assert.Error(f.BatchVerify(&c2.Digest, z, &proof))
+}
+
+func TestBatchFRITranscript(t *testing.T) {
+	assert := require.New(t)
+
+	f, err := NewFRI(128, sha256.New(), Config{FoldingFactor: 4, BlowupFactor: 4, NbQueries: 16, FinalDegree: 1})
+	assert.NoError(err)
+	var z fr.Element
+	z.SetRandom()
+	evaluations := []fr.Element{z, z}
+	gamma := func(digest BatchDigest) fr.Element {
+		res, err := f.bindBatch(f.batchTranscript(), &digest, z, evaluations)
+		assert.NoError(err)
+		return res
+	}
+
+	// the same root in different rounds, the other groups being empty
+	root := []byte("root")
+	roots := make([][][]byte, f.NbRounds())
+	for r := range roots {
+		roots[r] = make([][]byte, f.NbRounds())
+		roots[r][r] = root
+	}
+	assert.NotEqual(gamma(BatchDigest{DegreeBounds: []uint64{128, 32}, Roots: roots[0]}), gamma(BatchDigest{DegreeBounds: []uint64{128, 32}, Roots: roots[1]}))
+
+	// the same roots for other degree bounds
+	assert.NotEqual(gamma(BatchDigest{DegreeBounds: []uint64{128, 32}, Roots: roots[0]}), gamma(BatchDigest{DegreeBounds: []uint64{128, 128}, Roots: roots[0]}))
+	assert.NotEqual(gamma(BatchDigest{DegreeBounds: []uint64{128, 32}, Roots: roots[0]}), gamma(BatchDigest{DegreeBounds: []uint64{128}, Roots: roots[0]}))
+}
+
+func cloneOpenings(openings [][]Opening) [][]Opening {
+	res := make([][]Opening, len(openings))
+	for q := range openings {
+		res[q] = make([]Opening, len(openings[q]))
+		for r, o := range openings[q] {
+			res[q][r] = Opening{Values: append([]fr.Element{}, o.Values...), Path: o.Path}
+		}
+	}
+	return res
+}
+
+func TestBatchFRIErrors(t *testing.T) {
+	assert := require.New(t)
+
+	f, err := NewFRI(64, sha256.New(), Config{FoldingFactor: 4, BlowupFactor: 2, NbQueries: 4, FinalDegree: 3})
+	assert.NoError(err)
+	_, err = f.BatchCommit([][]fr.Element{randomCoefficients(64)}, []uint64{64, 16})
+	assert.ErrorIs(err, ErrNbPolynomials)
+	_, err = f.BatchCommit([][]fr.Element{randomCoefficients(32)}, []uint64{32})
+	assert.ErrorIs(err, ErrDegreeBound)
+	_, err = f.BatchCommit([][]fr.Element{randomCoefficients(4)}, []uint64{4})
+	assert.ErrorIs(err, ErrDegreeBound)
+	_, err = f.BatchCommit([][]fr.Element{randomCoefficients(17)}, []uint64{16})
+	assert.ErrorIs(err, ErrPolynomialSize)
+
+	c, err := f.BatchCommit([][]fr.Element{randomCoefficients(64)}, []uint64{64})
+	assert.NoError(err)
+	z := f.domain.Generator
+	_, err = f.BatchProve(c, z)
+	assert.ErrorIs(err, ErrPointInDomain)
+
+	g, err := NewFRI(64, sha256.New(), f.Config())
+	assert.NoError(err)
+	z.SetRandom()
+	_, err = g.BatchProve(c, z)
+	assert.ErrorIs(err, ErrBatchFRIMismatch)
+}
+
+func BenchmarkBatchFRI(b *testing.B) {
+	const size = 1 << 12
+	f, err := NewFRI(size, sha256.New(), DefaultConfig())
+	if err != nil {
+		b.Fatal(err)
+	}
+	polynomials := make([][]fr.Element, 16)
+	degreeBounds := make([]uint64, len(polynomials))
+	for i := range polynomials {
+		degreeBounds[i] = f.degreeBounds[i%2]
+		polynomials[i] = randomCoefficients(int(degreeBounds[i]))
+	}
+	c, err := f.BatchCommit(polynomials, degreeBounds)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var z fr.Element
+	z.SetRandom()
+	b.Run("prove", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = f.BatchProve(c, z)
+		}
+	})
+	proof, err := f.BatchProve(c, z)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("verify", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = f.BatchVerify(&c.Digest, z, &proof)
+		}
+	})
+}
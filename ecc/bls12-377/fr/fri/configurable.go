@@ -0,0 +1,443 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/fft"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrPolynomialSize = errors.New("the polynomial is larger than the size of the FRI")
+	ErrProofShape     = errors.New("the proof does not match the configuration")
+	ErrGrinding       = errors.New("invalid proof of work")
+)
+
+// FRI configurable FRI, proving that the evaluations of a function on the
+// domain of size N = B·size are close to the evaluations of a polynomial of
+// degree < size.
+//
+// The function f₀ is given by its evaluations fᵣ(gᵣⁱ) on the domains Dᵣ
+// generated by gᵣ = g^{kʳ}. At round r, fᵣ(X) = ∑ⱼ Xʲfᵣ,ⱼ(Xᵏ) is folded into
+// fᵣ₊₁ = ∑ⱼ αᵣʲfᵣ,ⱼ, whose evaluations on Dᵣ₊₁ only depend on the
+// evaluations of fᵣ on the cosets gᵣⁱ⟨ω⟩, ω being a primitive k-th root of
+// unity. The leaves of the Merkle tree of fᵣ are these cosets.
+type FRI struct {
+	config Config
+	h      hash.Hash
+
+	// degreeBounds degree bounds n₀ = size, …, n_R of the folded polynomials
+	degreeBounds []uint64
+
+	// domain of size N of the first codeword
+	domain *fft.Domain
+
+	// omegaInv powers of ω⁻¹, where ω = g^{N/k}
+	omegaInv []fr.Element
+	kInv     fr.Element
+}
+
+// Proof proof of proximity of FRI.
+type Proof struct {
+	// Roots Merkle roots of the codewords f₀, …, f_{R-1}
+	Roots [][]byte
+
+	// FinalPolynomial coefficients of the final polynomial f_R, in canonical
+	// basis
+	FinalPolynomial []fr.Element
+
+	// Nonce proof of work
+	Nonce uint64
+
+	// Queries for each query, the openings of the codewords f₀, …, f_{R-1}
+	Queries [][]Opening
+}
+
+// Opening opening of a leaf of a codeword: the k values of the codeword on a
+// coset of ⟨ω⟩, with their Merkle path.
+type Opening struct {
+	Values []fr.Element
+	Path   [][]byte
+}
+
+// NewFRI returns the FRI with given configuration for polynomials of degree <
+// size, a power of 2. The hash function is used for the Merkle trees and Fiat
+// Shamir.
+func NewFRI(size uint64, h hash.Hash, config Config) (*FRI, error) {
+	degreeBounds, err := config.degreeBounds(size)
+	if err != nil {
+		return nil, err
+	}
+	f := FRI{
+		config:       config,
+		h:            h,
+		degreeBounds: degreeBounds,
+		domain:       fft.NewDomain(size * uint64(config.BlowupFactor)),
+		omegaInv:     make([]fr.Element, config.FoldingFactor),
+	}
+	var omegaInv fr.Element
+	omegaInv.Exp(f.domain.GeneratorInv, new(big.Int).SetUint64(f.domain.Cardinality/uint64(config.FoldingFactor)))
+	f.omegaInv[0].SetOne()
+	for i := 1; i < len(f.omegaInv); i++ {
+		f.omegaInv[i].Mul(&f.omegaInv[i-1], &omegaInv)
+	}
+	f.kInv.SetUint64(uint64(config.FoldingFactor)).Inverse(&f.kInv)
+	return &f, nil
+}
+
+// Config returns the configuration of f.
+func (f *FRI) Config() Config {
+	return f.config
+}
+
+// NbRounds returns the number R of folding rounds.
+func (f *FRI) NbRounds() int {
+	return len(f.degreeBounds) - 1
+}
+
+// Prove returns a proof that the evaluations of p, given by its coefficients
+// in canonical basis, on the domain of size N are close to a polynomial of
+// degree < size. The proof is built non-interactively using Fiat Shamir.
+func (f *FRI) Prove(p []fr.Element) (Proof, error) {
+	if uint64(len(p)) > f.degreeBounds[0] {
+		return Proof{}, ErrPolynomialSize
+	}
+	codeword := make([]fr.Element, f.domain.Cardinality)
+	copy(codeword, p)
+	f.domain.FFT(codeword, fft.DIF)
+	fft.BitReverse(codeword)
+	return f.proveCodeword(codeword)
+}
+
+// proveCodeword returns a proof of proximity of the codeword, given by its
+// evaluations on the domain of size N in natural order.
+func (f *FRI) proveCodeword(codeword []fr.Element) (Proof, error) {
+	fs := f.transcript()
+	k := f.config.FoldingFactor
+	var proof Proof
+
+	// commit phase
+	trees := make([]*merkleTree, f.NbRounds())
+	codewords := make([][]fr.Element, f.NbRounds())
+	gInv := f.domain.GeneratorInv
+	for r := range trees {
+		codewords[r] = codeword
+		trees[r] = commitCodeword(f.h, codeword, k)
+		proof.Roots = append(proof.Roots, trees[r].root())
+
+		alpha, err := challenge(fs, alphaID(r), trees[r].root())
+		if err != nil {
+			return proof, err
+		}
+		codeword = f.foldCodeword(codeword, gInv, alpha)
+		gInv.Exp(gInv, big.NewInt(int64(k)))
+	}
+
+	// the final polynomial, of degree < n_R, is interpolated on the last
+	// domain
+	final := make([]fr.Element, len(codeword))
+	copy(final, codeword)
+	fft.NewDomain(uint64(len(final))).FFTInverse(final, fft.DIF)
+	fft.BitReverse(final)
+	proof.FinalPolynomial = final[:f.degreeBounds[f.NbRounds()]]
+
+	// proof of work and queries
+	seed, err := challengeBytes(fs, grindingID, marshalElements(proof.FinalPolynomial))
+	if err != nil {
+		return proof, err
+	}
+	proof.Nonce = grind(f.h, seed, f.config.GrindingBits)
+	positions, err := f.queryPositions(fs, proof.Nonce)
+	if err != nil {
+		return proof, err
+	}
+
+	proof.Queries = make([][]Opening, len(positions))
+	for q, pos := range positions {
+		proof.Queries[q] = make([]Opening, f.NbRounds())
+		for r := range codewords {
+			m := len(codewords[r]) / k
+			l := pos % m
+			values := make([]fr.Element, k)
+			for t := range values {
+				values[t] = codewords[r][l+t*m]
+			}
+			proof.Queries[q][r] = Opening{Values: values, Path: trees[r].path(l)}
+			pos = l
+		}
+	}
+	return proof, nil
+}
+
+// Verify verifies a proof of proximity.
+func (f *FRI) Verify(proof *Proof) error {
+	if err := f.checkShape(proof); err != nil {
+		return err
+	}
+
+	fs := f.transcript()
+	alphas := make([]fr.Element, f.NbRounds())
+	for r := range alphas {
+		var err error
+		if alphas[r], err = challenge(fs, alphaID(r), proof.Roots[r]); err != nil {
+			return err
+		}
+	}
+	seed, err := challengeBytes(fs, grindingID, marshalElements(proof.FinalPolynomial))
+	if err != nil {
+		return err
+	}
+	if !checkProofOfWork(f.h, seed, proof.Nonce, f.config.GrindingBits) {
+		return ErrGrinding
+	}
+	positions, err := f.queryPositions(fs, proof.Nonce)
+	if err != nil {
+		return err
+	}
+
+	// generators of the domains, and of the final domain
+	gInvs := make([]fr.Element, f.NbRounds())
+	gInvs[0] = f.domain.GeneratorInv
+	for r := 1; r < len(gInvs); r++ {
+		gInvs[r].Exp(gInvs[r-1], big.NewInt(int64(f.config.FoldingFactor)))
+	}
+	var gFinal fr.Element
+	gFinal.Exp(f.domain.Generator, new(big.Int).Exp(big.NewInt(int64(f.config.FoldingFactor)), big.NewInt(int64(f.NbRounds())), nil))
+
+	var xInv, x fr.Element
+	for q, pos := range positions {
+		var folded fr.Element
+		size := f.domain.Cardinality
+		for r, opening := range proof.Queries[q] {
+			m := int(size) / f.config.FoldingFactor
+			l := pos % m
+			if err := verifyMerklePath(f.h, proof.Roots[r], l, opening.Values, opening.Path); err != nil {
+				return err
+			}
+			if r > 0 && !opening.Values[pos/m].Equal(&folded) {
+				return ErrProximityTestFolding
+			}
+			xInv.Exp(gInvs[r], big.NewInt(int64(l)))
+			folded = f.fold(opening.Values, xInv, alphas[r])
+			pos, size = l, uint64(m)
+		}
+		x.Exp(gFinal, big.NewInt(int64(pos)))
+		if e := evaluate(proof.FinalPolynomial, x); !e.Equal(&folded) {
+			return ErrProximityTestFolding
+		}
+	}
+	return nil
+}
+
+// checkShape checks that the proof has the sizes given by the configuration.
+func (f *FRI) checkShape(proof *Proof) error {
+	if len(proof.Roots) != f.NbRounds() ||
+		uint64(len(proof.FinalPolynomial)) != f.degreeBounds[f.NbRounds()] ||
+		len(proof.Queries) != f.config.NbQueries {
+		return ErrProofShape
+	}
+	for _, openings := range proof.Queries {
+		if len(openings) != f.NbRounds() {
+			return ErrProofShape
+		}
+		nbLeaves := f.domain.Cardinality / uint64(f.config.FoldingFactor)
+		for _, o := range openings {
+			if len(o.Values) != f.config.FoldingFactor || len(o.Path) != bits.TrailingZeros64(nbLeaves) {
+				return ErrProofShape
+			}
+			nbLeaves /= uint64(f.config.FoldingFactor)
+		}
+	}
+	return nil
+}
+
+// fold returns g(α), where g is the polynomial of degree < k such that
+// g(xωᵗ) = vₜ. If vₜ = f(xωᵗ) with f(X) = ∑ⱼ Xʲfⱼ(Xᵏ), then g(α) is the value
+// at xᵏ of the folded polynomial ∑ⱼ αʲfⱼ.
+func (f *FRI) fold(values []fr.Element, xInv, alpha fr.Element) fr.Element {
+	// g(xu) = ∑ⱼ cⱼuʲ where cⱼ = 1/k ∑ₜ vₜω⁻ᵗʲ, so that g(α) = ∑ⱼ cⱼ(α/x)ʲ
+	k := len(values)
+	var beta, res, c, t fr.Element
+	beta.Mul(&alpha, &xInv)
+	for j := k - 1; j >= 0; j-- {
+		c.SetZero()
+		for i := range values {
+			t.Mul(&values[i], &f.omegaInv[(i*j)%k])
+			c.Add(&c, &t)
+		}
+		res.Mul(&res, &beta).Add(&res, &c)
+	}
+	return *res.Mul(&res, &f.kInv)
+}
+
+// foldCodeword returns the evaluations of the folded polynomial on the domain
+// generated by gᵏ, from the evaluations of the polynomial on the domain
+// generated by g.
+func (f *FRI) foldCodeword(codeword []fr.Element, gInv, alpha fr.Element) []fr.Element {
+	k := f.config.FoldingFactor
+	m := len(codeword) / k
+	res := make([]fr.Element, m)
+	parallel.Execute(m, func(start, end int) {
+		var xInv fr.Element
+		xInv.Exp(gInv, big.NewInt(int64(start)))
+		values := make([]fr.Element, k)
+		for l := start; l < end; l++ {
+			for t := range values {
+				values[t] = codeword[l+t*m]
+			}
+			res[l] = f.fold(values, xInv, alpha)
+			xInv.Mul(&xInv, &gInv)
+		}
+	})
+	return res
+}
+
+// commitCodeword returns the Merkle tree of the codeword, whose i-th leaf is
+// made of the values at positions i + t·N/k, for t < k.
+func commitCodeword(h hash.Hash, codeword []fr.Element, k int) *merkleTree {
+	m := len(codeword) / k
+	return newMerkleTree(h, m, func(i int, buf []fr.Element) []fr.Element {
+		for t := 0; t < k; t++ {
+			buf = append(buf, codeword[i+t*m])
+		}
+		return buf
+	})
+}
+
+const grindingID = "grinding"
+
+func alphaID(round int) string {
+	return fmt.Sprintf("alpha%d", round)
+}
+
+func queryID(query int) string {
+	return fmt.Sprintf("query%d", query)
+}
+
+// transcript returns the Fiat Shamir transcript of f: the challenges αᵣ
+// folding the rounds, the seed of the proof of work, and the queries.
+func (f *FRI) transcript() *fiatshamir.Transcript {
+	ids := make([]string, 0, f.NbRounds()+1+f.config.NbQueries)
+	for r := 0; r < f.NbRounds(); r++ {
+		ids = append(ids, alphaID(r))
+	}
+	ids = append(ids, grindingID)
+	for q := 0; q < f.config.NbQueries; q++ {
+		ids = append(ids, queryID(q))
+	}
+	return fiatshamir.NewTranscript(f.h, ids...)
+}
+
+// challenge binds data to the challenge id and returns its value as a field
+// element.
+func challenge(fs *fiatshamir.Transcript, id string, data []byte) (fr.Element, error) {
+	var res fr.Element
+	b, err := challengeBytes(fs, id, data)
+	if err != nil {
+		return res, err
+	}
+	res.SetBytes(b)
+	return res, nil
+}
+
+// challengeBytes binds data, if any, to the challenge id and returns its
+// value.
+func challengeBytes(fs *fiatshamir.Transcript, id string, data []byte) ([]byte, error) {
+	if data != nil {
+		if err := fs.Bind(id, data); err != nil {
+			return nil, err
+		}
+	}
+	return fs.ComputeChallenge(id)
+}
+
+// queryPositions binds the nonce and returns the leaves of f₀ queried by the
+// verifier.
+func (f *FRI) queryPositions(fs *fiatshamir.Transcript, nonce uint64) ([]int, error) {
+	var bNonce [8]byte
+	binary.BigEndian.PutUint64(bNonce[:], nonce)
+	if err := fs.Bind(queryID(0), bNonce[:]); err != nil {
+		return nil, err
+	}
+	// the number of leaves is a power of 2, so that masking the challenges
+	// gives uniform positions
+	mask := f.domain.Cardinality/uint64(f.config.FoldingFactor) - 1
+	res := make([]int, f.config.NbQueries)
+	for q := range res {
+		b, err := fs.ComputeChallenge(queryID(q))
+		if err != nil {
+			return nil, err
+		}
+		res[q] = int(binary.BigEndian.Uint64(b[len(b)-8:]) & mask)
+	}
+	return res, nil
+}
+
+// grind returns the smallest nonce such that H(seed ‖ nonce) ends with nbBits
+// zero bits.
+func grind(h hash.Hash, seed []byte, nbBits int) uint64 {
+	var nonce uint64
+	for !checkProofOfWork(h, seed, nonce, nbBits) {
+		nonce++
+	}
+	return nonce
+}
+
+// checkProofOfWork returns true if H(seed ‖ nonce) ends with nbBits zero bits.
+// The last bits are used since the digests of field-native hash functions have
+// leading zero bits.
+func checkProofOfWork(h hash.Hash, seed []byte, nonce uint64, nbBits int) bool {
+	if nbBits == 0 {
+		return true
+	}
+	var bNonce [8]byte
+	binary.BigEndian.PutUint64(bNonce[:], nonce)
+	h.Reset()
+	if _, err := h.Write(seed); err != nil {
+		panic(err)
+	}
+	if _, err := h.Write(bNonce[:]); err != nil {
+		panic(err)
+	}
+	digest := h.Sum(nil)
+	zeros := 0
+	for i := len(digest) - 1; i >= 0 && zeros < nbBits; i-- {
+		if digest[i] != 0 {
+			zeros += bits.TrailingZeros8(digest[i])
+			break
+		}
+		zeros += 8
+	}
+	return zeros >= nbBits
+}
+
+// marshalElements returns the concatenation of the encodings of v.
+func marshalElements(v []fr.Element) []byte {
+	res := make([]byte, 0, len(v)*fr.Bytes)
+	for i := range v {
+		b := v[i].Bytes()
+		res = append(res, b[:]...)
+	}
+	return res
+}
+
+// evaluate returns p(x), p being given in canonical basis.
+func evaluate(p []fr.Element, x fr.Element) fr.Element {
+	var res fr.Element
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, &x).Add(&res, &p[i])
+	}
+	return res
+}
@@ -0,0 +1,101 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"bytes"
+	"hash"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// merkleTree Merkle tree of the leaves of a codeword, the digest of a leaf
+// being H(v₀ ‖ … ‖ vₖ₋₁) where the vᵢ are the values of the leaf, and the
+// digest of a node H(left ‖ right). The number of leaves is a power of 2.
+type merkleTree struct {
+	// levels[0] digests of the leaves, levels[len(levels)-1] the root
+	levels [][][]byte
+}
+
+// hashLeaf returns the digest of a leaf.
+func hashLeaf(h hash.Hash, values []fr.Element) []byte {
+	h.Reset()
+	for i := range values {
+		b := values[i].Bytes()
+		// field-native hash functions accept canonical field elements
+		if _, err := h.Write(b[:]); err != nil {
+			panic(err)
+		}
+	}
+	return h.Sum(nil)
+}
+
+// hashNode returns the digest of a node.
+func hashNode(h hash.Hash, left, right []byte) []byte {
+	h.Reset()
+	if _, err := h.Write(left); err != nil {
+		panic(err)
+	}
+	if _, err := h.Write(right); err != nil {
+		panic(err)
+	}
+	return h.Sum(nil)
+}
+
+// newMerkleTree returns the Merkle tree of nbLeaves leaves, the i-th leaf being
+// given by leaf(i, buf), which may use buf to store the values.
+func newMerkleTree(h hash.Hash, nbLeaves int, leaf func(i int, buf []fr.Element) []fr.Element) *merkleTree {
+	var t merkleTree
+	digests := make([][]byte, nbLeaves)
+	var buf []fr.Element
+	for i := range digests {
+		buf = leaf(i, buf[:0])
+		digests[i] = hashLeaf(h, buf)
+	}
+	t.levels = append(t.levels, digests)
+	for len(digests) > 1 {
+		parents := make([][]byte, len(digests)/2)
+		for i := range parents {
+			parents[i] = hashNode(h, digests[2*i], digests[2*i+1])
+		}
+		t.levels = append(t.levels, parents)
+		digests = parents
+	}
+	return &t
+}
+
+// root returns the root of the tree.
+func (t *merkleTree) root() []byte {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// path returns the siblings of the path from the leaf i to the root.
+func (t *merkleTree) path(i int) [][]byte {
+	res := make([][]byte, len(t.levels)-1)
+	for l := range res {
+		res[l] = t.levels[l][i^1]
+		i >>= 1
+	}
+	return res
+}
+
+// verifyMerklePath verifies that the leaf i of the tree of given root, with
+// 2^len(path) leaves, has given values.
+func verifyMerklePath(h hash.Hash, root []byte, i int, values []fr.Element, path [][]byte) error {
+	digest := hashLeaf(h, values)
+	for _, sibling := range path {
+		if i&1 == 0 {
+			digest = hashNode(h, digest, sibling)
+		} else {
+			digest = hashNode(h, sibling, digest)
+		}
+		i >>= 1
+	}
+	if !bytes.Equal(digest, root) {
+		return ErrMerklePath
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon2
+
+import (
+	"encoding/binary"
+	"errors"
+	stdhash "hash"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/hash"
+)
+
+func init() {
+	hash.RegisterHash(hash.POSEIDON2_BLS12_377, func() stdhash.Hash {
+		return NewMerkleDamgardHasher()
+	})
+}
+
+// parameters of the permutation used by the hasher, of width 2: one element
+// for the chaining value (the capacity) and one for the message (the rate)
+const (
+	hasherWidth           = 2
+	hasherNbFullRounds    = 6
+	hasherNbPartialRounds = 26
+	hasherSeed            = "Poseidon2 hash for BLS12_377 with t=2, rF=6, rP=26"
+
+	BlockSize = fr.Bytes // BlockSize size that poseidon2 consumes
+)
+
+var (
+	hasherPermutation Hash
+	hasherOnce        sync.Once
+)
+
+// digest Merkle–Damgård construction on the Poseidon2 compression
+//
+//	f(h, m) = P(h ‖ m)[1] + m
+//
+// where P is the permutation of width 2.
+type digest struct {
+	iv        fr.Element // initial chaining value, set by the domain separation tag
+	h         fr.Element // chaining value
+	n         uint64     // number of elements absorbed
+	byteOrder fr.ByteOrder
+}
+
+// NewMerkleDamgardHasher returns a Poseidon2 hasher in Merkle–Damgård mode:
+// the input elements are absorbed one by one with the compression
+// f(h, m) = P(h ‖ m)[1] + m, starting from a chaining value set by the domain
+// separation tag (zero by default), and the digest is f(h, n) where n is the
+// number of elements absorbed.
+func NewMerkleDamgardHasher(opts ...Option) hash.StateStorer {
+	cfg := poseidon2Options(opts...)
+	d := &digest{byteOrder: cfg.byteOrder}
+	if len(cfg.domainSeparation) != 0 {
+		// the elements are uniformly distributed, the error is not possible
+		iv, _ := fr.Hash(cfg.domainSeparation, []byte("POSEIDON2_BLS12_377 domain separation"), 1)
+		d.iv = iv[0]
+	}
+	d.Reset()
+	return d
+}
+
+func permutation() *Hash {
+	hasherOnce.Do(func() {
+		hasherPermutation = NewHash(hasherWidth, hasherNbFullRounds, hasherNbPartialRounds, hasherSeed)
+	})
+	return &hasherPermutation
+}
+
+// compress returns f(h, m) = P(h ‖ m)[1] + m
+func compress(h, m *fr.Element) fr.Element {
+	var state [hasherWidth]fr.Element
+	state[0], state[1] = *h, *m
+	// the width is the one of the permutation
+	_ = permutation().Permutation(state[:])
+	state[1].Add(&state[1], m)
+	return state[1]
+}
+
+// Reset resets the Hash to its initial state.
+func (d *digest) Reset() {
+	d.h = d.iv
+	d.n = 0
+}
+
+// Sum appends the current hash to b and returns the resulting slice.
+// It does not change the underlying hash state.
+func (d *digest) Sum(b []byte) []byte {
+	// the length of the input is appended, so that the inputs which differ
+	// by trailing zeros have different digests
+	var length fr.Element
+	length.SetUint64(d.n)
+	h := compress(&d.h, &length)
+	bytes := h.Bytes()
+	return append(b, bytes[:]...)
+}
+
+// Size returns the number of bytes Sum will return.
+func (d *digest) Size() int {
+	return BlockSize
+}
+
+// BlockSize returns the hash's underlying block size.
+// The Write method must be able to accept any amount
+// of data, but it may operate more efficiently if all writes
+// are a multiple of the block size.
+func (d *digest) BlockSize() int {
+	return BlockSize
+}
+
+// Write (via the embedded io.Writer interface) adds more data to the running hash.
+//
+// Each []byte block of size BlockSize represents a field element, in the byte
+// order of the hasher (big endian by default).
+//
+// If len(p) is not a multiple of BlockSize and any of the []byte in p represent an integer
+// larger than fr.Modulus, this function returns an error.
+//
+// To hash arbitrary data ([]byte not representing canonical field elements) use fr.Hash first
+func (d *digest) Write(p []byte) (int, error) {
+	// we usually expect multiple of block size. But sometimes we hash short
+	// values (FS transcript). Instead of forcing to hash to field, we left-pad the
+	// input here.
+	if len(p) > 0 && len(p) < BlockSize {
+		pp := make([]byte, BlockSize)
+		copy(pp[len(pp)-len(p):], p)
+		p = pp
+	}
+
+	if len(p)%BlockSize != 0 {
+		return 0, errors.New("invalid input length: must represent a list of field elements, expects a []byte of len m*BlockSize")
+	}
+
+	// the elements are decoded before being absorbed, so that the state is
+	// unchanged on error
+	elems := make([]fr.Element, len(p)/BlockSize)
+	for i := range elems {
+		var err error
+		if elems[i], err = d.byteOrder.Element((*[BlockSize]byte)(p[i*BlockSize : (i+1)*BlockSize])); err != nil {
+			return 0, err
+		}
+	}
+	for i := range elems {
+		d.h = compress(&d.h, &elems[i])
+	}
+	d.n += uint64(len(elems))
+
+	return len(p), nil
+}
+
+// SetState manually sets the state of the hasher to an user-provided value. In
+// the context of Poseidon2, the method expects a byte slice of 32+8 bytes: the
+// chaining value followed by the number of elements absorbed, in big endian.
+func (d *digest) SetState(newState []byte) error {
+	if len(newState) != BlockSize+8 {
+		return errors.New("the poseidon2 state expects a state of 32+8 bytes")
+	}
+
+	if err := d.h.SetBytesCanonical(newState[:BlockSize]); err != nil {
+		return errors.New("the provided newState does not represent a valid state")
+	}
+	d.n = binary.BigEndian.Uint64(newState[BlockSize:])
+
+	return nil
+}
+
+// State returns the internal state of the hasher
+func (d *digest) State() []byte {
+	b := d.h.Bytes()
+	return binary.BigEndian.AppendUint64(b[:], d.n)
+}
@@ -0,0 +1,92 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package hyrax
+
+import (
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+)
+
+// ReadFrom decodes Key data from reader.
+func (key *Key) ReadFrom(r io.Reader) (int64, error) {
+
+	dec := bls12377.NewDecoder(r)
+
+	toDecode := []interface{}{
+		&key.G,
+		&key.U,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of a Key
+func (key *Key) WriteTo(w io.Writer) (int64, error) {
+
+	enc := bls12377.NewEncoder(w)
+
+	toEncode := []interface{}{
+		key.G,
+		&key.U,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes OpeningProof data from reader.
+func (proof *OpeningProof) ReadFrom(r io.Reader) (int64, error) {
+
+	dec := bls12377.NewDecoder(r)
+
+	toDecode := []interface{}{
+		&proof.ClaimedValue,
+		&proof.L,
+		&proof.R,
+		&proof.A,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of a OpeningProof
+func (proof *OpeningProof) WriteTo(w io.Writer) (int64, error) {
+
+	enc := bls12377.NewEncoder(w)
+
+	toEncode := []interface{}{
+		&proof.ClaimedValue,
+		proof.L,
+		proof.R,
+		&proof.A,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
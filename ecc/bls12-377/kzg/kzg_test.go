@@ -8,9 +8,13 @@ package kzg
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
@@ -19,6 +23,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/fft"
 
 	"github.com/consensys/gnark-crypto/utils/testutils"
+	"github.com/consensys/gnark-crypto/utils/unsafe"
 )
 
 // Test SRS re-used across tests of the KZG scheme
@@ -152,6 +157,125 @@ func TestSerializationSRS(t *testing.T) {
 	t.Run("verifying key round-trip", testutils.SerializationRoundTrip(&srs.Vk))
 	t.Run("whole SRS round-trip", testutils.SerializationRoundTrip(srs))
 	t.Run("unsafe whole SRS round-trip", testutils.UnsafeBinaryMarshalerRoundTrip(srs))
+
+	// hiding SRS
+	srs = newTestHidingSRS(t)
+	t.Run("hiding SRS round-trip", testutils.SerializationRoundTrip(srs))
+	t.Run("hiding SRS raw round-trip", testutils.SerializationRoundTripRaw(srs))
+
+	// unsupported version of the hiding encoding
+	var buf bytes.Buffer
+	_, err = srs.Vk.WriteTo(&buf)
+	assert.NoError(t, err)
+	data := buf.Bytes()
+	binary.BigEndian.PutUint32(data[4:], hidingVersion+1)
+	var vk VerifyingKey
+	_, err = vk.ReadFrom(bytes.NewReader(data))
+	assert.ErrorIs(t, err, ErrHidingVersion)
+}
+
+// TestSerializationBaseline checks that a SRS without blinding generators is
+// encoded as in the versions which did not support hiding commitments, and
+// that these encodings are decoded.
+func TestSerializationBaseline(t *testing.T) {
+	assert := require.New(t)
+
+	// SHA-256 of the encodings of NewSRS(16, 42) with WriteTo and WriteRawTo,
+	// computed with the versions without hiding commitments
+	const (
+		hashCompressed = "95e8f95b2e7b79870790fa5d5e9bfaa80b5121fb20c99b165a6ec4d74fe26323"
+		hashRaw        = "c5e3718c696a0cf096b10f8647ee6236700bcc9f16b08816ce9c3fb80e276b53"
+	)
+
+	srs, err := NewSRS(16, big.NewInt(42))
+	assert.NoError(err)
+
+	for _, raw := range []bool{false, true} {
+		var options []func(*bls12377.Encoder)
+		expected := hashCompressed
+		if raw {
+			options = append(options, bls12377.RawEncoding())
+			expected = hashRaw
+		}
+		var baseline bytes.Buffer
+		enc := bls12377.NewEncoder(&baseline, options...)
+		assert.NoError(enc.Encode(srs.Pk.G1))
+		assert.NoError(encodeBaselineVk(enc, &srs.Vk))
+		h := sha256.Sum256(baseline.Bytes())
+		assert.Equal(expected, hex.EncodeToString(h[:]), "raw: %t", raw)
+
+		// the encoding is unchanged
+		var buf bytes.Buffer
+		if raw {
+			_, err = srs.WriteRawTo(&buf)
+		} else {
+			_, err = srs.WriteTo(&buf)
+		}
+		assert.NoError(err)
+		assert.Equal(baseline.Bytes(), buf.Bytes(), "raw: %t", raw)
+
+		// the encoding is decoded, in a stream with other data
+		size := baseline.Len()
+		baseline.Write([]byte{1, 2, 3, 4})
+		var decoded SRS
+		n, err := decoded.ReadFrom(bytes.NewReader(baseline.Bytes()))
+		assert.NoError(err)
+		assert.Equal(int64(size), n)
+		assert.Equal(*srs, decoded)
+	}
+
+	// VerifyingKey
+	var buf bytes.Buffer
+	assert.NoError(encodeBaselineVk(bls12377.NewEncoder(&buf), &srs.Vk))
+	var vk VerifyingKey
+	_, err = vk.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(srs.Vk, vk)
+
+	// dump
+	buf.Reset()
+	assert.NoError(encodeBaselineVk(bls12377.NewEncoder(&buf, bls12377.RawEncoding()), &srs.Vk))
+	assert.NoError(unsafe.WriteMarker(&buf))
+	assert.NoError(unsafe.WriteSlice(&buf, srs.Pk.G1))
+	var dump bytes.Buffer
+	assert.NoError(srs.WriteDump(&dump))
+	assert.Equal(buf.Bytes(), dump.Bytes())
+	var dumped SRS
+	assert.NoError(dumped.ReadDump(bytes.NewReader(buf.Bytes())))
+	assert.Equal(*srs, dumped)
+
+	path := filepath.Join(t.TempDir(), "srs.dump")
+	assert.NoError(os.WriteFile(path, buf.Bytes(), 0o600))
+	mapped, err := OpenMappedSRS(path)
+	assert.NoError(err)
+	assert.Equal(srs.Pk.G1, mapped.Pk.G1)
+	assert.Nil(mapped.Pk.Gamma)
+	assert.NoError(mapped.Close())
+
+	// the marker of the hiding encodings is not a valid encoding of a G₂ point
+	marker := make([]byte, bls12377.SizeOfG2AffineUncompressed)
+	binary.BigEndian.PutUint32(marker, hidingMarker)
+	var g2 bls12377.G2Affine
+	_, err = g2.SetBytes(marker)
+	assert.Error(err)
+}
+
+// encodeBaselineVk encodes vk as the versions without hiding commitments did
+func encodeBaselineVk(enc *bls12377.Encoder, vk *VerifyingKey) error {
+	toEncode := []interface{}{&vk.G2[0], &vk.G2[1], &vk.G1}
+	for k := 0; k < 2; k++ {
+		for j := 0; j < 2; j++ {
+			for i := len(vk.Lines[k][j]) - 1; i >= 0; i-- {
+				toEncode = append(toEncode, &vk.Lines[k][j][i].R0, &vk.Lines[k][j][i].R1)
+			}
+		}
+	}
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func TestCommit(t *testing.T) {
@@ -6,12 +6,63 @@
 package kzg
 
 import (
+	"bytes"
+	"encoding/binary"
+	"errors"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377"
 	"io"
 
 	"github.com/consensys/gnark-crypto/utils/unsafe"
 )
 
+// The encodings of a ProvingKey and of a VerifyingKey which support hiding
+// commitments start with hidingMarker followed by hidingVersion (uint32, big
+// endian). The marker is not a valid prefix of the encodings without blinding
+// generators, where it would be the number of points of the ProvingKey or the
+// first coordinate of a G₂ point of the VerifyingKey: the encodings of a SRS
+// which does not support hiding commitments are unchanged.
+const (
+	hidingMarker  = 0xffffffff
+	hidingVersion = 1
+
+	// sizeOfHidingHeader keeps the alignment of the dumps
+	sizeOfHidingHeader = 8
+)
+
+var ErrHidingVersion = errors.New("kzg: unsupported version of the hiding SRS encoding")
+
+// writeHidingHeader writes the marker and the version to w if hiding is set
+func writeHidingHeader(w io.Writer, hiding bool) (int64, error) {
+	if !hiding {
+		return 0, nil
+	}
+	var buf [sizeOfHidingHeader]byte
+	binary.BigEndian.PutUint32(buf[:4], hidingMarker)
+	binary.BigEndian.PutUint32(buf[4:], hidingVersion)
+	n, err := w.Write(buf[:])
+	return int64(n), err
+}
+
+// readHidingHeader reads the first 4 bytes of r and returns whether they are
+// hidingMarker, in which case the version is read too. If not, the returned
+// reader replays them. n is the number of bytes read which are not replayed.
+func readHidingHeader(r io.Reader) (hiding bool, n int64, _ io.Reader, err error) {
+	var buf [sizeOfHidingHeader]byte
+	if _, err = io.ReadFull(r, buf[:4]); err != nil {
+		return false, 0, r, err
+	}
+	if binary.BigEndian.Uint32(buf[:4]) != hidingMarker {
+		return false, 0, io.MultiReader(bytes.NewReader(buf[:4]), r), nil
+	}
+	if _, err = io.ReadFull(r, buf[4:]); err != nil {
+		return true, 4, r, err
+	}
+	if binary.BigEndian.Uint32(buf[4:]) != hidingVersion {
+		return true, sizeOfHidingHeader, r, ErrHidingVersion
+	}
+	return true, sizeOfHidingHeader, r, nil
+}
+
 // WriteTo writes binary encoding of the ProvingKey
 func (pk *ProvingKey) WriteTo(w io.Writer) (int64, error) {
 	return pk.writeTo(w)
@@ -23,12 +74,23 @@ func (pk *ProvingKey) WriteRawTo(w io.Writer) (int64, error) {
 }
 
 func (pk *ProvingKey) writeTo(w io.Writer, options ...func(*bls12377.Encoder)) (int64, error) {
+	hiding := len(pk.Gamma) != 0
+	n, err := writeHidingHeader(w, hiding)
+	if err != nil {
+		return n, err
+	}
+
 	// encode the ProvingKey
 	enc := bls12377.NewEncoder(w, options...)
 	if err := enc.Encode(pk.G1); err != nil {
-		return enc.BytesWritten(), err
+		return n + enc.BytesWritten(), err
 	}
-	return enc.BytesWritten(), nil
+	if hiding {
+		if err := enc.Encode(pk.Gamma); err != nil {
+			return n + enc.BytesWritten(), err
+		}
+	}
+	return n + enc.BytesWritten(), nil
 }
 
 // WriteRawTo writes binary encoding of VerifyingKey to w without point compression
@@ -42,10 +104,16 @@ func (vk *VerifyingKey) WriteTo(w io.Writer) (int64, error) {
 }
 
 func (vk *VerifyingKey) writeTo(w io.Writer, options ...func(*bls12377.Encoder)) (int64, error) {
+	hiding := !vk.Gamma.IsInfinity()
+	n, err := writeHidingHeader(w, hiding)
+	if err != nil {
+		return n, err
+	}
+
 	// encode the VerifyingKey
 	enc := bls12377.NewEncoder(w, options...)
 	nLines := 63
-	toEncode := make([]interface{}, 0, 4*nLines+3)
+	toEncode := make([]interface{}, 0, 4*nLines+4)
 	toEncode = append(toEncode, &vk.G2[0])
 	toEncode = append(toEncode, &vk.G2[1])
 	toEncode = append(toEncode, &vk.G1)
@@ -57,14 +125,17 @@ func (vk *VerifyingKey) writeTo(w io.Writer, options ...func(*bls12377.Encoder))
 			}
 		}
 	}
+	if hiding {
+		toEncode = append(toEncode, &vk.Gamma)
+	}
 
 	for _, v := range toEncode {
 		if err := enc.Encode(v); err != nil {
-			return enc.BytesWritten(), err
+			return n + enc.BytesWritten(), err
 		}
 	}
 
-	return enc.BytesWritten(), nil
+	return n + enc.BytesWritten(), nil
 }
 
 // WriteDump writes the binary encoding of the entire SRS memory representation
@@ -90,8 +161,15 @@ func (srs *SRS) WriteDump(w io.Writer, maxPkPoints ...int) error {
 		return err
 	}
 
-	// write the slice
-	return unsafe.WriteSlice(w, srs.Pk.G1[:maxG1])
+	// write the slices; the blinding generators are written if the
+	// VerifyingKey supports hiding commitments
+	if err := unsafe.WriteSlice(w, srs.Pk.G1[:maxG1]); err != nil {
+		return err
+	}
+	if srs.Vk.Gamma.IsInfinity() {
+		return nil
+	}
+	return unsafe.WriteSlice(w, srs.Pk.Gamma)
 }
 
 // ReadDump deserializes the SRS from a reader, as written by WriteDump
@@ -107,8 +185,16 @@ func (srs *SRS) ReadDump(r io.Reader, maxPkPoints ...int) error {
 		return err
 	}
 
-	// read the slice
+	// read the slices
 	srs.Pk.G1, _, err = unsafe.ReadSlice[[]bls12377.G1Affine](r, maxPkPoints...)
+	if err != nil {
+		return err
+	}
+	srs.Pk.Gamma = nil
+	if srs.Vk.Gamma.IsInfinity() {
+		return nil
+	}
+	srs.Pk.Gamma, _, err = unsafe.ReadSlice[[]bls12377.G1Affine](r)
 	return err
 }
 
@@ -138,31 +224,58 @@ func (srs *SRS) WriteRawTo(w io.Writer) (int64, error) {
 
 // ReadFrom decodes ProvingKey data from reader.
 func (pk *ProvingKey) ReadFrom(r io.Reader) (int64, error) {
+	hiding, n, r, err := readHidingHeader(r)
+	if err != nil {
+		return n, err
+	}
+
 	// decode the ProvingKey
 	dec := bls12377.NewDecoder(r)
 	if err := dec.Decode(&pk.G1); err != nil {
-		return dec.BytesRead(), err
+		return n + dec.BytesRead(), err
 	}
-	return dec.BytesRead(), nil
+	pk.Gamma = nil
+	if hiding {
+		if err := dec.Decode(&pk.Gamma); err != nil {
+			return n + dec.BytesRead(), err
+		}
+	}
+	return n + dec.BytesRead(), nil
 }
 
 // UnsafeReadFrom decodes ProvingKey data from reader without checking
 // that point are in the correct subgroup.
 func (pk *ProvingKey) UnsafeReadFrom(r io.Reader) (int64, error) {
+	hiding, n, r, err := readHidingHeader(r)
+	if err != nil {
+		return n, err
+	}
+
 	// decode the ProvingKey
 	dec := bls12377.NewDecoder(r, bls12377.NoSubgroupChecks())
 	if err := dec.Decode(&pk.G1); err != nil {
-		return dec.BytesRead(), err
+		return n + dec.BytesRead(), err
 	}
-	return dec.BytesRead(), nil
+	pk.Gamma = nil
+	if hiding {
+		if err := dec.Decode(&pk.Gamma); err != nil {
+			return n + dec.BytesRead(), err
+		}
+	}
+	return n + dec.BytesRead(), nil
 }
 
 // ReadFrom decodes VerifyingKey data from reader.
 func (vk *VerifyingKey) ReadFrom(r io.Reader) (int64, error) {
+	hiding, n, r, err := readHidingHeader(r)
+	if err != nil {
+		return n, err
+	}
+
 	// decode the VerifyingKey
 	dec := bls12377.NewDecoder(r)
 	nLines := 63
-	toDecode := make([]interface{}, 0, 4*nLines+3)
+	toDecode := make([]interface{}, 0, 4*nLines+4)
 	toDecode = append(toDecode, &vk.G2[0])
 	toDecode = append(toDecode, &vk.G2[1])
 	toDecode = append(toDecode, &vk.G1)
@@ -174,14 +287,18 @@ func (vk *VerifyingKey) ReadFrom(r io.Reader) (int64, error) {
 			}
 		}
 	}
+	vk.Gamma.SetInfinity()
+	if hiding {
+		toDecode = append(toDecode, &vk.Gamma)
+	}
 
 	for _, v := range toDecode {
 		if err := dec.Decode(v); err != nil {
-			return dec.BytesRead(), err
+			return n + dec.BytesRead(), err
 		}
 	}
 
-	return dec.BytesRead(), nil
+	return n + dec.BytesRead(), nil
 }
 
 // ReadFrom decodes SRS data from reader.
@@ -278,3 +395,115 @@ func (proof *BatchOpeningProof) ReadFrom(r io.Reader) (int64, error) {
 
 	return dec.BytesRead(), nil
 }
+
+// WriteTo writes binary encoding of a HidingOpeningProof
+func (proof *HidingOpeningProof) WriteTo(w io.Writer) (int64, error) {
+	enc := bls12377.NewEncoder(w)
+
+	toEncode := []interface{}{
+		&proof.H,
+		&proof.ClaimedValue,
+		&proof.BlindedValue,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes HidingOpeningProof data from reader.
+func (proof *HidingOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := bls12377.NewDecoder(r)
+
+	toDecode := []interface{}{
+		&proof.H,
+		&proof.ClaimedValue,
+		&proof.BlindedValue,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of a HidingBatchOpeningProof
+func (proof *HidingBatchOpeningProof) WriteTo(w io.Writer) (int64, error) {
+	enc := bls12377.NewEncoder(w)
+
+	toEncode := []interface{}{
+		&proof.H,
+		proof.ClaimedValues,
+		proof.BlindedValues,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes HidingBatchOpeningProof data from reader.
+func (proof *HidingBatchOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := bls12377.NewDecoder(r)
+	toDecode := []interface{}{
+		&proof.H,
+		&proof.ClaimedValues,
+		&proof.BlindedValues,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of a DegreeBoundProof
+func (proof *DegreeBoundProof) WriteTo(w io.Writer) (int64, error) {
+	enc := bls12377.NewEncoder(w)
+
+	toEncode := []interface{}{
+		proof.Shifted,
+		&proof.H,
+		proof.ClaimedValues,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes DegreeBoundProof data from reader.
+func (proof *DegreeBoundProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := bls12377.NewDecoder(r)
+	toDecode := []interface{}{
+		&proof.Shifted,
+		&proof.H,
+		&proof.ClaimedValues,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
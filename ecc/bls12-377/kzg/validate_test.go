@@ -0,0 +1,96 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/stretchr/testify/require"
+)
+
+// cloneSRS returns a deep copy of srs
+func cloneSRS(srs *SRS) *SRS {
+	res := *srs
+	res.Pk.G1 = append([]bls12377.G1Affine{}, srs.Pk.G1...)
+	return &res
+}
+
+func TestValidate(t *testing.T) {
+	assert := require.New(t)
+
+	assert.NoError(testSrs.Validate())
+
+	// a power of τ is wrong
+	srs := cloneSRS(testSrs)
+	srs.Pk.G1[10] = srs.Pk.G1[11]
+	err := srs.Validate()
+	assert.ErrorIs(err, ErrInvalidSRS)
+	assert.Contains(err.Error(), "Pk.G1[10] ≠ [τ]Pk.G1[9]")
+
+	srs = cloneSRS(testSrs)
+	srs.Pk.G1[len(srs.Pk.G1)-1] = srs.Pk.G1[0]
+	err = srs.Validate()
+	assert.ErrorIs(err, ErrInvalidSRS)
+	assert.Contains(err.Error(), "Pk.G1[255]")
+
+	// a point is not on the curve
+	srs = cloneSRS(testSrs)
+	srs.Pk.G1[7].X.SetOne()
+	err = srs.Validate()
+	assert.ErrorIs(err, ErrInvalidSRS)
+	assert.Contains(err.Error(), "Pk.G1[7] is not on the curve")
+
+	// verifying key
+	srs = cloneSRS(testSrs)
+	srs.Vk.Lines[1] = srs.Vk.Lines[0]
+	assert.ErrorIs(srs.Validate(), ErrInvalidSRS)
+
+	srs = cloneSRS(testSrs)
+	srs.Vk.G1 = srs.Pk.G1[1]
+	assert.ErrorIs(srs.Validate(), ErrInvalidSRS)
+
+	// [τ]G₂ does not match the proving key
+	srs = cloneSRS(testSrs)
+	srs.Vk.G2[1] = srs.Vk.G2[0]
+	srs.Vk.Lines[1] = srs.Vk.Lines[0]
+	err = srs.Validate()
+	assert.ErrorIs(err, ErrInvalidSRS)
+	assert.Contains(err.Error(), "Pk.G1[1] ≠ [τ]Pk.G1[0]")
+}
+
+func TestValidateLagrange(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 32
+	lagrange, err := ToLagrangeG1(testSrs.Pk.G1[:size])
+	assert.NoError(err)
+	pk := ProvingKey{G1: lagrange}
+
+	assert.NoError(testSrs.ValidateLagrange(pk))
+
+	// the monomial form is not the Lagrange form
+	assert.ErrorIs(testSrs.ValidateLagrange(ProvingKey{G1: testSrs.Pk.G1[:size]}), ErrInvalidSRS)
+
+	// a point is wrong
+	pk.G1[5], pk.G1[6] = pk.G1[6], pk.G1[5]
+	err = testSrs.ValidateLagrange(pk)
+	assert.ErrorIs(err, ErrInvalidSRS)
+	assert.Contains(err.Error(), "Lagrange G1[5]")
+	pk.G1[5], pk.G1[6] = pk.G1[6], pk.G1[5]
+
+	// invalid sizes
+	assert.ErrorIs(testSrs.ValidateLagrange(ProvingKey{G1: lagrange[:size-1]}), ErrInvalidSRS)
+	assert.ErrorIs(testSrs.ValidateLagrange(ProvingKey{}), ErrInvalidSRS)
+}
+
+func BenchmarkValidate(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if err := testSrs.Validate(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
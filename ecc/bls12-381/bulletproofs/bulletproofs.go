@@ -0,0 +1,554 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bulletproofs
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// NbBits size in bits of the range proven by a range proof.
+const NbBits = 64
+
+var (
+	ErrInvalidNbValues    = errors.New("the number of values must be positive and at most the aggregation capacity of the generators")
+	ErrInvalidNbBlindings = errors.New("the number of blinding factors must be the number of values")
+	ErrInvalidProof       = errors.New("malformed range proof")
+	ErrVerifyRangeProof   = errors.New("can't verify range proof")
+)
+
+// Generators public parameters of the range proofs, obtained by hashing to G1.
+//
+// implements io.ReaderFrom and io.WriterTo
+type Generators struct {
+	// G, H bases of the Pedersen commitments vG + γH to the values
+	G, H bls12381.G1Affine
+
+	// Gs, Hs bases of the vector commitments, of size NbBits times the
+	// maximum number of aggregated values
+	Gs, Hs []bls12381.G1Affine
+
+	// U base of the inner products in the inner-product argument
+	U bls12381.G1Affine
+}
+
+// RangeProof proof that committed values are in [0, 2⁶⁴). It proves one value,
+// or several values at once when aggregated.
+//
+// implements io.ReaderFrom and io.WriterTo
+type RangeProof struct {
+	// A commitment to the bits of the values
+	A bls12381.G1Affine
+
+	// S commitment to the blinding vectors of the bits
+	S bls12381.G1Affine
+
+	// T1, T2 commitments to the coefficients of t(X) = ⟨l(X), r(X)⟩
+	T1, T2 bls12381.G1Affine
+
+	// TauX blinding factor of t(x)
+	TauX fr.Element
+
+	// Mu blinding factor of A + xS
+	Mu fr.Element
+
+	// T value of t(x)
+	T fr.Element
+
+	// InnerProduct proof that T = ⟨l(x), r(x)⟩
+	InnerProduct InnerProductProof
+}
+
+// NewGenerators returns generators for range proofs aggregating up to
+// maxAggregation values. The generators are obtained with HashToG1 on the
+// messages "G", "H", "U", and "Gs" and "Hs" followed by the index encoded on 8
+// bytes, with the domain separation tag dst.
+func NewGenerators(maxAggregation int, dst []byte) (Generators, error) {
+	if maxAggregation < 1 || maxAggregation > 1<<24 {
+		return Generators{}, ErrInvalidNbValues
+	}
+	n := NbBits * int(ecc.NextPowerOfTwo(uint64(maxAggregation)))
+
+	var res Generators
+	var err error
+	if res.G, err = bls12381.HashToG1([]byte("G"), dst); err != nil {
+		return Generators{}, err
+	}
+	if res.H, err = bls12381.HashToG1([]byte("H"), dst); err != nil {
+		return Generators{}, err
+	}
+	if res.U, err = bls12381.HashToG1([]byte("U"), dst); err != nil {
+		return Generators{}, err
+	}
+	if res.Gs, err = hashToG1Vector("Gs", n, dst); err != nil {
+		return Generators{}, err
+	}
+	if res.Hs, err = hashToG1Vector("Hs", n, dst); err != nil {
+		return Generators{}, err
+	}
+	return res, nil
+}
+
+// Commit returns the Pedersen commitment vG + γH to v with blinding factor γ.
+func Commit(v uint64, blinding fr.Element, gens *Generators) bls12381.G1Affine {
+	var s fr.Element
+	s.SetUint64(v)
+	return pedersen(&s, &blinding, gens)
+}
+
+// Prove computes a range proof for the values committed with the blinding
+// factors blindings, that is, for the commitments Commit(values[j], blindings[j]).
+// When there are several values, the proof is aggregated; the number of values
+// is padded to the next power of 2 with commitments to 0.
+//
+// * dataTranscript extra data that might be needed to derive the challenges
+func Prove(values []uint64, blindings []fr.Element, gens *Generators, hf hash.Hash, dataTranscript ...[]byte) (RangeProof, error) {
+	m := len(values)
+	if len(blindings) != m {
+		return RangeProof{}, ErrInvalidNbBlindings
+	}
+	n, err := checkNbValues(m, gens)
+	if err != nil {
+		return RangeProof{}, err
+	}
+	commitments := make([]bls12381.G1Affine, m)
+	for j := range values {
+		commitments[j] = Commit(values[j], blindings[j], gens)
+	}
+
+	// aL bits of the values, aR = aL - 1, sL, sR random blinding vectors
+	aL := make([]fr.Element, n)
+	aR := make([]fr.Element, n)
+	sL := make([]fr.Element, n)
+	sR := make([]fr.Element, n)
+	var one fr.Element
+	one.SetOne()
+	for i := 0; i < n; i++ {
+		if j := i / NbBits; j < m && (values[j]>>(i%NbBits))&1 == 1 {
+			aL[i].SetOne()
+		} else {
+			aR[i].Neg(&one)
+		}
+		if _, err := sL[i].SetRandom(); err != nil {
+			return RangeProof{}, err
+		}
+		if _, err := sR[i].SetRandom(); err != nil {
+			return RangeProof{}, err
+		}
+	}
+	var alpha, rho, tau1, tau2 fr.Element
+	for _, r := range []*fr.Element{&alpha, &rho, &tau1, &tau2} {
+		if _, err := r.SetRandom(); err != nil {
+			return RangeProof{}, err
+		}
+	}
+
+	var res RangeProof
+	if err := vectorCommit(&res.A, aL, aR, alpha, gens); err != nil {
+		return RangeProof{}, err
+	}
+	if err := vectorCommit(&res.S, sL, sR, rho, gens); err != nil {
+		return RangeProof{}, err
+	}
+
+	fs := newTranscript(hf, n)
+	y, z, err := deriveYZ(fs, commitments, &res, dataTranscript...)
+	if err != nil {
+		return RangeProof{}, err
+	}
+
+	// l(X) = aL - z1 + sL X
+	// r(X) = yⁿ∘(aR + z1 + sR X) + ∑ⱼ z²⁺ʲ(0 ‖ 2ⁿ ‖ 0)
+	yPowers := powers(y, n)
+	zPowers := powers(z, n/NbBits+3)
+	l0, l1, r0, r1 := aL, sL, aR, sR
+	for i := 0; i < n; i++ {
+		l0[i].Sub(&l0[i], &z)
+		r0[i].Add(&r0[i], &z).Mul(&r0[i], &yPowers[i])
+		r1[i].Mul(&r1[i], &yPowers[i])
+	}
+	var twoPower fr.Element
+	for j := 0; j < n/NbBits; j++ {
+		twoPower.Set(&zPowers[2+j])
+		for i := j * NbBits; i < (j+1)*NbBits; i++ {
+			r0[i].Add(&r0[i], &twoPower)
+			twoPower.Double(&twoPower)
+		}
+	}
+
+	// t(X) = ⟨l(X), r(X)⟩ = t₀ + t₁X + t₂X²
+	t1 := innerProduct(l0, r1)
+	t := innerProduct(l1, r0)
+	t1.Add(&t1, &t)
+	t2 := innerProduct(l1, r1)
+	res.T1 = pedersen(&t1, &tau1, gens)
+	res.T2 = pedersen(&t2, &tau2, gens)
+
+	x, err := deriveX(fs, &res)
+	if err != nil {
+		return RangeProof{}, err
+	}
+
+	// l = l(x), r = r(x)
+	for i := 0; i < n; i++ {
+		t.Mul(&l1[i], &x)
+		l0[i].Add(&l0[i], &t)
+		t.Mul(&r1[i], &x)
+		r0[i].Add(&r0[i], &t)
+	}
+	res.T = innerProduct(l0, r0)
+
+	// τₓ = τ₂x² + τ₁x + ∑ⱼ z²⁺ʲγⱼ, μ = α + ρx
+	res.TauX.Mul(&tau2, &x).Add(&res.TauX, &tau1).Mul(&res.TauX, &x)
+	for j := range blindings {
+		t.Mul(&zPowers[2+j], &blindings[j])
+		res.TauX.Add(&res.TauX, &t)
+	}
+	res.Mu.Mul(&rho, &x).Add(&res.Mu, &alpha)
+
+	w, err := deriveW(fs, &res)
+	if err != nil {
+		return RangeProof{}, err
+	}
+	var u bls12381.G1Affine
+	var bw big.Int
+	u.ScalarMultiplication(&gens.U, w.BigInt(&bw))
+
+	// the inner-product argument is on the bases Gs and H'ᵢ = y⁻ⁱHᵢ
+	g := make([]bls12381.G1Affine, n)
+	copy(g, gens.Gs)
+	h := make([]bls12381.G1Jac, n)
+	var yInv fr.Element
+	yInvPowers := powers(*yInv.Inverse(&y), n)
+	parallel.Execute(n, func(start, end int) {
+		var b big.Int
+		for i := start; i < end; i++ {
+			h[i].FromAffine(&gens.Hs[i])
+			h[i].ScalarMultiplication(&h[i], yInvPowers[i].BigInt(&b))
+		}
+	})
+	res.InnerProduct, err = proveInnerProduct(fs, g, bls12381.BatchJacobianToAffineG1(h), u, l0, r0)
+	if err != nil {
+		return RangeProof{}, err
+	}
+
+	return res, nil
+}
+
+// Verify verifies a range proof for the commitments.
+func Verify(commitments []bls12381.G1Affine, proof *RangeProof, gens *Generators, hf hash.Hash, dataTranscript ...[]byte) error {
+	return BatchVerify([][]bls12381.G1Affine{commitments}, []RangeProof{*proof}, gens, hf, dataTranscript...)
+}
+
+// BatchVerify verifies several range proofs, proofs[k] being a proof for the
+// commitments commitments[k]. All the verification equations are combined
+// with random coefficients and checked with a single multi-scalar
+// multiplication.
+func BatchVerify(commitments [][]bls12381.G1Affine, proofs []RangeProof, gens *Generators, hf hash.Hash, dataTranscript ...[]byte) error {
+	if len(commitments) != len(proofs) {
+		return ErrInvalidProof
+	}
+	if len(proofs) == 0 {
+		return ErrInvalidNbValues
+	}
+
+	// the scalars of the bases shared by all the proofs
+	maxSize := 0
+	nbPoints := 0
+	for k := range proofs {
+		n, err := checkNbValues(len(commitments[k]), gens)
+		if err != nil {
+			return err
+		}
+		if len(proofs[k].InnerProduct.L) != log2(n) || len(proofs[k].InnerProduct.R) != log2(n) {
+			return ErrInvalidProof
+		}
+		maxSize = max(maxSize, n)
+		nbPoints += len(commitments[k]) + 4 + 2*log2(n)
+	}
+	var gScalar, hScalar, uScalar fr.Element
+	gsScalars := make([]fr.Element, maxSize)
+	hsScalars := make([]fr.Element, maxSize)
+
+	// the bases specific to each proof
+	points := make([]bls12381.G1Affine, 0, nbPoints+2*maxSize+3)
+	scalars := make([]fr.Element, 0, nbPoints+2*maxSize+3)
+
+	var t, twoPowerSum fr.Element
+	twoPowerSum.SetUint64(^uint64(0)) // ∑ᵢ 2ⁱ
+	for k := range proofs {
+		proof := &proofs[k]
+		m := len(commitments[k])
+		n, _ := checkNbValues(m, gens)
+
+		fs := newTranscript(hf, n)
+		y, z, err := deriveYZ(fs, commitments[k], proof, dataTranscript...)
+		if err != nil {
+			return err
+		}
+		x, err := deriveX(fs, proof)
+		if err != nil {
+			return err
+		}
+		w, err := deriveW(fs, proof)
+		if err != nil {
+			return err
+		}
+		u, uInv, err := proof.InnerProduct.roundChallenges(fs)
+		if err != nil {
+			return err
+		}
+		s := foldingScalars(u, uInv)
+
+		// random coefficients of the two verification equations
+		var beta1, beta2 fr.Element
+		if _, err := beta1.SetRandom(); err != nil {
+			return err
+		}
+		if _, err := beta2.SetRandom(); err != nil {
+			return err
+		}
+
+		yPowers := powers(y, n)
+		var yInv fr.Element
+		yInvPowers := powers(*yInv.Inverse(&y), n)
+		zPowers := powers(z, n/NbBits+3)
+
+		// 1. TG + τₓH = ∑ⱼ z²⁺ʲVⱼ + δ(y, z)G + xT₁ + x²T₂
+		// where δ(y, z) = (z-z²)⟨1, yⁿ⟩ - ∑ⱼ z³⁺ʲ⟨1, 2ⁿ⟩
+		var delta, ySum fr.Element
+		for i := range yPowers {
+			ySum.Add(&ySum, &yPowers[i])
+		}
+		delta.Sub(&z, &zPowers[2]).Mul(&delta, &ySum)
+		for j := 0; j < n/NbBits; j++ {
+			t.Mul(&zPowers[3+j], &twoPowerSum)
+			delta.Sub(&delta, &t)
+		}
+		t.Sub(&proof.T, &delta).Mul(&t, &beta1)
+		gScalar.Add(&gScalar, &t)
+		t.Mul(&proof.TauX, &beta1)
+		hScalar.Add(&hScalar, &t)
+		for j := 0; j < m; j++ {
+			t.Mul(&zPowers[2+j], &beta1).Neg(&t)
+			points = append(points, commitments[k][j])
+			scalars = append(scalars, t)
+		}
+		t.Mul(&x, &beta1).Neg(&t)
+		points = append(points, proof.T1)
+		scalars = append(scalars, t)
+		t.Mul(&t, &x)
+		points = append(points, proof.T2)
+		scalars = append(scalars, t)
+
+		// 2. A + xS - z⟨1, Gs⟩ + ⟨zyⁿ + c, H'⟩ - μH + TwU + ∑ₖ (u²ₖLₖ + u⁻²ₖRₖ)
+		//    = a⟨s, Gs⟩ + b⟨s', H'⟩ + abwU
+		// where H'ᵢ = y⁻ⁱHsᵢ, and cᵢ = z²⁺ʲ2ⁱ⁻ʲⁿ for i in the j-th block
+		points = append(points, proof.A, proof.S)
+		scalars = append(scalars, beta2)
+		t.Mul(&x, &beta2)
+		scalars = append(scalars, t)
+		t.Mul(&proof.Mu, &beta2)
+		hScalar.Sub(&hScalar, &t)
+		t.Mul(&proof.InnerProduct.A, &proof.InnerProduct.B).Sub(&proof.T, &t).Mul(&t, &w).Mul(&t, &beta2)
+		uScalar.Add(&uScalar, &t)
+		for i := range u {
+			t.Square(&u[i]).Mul(&t, &beta2)
+			points = append(points, proof.InnerProduct.L[i])
+			scalars = append(scalars, t)
+		}
+		for i := range uInv {
+			t.Square(&uInv[i]).Mul(&t, &beta2)
+			points = append(points, proof.InnerProduct.R[i])
+			scalars = append(scalars, t)
+		}
+
+		var bz, twoPower, gs, hs fr.Element
+		bz.Mul(&beta2, &z)
+		for i := 0; i < n; i++ {
+			// Gsᵢ: -β₂(z + asᵢ)
+			gs.Mul(&proof.InnerProduct.A, &s[i]).Add(&gs, &z).Mul(&gs, &beta2)
+			gsScalars[i].Sub(&gsScalars[i], &gs)
+
+			// Hsᵢ: β₂(z + y⁻ⁱ(cᵢ - bs'ᵢ))
+			if i%NbBits == 0 {
+				twoPower.Set(&zPowers[2+i/NbBits])
+			}
+			hs.Mul(&proof.InnerProduct.B, &s[n-1-i]).Sub(&twoPower, &hs).Mul(&hs, &yInvPowers[i]).Mul(&hs, &beta2)
+			hs.Add(&hs, &bz)
+			hsScalars[i].Add(&hsScalars[i], &hs)
+			twoPower.Double(&twoPower)
+		}
+	}
+
+	points = append(points, gens.Gs[:maxSize]...)
+	scalars = append(scalars, gsScalars...)
+	points = append(points, gens.Hs[:maxSize]...)
+	scalars = append(scalars, hsScalars...)
+	points = append(points, gens.G, gens.H, gens.U)
+	scalars = append(scalars, gScalar, hScalar, uScalar)
+
+	var check bls12381.G1Jac
+	if _, err := check.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	if !check.Z.IsZero() {
+		return ErrVerifyRangeProof
+	}
+	return nil
+}
+
+// checkNbValues checks that m values can be proven with gens, and returns the
+// size of the bit vectors.
+func checkNbValues(m int, gens *Generators) (int, error) {
+	if m < 1 || m > len(gens.Gs)/NbBits || len(gens.Hs) != len(gens.Gs) {
+		return 0, ErrInvalidNbValues
+	}
+	return NbBits * int(ecc.NextPowerOfTwo(uint64(m))), nil
+}
+
+// vectorCommit sets res to ⟨l, Gs⟩ + ⟨r, Hs⟩ + blinding H.
+func vectorCommit(res *bls12381.G1Affine, l, r []fr.Element, blinding fr.Element, gens *Generators) error {
+	n := len(l)
+	points := make([]bls12381.G1Affine, 0, 2*n+1)
+	points = append(points, gens.Gs[:n]...)
+	points = append(points, gens.Hs[:n]...)
+	points = append(points, gens.H)
+	scalars := make([]fr.Element, 0, 2*n+1)
+	scalars = append(scalars, l...)
+	scalars = append(scalars, r...)
+	scalars = append(scalars, blinding)
+	_, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{})
+	return err
+}
+
+// pedersen returns vG + γH.
+func pedersen(v, blinding *fr.Element, gens *Generators) bls12381.G1Affine {
+	var bv, bBlinding big.Int
+	var res bls12381.G1Jac
+	res.JointScalarMultiplication(&gens.G, &gens.H, v.BigInt(&bv), blinding.BigInt(&bBlinding))
+	var resAff bls12381.G1Affine
+	resAff.FromJacobian(&res)
+	return resAff
+}
+
+func hashToG1Vector(prefix string, n int, dst []byte) ([]bls12381.G1Affine, error) {
+	res := make([]bls12381.G1Affine, n)
+	errs := make([]error, n)
+	parallel.Execute(n, func(start, end int) {
+		msg := make([]byte, len(prefix)+8)
+		copy(msg, prefix)
+		for i := start; i < end; i++ {
+			binary.BigEndian.PutUint64(msg[len(prefix):], uint64(i))
+			res[i], errs[i] = bls12381.HashToG1(msg, dst)
+		}
+	})
+	return res, errors.Join(errs...)
+}
+
+// powers returns 1, x, ..., xⁿ⁻¹.
+func powers(x fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &x)
+	}
+	return res
+}
+
+// log2 returns log₂(n) for a power of 2.
+func log2(n int) int {
+	return bits.TrailingZeros(uint(n))
+}
+
+func newTranscript(hf hash.Hash, n int) *fiatshamir.Transcript {
+	challenges := []string{"y", "z", "x", "w"}
+	for k := 0; k < log2(n); k++ {
+		challenges = append(challenges, "u"+strconv.Itoa(k))
+	}
+	return fiatshamir.NewTranscript(hf, challenges...)
+}
+
+// deriveYZ derives the challenges y and z, binded to the commitments to the
+// values and to the bits.
+func deriveYZ(fs *fiatshamir.Transcript, commitments []bls12381.G1Affine, proof *RangeProof, dataTranscript ...[]byte) (y, z fr.Element, err error) {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(len(commitments)))
+	if err = fs.Bind("y", buf[:]); err != nil {
+		return
+	}
+	for i := range commitments {
+		if err = bindPoints(fs, "y", &commitments[i]); err != nil {
+			return
+		}
+	}
+	if err = bindPoints(fs, "y", &proof.A, &proof.S); err != nil {
+		return
+	}
+	for i := range dataTranscript {
+		if err = fs.Bind("y", dataTranscript[i]); err != nil {
+			return
+		}
+	}
+	if y, err = computeChallenge(fs, "y"); err != nil {
+		return
+	}
+	z, err = computeChallenge(fs, "z")
+	return
+}
+
+// deriveX derives the challenge x, binded to T₁ and T₂.
+func deriveX(fs *fiatshamir.Transcript, proof *RangeProof) (fr.Element, error) {
+	if err := bindPoints(fs, "x", &proof.T1, &proof.T2); err != nil {
+		return fr.Element{}, err
+	}
+	return computeChallenge(fs, "x")
+}
+
+// deriveW derives the challenge w scaling U in the inner-product argument,
+// binded to the opening of t(x).
+func deriveW(fs *fiatshamir.Transcript, proof *RangeProof) (fr.Element, error) {
+	for _, v := range []*fr.Element{&proof.TauX, &proof.Mu, &proof.T} {
+		if err := fs.Bind("w", v.Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	return computeChallenge(fs, "w")
+}
+
+func bindPoints(fs *fiatshamir.Transcript, name string, points ...*bls12381.G1Affine) error {
+	for _, p := range points {
+		b := p.RawBytes()
+		if err := fs.Bind(name, b[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// computeChallenge returns the challenge name as a non-zero field element.
+func computeChallenge(fs *fiatshamir.Transcript, name string) (fr.Element, error) {
+	b, err := fs.ComputeChallenge(name)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	if res.IsZero() {
+		return fr.Element{}, ErrVerifyRangeProof
+	}
+	return res, nil
+}
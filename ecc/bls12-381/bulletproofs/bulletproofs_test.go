@@ -0,0 +1,229 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bulletproofs
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+)
+
+// Generators re-used across tests of the range proofs
+var testGens Generators
+
+func init() {
+	var err error
+	testGens, err = NewGenerators(8, []byte("bulletproofs test"))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func randomBlindings(n int) []fr.Element {
+	res := make([]fr.Element, n)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+func commitAll(values []uint64, blindings []fr.Element) []bls12381.G1Affine {
+	res := make([]bls12381.G1Affine, len(values))
+	for i := range values {
+		res[i] = Commit(values[i], blindings[i], &testGens)
+	}
+	return res
+}
+
+func TestRangeProof(t *testing.T) {
+	assert := require.New(t)
+
+	for _, v := range []uint64{0, 1, 42, 1 << 32, math.MaxUint64} {
+		blindings := randomBlindings(1)
+		commitments := commitAll([]uint64{v}, blindings)
+
+		proof, err := Prove([]uint64{v}, blindings, &testGens, sha256.New())
+		assert.NoError(err)
+		assert.NoError(Verify(commitments, &proof, &testGens, sha256.New()))
+
+		// wrong commitment
+		other := commitAll([]uint64{v + 1}, blindings)
+		assert.Error(Verify(other, &proof, &testGens, sha256.New()))
+
+		// extra data in the transcript
+		assert.Error(Verify(commitments, &proof, &testGens, sha256.New(), []byte("data")))
+	}
+}
+
+func TestRangeProofOutOfRange(t *testing.T) {
+	assert := require.New(t)
+
+	// a commitment to -1 = r-1 is out of range; a proof for 2⁶⁴-1 with the same
+	// blinding must not verify against it
+	blindings := randomBlindings(1)
+	var minusOne fr.Element
+	minusOne.SetOne().Neg(&minusOne)
+	commitment := pedersen(&minusOne, &blindings[0], &testGens)
+
+	proof, err := Prove([]uint64{math.MaxUint64}, blindings, &testGens, sha256.New())
+	assert.NoError(err)
+	assert.Error(Verify([]bls12381.G1Affine{commitment}, &proof, &testGens, sha256.New()))
+}
+
+func TestAggregatedRangeProof(t *testing.T) {
+	assert := require.New(t)
+
+	for _, m := range []int{2, 3, 4, 8} {
+		values := make([]uint64, m)
+		for i := range values {
+			values[i] = uint64(i)*0x1234567890abcdef + 7
+		}
+		blindings := randomBlindings(m)
+		commitments := commitAll(values, blindings)
+
+		proof, err := Prove(values, blindings, &testGens, sha256.New())
+		assert.NoError(err)
+		assert.NoError(Verify(commitments, &proof, &testGens, sha256.New()))
+
+		// swapped commitments
+		commitments[0], commitments[1] = commitments[1], commitments[0]
+		assert.Error(Verify(commitments, &proof, &testGens, sha256.New()))
+
+		// missing commitment
+		assert.Error(Verify(commitments[1:], &proof, &testGens, sha256.New()))
+	}
+
+	_, err := Prove(make([]uint64, 9), randomBlindings(9), &testGens, sha256.New())
+	assert.ErrorIs(err, ErrInvalidNbValues)
+	_, err = Prove(make([]uint64, 2), randomBlindings(1), &testGens, sha256.New())
+	assert.ErrorIs(err, ErrInvalidNbBlindings)
+}
+
+func TestRangeProofTampered(t *testing.T) {
+	assert := require.New(t)
+
+	values := []uint64{5, 6}
+	blindings := randomBlindings(2)
+	commitments := commitAll(values, blindings)
+	proof, err := Prove(values, blindings, &testGens, sha256.New())
+	assert.NoError(err)
+
+	tampered := proof
+	tampered.T.SetRandom()
+	assert.Error(Verify(commitments, &tampered, &testGens, sha256.New()))
+
+	tampered = proof
+	tampered.TauX.SetRandom()
+	assert.Error(Verify(commitments, &tampered, &testGens, sha256.New()))
+
+	tampered = proof
+	tampered.A, tampered.S = proof.S, proof.A
+	assert.Error(Verify(commitments, &tampered, &testGens, sha256.New()))
+
+	tampered = proof
+	tampered.InnerProduct.B.SetRandom()
+	assert.Error(Verify(commitments, &tampered, &testGens, sha256.New()))
+
+	tampered = proof
+	tampered.InnerProduct.L = proof.InnerProduct.L[1:]
+	assert.ErrorIs(Verify(commitments, &tampered, &testGens, sha256.New()), ErrInvalidProof)
+}
+
+func TestBatchVerify(t *testing.T) {
+	assert := require.New(t)
+
+	const nbProofs = 5
+	commitments := make([][]bls12381.G1Affine, nbProofs)
+	proofs := make([]RangeProof, nbProofs)
+	for k := range proofs {
+		values := make([]uint64, k%3+1)
+		for i := range values {
+			values[i] = uint64(k*100 + i)
+		}
+		blindings := randomBlindings(len(values))
+		commitments[k] = commitAll(values, blindings)
+		var err error
+		proofs[k], err = Prove(values, blindings, &testGens, sha256.New())
+		assert.NoError(err)
+	}
+	assert.NoError(BatchVerify(commitments, proofs, &testGens, sha256.New()))
+
+	// one invalid proof
+	proofs[3].Mu.SetRandom()
+	assert.Error(BatchVerify(commitments, proofs, &testGens, sha256.New()))
+}
+
+func TestSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	values := []uint64{1, 2, 3}
+	proof, err := Prove(values, randomBlindings(3), &testGens, sha256.New())
+	assert.NoError(err)
+
+	var buf bytes.Buffer
+	written, err := proof.WriteTo(&buf)
+	assert.NoError(err)
+	assert.Equal(int64(buf.Len()), written)
+
+	var decoded RangeProof
+	read, err := decoded.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(proof, decoded)
+
+	buf.Reset()
+	written, err = testGens.WriteTo(&buf)
+	assert.NoError(err)
+	var gens Generators
+	read, err = gens.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(testGens, gens)
+}
+
+func BenchmarkProve(b *testing.B) {
+	blindings := randomBlindings(1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Prove([]uint64{42}, blindings, &testGens, sha256.New())
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	blindings := randomBlindings(1)
+	commitments := commitAll([]uint64{42}, blindings)
+	proof, err := Prove([]uint64{42}, blindings, &testGens, sha256.New())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Verify(commitments, &proof, &testGens, sha256.New())
+	}
+}
+
+func BenchmarkBatchVerify(b *testing.B) {
+	const nbProofs = 16
+	commitments := make([][]bls12381.G1Affine, nbProofs)
+	proofs := make([]RangeProof, nbProofs)
+	for k := range proofs {
+		blindings := randomBlindings(1)
+		commitments[k] = commitAll([]uint64{uint64(k)}, blindings)
+		var err error
+		if proofs[k], err = Prove([]uint64{uint64(k)}, blindings, &testGens, sha256.New()); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = BatchVerify(commitments, proofs, &testGens, sha256.New())
+	}
+}
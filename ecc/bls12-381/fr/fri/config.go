@@ -0,0 +1,130 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+var ErrInvalidConfig = errors.New("invalid FRI configuration")
+
+// Config parameters of FRI.
+type Config struct {
+	// FoldingFactor arity k of the folding, 2, 4, 8 or 16: each round divides
+	// the degree bound by k.
+	FoldingFactor int
+
+	// BlowupFactor ratio B = 1/ρ between the sizes of the codewords and the
+	// degree bounds, a power of 2 larger than 1.
+	BlowupFactor int
+
+	// NbQueries number of queries of the verifier.
+	NbQueries int
+
+	// FinalDegree the folding stops once the degree bound is at most
+	// FinalDegree + 1, and the folded polynomial is sent in clear.
+	FinalDegree int
+
+	// GrindingBits number of zero bits of the proof of work of the prover,
+	// computed before the queries are derived.
+	GrindingBits int
+}
+
+// DefaultConfig returns a configuration with about 100 bits of conjectured
+// security.
+func DefaultConfig() Config {
+	return Config{
+		FoldingFactor: 4,
+		BlowupFactor:  8,
+		NbQueries:     28,
+		FinalDegree:   7,
+		GrindingBits:  16,
+	}
+}
+
+// Check returns an error wrapping ErrInvalidConfig if the configuration is
+// invalid.
+func (c *Config) Check() error {
+	switch c.FoldingFactor {
+	case 2, 4, 8, 16:
+	default:
+		return fmt.Errorf("%w: the folding factor must be 2, 4, 8 or 16", ErrInvalidConfig)
+	}
+	if c.BlowupFactor < 2 || bits.OnesCount(uint(c.BlowupFactor)) != 1 {
+		return fmt.Errorf("%w: the blowup factor must be a power of 2 larger than 1", ErrInvalidConfig)
+	}
+	if c.NbQueries < 1 {
+		return fmt.Errorf("%w: the number of queries must be positive", ErrInvalidConfig)
+	}
+	if c.FinalDegree < 0 {
+		return fmt.Errorf("%w: the final degree must be non-negative", ErrInvalidConfig)
+	}
+	if c.GrindingBits < 0 || c.GrindingBits > 32 {
+		return fmt.Errorf("%w: the grinding bits must be between 0 and 32", ErrInvalidConfig)
+	}
+	return nil
+}
+
+// degreeBounds returns the degree bounds n₀ = size, …, n_R of the folded
+// polynomials, n_R being the degree bound of the final polynomial.
+func (c *Config) degreeBounds(size uint64) ([]uint64, error) {
+	if err := c.Check(); err != nil {
+		return nil, err
+	}
+	if bits.OnesCount64(size) != 1 {
+		return nil, fmt.Errorf("%w: the size must be a power of 2", ErrInvalidConfig)
+	}
+	if size <= uint64(c.FinalDegree)+1 {
+		return nil, fmt.Errorf("%w: the size must be larger than FinalDegree + 1", ErrInvalidConfig)
+	}
+	k := uint64(c.FoldingFactor)
+	res := []uint64{size}
+	for n := size; n > uint64(c.FinalDegree)+1; {
+		if n*uint64(c.BlowupFactor) < k {
+			return nil, fmt.Errorf("%w: the codewords are too small to be folded", ErrInvalidConfig)
+		}
+		n = max(n/k, 1)
+		res = append(res, n)
+	}
+	return res, nil
+}
+
+// ConjecturedSecurity returns the bits of security of FRI on polynomials of
+// degree < size, following the conjecture of the ethSTARK documentation that
+// each query brings log₂(B) bits, capped by the size of the field.
+func (c *Config) ConjecturedSecurity(size uint64) float64 {
+	queries := float64(c.NbQueries)*math.Log2(float64(c.BlowupFactor)) + float64(c.GrindingBits)
+	field := float64(fr.Bits-1) - math.Log2(float64(size*uint64(c.BlowupFactor)))
+	return math.Min(queries, field)
+}
+
+// ProvableSecurity returns the bits of security of FRI on polynomials of
+// degree < size, proven in the unique decoding regime, with a proximity
+// parameter δ = (1 - ρ)/2. The soundness error is bounded by
+//
+//	∑ᵣ (k - 1)·Nᵣ/|F| + ((1 + ρ)/2)^q·2^{-g}
+//
+// where Nᵣ are the sizes of the codewords which are folded, q the number of
+// queries and g the grinding bits.
+func (c *Config) ProvableSecurity(size uint64) float64 {
+	degreeBounds, err := c.degreeBounds(size)
+	if err != nil {
+		return 0
+	}
+	var folded float64
+	for _, n := range degreeBounds[:len(degreeBounds)-1] {
+		folded += float64(n * uint64(c.BlowupFactor))
+	}
+	commitError := math.Log2(float64(c.FoldingFactor-1)*folded) - float64(fr.Bits-1)
+	rho := 1 / float64(c.BlowupFactor)
+	queryError := float64(c.NbQueries)*math.Log2((1+rho)/2) - float64(c.GrindingBits)
+	return -math.Log2(math.Exp2(commitError) + math.Exp2(queryError))
+}
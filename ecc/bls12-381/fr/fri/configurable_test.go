@@ -0,0 +1,182 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/mimc"
+	"github.com/stretchr/testify/require"
+)
+
+func randomCoefficients(size int) []fr.Element {
+	res := make([]fr.Element, size)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+func TestConfigurableFRI(t *testing.T) {
+	const size = 256
+	for _, k := range []int{2, 4, 8, 16} {
+		for _, blowup := range []int{2, 4} {
+			for _, finalDegree := range []int{0, 3, 20} {
+				config := Config{
+					FoldingFactor: k,
+					BlowupFactor:  blowup,
+					NbQueries:     8,
+					FinalDegree:   finalDegree,
+					GrindingBits:  4,
+				}
+				t.Run(fmt.Sprintf("k=%d/blowup=%d/final=%d", k, blowup, finalDegree), func(t *testing.T) {
+					assert := require.New(t)
+
+					f, err := NewFRI(size, sha256.New(), config)
+					assert.NoError(err)
+					assert.LessOrEqual(f.degreeBounds[f.NbRounds()], uint64(finalDegree+1))
+
+					p := randomCoefficients(size)
+					proof, err := f.Prove(p)
+					assert.NoError(err)
+					assert.NoError(f.Verify(&proof))
+
+					// smaller polynomials
+					proof, err = f.Prove(p[:size/3])
+					assert.NoError(err)
+					assert.NoError(f.Verify(&proof))
+				})
+			}
+		}
+	}
+}
+
+func TestConfigurableFRIMiMC(t *testing.T) {
+	assert := require.New(t)
+
+	f, err := NewFRI(64, mimc.NewMiMC(), Config{FoldingFactor: 4, BlowupFactor: 4, NbQueries: 4, FinalDegree: 1, GrindingBits: 2})
+	assert.NoError(err)
+	proof, err := f.Prove(randomCoefficients(64))
+	assert.NoError(err)
+	assert.NoError(f.Verify(&proof))
+}
+
+func TestConfigurableFRISoundness(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 256
+	config := Config{FoldingFactor: 4, BlowupFactor: 4, NbQueries: 16, FinalDegree: 3}
+	f, err := NewFRI(size, sha256.New(), config)
+	assert.NoError(err)
+	p := randomCoefficients(size)
+	proof, err := f.Prove(p)
+	assert.NoError(err)
+
+	tamper := func(f func(proof *Proof)) *Proof {
+		tampered := proof
+		tampered.Roots = append([][]byte{}, proof.Roots...)
+		tampered.FinalPolynomial = append([]fr.Element{}, proof.FinalPolynomial...)
+		tampered.Queries = make([][]Opening, len(proof.Queries))
+		for q := range proof.Queries {
+			tampered.Queries[q] = make([]Opening, len(proof.Queries[q]))
+			for r, o := range proof.Queries[q] {
+				tampered.Queries[q][r] = Opening{Values: append([]fr.Element{}, o.Values...), Path: o.Path}
+			}
+		}
+		f(&tampered)
+		return &tampered
+	}
+
+	assert.ErrorIs(f.Verify(tamper(func(proof *Proof) { proof.Queries[3][1].Values[2].SetRandom() })), ErrMerklePath)
+	assert.ErrorIs(f.Verify(tamper(func(proof *Proof) { proof.Roots[0] = proof.Roots[1] })), ErrMerklePath)
+	assert.Error(f.Verify(tamper(func(proof *Proof) { proof.FinalPolynomial[1].SetRandom() })))
+	assert.ErrorIs(f.Verify(tamper(func(proof *Proof) { proof.Queries = proof.Queries[1:] })), ErrProofShape)
+	assert.ErrorIs(f.Verify(tamper(func(proof *Proof) { proof.FinalPolynomial = append(proof.FinalPolynomial, fr.One()) })), ErrProofShape)
+
+	// a codeword which is far from the code
+	codeword := make([]fr.Element, f.domain.Cardinality)
+	copy(codeword, randomCoefficients(4*size))
+	f.domain.FFT(codeword, fft.DIF)
+	fft.BitReverse(codeword)
+	proof, err = f.proveCodeword(codeword)
+	assert.NoError(err)
+	assert.ErrorIs(f.Verify(&proof), ErrProximityTestFolding)
+
+	_, err = f.Prove(randomCoefficients(size + 1))
+	assert.ErrorIs(err, ErrPolynomialSize)
+
+	// the nonce is the smallest one with enough zero bits
+	config.GrindingBits = 8
+	f, err = NewFRI(size, sha256.New(), config)
+	assert.NoError(err)
+	proof, err = f.Prove(p)
+	assert.NoError(err)
+	assert.NoError(f.Verify(&proof))
+	assert.ErrorIs(f.Verify(tamper(func(proof *Proof) { proof.Nonce-- })), ErrGrinding)
+	assert.Error(f.Verify(tamper(func(proof *Proof) { proof.FinalPolynomial[0].SetRandom() })))
+}
+
+func TestConfig(t *testing.T) {
+	assert := require.New(t)
+
+	config := DefaultConfig()
+	assert.NoError(config.Check())
+	assert.InDelta(100, config.ConjecturedSecurity(1<<20), 1)
+	assert.Greater(config.ConjecturedSecurity(1<<20), config.ProvableSecurity(1<<20))
+
+	// more queries, more security
+	moreQueries := config
+	moreQueries.NbQueries *= 2
+	assert.Greater(moreQueries.ProvableSecurity(1<<20), config.ProvableSecurity(1<<20))
+	assert.Greater(moreQueries.ConjecturedSecurity(1<<20), config.ConjecturedSecurity(1<<20))
+
+	for _, invalid := range []Config{
+		{FoldingFactor: 3, BlowupFactor: 2, NbQueries: 1},
+		{FoldingFactor: 2, BlowupFactor: 3, NbQueries: 1},
+		{FoldingFactor: 2, BlowupFactor: 2, NbQueries: 0},
+		{FoldingFactor: 2, BlowupFactor: 2, NbQueries: 1, FinalDegree: -1},
+		{FoldingFactor: 2, BlowupFactor: 2, NbQueries: 1, GrindingBits: 40},
+	} {
+		assert.ErrorIs(invalid.Check(), ErrInvalidConfig)
+	}
+	_, err := NewFRI(100, sha256.New(), config)
+	assert.ErrorIs(err, ErrInvalidConfig)
+	_, err = NewFRI(8, sha256.New(), config)
+	assert.ErrorIs(err, ErrInvalidConfig)
+	_, err = NewFRI(4, sha256.New(), Config{FoldingFactor: 16, BlowupFactor: 2, NbQueries: 1})
+	assert.ErrorIs(err, ErrInvalidConfig)
+}
+
+func BenchmarkConfigurableFRI(b *testing.B) {
+	const size = 1 << 14
+	p := randomCoefficients(size)
+	for _, k := range []int{2, 4, 8, 16} {
+		config := DefaultConfig()
+		config.FoldingFactor = k
+		f, err := NewFRI(size, sha256.New(), config)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("prove/k=%d", k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = f.Prove(p)
+			}
+		})
+		proof, err := f.Prove(p)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("verify/k=%d", k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = f.Verify(&proof)
+			}
+		})
+	}
+}
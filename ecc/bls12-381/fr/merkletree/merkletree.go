@@ -0,0 +1,215 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package merkletree
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// DigestSize number of field elements of a digest, so that a digest has at
+// least 248 bits.
+const DigestSize = 1
+
+// Digest digest of a node of a tree.
+type Digest [DigestSize]fr.Element
+
+var (
+	ErrInvalidArity    = errors.New("the arity must be 2, 4, 8 or 16")
+	ErrInvalidNbLeaves = errors.New("the number of leaves must be positive")
+	ErrInvalidIndices  = errors.New("the indices must be in increasing order and smaller than the number of leaves")
+	ErrInvalidProof    = errors.New("malformed multi-proof")
+	ErrVerifyProof     = errors.New("can't verify multi-proof")
+)
+
+// Tree Merkle tree of digests.
+type Tree struct {
+	arity       int
+	nbLeaves    int
+	compression Compression
+
+	// levels[0] the leaves padded to a power of the arity, levels[len(levels)-1]
+	// the root
+	levels [][]Digest
+}
+
+// MultiProof proof that several leaves belong to a tree.
+//
+// implements io.ReaderFrom and io.WriterTo
+type MultiProof struct {
+	// Siblings digests needed to compute the root from the leaves, that is, the
+	// children of the nodes on the paths of the leaves which are not on these
+	// paths, level by level from the leaves, in increasing order of index
+	Siblings []Digest
+}
+
+// New returns the tree of arity 2, 4, 8 or 16 of the leaves. The levels are
+// computed in parallel.
+func New(leaves []Digest, arity int, compression Compression) (*Tree, error) {
+	depth, err := depth(len(leaves), arity)
+	if err != nil {
+		return nil, err
+	}
+	size := 1
+	for i := 0; i < depth; i++ {
+		size *= arity
+	}
+
+	t := Tree{
+		arity:       arity,
+		nbLeaves:    len(leaves),
+		compression: compression,
+		levels:      make([][]Digest, depth+1),
+	}
+	t.levels[0] = make([]Digest, size)
+	copy(t.levels[0], leaves)
+	for l := 1; l <= depth; l++ {
+		children := t.levels[l-1]
+		nodes := make([]Digest, len(children)/arity)
+		parallel.Execute(len(nodes), func(start, end int) {
+			for i := start; i < end; i++ {
+				nodes[i] = compressNode(compression, children[i*arity:(i+1)*arity])
+			}
+		})
+		t.levels[l] = nodes
+	}
+	return &t, nil
+}
+
+// Root returns the root of the tree.
+func (t *Tree) Root() Digest {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// Arity returns the arity of the tree.
+func (t *Tree) Arity() int {
+	return t.arity
+}
+
+// NbLeaves returns the number of leaves of the tree, without padding.
+func (t *Tree) NbLeaves() int {
+	return t.nbLeaves
+}
+
+// Leaf returns the i-th leaf.
+func (t *Tree) Leaf(i int) Digest {
+	return t.levels[0][i]
+}
+
+// Prove returns a proof that the leaves of given indices belong to the tree.
+// The indices must be in increasing order.
+func (t *Tree) Prove(indices ...int) (MultiProof, error) {
+	if err := checkIndices(indices, t.nbLeaves); err != nil {
+		return MultiProof{}, err
+	}
+
+	var res MultiProof
+	known := append([]int{}, indices...)
+	for l := 0; l < len(t.levels)-1; l++ {
+		parents := known[:0]
+		for i := 0; i < len(known); {
+			p := known[i] / t.arity
+			for c := p * t.arity; c < (p+1)*t.arity; c++ {
+				if i < len(known) && known[i] == c {
+					i++
+				} else {
+					res.Siblings = append(res.Siblings, t.levels[l][c])
+				}
+			}
+			parents = append(parents, p)
+		}
+		known = parents
+	}
+	return res, nil
+}
+
+// Verify verifies that leaves[i] is the leaf of index indices[i] in a tree of
+// nbLeaves leaves, of given arity and root. The indices must be in increasing
+// order.
+func Verify(root Digest, nbLeaves, arity int, indices []int, leaves []Digest, proof *MultiProof, compression Compression) error {
+	depth, err := depth(nbLeaves, arity)
+	if err != nil {
+		return err
+	}
+	if err := checkIndices(indices, nbLeaves); err != nil {
+		return err
+	}
+	if len(leaves) != len(indices) {
+		return ErrInvalidIndices
+	}
+
+	known := append([]int{}, indices...)
+	values := append([]Digest{}, leaves...)
+	siblings := proof.Siblings
+	children := make([]Digest, arity)
+	for l := 0; l < depth; l++ {
+		nbParents := 0
+		for i := 0; i < len(known); {
+			p := known[i] / arity
+			for c := p * arity; c < (p+1)*arity; c++ {
+				if i < len(known) && known[i] == c {
+					children[c-p*arity] = values[i]
+					i++
+				} else {
+					if len(siblings) == 0 {
+						return ErrInvalidProof
+					}
+					children[c-p*arity] = siblings[0]
+					siblings = siblings[1:]
+				}
+			}
+			known[nbParents] = p
+			values[nbParents] = compressNode(compression, children)
+			nbParents++
+		}
+		known, values = known[:nbParents], values[:nbParents]
+	}
+	if len(siblings) != 0 {
+		return ErrInvalidProof
+	}
+	if values[0] != root {
+		return ErrVerifyProof
+	}
+	return nil
+}
+
+// compressNode returns the digest of a node of given children.
+func compressNode(compression Compression, children []Digest) Digest {
+	res := compression.Compress(&children[0], &children[1])
+	for i := 2; i < len(children); i++ {
+		res = compression.Compress(&res, &children[i])
+	}
+	return res
+}
+
+// depth returns the number of levels above the leaves of a tree.
+func depth(nbLeaves, arity int) (int, error) {
+	if arity != 2 && arity != 4 && arity != 8 && arity != 16 {
+		return 0, ErrInvalidArity
+	}
+	if nbLeaves < 1 {
+		return 0, ErrInvalidNbLeaves
+	}
+	res := 0
+	for size := 1; size < nbLeaves; size *= arity {
+		res++
+	}
+	return res, nil
+}
+
+func checkIndices(indices []int, nbLeaves int) error {
+	if len(indices) == 0 {
+		return ErrInvalidIndices
+	}
+	for i := range indices {
+		if indices[i] < 0 || indices[i] >= nbLeaves || (i > 0 && indices[i] <= indices[i-1]) {
+			return ErrInvalidIndices
+		}
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package merkletree
+
+import (
+	"bytes"
+	"hash"
+	"strconv"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/mimc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/poseidon2"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCompression() Compression {
+	c, err := NewHashCompression(func() hash.Hash { return mimc.NewMiMC() })
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func randomDigests(n int) []Digest {
+	res := make([]Digest, n)
+	for i := range res {
+		for j := range res[i] {
+			res[i][j].SetRandom()
+		}
+	}
+	return res
+}
+
+// referenceRoot computes the root of the tree recursively.
+func referenceRoot(leaves []Digest, arity int, compression Compression) Digest {
+	if len(leaves) == 1 {
+		return leaves[0]
+	}
+	size := 1
+	for size < len(leaves) {
+		size *= arity
+	}
+	padded := make([]Digest, size)
+	copy(padded, leaves)
+	children := make([]Digest, arity)
+	for i := range children {
+		children[i] = referenceRoot(padded[i*size/arity:(i+1)*size/arity], arity, compression)
+	}
+	return compressNode(compression, children)
+}
+
+func TestTree(t *testing.T) {
+	assert := require.New(t)
+
+	compression := newTestCompression()
+	for _, arity := range []int{2, 4, 8, 16} {
+		for _, nbLeaves := range []int{1, 5, arity * arity, 100} {
+			leaves := randomDigests(nbLeaves)
+			tree, err := New(leaves, arity, compression)
+			assert.NoError(err)
+			assert.Equal(referenceRoot(leaves, arity, compression), tree.Root())
+			assert.Equal(nbLeaves, tree.NbLeaves())
+
+			// single leaves
+			for _, i := range []int{0, nbLeaves / 2, nbLeaves - 1} {
+				proof, err := tree.Prove(i)
+				assert.NoError(err)
+				assert.NoError(Verify(tree.Root(), nbLeaves, arity, []int{i}, leaves[i:i+1], &proof, compression))
+
+				other := randomDigests(1)
+				assert.ErrorIs(Verify(tree.Root(), nbLeaves, arity, []int{i}, other, &proof, compression), ErrVerifyProof)
+			}
+		}
+	}
+
+	_, err := New(randomDigests(4), 3, compression)
+	assert.ErrorIs(err, ErrInvalidArity)
+	_, err = New(nil, 2, compression)
+	assert.ErrorIs(err, ErrInvalidNbLeaves)
+}
+
+func TestMultiProof(t *testing.T) {
+	assert := require.New(t)
+
+	compression := newTestCompression()
+	for _, arity := range []int{2, 4, 8, 16} {
+		const nbLeaves = 300
+		leaves := randomDigests(nbLeaves)
+		tree, err := New(leaves, arity, compression)
+		assert.NoError(err)
+
+		indices := []int{0, 1, 2, 17, 18, 100, 255, 256, 299}
+		opened := make([]Digest, len(indices))
+		nbSiblings := 0
+		for k, i := range indices {
+			opened[k] = leaves[i]
+			proof, err := tree.Prove(i)
+			assert.NoError(err)
+			nbSiblings += len(proof.Siblings)
+		}
+
+		proof, err := tree.Prove(indices...)
+		assert.NoError(err)
+		assert.Less(len(proof.Siblings), nbSiblings, "shared siblings must be removed")
+		assert.NoError(Verify(tree.Root(), nbLeaves, arity, indices, opened, &proof, compression))
+
+		// all the leaves
+		all := make([]int, nbLeaves)
+		for i := range all {
+			all[i] = i
+		}
+		proofAll, err := tree.Prove(all...)
+		assert.NoError(err)
+		assert.NoError(Verify(tree.Root(), nbLeaves, arity, all, leaves, &proofAll, compression))
+
+		// wrong leaf
+		tampered := append([]Digest{}, opened...)
+		tampered[3][0].SetRandom()
+		assert.ErrorIs(Verify(tree.Root(), nbLeaves, arity, indices, tampered, &proof, compression), ErrVerifyProof)
+
+		// wrong index
+		wrongIndices := append([]int{}, indices...)
+		wrongIndices[3] = 16
+		assert.Error(Verify(tree.Root(), nbLeaves, arity, wrongIndices, opened, &proof, compression))
+
+		// missing and extra siblings
+		truncated := MultiProof{Siblings: proof.Siblings[1:]}
+		assert.ErrorIs(Verify(tree.Root(), nbLeaves, arity, indices, opened, &truncated, compression), ErrInvalidProof)
+		extended := MultiProof{Siblings: append(append([]Digest{}, proof.Siblings...), randomDigests(1)...)}
+		assert.ErrorIs(Verify(tree.Root(), nbLeaves, arity, indices, opened, &extended, compression), ErrInvalidProof)
+
+		// unsorted indices
+		_, err = tree.Prove(2, 1)
+		assert.ErrorIs(err, ErrInvalidIndices)
+		_, err = tree.Prove(nbLeaves)
+		assert.ErrorIs(err, ErrInvalidIndices)
+	}
+}
+
+func TestPermutationCompression(t *testing.T) {
+	assert := require.New(t)
+
+	h := poseidon2.NewHash(2*DigestSize, 6, 50, "seed")
+	compression, err := NewPermutationCompression(&h)
+	assert.NoError(err)
+
+	leaves := randomDigests(50)
+	tree, err := New(leaves, 4, compression)
+	assert.NoError(err)
+	assert.Equal(referenceRoot(leaves, 4, compression), tree.Root())
+	proof, err := tree.Prove(3, 40)
+	assert.NoError(err)
+	assert.NoError(Verify(tree.Root(), 50, 4, []int{3, 40}, []Digest{leaves[3], leaves[40]}, &proof, compression))
+
+	wrongWidth := poseidon2.NewHash(3, 6, 50, "seed")
+	_, err = NewPermutationCompression(&wrongWidth)
+	assert.Error(err)
+}
+
+func TestHashCompression(t *testing.T) {
+	assert := require.New(t)
+
+	_, err := NewHashCompression(func() hash.Hash { return &fixedSizeHash{size: DigestSize*fr.Bytes + 1} })
+	assert.ErrorIs(err, ErrInvalidHashSize)
+}
+
+// fixedSizeHash hash.Hash of given size, only used for its size.
+type fixedSizeHash struct {
+	hash.Hash
+	size int
+}
+
+func (h *fixedSizeHash) Size() int {
+	return h.size
+}
+
+func TestSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	compression := newTestCompression()
+	tree, err := New(randomDigests(64), 8, compression)
+	assert.NoError(err)
+	proof, err := tree.Prove(1, 9, 63)
+	assert.NoError(err)
+
+	var buf bytes.Buffer
+	written, err := proof.WriteTo(&buf)
+	assert.NoError(err)
+	assert.Equal(int64(buf.Len()), written)
+
+	var decoded MultiProof
+	read, err := decoded.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(proof, decoded)
+}
+
+func BenchmarkNew(b *testing.B) {
+	compression := newTestCompression()
+	leaves := randomDigests(1 << 14)
+	for _, arity := range []int{2, 4, 8, 16} {
+		b.Run("arity="+strconv.Itoa(arity), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = New(leaves, arity, compression)
+			}
+		})
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	compression := newTestCompression()
+	leaves := randomDigests(1 << 14)
+	tree, err := New(leaves, 2, compression)
+	if err != nil {
+		b.Fatal(err)
+	}
+	indices := []int{1, 1000, 5000, 10000}
+	opened := []Digest{leaves[1], leaves[1000], leaves[5000], leaves[10000]}
+	proof, err := tree.Prove(indices...)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Verify(tree.Root(), len(leaves), 2, indices, opened, &proof, compression)
+	}
+}
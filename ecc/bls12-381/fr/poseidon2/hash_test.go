@@ -0,0 +1,184 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon2
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/hash"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashRegistry(t *testing.T) {
+	assert := require.New(t)
+
+	assert.True(hash.POSEIDON2_BLS12_381.Available())
+	assert.Equal("POSEIDON2_BLS12_381", hash.POSEIDON2_BLS12_381.String())
+	h := hash.POSEIDON2_BLS12_381.New()
+	assert.Equal(hash.POSEIDON2_BLS12_381.Size(), h.Size())
+	assert.Equal(NewMerkleDamgardHasher().Sum(nil), h.Sum(nil))
+}
+
+func TestHashFiatShamir(t *testing.T) {
+	assert := require.New(t)
+
+	fs := fiatshamir.NewTranscript(NewMerkleDamgardHasher(), "c0")
+	zero := make([]byte, BlockSize)
+	assert.NoError(fs.Bind("c0", zero))
+	_, err := fs.ComputeChallenge("c0")
+	assert.NoError(err)
+}
+
+func TestHashKnownAnswer(t *testing.T) {
+	assert := require.New(t)
+
+	// digest of (1, 2), regression value
+	const expected = "074da351fdfc4fabbbc2c1f686a200ed4364fe14ff8383ddcf7dcfe695145ba3"
+
+	var one, two fr.Element
+	one.SetOne()
+	two.SetUint64(2)
+	hasher := NewMerkleDamgardHasher()
+	_, err := hasher.Write(one.Marshal())
+	assert.NoError(err)
+	_, err = hasher.Write(two.Marshal())
+	assert.NoError(err)
+	assert.Equal(expected, hex.EncodeToString(hasher.Sum(nil)))
+}
+
+func TestHashSum(t *testing.T) {
+	assert := require.New(t)
+
+	inputs := make([]fr.Element, 5)
+	for i := range inputs {
+		inputs[i].SetRandom()
+	}
+
+	// the digest computed by hand
+	var h, length fr.Element
+	for i := range inputs {
+		h = compress(&h, &inputs[i])
+	}
+	length.SetUint64(uint64(len(inputs)))
+	h = compress(&h, &length)
+	expected := h.Bytes()
+
+	hasher := NewMerkleDamgardHasher()
+	for i := range inputs {
+		_, err := hasher.Write(inputs[i].Marshal())
+		assert.NoError(err)
+	}
+	assert.Equal(expected[:], hasher.Sum(nil))
+	// Sum does not change the state
+	assert.Equal(expected[:], hasher.Sum(nil))
+
+	// the inputs which differ by trailing zeros have different digests
+	var zero fr.Element
+	_, err := hasher.Write(zero.Marshal())
+	assert.NoError(err)
+	assert.NotEqual(expected[:], hasher.Sum(nil))
+
+	// the domain separation tag changes the digests
+	hasher.Reset()
+	other := NewMerkleDamgardHasher(WithDomainSeparation([]byte("tag")))
+	assert.NotEqual(hasher.Sum(nil), other.Sum(nil))
+	other.Reset()
+	assert.Equal(NewMerkleDamgardHasher(WithDomainSeparation([]byte("tag"))).Sum(nil), other.Sum(nil))
+
+	// the state is unchanged on invalid inputs
+	state := hasher.State()
+	invalid := fr.Modulus().Bytes()
+	_, err = hasher.Write(invalid)
+	assert.Error(err)
+	_, err = hasher.Write(make([]byte, BlockSize+1))
+	assert.Error(err)
+	assert.Equal(state, hasher.State())
+}
+
+func TestHashByteOrder(t *testing.T) {
+	assert := require.New(t)
+
+	var x fr.Element
+	x.SetRandom()
+	var be, le [fr.Bytes]byte
+	fr.BigEndian.PutElement(&be, x)
+	fr.LittleEndian.PutElement(&le, x)
+
+	h1 := NewMerkleDamgardHasher()
+	h2 := NewMerkleDamgardHasher(WithByteOrder(fr.LittleEndian))
+	_, err := h1.Write(be[:])
+	assert.NoError(err)
+	_, err = h2.Write(le[:])
+	assert.NoError(err)
+	assert.Equal(h1.Sum(nil), h2.Sum(nil))
+}
+
+func TestHashSetState(t *testing.T) {
+	// we use for hashing and retrieving the state
+	h1 := NewMerkleDamgardHasher()
+	// only hashing
+	h2 := NewMerkleDamgardHasher()
+	// we use for restoring from state
+	h3 := NewMerkleDamgardHasher()
+
+	randInputs := make([]fr.Element, 10)
+	for i := range randInputs {
+		randInputs[i].SetRandom()
+	}
+
+	storedStates := make([][]byte, len(randInputs))
+
+	for i := range randInputs {
+		storedStates[i] = h1.State()
+
+		if _, err := h1.Write(randInputs[i].Marshal()); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := h2.Write(randInputs[i].Marshal()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dgst1 := h1.Sum(nil)
+	dgst2 := h2.Sum(nil)
+	if !bytes.Equal(dgst1, dgst2) {
+		t.Fatal("hashes do not match")
+	}
+
+	for i := range storedStates {
+		if err := h3.SetState(storedStates[i]); err != nil {
+			t.Fatal(err)
+		}
+		for j := i; j < len(randInputs); j++ {
+			if _, err := h3.Write(randInputs[j].Marshal()); err != nil {
+				t.Fatal(err)
+			}
+		}
+		dgst3 := h3.Sum(nil)
+		if !bytes.Equal(dgst1, dgst3) {
+			t.Fatal("hashes do not match")
+		}
+	}
+
+	if err := h3.SetState(storedStates[0][1:]); err == nil {
+		t.Fatal("expected an error on a state of invalid size")
+	}
+}
+
+func BenchmarkMerkleDamgardHasher(b *testing.B) {
+	var x fr.Element
+	x.SetRandom()
+	input := x.Marshal()
+	h := NewMerkleDamgardHasher()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.Write(input)
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package hyperkzg provides a commitment scheme for multilinear polynomials on top of KZG (HyperKZG), cf https://eprint.iacr.org/2022/420.pdf
+//
+// The evaluations of a multilinear polynomial on the boolean hypercube are
+// committed to as the coefficients of a univariate polynomial. An opening proof
+// folds this polynomial variable by variable, commits to the intermediate
+// polynomials, and opens all of them at r, -r and r² with a single SHPLONK proof.
+package hyperkzg
@@ -0,0 +1,390 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package hyperkzg
+
+import (
+	"errors"
+	"hash"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/polynomial"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/shplonk"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+var (
+	ErrInvalidPolynomialSize = errors.New("the size of the multilinear polynomial must be a power of 2, at least 2 and small enough for the SRS")
+	ErrInvalidNbVariables    = errors.New("the number of coordinates of the point must be the number of variables of the polynomial")
+	ErrInvalidNbDigests      = errors.New("number of digests is not the same as the number of polynomials")
+	ErrZeroNbDigests         = errors.New("number of digests is zero")
+	ErrInvalidOpeningProof   = errors.New("malformed opening proof")
+	ErrVerifyOpeningProof    = errors.New("can't verify opening proof")
+	ErrZeroChallenge         = errors.New("the folding challenge is zero")
+)
+
+// The multilinear polynomial f(X₁, ..., Xₙ) whose evaluations on the boolean
+// hypercube are m[0], ..., m[2ⁿ-1] (X₁ being the most significant bit of the
+// index, as in polynomial.MultiLin) is committed to as the univariate
+// polynomial f₀(X) = ∑ᵢ m[i]Xⁱ.
+//
+// To open f at (u₁, ..., uₙ), the least significant variable is fixed first:
+// writing fₖ(X) = fₖᵉ(X²) + Xfₖᵒ(X²), the prover defines
+//
+//	fₖ₊₁ = (1-uₙ₋ₖ)fₖᵉ + uₙ₋ₖfₖᵒ
+//
+// so that fₙ is the constant f(u₁, ..., uₙ). The prover commits to f₁, ..., fₙ₋₁,
+// and for a random r, opens f₀ at r, -r and fₖ at r, -r, r² for k ≥ 1. The
+// verifier checks that
+//
+//	fₖ₊₁(r²) = (1-uₙ₋ₖ)(fₖ(r)+fₖ(-r))/2 + uₙ₋ₖ(fₖ(r)-fₖ(-r))/2r
+//
+// for all k, where fₙ(r²) is the claimed value.
+//
+// The 3n-1 openings are batched with shplonk, whose quotient has 2ⁿ+3n-2
+// coefficients: this is the size of the SRS needed for n variables.
+
+// OpeningProof proof that the multilinear polynomial committed in a digest
+// evaluates to ClaimedValue at a point.
+//
+// implements io.ReaderFrom and io.WriterTo
+type OpeningProof struct {
+	// Folded commitments to the folded polynomials f₁, ..., fₙ₋₁
+	Folded []kzg.Digest
+
+	// ClaimedValue value of the multilinear polynomial at the point
+	ClaimedValue fr.Element
+
+	// Openings of f₀ at r, -r and of fₖ at r, -r, r² for k ≥ 1
+	Openings shplonk.OpeningProof
+}
+
+// BatchOpeningProof proof that several multilinear polynomials evaluate to
+// ClaimedValues at the same point.
+//
+// implements io.ReaderFrom and io.WriterTo
+type BatchOpeningProof struct {
+	// ClaimedValues values of the multilinear polynomials at the point
+	ClaimedValues []fr.Element
+
+	// OpeningProof opening of the random linear combination of the polynomials
+	OpeningProof
+}
+
+// Commit commits to the multilinear polynomial m, seen as the coefficients of a
+// univariate polynomial.
+func Commit(m polynomial.MultiLin, pk kzg.ProvingKey, nbTasks ...int) (kzg.Digest, error) {
+	if _, err := nbVariables(m, pk); err != nil {
+		return kzg.Digest{}, err
+	}
+	return kzg.Commit(m, pk, nbTasks...)
+}
+
+// Open computes an opening proof of the multilinear polynomial m, committed in
+// digest, at point.
+//
+// * dataTranscript extra data that might be needed to derive the challenges
+func Open(m polynomial.MultiLin, digest kzg.Digest, point []fr.Element, hf hash.Hash, pk kzg.ProvingKey, dataTranscript ...[]byte) (OpeningProof, error) {
+	n, err := nbVariables(m, pk)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	if len(point) != n {
+		return OpeningProof{}, ErrInvalidNbVariables
+	}
+
+	// fold the polynomial, fixing the variables from the last one
+	var res OpeningProof
+	res.Folded = make([]kzg.Digest, n-1)
+	folded := make([][]fr.Element, n)
+	folded[0] = m
+	for k := 0; k < n; k++ {
+		next := fold(folded[k], point[n-1-k])
+		if k == n-1 {
+			res.ClaimedValue = next[0]
+			break
+		}
+		folded[k+1] = next
+		if res.Folded[k], err = kzg.Commit(next, pk); err != nil {
+			return OpeningProof{}, err
+		}
+	}
+
+	r, err := deriveChallenge(digest, res.Folded, point, res.ClaimedValue, hf, dataTranscript...)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	points := openingPoints(r, n)
+	digests := append([]kzg.Digest{digest}, res.Folded...)
+	res.Openings, err = shplonk.BatchOpen(folded, digests, points, hf, pk, dataTranscript...)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	return res, nil
+}
+
+// Verify verifies that the multilinear polynomial committed in digest
+// evaluates to proof.ClaimedValue at point.
+func Verify(digest kzg.Digest, proof *OpeningProof, point []fr.Element, hf hash.Hash, vk kzg.VerifyingKey, dataTranscript ...[]byte) error {
+	n := len(point)
+	if n == 0 {
+		return ErrInvalidNbVariables
+	}
+	if len(proof.Folded) != n-1 || len(proof.Openings.ClaimedValues) != n {
+		return ErrInvalidOpeningProof
+	}
+	for k := range proof.Openings.ClaimedValues {
+		if len(proof.Openings.ClaimedValues[k]) != min(k+2, 3) {
+			return ErrInvalidOpeningProof
+		}
+	}
+
+	r, err := deriveChallenge(digest, proof.Folded, point, proof.ClaimedValue, hf, dataTranscript...)
+	if err != nil {
+		return err
+	}
+
+	// check the folding relations: (1-u)(a+b)/2 + u(a-b)/2r where a = fₖ(r), b = fₖ(-r)
+	var twoInv, twoRInv fr.Element
+	twoInv.SetUint64(2).Inverse(&twoInv)
+	twoRInv.Inverse(&r).Mul(&twoRInv, &twoInv)
+	var even, odd, expected fr.Element
+	for k := 0; k < n; k++ {
+		values := proof.Openings.ClaimedValues[k]
+		u := &point[n-1-k]
+		even.Add(&values[0], &values[1]).Mul(&even, &twoInv)
+		odd.Sub(&values[0], &values[1]).Mul(&odd, &twoRInv)
+		odd.Sub(&odd, &even).Mul(&odd, u)
+		expected.Add(&even, &odd)
+
+		next := &proof.ClaimedValue
+		if k < n-1 {
+			next = &proof.Openings.ClaimedValues[k+1][2]
+		}
+		if !expected.Equal(next) {
+			return ErrVerifyOpeningProof
+		}
+	}
+
+	digests := append([]kzg.Digest{digest}, proof.Folded...)
+	return shplonk.BatchVerify(proof.Openings, digests, openingPoints(r, n), hf, vk, dataTranscript...)
+}
+
+// BatchOpen computes an opening proof of the multilinear polynomials ms,
+// committed in digests, at the same point. The polynomials are folded with a
+// random challenge, and the folded polynomial is opened with Open.
+//
+// * dataTranscript extra data that might be needed to derive the challenges
+func BatchOpen(ms []polynomial.MultiLin, digests []kzg.Digest, point []fr.Element, hf hash.Hash, pk kzg.ProvingKey, dataTranscript ...[]byte) (BatchOpeningProof, error) {
+	if len(ms) != len(digests) {
+		return BatchOpeningProof{}, ErrInvalidNbDigests
+	}
+	if len(ms) == 0 {
+		return BatchOpeningProof{}, ErrZeroNbDigests
+	}
+
+	var res BatchOpeningProof
+	res.ClaimedValues = make([]fr.Element, len(ms))
+	for i := range ms {
+		if len(ms[i]) != len(ms[0]) {
+			return BatchOpeningProof{}, ErrInvalidPolynomialSize
+		}
+		if len(ms[i]) != 1<<len(point) {
+			return BatchOpeningProof{}, ErrInvalidNbVariables
+		}
+		res.ClaimedValues[i] = ms[i].Evaluate(point, nil)
+	}
+
+	rho, err := deriveBatchChallenge(digests, point, res.ClaimedValues, hf, dataTranscript...)
+	if err != nil {
+		return BatchOpeningProof{}, err
+	}
+
+	// ∑ᵢρⁱmᵢ
+	folded := make(polynomial.MultiLin, len(ms[0]))
+	var acc, t fr.Element
+	acc.SetOne()
+	for i := range ms {
+		for j := range folded {
+			t.Mul(&ms[i][j], &acc)
+			folded[j].Add(&folded[j], &t)
+		}
+		acc.Mul(&acc, &rho)
+	}
+	foldedDigest, err := foldDigests(digests, rho)
+	if err != nil {
+		return BatchOpeningProof{}, err
+	}
+
+	res.OpeningProof, err = Open(folded, foldedDigest, point, hf, pk, dataTranscript...)
+	if err != nil {
+		return BatchOpeningProof{}, err
+	}
+	return res, nil
+}
+
+// BatchVerify verifies that the multilinear polynomials committed in digests
+// evaluate to proof.ClaimedValues at point.
+func BatchVerify(digests []kzg.Digest, proof *BatchOpeningProof, point []fr.Element, hf hash.Hash, vk kzg.VerifyingKey, dataTranscript ...[]byte) error {
+	if len(digests) != len(proof.ClaimedValues) {
+		return ErrInvalidNbDigests
+	}
+	if len(digests) == 0 {
+		return ErrZeroNbDigests
+	}
+
+	rho, err := deriveBatchChallenge(digests, point, proof.ClaimedValues, hf, dataTranscript...)
+	if err != nil {
+		return err
+	}
+
+	// the folded opening must be the combination of the claimed values
+	var expected, acc, t fr.Element
+	acc.SetOne()
+	for i := range proof.ClaimedValues {
+		t.Mul(&proof.ClaimedValues[i], &acc)
+		expected.Add(&expected, &t)
+		acc.Mul(&acc, &rho)
+	}
+	if !expected.Equal(&proof.ClaimedValue) {
+		return ErrVerifyOpeningProof
+	}
+
+	foldedDigest, err := foldDigests(digests, rho)
+	if err != nil {
+		return err
+	}
+	return Verify(foldedDigest, &proof.OpeningProof, point, hf, vk, dataTranscript...)
+}
+
+// nbVariables returns the number of variables of m, and checks that m can be
+// committed to and opened with pk.
+func nbVariables(m polynomial.MultiLin, pk kzg.ProvingKey) (int, error) {
+	if len(m) < 2 || len(m)&(len(m)-1) != 0 {
+		return 0, ErrInvalidPolynomialSize
+	}
+	n := bits.TrailingZeros(uint(len(m)))
+	if len(m)+3*n-2 > len(pk.G1) {
+		return 0, ErrInvalidPolynomialSize
+	}
+	return n, nil
+}
+
+// fold returns (1-u)fᵉ + ufᵒ where f(X) = fᵉ(X²) + Xfᵒ(X²).
+func fold(f []fr.Element, u fr.Element) []fr.Element {
+	res := make([]fr.Element, len(f)/2)
+	for j := range res {
+		// f[2j] + u(f[2j+1] - f[2j])
+		res[j].Sub(&f[2*j+1], &f[2*j]).
+			Mul(&res[j], &u).
+			Add(&res[j], &f[2*j])
+	}
+	return res
+}
+
+// openingPoints returns {r, -r} for f₀ and {r, -r, r²} for f₁, ..., fₙ₋₁.
+func openingPoints(r fr.Element, n int) [][]fr.Element {
+	var minusR, rSquare fr.Element
+	minusR.Neg(&r)
+	rSquare.Square(&r)
+	res := make([][]fr.Element, n)
+	res[0] = []fr.Element{r, minusR}
+	for k := 1; k < n; k++ {
+		res[k] = []fr.Element{r, minusR, rSquare}
+	}
+	return res
+}
+
+// foldDigests returns ∑ᵢρⁱdigests[i].
+func foldDigests(digests []kzg.Digest, rho fr.Element) (kzg.Digest, error) {
+	scalars := make([]fr.Element, len(digests))
+	scalars[0].SetOne()
+	for i := 1; i < len(scalars); i++ {
+		scalars[i].Mul(&scalars[i-1], &rho)
+	}
+	var res bls12381.G1Affine
+	if _, err := res.MultiExp(digests, scalars, ecc.MultiExpConfig{}); err != nil {
+		return kzg.Digest{}, err
+	}
+	return res, nil
+}
+
+// deriveChallenge derives the challenge r, binded to the commitment, the
+// folded commitments, the point and the claimed value.
+func deriveChallenge(digest kzg.Digest, folded []kzg.Digest, point []fr.Element, claimedValue fr.Element, hf hash.Hash, dataTranscript ...[]byte) (fr.Element, error) {
+	fs := fiatshamir.NewTranscript(hf, "r")
+	if err := fs.Bind("r", digest.Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+	for i := range folded {
+		if err := fs.Bind("r", folded[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range point {
+		if err := fs.Bind("r", point[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	if err := fs.Bind("r", claimedValue.Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+	for i := range dataTranscript {
+		if err := fs.Bind("r", dataTranscript[i]); err != nil {
+			return fr.Element{}, err
+		}
+	}
+
+	b, err := fs.ComputeChallenge("r")
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	if res.IsZero() {
+		return fr.Element{}, ErrZeroChallenge
+	}
+	return res, nil
+}
+
+// deriveBatchChallenge derives the challenge ρ used to fold the polynomials,
+// binded to the commitments, the point and the claimed values.
+func deriveBatchChallenge(digests []kzg.Digest, point, claimedValues []fr.Element, hf hash.Hash, dataTranscript ...[]byte) (fr.Element, error) {
+	fs := fiatshamir.NewTranscript(hf, "rho")
+	for i := range digests {
+		if err := fs.Bind("rho", digests[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range point {
+		if err := fs.Bind("rho", point[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range claimedValues {
+		if err := fs.Bind("rho", claimedValues[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range dataTranscript {
+		if err := fs.Bind("rho", dataTranscript[i]); err != nil {
+			return fr.Element{}, err
+		}
+	}
+
+	b, err := fs.ComputeChallenge("rho")
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	return res, nil
+}
@@ -0,0 +1,22 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ceremony provides a powers of τ MPC ceremony to generate a KZG SRS.
+//
+// The ceremony starts from a SRS derived from a public random beacon. Each
+// participant then updates the SRS with a fresh secret x, so that
+// [τⁱ]G₁ becomes [(xτ)ⁱ]G₁ and [τ]G₂ becomes [xτ]G₂, and publishes a
+// proof of knowledge of x. The final τ is unknown as long as one of the
+// participants discarded its secret.
+//
+// A contribution scales each point [τⁱ]G₁ by xⁱ. These points do not share a
+// base, and their discrete logarithms τⁱ are unknown to the participant, so
+// BatchScalarMultiplicationG1, which multiplies a single base by many scalars,
+// does not apply: the points are scaled one by one, by parallel batches whose
+// results are converted to affine coordinates at once. It is only used for
+// the multiples [x]G₁ and [r]G₁ of the generator.
+//
+// See https://eprint.iacr.org/2017/1050.pdf for the powers of τ protocol.
+package ceremony
@@ -0,0 +1,140 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ceremony
+
+import (
+	"encoding/binary"
+	"io"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-381"
+)
+
+// WriteTo writes binary encoding of a Contribution
+func (c *Contribution) WriteTo(w io.Writer) (int64, error) {
+	enc := curve.NewEncoder(w)
+
+	toEncode := []interface{}{
+		&c.Tau,
+		&c.PublicKey,
+		&c.XG1,
+		&c.R,
+		&c.Z,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes Contribution data from reader.
+func (c *Contribution) ReadFrom(r io.Reader) (int64, error) {
+	dec := curve.NewDecoder(r)
+
+	toDecode := []interface{}{
+		&c.Tau,
+		&c.PublicKey,
+		&c.XG1,
+		&c.R,
+		&c.Z,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of a Ceremony
+func (c *Ceremony) WriteTo(w io.Writer) (int64, error) {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], uint32(len(c.Beacon)))
+	n, err := w.Write(buf[:])
+	written := int64(n)
+	if err != nil {
+		return written, err
+	}
+	n, err = w.Write(c.Beacon)
+	written += int64(n)
+	if err != nil {
+		return written, err
+	}
+
+	m, err := c.SRS.WriteTo(w)
+	written += m
+	if err != nil {
+		return written, err
+	}
+
+	binary.BigEndian.PutUint32(buf[:], uint32(len(c.Contributions)))
+	n, err = w.Write(buf[:])
+	written += int64(n)
+	if err != nil {
+		return written, err
+	}
+	for i := range c.Contributions {
+		m, err = c.Contributions[i].WriteTo(w)
+		written += m
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// ReadFrom decodes Ceremony data from reader.
+func (c *Ceremony) ReadFrom(r io.Reader) (int64, error) {
+	var buf [4]byte
+	n, err := io.ReadFull(r, buf[:])
+	read := int64(n)
+	if err != nil {
+		return read, err
+	}
+	beaconSize := binary.BigEndian.Uint32(buf[:])
+	if beaconSize > maxBeaconSize {
+		return read, ErrBeaconSize
+	}
+	c.Beacon = make([]byte, beaconSize)
+	n, err = io.ReadFull(r, c.Beacon)
+	read += int64(n)
+	if err != nil {
+		return read, err
+	}
+
+	m, err := c.SRS.ReadFrom(r)
+	read += m
+	if err != nil {
+		return read, err
+	}
+
+	n, err = io.ReadFull(r, buf[:])
+	read += int64(n)
+	if err != nil {
+		return read, err
+	}
+	// the contributions are appended as they are read, so that the number of
+	// contributions announced does not drive the allocations
+	nbContributions := binary.BigEndian.Uint32(buf[:])
+	c.Contributions = nil
+	for i := uint32(0); i < nbContributions; i++ {
+		var contribution Contribution
+		m, err = contribution.ReadFrom(r)
+		read += m
+		if err != nil {
+			return read, err
+		}
+		c.Contributions = append(c.Contributions, contribution)
+	}
+
+	return read, nil
+}
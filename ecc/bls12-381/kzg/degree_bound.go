@@ -0,0 +1,223 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+var (
+	ErrInvalidDegreeBound = errors.New("invalid degree bound (zero, larger than the SRS, or smaller than the polynomial)")
+	ErrVerifyDegreeBound  = errors.New("can't verify degree bound proof")
+)
+
+// Degree bounds are proven with shifted commitments: for a SRS made of the
+// powers [τⁱ]G₁ for i < N, no polynomial of degree ≥ N can be committed to. To
+// prove that deg(f) < d, the prover commits to Xᴺ⁻ᵈf, which is possible only
+// if deg(f) < d, and opens both f and Xᴺ⁻ᵈf at a random point z, so that the
+// verifier checks that the second value is zᴺ⁻ᵈ times the first one.
+//
+// The shifted commitments only use the G₁ part of the SRS, extracted on demand
+// from [τᴺ⁻ᵈ]G₁, and all the openings are batched with BatchOpenSinglePoint,
+// so that the verification costs a single pairing check.
+//
+// N is the size of the SRS the prover has access to: it must be the size of
+// the original powers of τ, and not the size of a truncated SRS.
+
+// DegreeBoundProof proves that committed polynomials have degrees smaller
+// than their respective bounds.
+//
+// implements io.ReaderFrom and io.WriterTo
+type DegreeBoundProof struct {
+	// Shifted commitments [τᴺ⁻ᵈf(τ)]G₁
+	Shifted []Digest
+
+	// BatchOpeningProof opening proof of the polynomials followed by the
+	// shifted polynomials, at the point derived from the commitments
+	BatchOpeningProof
+}
+
+// CommitShifted commits to Xᴺ⁻ᵈp where N is srsSize and d is bound, using the
+// powers [τᴺ⁻ᵈ]G₁, ..., [τᴺ⁻ᵈ⁺ᵈᵉᵍ⁽ᵖ⁾]G₁ of pk. The trailing zero coefficients of
+// p are ignored.
+func CommitShifted(p []fr.Element, bound, srsSize int, pk ProvingKey, nbTasks ...int) (Digest, error) {
+	if len(p) == 0 {
+		return Digest{}, ErrInvalidPolynomialSize
+	}
+	p = trimZeros(p)
+	if bound <= 0 || bound < len(p) || srsSize > len(pk.G1) || bound > srsSize {
+		return Digest{}, ErrInvalidDegreeBound
+	}
+	shift := srsSize - bound
+	return Commit(p, ProvingKey{G1: pk.G1[shift : shift+len(p)]}, nbTasks...)
+}
+
+// ProveDegreeBounds proves that the polynomials committed in digests have
+// degrees smaller than bounds, for a SRS of size srsSize. The polynomials are
+// opened with their shifted versions at a point derived from the commitments.
+//
+// * dataTranscript extra data that might be needed to derive the challenges
+func ProveDegreeBounds(polynomials [][]fr.Element, digests []Digest, bounds []int, srsSize int, hf hash.Hash, pk ProvingKey, dataTranscript ...[]byte) (DegreeBoundProof, error) {
+	n := len(polynomials)
+	if n != len(digests) || n != len(bounds) {
+		return DegreeBoundProof{}, ErrInvalidNbDigests
+	}
+	if n == 0 {
+		return DegreeBoundProof{}, ErrZeroNbDigests
+	}
+
+	var res DegreeBoundProof
+	res.Shifted = make([]Digest, n)
+	shifted := make([][]fr.Element, n)
+	for i := range polynomials {
+		var err error
+		if res.Shifted[i], err = CommitShifted(polynomials[i], bounds[i], srsSize, pk); err != nil {
+			return DegreeBoundProof{}, err
+		}
+		shift := srsSize - bounds[i]
+		p := trimZeros(polynomials[i])
+		shifted[i] = make([]fr.Element, shift+len(p))
+		copy(shifted[i][shift:], p)
+	}
+
+	point, err := deriveDegreeBoundPoint(digests, res.Shifted, bounds, srsSize, hf, dataTranscript...)
+	if err != nil {
+		return DegreeBoundProof{}, err
+	}
+
+	allDigests := append(digests[:n:n], res.Shifted...)
+	res.BatchOpeningProof, err = BatchOpenSinglePoint(append(polynomials[:n:n], shifted...), allDigests, point, hf, pk, dataTranscript...)
+	if err != nil {
+		return DegreeBoundProof{}, err
+	}
+	return res, nil
+}
+
+// VerifyDegreeBounds verifies a proof that the polynomials committed in
+// digests have degrees smaller than bounds, for a SRS of size srsSize.
+func VerifyDegreeBounds(digests []Digest, proof *DegreeBoundProof, bounds []int, srsSize int, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+	point, allDigests, err := checkShiftedValues(digests, proof, bounds, srsSize, hf, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	return BatchVerifySinglePoint(allDigests, &proof.BatchOpeningProof, point, hf, vk, dataTranscript...)
+}
+
+// BatchVerifyDegreeBounds verifies several degree bound proofs at once. The
+// folded openings are checked with a single randomized pairing check with
+// BatchVerifyMultiPoints.
+func BatchVerifyDegreeBounds(digests [][]Digest, proofs []DegreeBoundProof, bounds [][]int, srsSize int, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+	if len(digests) != len(proofs) || len(digests) != len(bounds) {
+		return ErrInvalidNbDigests
+	}
+	if len(digests) == 0 {
+		return ErrZeroNbDigests
+	}
+
+	foldedProofs := make([]OpeningProof, len(proofs))
+	foldedDigests := make([]Digest, len(proofs))
+	points := make([]fr.Element, len(proofs))
+	for k := range proofs {
+		var allDigests []Digest
+		var err error
+		points[k], allDigests, err = checkShiftedValues(digests[k], &proofs[k], bounds[k], srsSize, hf, dataTranscript...)
+		if err != nil {
+			return err
+		}
+		foldedProofs[k], foldedDigests[k], err = FoldProof(allDigests, &proofs[k].BatchOpeningProof, points[k], hf, dataTranscript...)
+		if err != nil {
+			return err
+		}
+	}
+
+	return BatchVerifyMultiPoints(foldedDigests, foldedProofs, points, vk)
+}
+
+// checkShiftedValues derives the opening point of a degree bound proof and
+// checks that the shifted polynomials evaluate to zᴺ⁻ᵈf(z). It returns the
+// point and the digests of the batch opening proof.
+func checkShiftedValues(digests []Digest, proof *DegreeBoundProof, bounds []int, srsSize int, hf hash.Hash, dataTranscript ...[]byte) (fr.Element, []Digest, error) {
+	n := len(digests)
+	if n != len(bounds) || n != len(proof.Shifted) || 2*n != len(proof.ClaimedValues) {
+		return fr.Element{}, nil, ErrInvalidNbDigests
+	}
+	if n == 0 {
+		return fr.Element{}, nil, ErrZeroNbDigests
+	}
+	for _, b := range bounds {
+		if b <= 0 || b > srsSize {
+			return fr.Element{}, nil, ErrInvalidDegreeBound
+		}
+	}
+
+	point, err := deriveDegreeBoundPoint(digests, proof.Shifted, bounds, srsSize, hf, dataTranscript...)
+	if err != nil {
+		return fr.Element{}, nil, err
+	}
+
+	var expected fr.Element
+	for i := range bounds {
+		expected.Exp(point, big.NewInt(int64(srsSize-bounds[i])))
+		expected.Mul(&expected, &proof.ClaimedValues[i])
+		if !expected.Equal(&proof.ClaimedValues[n+i]) {
+			return fr.Element{}, nil, ErrVerifyDegreeBound
+		}
+	}
+
+	return point, append(digests[:n:n], proof.Shifted...), nil
+}
+
+// deriveDegreeBoundPoint derives the opening point of a degree bound proof,
+// binded to the commitments, the shifted commitments and the bounds.
+func deriveDegreeBoundPoint(digests, shifted []Digest, bounds []int, srsSize int, hf hash.Hash, dataTranscript ...[]byte) (fr.Element, error) {
+	fs := fiatshamir.NewTranscript(hf, "z")
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(srsSize))
+	if err := fs.Bind("z", buf[:]); err != nil {
+		return fr.Element{}, err
+	}
+	for i := range digests {
+		binary.BigEndian.PutUint64(buf[:], uint64(bounds[i]))
+		if err := fs.Bind("z", buf[:]); err != nil {
+			return fr.Element{}, err
+		}
+		if err := fs.Bind("z", digests[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+		if err := fs.Bind("z", shifted[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range dataTranscript {
+		if err := fs.Bind("z", dataTranscript[i]); err != nil {
+			return fr.Element{}, err
+		}
+	}
+
+	b, err := fs.ComputeChallenge("z")
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	return res, nil
+}
+
+// trimZeros returns p without its trailing zero coefficients, keeping at least
+// one coefficient.
+func trimZeros(p []fr.Element) []fr.Element {
+	n := len(p)
+	for n > 1 && p[n-1].IsZero() {
+		n--
+	}
+	return p[:n]
+}
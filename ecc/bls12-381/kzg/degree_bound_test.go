@@ -0,0 +1,111 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+)
+
+// randomPolynomials returns random polynomials of the given sizes and their commitments
+func randomPolynomials(sizes ...int) ([][]fr.Element, []Digest) {
+	polynomials := make([][]fr.Element, len(sizes))
+	digests := make([]Digest, len(sizes))
+	for i, size := range sizes {
+		polynomials[i] = make([]fr.Element, size)
+		for j := range polynomials[i] {
+			polynomials[i][j].SetRandom()
+		}
+		digests[i], _ = Commit(polynomials[i], testSrs.Pk)
+	}
+	return polynomials, digests
+}
+
+func TestDegreeBounds(t *testing.T) {
+	assert := require.New(t)
+
+	srsSize := len(testSrs.Pk.G1)
+	hf := sha256.New()
+	polynomials, digests := randomPolynomials(10, 32, 100, srsSize)
+	bounds := []int{10, 40, 128, srsSize}
+
+	proof, err := ProveDegreeBounds(polynomials, digests, bounds, srsSize, hf, testSrs.Pk)
+	assert.NoError(err)
+	assert.NoError(VerifyDegreeBounds(digests, &proof, bounds, srsSize, hf, testSrs.Vk))
+
+	// serialization
+	var buf bytes.Buffer
+	_, err = proof.WriteTo(&buf)
+	assert.NoError(err)
+	var reconstructed DegreeBoundProof
+	_, err = reconstructed.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(proof, reconstructed)
+
+	// the bounds are bound to the proof
+	assert.Error(VerifyDegreeBounds(digests, &proof, []int{10, 40, 100, srsSize}, srsSize, hf, testSrs.Vk))
+	assert.Error(VerifyDegreeBounds(digests, &proof, bounds, srsSize+1, hf, testSrs.Vk))
+
+	// a polynomial larger than its bound can't be shifted
+	_, err = ProveDegreeBounds(polynomials, digests, []int{9, 40, 128, srsSize}, srsSize, hf, testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidDegreeBound)
+
+	// the bound applies to the degree, not to the number of coefficients
+	padded := make([]fr.Element, 2*srsSize)
+	copy(padded, polynomials[0])
+	paddedDigest, err := Commit(padded[:srsSize], testSrs.Pk)
+	assert.NoError(err)
+	assert.Equal(digests[0], paddedDigest)
+	shifted, err := CommitShifted(padded, 10, srsSize, testSrs.Pk)
+	assert.NoError(err)
+	assert.Equal(proof.Shifted[0], shifted)
+	paddedProof, err := ProveDegreeBounds([][]fr.Element{padded[:srsSize]}, []Digest{paddedDigest}, []int{10}, srsSize, hf, testSrs.Pk)
+	assert.NoError(err)
+	assert.NoError(VerifyDegreeBounds([]Digest{paddedDigest}, &paddedProof, []int{10}, srsSize, hf, testSrs.Vk))
+	_, err = CommitShifted(make([]fr.Element, 20), 1, srsSize, testSrs.Pk)
+	assert.NoError(err, "the zero polynomial has degree bound 1")
+
+	// a cheating prover shifting by less than N-d
+	cheating := proof
+	cheating.Shifted = append([]Digest{}, proof.Shifted...)
+	cheating.Shifted[0], err = CommitShifted(polynomials[0], 11, srsSize, testSrs.Pk)
+	assert.NoError(err)
+	assert.Error(VerifyDegreeBounds(digests, &cheating, bounds, srsSize, hf, testSrs.Vk))
+
+	// wrong shifted value
+	cheating = proof
+	cheating.ClaimedValues = append([]fr.Element{}, proof.ClaimedValues...)
+	cheating.ClaimedValues[5].SetRandom()
+	assert.ErrorIs(VerifyDegreeBounds(digests, &cheating, bounds, srsSize, hf, testSrs.Vk), ErrVerifyDegreeBound)
+}
+
+func TestBatchVerifyDegreeBounds(t *testing.T) {
+	assert := require.New(t)
+
+	srsSize := len(testSrs.Pk.G1)
+	hf := sha256.New()
+
+	const nbProofs = 3
+	digests := make([][]Digest, nbProofs)
+	bounds := make([][]int, nbProofs)
+	proofs := make([]DegreeBoundProof, nbProofs)
+	for k := range proofs {
+		var polynomials [][]fr.Element
+		polynomials, digests[k] = randomPolynomials(20+k, 50)
+		bounds[k] = []int{32, 64 + k}
+		var err error
+		proofs[k], err = ProveDegreeBounds(polynomials, digests[k], bounds[k], srsSize, hf, testSrs.Pk)
+		assert.NoError(err)
+	}
+	assert.NoError(BatchVerifyDegreeBounds(digests, proofs, bounds, srsSize, hf, testSrs.Vk))
+
+	proofs[1].H = proofs[0].H
+	assert.Error(BatchVerifyDegreeBounds(digests, proofs, bounds, srsSize, hf, testSrs.Vk))
+}
@@ -0,0 +1,150 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+)
+
+var bGamma = new(big.Int).SetInt64(1337)
+
+// newTestHidingSRS returns a SRS with blinding generators, of the same τ as testSrs
+func newTestHidingSRS(t *testing.T) *SRS {
+	srs, err := NewSRS(64, bAlpha, bGamma)
+	require.NoError(t, err)
+	return srs
+}
+
+func TestHidingCommitment(t *testing.T) {
+	assert := require.New(t)
+	hidingSrs := newTestHidingSRS(t)
+
+	f := make([]fr.Element, 60)
+	for i := range f {
+		f[i].SetRandom()
+	}
+
+	digest, blinding, err := CommitHiding(f, hidingSrs.Pk)
+	assert.NoError(err)
+	assert.Len(blinding, HidingBound+1)
+
+	// the commitment is blinded
+	nonHiding, err := Commit(f, hidingSrs.Pk)
+	assert.NoError(err)
+	assert.False(digest.Equal(&nonHiding))
+	other, _, err := CommitHiding(f, hidingSrs.Pk)
+	assert.NoError(err)
+	assert.False(digest.Equal(&other))
+
+	var point fr.Element
+	point.SetRandom()
+	proof, err := OpenHiding(f, blinding, point, hidingSrs.Pk)
+	assert.NoError(err)
+	assert.Equal(eval(f, point), proof.ClaimedValue)
+	assert.NoError(VerifyHiding(&digest, &proof, point, hidingSrs.Vk))
+
+	// serialization
+	var buf bytes.Buffer
+	_, err = proof.WriteTo(&buf)
+	assert.NoError(err)
+	var reconstructed HidingOpeningProof
+	_, err = reconstructed.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(proof, reconstructed)
+
+	// wrong values
+	wrong := proof
+	wrong.ClaimedValue.SetRandom()
+	assert.Error(VerifyHiding(&digest, &wrong, point, hidingSrs.Vk))
+	wrong = proof
+	wrong.BlindedValue.SetRandom()
+	assert.Error(VerifyHiding(&digest, &wrong, point, hidingSrs.Vk))
+	assert.Error(VerifyHiding(&nonHiding, &proof, point, hidingSrs.Vk))
+
+	// a SRS without blinding generators
+	srs, err := NewSRS(64, bAlpha)
+	assert.NoError(err)
+	_, _, err = CommitHiding(f, srs.Pk)
+	assert.ErrorIs(err, ErrNotHiding)
+	assert.ErrorIs(VerifyHiding(&digest, &proof, point, srs.Vk), ErrNotHiding)
+}
+
+func TestBatchVerifySinglePointHiding(t *testing.T) {
+	assert := require.New(t)
+	hidingSrs := newTestHidingSRS(t)
+
+	const nbPolynomials = 5
+	polynomials := make([][]fr.Element, nbPolynomials)
+	blindings := make([][]fr.Element, nbPolynomials)
+	digests := make([]Digest, nbPolynomials)
+	for i := range polynomials {
+		polynomials[i] = make([]fr.Element, 20+i)
+		for j := range polynomials[i] {
+			polynomials[i][j].SetRandom()
+		}
+		var err error
+		digests[i], blindings[i], err = CommitHiding(polynomials[i], hidingSrs.Pk)
+		assert.NoError(err)
+	}
+	// a blinding polynomial of lower degree
+	blindings[2] = blindings[2][:1]
+	var err error
+	digests[2], err = CommitWithBlinding(polynomials[2], blindings[2], hidingSrs.Pk)
+	assert.NoError(err)
+
+	hf := sha256.New()
+	var point, salt fr.Element
+	point.SetRandom()
+	salt.SetRandom()
+	proof, err := BatchOpenSinglePointHiding(polynomials, blindings, digests, point, hf, hidingSrs.Pk, salt.Marshal())
+	assert.NoError(err)
+	assert.NoError(BatchVerifySinglePointHiding(digests, &proof, point, hf, hidingSrs.Vk, salt.Marshal()))
+	assert.Error(BatchVerifySinglePointHiding(digests, &proof, point, hf, hidingSrs.Vk))
+
+	// serialization
+	var buf bytes.Buffer
+	_, err = proof.WriteTo(&buf)
+	assert.NoError(err)
+	var reconstructed HidingBatchOpeningProof
+	_, err = reconstructed.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(proof, reconstructed)
+
+	// the blinded values are bound to the challenge
+	proof.BlindedValues[0].SetRandom()
+	assert.Error(BatchVerifySinglePointHiding(digests, &proof, point, hf, hidingSrs.Vk, salt.Marshal()))
+	proof = reconstructed
+	digests[0], digests[1] = digests[1], digests[0]
+	assert.Error(BatchVerifySinglePointHiding(digests, &proof, point, hf, hidingSrs.Vk, salt.Marshal()))
+}
+
+func TestValidateHiding(t *testing.T) {
+	assert := require.New(t)
+	hidingSrs := newTestHidingSRS(t)
+
+	srs := cloneSRS(hidingSrs)
+	srs.Pk.Gamma = append(srs.Pk.Gamma[:0:0], hidingSrs.Pk.Gamma...)
+	srs.Pk.Gamma[2] = srs.Pk.Gamma[1]
+	err := srs.Validate()
+	assert.ErrorIs(err, ErrInvalidSRS)
+	assert.Contains(err.Error(), "Pk.Gamma[2] ≠ [τ]Pk.Gamma[1]")
+
+	// Vk.Gamma does not match
+	srs = cloneSRS(hidingSrs)
+	srs.Vk.Gamma = srs.Pk.G1[1]
+	assert.ErrorIs(srs.Validate(), ErrInvalidSRS)
+
+	// the fast SRS supports hiding commitments too
+	srs, err = NewSRS(32, big.NewInt(-1), bGamma)
+	assert.NoError(err)
+	assert.NoError(srs.Validate())
+}
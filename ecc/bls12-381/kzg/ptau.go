@@ -0,0 +1,1026 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// The .ptau container is the powers of tau file format of snarkjs. It is
+// made of a magic string, a version, and a list of sections. Each section
+// starts with its type (uint32) and its size in bytes (uint64). All integers
+// are little-endian and the field elements are stored in Montgomery form,
+// little-endian, which matches the internal representation of fp.Element.
+const (
+	ptauMagic   = "ptau"
+	ptauVersion = 1
+
+	ptauSectionHeader        = 1
+	ptauSectionTauG1         = 2
+	ptauSectionTauG2         = 3
+	ptauSectionAlphaTauG1    = 4
+	ptauSectionBetaTauG1     = 5
+	ptauSectionBetaG2        = 6
+	ptauSectionContributions = 7
+
+	ptauSizeG1 = 2 * fp.Bytes
+	ptauSizeG2 = 4 * fp.Bytes
+
+	// ptauSizePublicKey is the size of a contribution public key (6 points of G1 and 3 of G2)
+	ptauSizePublicKey = 6*ptauSizeG1 + 3*ptauSizeG2
+
+	// ptauChunkSize is the number of points decoded at once when streaming a file
+	ptauChunkSize = 1 << 14
+
+	// ptauMaxParamsSize is the maximum size of the optional parameters of a
+	// contribution: a name and a beacon hash of at most 255 bytes each, and
+	// the number of iterations of the beacon.
+	ptauMaxParamsSize = (2 + 255) + 2 + (2 + 255)
+)
+
+var (
+	ErrPtauInvalidMagic   = errors.New("ptau: invalid magic string")
+	ErrPtauInvalidVersion = errors.New("ptau: unsupported version")
+	ErrPtauWrongCurve     = errors.New("ptau: the file was not generated for bls12-381")
+	ErrPtauHeader         = errors.New("ptau: the header section must precede the points sections")
+	ErrPtauMissingSection = errors.New("ptau: missing section")
+	ErrPtauInvalidPoint   = errors.New("ptau: invalid point encoding")
+	ErrPtauSectionSize    = errors.New("ptau: section size does not match the number of powers")
+)
+
+// Ptau is the content of a snarkjs powers of tau file (.ptau).
+//
+// For a ceremony of power n, it contains
+//
+//	TauG1      = [τⁱ]G₁  for i < 2ⁿ⁺¹-1
+//	TauG2      = [τⁱ]G₂  for i < 2ⁿ
+//	AlphaTauG1 = [ατⁱ]G₁ for i < 2ⁿ
+//	BetaTauG1  = [βτⁱ]G₁ for i < 2ⁿ
+//	BetaG2     = [β]G₂
+//
+// implements io.ReaderFrom and io.WriterTo
+type Ptau struct {
+	Power         uint32
+	CeremonyPower uint32
+
+	TauG1      []bls12381.G1Affine
+	TauG2      []bls12381.G2Affine
+	AlphaTauG1 []bls12381.G1Affine
+	BetaTauG1  []bls12381.G1Affine
+	BetaG2     bls12381.G2Affine
+
+	Contributions []PtauContribution
+}
+
+// PtauPublicKey is the proof of knowledge published by a participant for
+// each of its secrets x ∈ {τ, α, β}: a random G₁ point [s]G₁, its multiple
+// [sx]G₁, and [x]G₂ where G₂ is derived from the transcript.
+type PtauPublicKey struct {
+	TauG1S, TauG1SX     bls12381.G1Affine
+	AlphaG1S, AlphaG1SX bls12381.G1Affine
+	BetaG1S, BetaG1SX   bls12381.G1Affine
+	TauG2SPX            bls12381.G2Affine
+	AlphaG2SPX          bls12381.G2Affine
+	BetaG2SPX           bls12381.G2Affine
+}
+
+// PtauContribution is a contribution record of a .ptau file
+type PtauContribution struct {
+	TauG1   bls12381.G1Affine // [τ]G₁ after the contribution
+	TauG2   bls12381.G2Affine // [τ]G₂ after the contribution
+	AlphaG1 bls12381.G1Affine // [α]G₁ after the contribution
+	BetaG1  bls12381.G1Affine // [β]G₁ after the contribution
+	BetaG2  bls12381.G2Affine // [β]G₂ after the contribution
+	Key     PtauPublicKey
+
+	PartialHash   [216]byte // blake2b state of the response hash before the public key
+	NextChallenge [64]byte
+
+	// Type is 0 for a regular contribution and 1 for a random beacon
+	Type uint32
+
+	Name string
+
+	// NumIterationsExp and BeaconHash are set for beacon contributions only
+	NumIterationsExp uint8
+	BeaconHash       []byte
+}
+
+// ReadPtau reads the proving and verifying keys of a snarkjs .ptau file.
+//
+// The file is streamed: only the needed powers of τ are kept in memory and
+// the other sections are skipped. If maxPkPoints is provided, the number of
+// points in the ProvingKey is limited to maxPkPoints.
+//
+// All points read are checked to be on the curve and in the correct subgroup.
+func (srs *SRS) ReadPtau(r io.Reader, maxPkPoints ...int) error {
+	pr := newPtauReader(r)
+	if err := pr.readPreamble(); err != nil {
+		return err
+	}
+
+	var header ptauHeader
+	seenHeader, seenTauG1, seenTauG2 := false, false, false
+	for !(seenTauG1 && seenTauG2) {
+		sectionType, sectionSize, err := pr.readSectionHeader()
+		if err == io.EOF {
+			return ErrPtauMissingSection
+		}
+		if err != nil {
+			return err
+		}
+		switch {
+		case sectionType == ptauSectionHeader:
+			if header, err = pr.readHeader(sectionSize); err != nil {
+				return err
+			}
+			seenHeader = true
+		case sectionType == ptauSectionTauG1 || sectionType == ptauSectionTauG2:
+			if !seenHeader {
+				return ErrPtauHeader
+			}
+			if sectionType == ptauSectionTauG1 {
+				nbPoints := header.nbTauG1()
+				if err = checkPtauSectionSize(sectionSize, nbPoints, ptauSizeG1); err != nil {
+					return err
+				}
+				n := nbPoints
+				if len(maxPkPoints) > 0 && maxPkPoints[0] > 0 && maxPkPoints[0] < n {
+					n = maxPkPoints[0]
+				}
+				if srs.Pk.G1, err = appendPoints(pr, nil, n, ptauSizeG1, decodePtauG1); err != nil {
+					return err
+				}
+				if err = pr.skip(uint64(nbPoints-n) * ptauSizeG1); err != nil {
+					return err
+				}
+				srs.Vk.G1 = srs.Pk.G1[0]
+				seenTauG1 = true
+			} else {
+				nbPoints := header.nbPowers()
+				if err = checkPtauSectionSize(sectionSize, nbPoints, ptauSizeG2); err != nil {
+					return err
+				}
+				if nbPoints < 2 {
+					return ErrPtauSectionSize
+				}
+				if err = readPoints(pr, srs.Vk.G2[:], ptauSizeG2, decodePtauG2); err != nil {
+					return err
+				}
+				if err = pr.skip(uint64(nbPoints-2) * ptauSizeG2); err != nil {
+					return err
+				}
+				seenTauG2 = true
+			}
+		default:
+			if err = pr.skip(sectionSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	srs.Vk.Lines[0] = bls12381.PrecomputeLines(srs.Vk.G2[0])
+	srs.Vk.Lines[1] = bls12381.PrecomputeLines(srs.Vk.G2[1])
+
+	return nil
+}
+
+// ReadFrom decodes a snarkjs .ptau file, loading all its sections in memory.
+//
+// Use SRS.ReadPtau to read only the powers of τ needed for KZG.
+func (p *Ptau) ReadFrom(r io.Reader) (int64, error) {
+	pr := newPtauReader(r)
+	if err := pr.readPreamble(); err != nil {
+		return pr.n, err
+	}
+
+	var header ptauHeader
+	seen := make(map[uint32]bool)
+	for {
+		sectionType, sectionSize, err := pr.readSectionHeader()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return pr.n, err
+		}
+		if sectionType > ptauSectionHeader && sectionType <= ptauSectionContributions && !seen[ptauSectionHeader] {
+			return pr.n, ErrPtauHeader
+		}
+		switch sectionType {
+		case ptauSectionHeader:
+			if header, err = pr.readHeader(sectionSize); err != nil {
+				return pr.n, err
+			}
+			p.Power = header.power
+			p.CeremonyPower = header.ceremonyPower
+		case ptauSectionTauG1:
+			p.TauG1, err = readPtauG1Section(pr, sectionSize, header.nbTauG1())
+		case ptauSectionTauG2:
+			if err = checkPtauSectionSize(sectionSize, header.nbPowers(), ptauSizeG2); err != nil {
+				return pr.n, err
+			}
+			p.TauG2, err = appendPoints(pr, nil, header.nbPowers(), ptauSizeG2, decodePtauG2)
+		case ptauSectionAlphaTauG1:
+			p.AlphaTauG1, err = readPtauG1Section(pr, sectionSize, header.nbPowers())
+		case ptauSectionBetaTauG1:
+			p.BetaTauG1, err = readPtauG1Section(pr, sectionSize, header.nbPowers())
+		case ptauSectionBetaG2:
+			if err = checkPtauSectionSize(sectionSize, 1, ptauSizeG2); err != nil {
+				return pr.n, err
+			}
+			err = pr.readG2(&p.BetaG2)
+		case ptauSectionContributions:
+			p.Contributions, err = pr.readContributions(sectionSize)
+		default:
+			// sections added by snarkjs when preparing phase 2 (Lagrange
+			// basis) are not part of the powers of tau.
+			err = pr.skip(sectionSize)
+		}
+		if err != nil {
+			return pr.n, err
+		}
+		seen[sectionType] = true
+	}
+
+	for s := uint32(ptauSectionHeader); s <= ptauSectionBetaG2; s++ {
+		if !seen[s] {
+			return pr.n, fmt.Errorf("%w %d", ErrPtauMissingSection, s)
+		}
+	}
+
+	return pr.n, nil
+}
+
+// WriteTo writes the .ptau encoding of p.
+//
+// Use TruncatePtau to write a .ptau file from another one without loading it
+// in memory.
+func (p *Ptau) WriteTo(w io.Writer) (int64, error) {
+	nbPowers := uint64(1) << p.Power
+	if uint64(len(p.TauG1)) != 2*nbPowers-1 || uint64(len(p.TauG2)) != nbPowers ||
+		uint64(len(p.AlphaTauG1)) != nbPowers || uint64(len(p.BetaTauG1)) != nbPowers {
+		return 0, ErrPtauSectionSize
+	}
+
+	pw := newPtauWriter(w)
+	pw.write([]byte(ptauMagic))
+	pw.writeUint32(ptauVersion)
+	pw.writeUint32(ptauSectionContributions)
+
+	pw.writeHeader(ptauHeader{power: p.Power, ceremonyPower: p.CeremonyPower})
+
+	// points
+	pw.writeSectionHeader(ptauSectionTauG1, uint64(len(p.TauG1))*ptauSizeG1)
+	writePoints(pw, p.TauG1, ptauSizeG1, encodePtauG1)
+	pw.writeSectionHeader(ptauSectionTauG2, uint64(len(p.TauG2))*ptauSizeG2)
+	writePoints(pw, p.TauG2, ptauSizeG2, encodePtauG2)
+	pw.writeSectionHeader(ptauSectionAlphaTauG1, uint64(len(p.AlphaTauG1))*ptauSizeG1)
+	writePoints(pw, p.AlphaTauG1, ptauSizeG1, encodePtauG1)
+	pw.writeSectionHeader(ptauSectionBetaTauG1, uint64(len(p.BetaTauG1))*ptauSizeG1)
+	writePoints(pw, p.BetaTauG1, ptauSizeG1, encodePtauG1)
+	pw.writeSectionHeader(ptauSectionBetaG2, ptauSizeG2)
+	pw.writeG2(&p.BetaG2)
+
+	// contributions
+	params := make([][]byte, len(p.Contributions))
+	size := uint64(4)
+	for i := range p.Contributions {
+		params[i] = p.Contributions[i].params()
+		size += 3*ptauSizeG1 + 2*ptauSizeG2 + ptauSizePublicKey + 216 + 64 + 4 + 4 + uint64(len(params[i]))
+	}
+	pw.writeSectionHeader(ptauSectionContributions, size)
+	pw.writeUint32(uint32(len(p.Contributions)))
+	for i := range p.Contributions {
+		c := &p.Contributions[i]
+		pw.writeG1(&c.TauG1)
+		pw.writeG2(&c.TauG2)
+		pw.writeG1(&c.AlphaG1)
+		pw.writeG1(&c.BetaG1)
+		pw.writeG2(&c.BetaG2)
+		pw.writePublicKey(&c.Key)
+		pw.write(c.PartialHash[:])
+		pw.write(c.NextChallenge[:])
+		pw.writeUint32(c.Type)
+		pw.writeUint32(uint32(len(params[i])))
+		pw.write(params[i])
+	}
+
+	return pw.flush()
+}
+
+// Truncate reduces p to a ceremony of power 'power', as snarkjs does when
+// a smaller .ptau file is extracted from a larger one.
+func (p *Ptau) Truncate(power uint32) error {
+	if power > p.Power {
+		return fmt.Errorf("ptau: cannot truncate a file of power %d to power %d", p.Power, power)
+	}
+	nbPowers := uint64(1) << power
+	p.Power = power
+	p.TauG1 = p.TauG1[:2*nbPowers-1]
+	p.TauG2 = p.TauG2[:nbPowers]
+	p.AlphaTauG1 = p.AlphaTauG1[:nbPowers]
+	p.BetaTauG1 = p.BetaTauG1[:nbPowers]
+	return nil
+}
+
+// TruncatePtau reads a .ptau file from r and writes to w the file reduced to
+// a ceremony of power 'power', as Ptau.Truncate does.
+//
+// The file is streamed by chunks of points: it is never fully loaded in
+// memory. The points are checked to be on the curve and in the correct
+// subgroup. The sections added by snarkjs when preparing phase 2 are dropped.
+func TruncatePtau(w io.Writer, r io.Reader, power uint32) (int64, error) {
+	pr := newPtauReader(r)
+	if err := pr.readPreamble(); err != nil {
+		return 0, err
+	}
+	pw := newPtauWriter(w)
+	pw.write([]byte(ptauMagic))
+	pw.writeUint32(ptauVersion)
+	pw.writeUint32(ptauSectionContributions)
+
+	var header ptauHeader
+	seen := make(map[uint32]bool)
+	for pw.err == nil {
+		sectionType, sectionSize, err := pr.readSectionHeader()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return pw.n, err
+		}
+		if sectionType > ptauSectionHeader && sectionType <= ptauSectionContributions && !seen[ptauSectionHeader] {
+			return pw.n, ErrPtauHeader
+		}
+		truncated := ptauHeader{power: power}
+		switch sectionType {
+		case ptauSectionHeader:
+			if header, err = pr.readHeader(sectionSize); err != nil {
+				return pw.n, err
+			}
+			if power > header.power {
+				return pw.n, fmt.Errorf("ptau: cannot truncate a file of power %d to power %d", header.power, power)
+			}
+			pw.writeHeader(ptauHeader{power: power, ceremonyPower: header.ceremonyPower})
+		case ptauSectionTauG1:
+			err = copyPtauPoints(pr, pw, sectionSize, header.nbTauG1(), truncated.nbTauG1(), sectionType, ptauSizeG1, decodePtauG1, encodePtauG1)
+		case ptauSectionTauG2:
+			err = copyPtauPoints(pr, pw, sectionSize, header.nbPowers(), truncated.nbPowers(), sectionType, ptauSizeG2, decodePtauG2, encodePtauG2)
+		case ptauSectionAlphaTauG1, ptauSectionBetaTauG1:
+			err = copyPtauPoints(pr, pw, sectionSize, header.nbPowers(), truncated.nbPowers(), sectionType, ptauSizeG1, decodePtauG1, encodePtauG1)
+		case ptauSectionBetaG2:
+			err = copyPtauPoints(pr, pw, sectionSize, 1, 1, sectionType, ptauSizeG2, decodePtauG2, encodePtauG2)
+		case ptauSectionContributions:
+			// the contributions do not depend on the power
+			pw.writeSectionHeader(sectionType, sectionSize)
+			if pw.err == nil {
+				var n int64
+				n, err = io.CopyN(pw.w, pr, int64(sectionSize))
+				pw.n += n
+			}
+		default:
+			err = pr.skip(sectionSize)
+		}
+		if err != nil {
+			return pw.n, err
+		}
+		seen[sectionType] = true
+	}
+
+	if pw.err != nil {
+		return pw.n, pw.err
+	}
+	for s := uint32(ptauSectionHeader); s <= ptauSectionBetaG2; s++ {
+		if !seen[s] {
+			return pw.n, fmt.Errorf("%w %d", ErrPtauMissingSection, s)
+		}
+	}
+	if !seen[ptauSectionContributions] {
+		// the number of sections announced is fixed, we write an empty list
+		pw.writeSectionHeader(ptauSectionContributions, 4)
+		pw.writeUint32(0)
+	}
+
+	return pw.flush()
+}
+
+// copyPtauPoints copies the first nbCopy points of a section of nbPoints
+// points from pr to pw, by chunks, and skips the other ones.
+func copyPtauPoints[T any](pr *ptauReader, pw *ptauWriter, sectionSize uint64, nbPoints, nbCopy int, sectionType uint32, pointSize int, decode func(*T, []byte) error, encode func([]byte, *T)) error {
+	if err := checkPtauSectionSize(sectionSize, nbPoints, pointSize); err != nil {
+		return err
+	}
+	pw.writeSectionHeader(sectionType, uint64(nbCopy)*uint64(pointSize))
+	buf := make([]T, min(nbCopy, ptauChunkSize))
+	for start := 0; start < nbCopy && pw.err == nil; start += len(buf) {
+		chunk := buf[:min(len(buf), nbCopy-start)]
+		if err := readPoints(pr, chunk, pointSize, decode); err != nil {
+			return err
+		}
+		writePoints(pw, chunk, pointSize, encode)
+	}
+	return pr.skip(uint64(nbPoints-nbCopy) * uint64(pointSize))
+}
+
+// SRS returns the KZG SRS made of the powers of τ of p. If maxPkPoints is
+// provided, the number of points in the ProvingKey is limited to maxPkPoints.
+func (p *Ptau) SRS(maxPkPoints ...int) (*SRS, error) {
+	if len(p.TauG1) < 2 || len(p.TauG2) < 2 {
+		return nil, ErrMinSRSSize
+	}
+	n := len(p.TauG1)
+	if len(maxPkPoints) > 0 && maxPkPoints[0] > 0 && maxPkPoints[0] < n {
+		n = maxPkPoints[0]
+	}
+	var srs SRS
+	srs.Pk.G1 = make([]bls12381.G1Affine, n)
+	copy(srs.Pk.G1, p.TauG1)
+	srs.Vk.G1 = p.TauG1[0]
+	srs.Vk.G2[0] = p.TauG2[0]
+	srs.Vk.G2[1] = p.TauG2[1]
+	srs.Vk.Lines[0] = bls12381.PrecomputeLines(srs.Vk.G2[0])
+	srs.Vk.Lines[1] = bls12381.PrecomputeLines(srs.Vk.G2[1])
+	return &srs, nil
+}
+
+// params returns the encoding of the optional parameters of a contribution
+func (c *PtauContribution) params() []byte {
+	var res []byte
+	if c.Name != "" {
+		name := []byte(c.Name)
+		if len(name) > 64 {
+			name = name[:64]
+		}
+		res = append(res, 1, byte(len(name)))
+		res = append(res, name...)
+	}
+	if c.Type == 1 {
+		res = append(res, 2, c.NumIterationsExp)
+		res = append(res, 3, byte(len(c.BeaconHash)))
+		res = append(res, c.BeaconHash...)
+	}
+	return res
+}
+
+// PPoTFormat is the encoding of a Perpetual Powers of Tau file.
+type PPoTFormat uint8
+
+const (
+	// PPoTChallenge files contain the hash of the previous response followed
+	// by the uncompressed points.
+	PPoTChallenge PPoTFormat = iota
+	// PPoTResponse files contain the hash of the challenge followed by the
+	// compressed points and the public key of the contributor.
+	PPoTResponse
+)
+
+// ReadPPoT reads the proving and verifying keys of a Perpetual Powers of Tau
+// challenge or response file of the given power (the file contains 2ᵖᵒʷᵉʳ⁺¹-1
+// powers of τ in G₁).
+//
+// The file is streamed: only the needed powers of τ are kept in memory. If
+// maxPkPoints is provided, the number of points in the ProvingKey is limited
+// to maxPkPoints.
+//
+// All points read are checked to be on the curve and in the correct subgroup.
+func (srs *SRS) ReadPPoT(r io.Reader, power uint8, format PPoTFormat, maxPkPoints ...int) error {
+	sizeG1, sizeG2 := bls12381.SizeOfG1AffineUncompressed, bls12381.SizeOfG2AffineUncompressed
+	decodeG1, decodeG2 := decodePPoTG1Uncompressed, decodePPoTG2Uncompressed
+	if format == PPoTResponse {
+		sizeG1, sizeG2 = bls12381.SizeOfG1AffineCompressed, bls12381.SizeOfG2AffineCompressed
+		decodeG1, decodeG2 = decodePPoTG1Compressed, decodePPoTG2Compressed
+	}
+
+	pr := newPtauReader(r)
+
+	// hash of the previous transcript
+	if err := pr.skip(64); err != nil {
+		return err
+	}
+
+	if power >= 32 {
+		return fmt.Errorf("ptau: invalid power %d", power)
+	}
+	nbPoints := int(uint64(1)<<(power+1) - 1)
+	n := nbPoints
+	if len(maxPkPoints) > 0 && maxPkPoints[0] > 0 && maxPkPoints[0] < n {
+		n = maxPkPoints[0]
+	}
+	var err error
+	if srs.Pk.G1, err = appendPoints(pr, nil, n, sizeG1, decodeG1); err != nil {
+		return err
+	}
+	if err := pr.skip(uint64(nbPoints-n) * uint64(sizeG1)); err != nil {
+		return err
+	}
+	if err := readPoints(pr, srs.Vk.G2[:], sizeG2, decodeG2); err != nil {
+		return err
+	}
+
+	srs.Vk.G1 = srs.Pk.G1[0]
+	srs.Vk.Lines[0] = bls12381.PrecomputeLines(srs.Vk.G2[0])
+	srs.Vk.Lines[1] = bls12381.PrecomputeLines(srs.Vk.G2[1])
+
+	return nil
+}
+
+// The PPoT ceremony encodes points with the zcash format, which is the one
+// used in this package.
+
+func decodePPoTG1Uncompressed(p *bls12381.G1Affine, buf []byte) error {
+	_, err := p.SetBytes(buf)
+	return err
+}
+
+func decodePPoTG1Compressed(p *bls12381.G1Affine, buf []byte) error {
+	_, err := p.SetBytes(buf)
+	return err
+}
+
+func decodePPoTG2Uncompressed(p *bls12381.G2Affine, buf []byte) error {
+	_, err := p.SetBytes(buf)
+	return err
+}
+
+func decodePPoTG2Compressed(p *bls12381.G2Affine, buf []byte) error {
+	_, err := p.SetBytes(buf)
+	return err
+}
+
+// ptauHeader is the content of the header section of a .ptau file
+type ptauHeader struct {
+	power, ceremonyPower uint32
+}
+
+// nbPowers returns the number of powers in G₂ and of the α, β sections
+func (h ptauHeader) nbPowers() int {
+	return 1 << h.power
+}
+
+// nbTauG1 returns the number of powers of τ in G₁
+func (h ptauHeader) nbTauG1() int {
+	return 2*h.nbPowers() - 1
+}
+
+func checkPtauSectionSize(sectionSize uint64, nbPoints, pointSize int) error {
+	if sectionSize != uint64(nbPoints)*uint64(pointSize) {
+		return ErrPtauSectionSize
+	}
+	return nil
+}
+
+func readPtauG1Section(pr *ptauReader, sectionSize uint64, nbPoints int) ([]bls12381.G1Affine, error) {
+	if err := checkPtauSectionSize(sectionSize, nbPoints, ptauSizeG1); err != nil {
+		return nil, err
+	}
+	return appendPoints(pr, nil, nbPoints, ptauSizeG1, decodePtauG1)
+}
+
+// ptauReader wraps a buffered reader and counts the bytes read
+type ptauReader struct {
+	r *bufio.Reader
+	n int64
+}
+
+func newPtauReader(r io.Reader) *ptauReader {
+	return &ptauReader{r: bufio.NewReaderSize(r, 1<<20)}
+}
+
+func (pr *ptauReader) Read(b []byte) (int, error) {
+	n, err := pr.r.Read(b)
+	pr.n += int64(n)
+	return n, err
+}
+
+func (pr *ptauReader) skip(n uint64) error {
+	m, err := io.CopyN(io.Discard, pr.r, int64(n))
+	pr.n += m
+	return err
+}
+
+func (pr *ptauReader) readUint32() (uint32, error) {
+	var b [4]byte
+	if _, err := io.ReadFull(pr, b[:]); err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b[:]), nil
+}
+
+func (pr *ptauReader) readPreamble() error {
+	var magic [4]byte
+	if _, err := io.ReadFull(pr, magic[:]); err != nil {
+		return err
+	}
+	if string(magic[:]) != ptauMagic {
+		return ErrPtauInvalidMagic
+	}
+	version, err := pr.readUint32()
+	if err != nil {
+		return err
+	}
+	if version != ptauVersion {
+		return ErrPtauInvalidVersion
+	}
+	// number of sections; we rely on the end of the stream instead
+	_, err = pr.readUint32()
+	return err
+}
+
+// readSectionHeader returns the type and size of the next section, or io.EOF
+// if there are no more sections.
+func (pr *ptauReader) readSectionHeader() (uint32, uint64, error) {
+	var b [12]byte
+	if _, err := io.ReadFull(pr, b[:]); err != nil {
+		return 0, 0, err
+	}
+	return binary.LittleEndian.Uint32(b[:4]), binary.LittleEndian.Uint64(b[4:]), nil
+}
+
+func (pr *ptauReader) readHeader(sectionSize uint64) (ptauHeader, error) {
+	var h ptauHeader
+	if sectionSize != 4+fp.Bytes+4+4 {
+		return h, ErrPtauWrongCurve
+	}
+	n8q, err := pr.readUint32()
+	if err != nil {
+		return h, err
+	}
+	if n8q != fp.Bytes {
+		return h, ErrPtauWrongCurve
+	}
+	var bq [fp.Bytes]byte
+	if _, err = io.ReadFull(pr, bq[:]); err != nil {
+		return h, err
+	}
+	for i, j := 0, len(bq)-1; i < j; i, j = i+1, j-1 {
+		bq[i], bq[j] = bq[j], bq[i]
+	}
+	var q [fp.Bytes]byte
+	fp.Modulus().FillBytes(q[:])
+	if q != bq {
+		return h, ErrPtauWrongCurve
+	}
+	if h.power, err = pr.readUint32(); err != nil {
+		return h, err
+	}
+	if h.power >= 32 {
+		return h, ErrPtauSectionSize
+	}
+	h.ceremonyPower, err = pr.readUint32()
+	return h, err
+}
+
+func (pr *ptauReader) readG1(p *bls12381.G1Affine) error {
+	var b [ptauSizeG1]byte
+	if _, err := io.ReadFull(pr, b[:]); err != nil {
+		return err
+	}
+	return decodePtauG1(p, b[:])
+}
+
+func (pr *ptauReader) readG2(p *bls12381.G2Affine) error {
+	var b [ptauSizeG2]byte
+	if _, err := io.ReadFull(pr, b[:]); err != nil {
+		return err
+	}
+	return decodePtauG2(p, b[:])
+}
+
+func (pr *ptauReader) readPublicKey(k *PtauPublicKey) error {
+	for _, p := range []*bls12381.G1Affine{&k.TauG1S, &k.TauG1SX, &k.AlphaG1S, &k.AlphaG1SX, &k.BetaG1S, &k.BetaG1SX} {
+		if err := pr.readG1(p); err != nil {
+			return err
+		}
+	}
+	for _, p := range []*bls12381.G2Affine{&k.TauG2SPX, &k.AlphaG2SPX, &k.BetaG2SPX} {
+		if err := pr.readG2(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pr *ptauReader) readContributions(sectionSize uint64) ([]PtauContribution, error) {
+	start := pr.n
+	nbContributions, err := pr.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	// each contribution takes more than ptauSizePublicKey bytes
+	if uint64(nbContributions)*ptauSizePublicKey > sectionSize {
+		return nil, ErrPtauSectionSize
+	}
+	// the contributions are appended as they are read, so that the number of
+	// contributions announced does not drive the allocations
+	var res []PtauContribution
+	for i := uint32(0); i < nbContributions; i++ {
+		var c PtauContribution
+		if err = pr.readG1(&c.TauG1); err != nil {
+			return nil, err
+		}
+		if err = pr.readG2(&c.TauG2); err != nil {
+			return nil, err
+		}
+		if err = pr.readG1(&c.AlphaG1); err != nil {
+			return nil, err
+		}
+		if err = pr.readG1(&c.BetaG1); err != nil {
+			return nil, err
+		}
+		if err = pr.readG2(&c.BetaG2); err != nil {
+			return nil, err
+		}
+		if err = pr.readPublicKey(&c.Key); err != nil {
+			return nil, err
+		}
+		if _, err = io.ReadFull(pr, c.PartialHash[:]); err != nil {
+			return nil, err
+		}
+		if _, err = io.ReadFull(pr, c.NextChallenge[:]); err != nil {
+			return nil, err
+		}
+		if c.Type, err = pr.readUint32(); err != nil {
+			return nil, err
+		}
+		paramsLen, err := pr.readUint32()
+		if err != nil {
+			return nil, err
+		}
+		if paramsLen > ptauMaxParamsSize || uint64(paramsLen) > sectionSize {
+			return nil, ErrPtauSectionSize
+		}
+		var params [ptauMaxParamsSize]byte
+		if _, err = io.ReadFull(pr, params[:paramsLen]); err != nil {
+			return nil, err
+		}
+		if err = c.setParams(params[:paramsLen]); err != nil {
+			return nil, err
+		}
+		res = append(res, c)
+	}
+	if uint64(pr.n-start) != sectionSize {
+		return nil, ErrPtauSectionSize
+	}
+	return res, nil
+}
+
+// setParams decodes the optional parameters of a contribution; they are
+// encoded as a list of (type, value) sorted by type.
+func (c *PtauContribution) setParams(params []byte) error {
+	errParams := errors.New("ptau: invalid contribution parameters")
+	var lastType byte
+	for len(params) > 0 {
+		if params[0] <= lastType || len(params) < 2 {
+			return errParams
+		}
+		lastType = params[0]
+		switch lastType {
+		case 1, 3:
+			l := int(params[1])
+			if len(params) < 2+l {
+				return errParams
+			}
+			if lastType == 1 {
+				c.Name = string(params[2 : 2+l])
+			} else {
+				c.BeaconHash = append([]byte{}, params[2:2+l]...)
+			}
+			params = params[2+l:]
+		case 2:
+			c.NumIterationsExp = params[1]
+			params = params[2:]
+		default:
+			return errParams
+		}
+	}
+	return nil
+}
+
+// readPoints reads len(dst) points encoded on pointSize bytes each. Points are
+// read by chunks which are decoded in parallel.
+func readPoints[T any](r io.Reader, dst []T, pointSize int, decode func(*T, []byte) error) error {
+	buf := make([]byte, min(len(dst), ptauChunkSize)*pointSize)
+	for start := 0; start < len(dst); start += ptauChunkSize {
+		chunk := dst[start:min(start+ptauChunkSize, len(dst))]
+		b := buf[:len(chunk)*pointSize]
+		if _, err := io.ReadFull(r, b); err != nil {
+			return err
+		}
+		var (
+			errLock sync.Mutex
+			err     error
+		)
+		parallel.Execute(len(chunk), func(start, end int) {
+			for i := start; i < end; i++ {
+				if e := decode(&chunk[i], b[i*pointSize:(i+1)*pointSize]); e != nil {
+					errLock.Lock()
+					err = e
+					errLock.Unlock()
+					return
+				}
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendPoints reads n points encoded on pointSize bytes each and appends them
+// to dst. dst grows by chunks as the points are read, so that a truncated or
+// malicious stream cannot trigger the allocation of all the points announced.
+func appendPoints[T any](r io.Reader, dst []T, n, pointSize int, decode func(*T, []byte) error) ([]T, error) {
+	for n > 0 {
+		m := min(n, ptauChunkSize)
+		dst = slices.Grow(dst, m)
+		if err := readPoints(r, dst[len(dst):len(dst)+m], pointSize, decode); err != nil {
+			return nil, err
+		}
+		dst = dst[:len(dst)+m]
+		n -= m
+	}
+	return dst, nil
+}
+
+// decodePtauElement sets z from its Montgomery little-endian encoding
+func decodePtauElement(z *fp.Element, b []byte) error {
+	for i := range z {
+		z[i] = binary.LittleEndian.Uint64(b[8*i:])
+	}
+	// z must be reduced
+	for i := len(z) - 1; i >= 0; i-- {
+		if z[i] != ptauModulus[i] {
+			if z[i] > ptauModulus[i] {
+				return ErrPtauInvalidPoint
+			}
+			return nil
+		}
+	}
+	return ErrPtauInvalidPoint
+}
+
+func encodePtauElement(b []byte, z *fp.Element) {
+	for i := range z {
+		binary.LittleEndian.PutUint64(b[8*i:], z[i])
+	}
+}
+
+// ptauModulus is the base field modulus in 64-bit little-endian words
+var ptauModulus = func() (res fp.Element) {
+	var b [fp.Bytes]byte
+	fp.Modulus().FillBytes(b[:])
+	for i := range res {
+		res[i] = binary.BigEndian.Uint64(b[fp.Bytes-8*(i+1):])
+	}
+	return
+}()
+
+func decodePtauG1(p *bls12381.G1Affine, b []byte) error {
+	if err := decodePtauElement(&p.X, b[:fp.Bytes]); err != nil {
+		return err
+	}
+	if err := decodePtauElement(&p.Y, b[fp.Bytes:]); err != nil {
+		return err
+	}
+	// the point at infinity is encoded as (0, 0)
+	if p.IsInfinity() {
+		return nil
+	}
+	if !p.IsOnCurve() || !p.IsInSubGroup() {
+		return ErrPtauInvalidPoint
+	}
+	return nil
+}
+
+func decodePtauG2(p *bls12381.G2Affine, b []byte) error {
+	for i, e := range []*fp.Element{&p.X.A0, &p.X.A1, &p.Y.A0, &p.Y.A1} {
+		if err := decodePtauElement(e, b[i*fp.Bytes:]); err != nil {
+			return err
+		}
+	}
+	if p.IsInfinity() {
+		return nil
+	}
+	if !p.IsOnCurve() || !p.IsInSubGroup() {
+		return ErrPtauInvalidPoint
+	}
+	return nil
+}
+
+func encodePtauG1(b []byte, p *bls12381.G1Affine) {
+	encodePtauElement(b, &p.X)
+	encodePtauElement(b[fp.Bytes:], &p.Y)
+}
+
+func encodePtauG2(b []byte, p *bls12381.G2Affine) {
+	for i, e := range []*fp.Element{&p.X.A0, &p.X.A1, &p.Y.A0, &p.Y.A1} {
+		encodePtauElement(b[i*fp.Bytes:], e)
+	}
+}
+
+// ptauWriter wraps a buffered writer, counts the bytes written and keeps the
+// first error encountered.
+type ptauWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func newPtauWriter(w io.Writer) *ptauWriter {
+	return &ptauWriter{w: bufio.NewWriterSize(w, 1<<20)}
+}
+
+func (pw *ptauWriter) write(b []byte) {
+	if pw.err != nil {
+		return
+	}
+	n, err := pw.w.Write(b)
+	pw.n += int64(n)
+	pw.err = err
+}
+
+func (pw *ptauWriter) writeUint32(v uint32) {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	pw.write(b[:])
+}
+
+func (pw *ptauWriter) writeSectionHeader(sectionType uint32, size uint64) {
+	var b [12]byte
+	binary.LittleEndian.PutUint32(b[:4], sectionType)
+	binary.LittleEndian.PutUint64(b[4:], size)
+	pw.write(b[:])
+}
+
+// writeHeader writes the header section
+func (pw *ptauWriter) writeHeader(h ptauHeader) {
+	q := fp.Modulus()
+	var bq [fp.Bytes]byte
+	q.FillBytes(bq[:])
+	for i, j := 0, len(bq)-1; i < j; i, j = i+1, j-1 {
+		bq[i], bq[j] = bq[j], bq[i]
+	}
+	pw.writeSectionHeader(ptauSectionHeader, 4+fp.Bytes+4+4)
+	pw.writeUint32(fp.Bytes)
+	pw.write(bq[:])
+	pw.writeUint32(h.power)
+	pw.writeUint32(h.ceremonyPower)
+}
+
+func (pw *ptauWriter) writeG1(p *bls12381.G1Affine) {
+	var b [ptauSizeG1]byte
+	encodePtauG1(b[:], p)
+	pw.write(b[:])
+}
+
+func (pw *ptauWriter) writeG2(p *bls12381.G2Affine) {
+	var b [ptauSizeG2]byte
+	encodePtauG2(b[:], p)
+	pw.write(b[:])
+}
+
+func (pw *ptauWriter) writePublicKey(k *PtauPublicKey) {
+	for _, p := range []*bls12381.G1Affine{&k.TauG1S, &k.TauG1SX, &k.AlphaG1S, &k.AlphaG1SX, &k.BetaG1S, &k.BetaG1SX} {
+		pw.writeG1(p)
+	}
+	for _, p := range []*bls12381.G2Affine{&k.TauG2SPX, &k.AlphaG2SPX, &k.BetaG2SPX} {
+		pw.writeG2(p)
+	}
+}
+
+func (pw *ptauWriter) flush() (int64, error) {
+	if pw.err != nil {
+		return pw.n, pw.err
+	}
+	return pw.n, pw.w.Flush()
+}
+
+// writePoints encodes the points by chunks, in parallel
+func writePoints[T any](pw *ptauWriter, points []T, pointSize int, encode func([]byte, *T)) {
+	buf := make([]byte, min(len(points), ptauChunkSize)*pointSize)
+	for start := 0; start < len(points) && pw.err == nil; start += ptauChunkSize {
+		chunk := points[start:min(start+ptauChunkSize, len(points))]
+		b := buf[:len(chunk)*pointSize]
+		parallel.Execute(len(chunk), func(start, end int) {
+			for i := start; i < end; i++ {
+				encode(b[i*pointSize:(i+1)*pointSize], &chunk[i])
+			}
+		})
+		pw.write(b)
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestPtau returns a .ptau content of the given power with τ = bAlpha
+func newTestPtau(power uint32) *Ptau {
+	nbPowers := 1 << power
+	_, _, g1, g2 := bls12381.Generators()
+
+	var tau, alpha, beta fr.Element
+	tau.SetBigInt(bAlpha)
+	alpha.SetUint64(3)
+	beta.SetUint64(5)
+
+	taus := make([]fr.Element, 2*nbPowers-1)
+	taus[0].SetOne()
+	for i := 1; i < len(taus); i++ {
+		taus[i].Mul(&taus[i-1], &tau)
+	}
+	alphaTaus := make([]fr.Element, nbPowers)
+	betaTaus := make([]fr.Element, nbPowers)
+	for i := range alphaTaus {
+		alphaTaus[i].Mul(&taus[i], &alpha)
+		betaTaus[i].Mul(&taus[i], &beta)
+	}
+
+	var p Ptau
+	p.Power = power
+	p.CeremonyPower = power + 1
+	p.TauG1 = bls12381.BatchScalarMultiplicationG1(&g1, taus)
+	p.TauG2 = bls12381.BatchScalarMultiplicationG2(&g2, taus[:nbPowers])
+	p.AlphaTauG1 = bls12381.BatchScalarMultiplicationG1(&g1, alphaTaus)
+	p.BetaTauG1 = bls12381.BatchScalarMultiplicationG1(&g1, betaTaus)
+	p.BetaG2.ScalarMultiplication(&g2, big.NewInt(5))
+
+	p.Contributions = make([]PtauContribution, 2)
+	for i := range p.Contributions {
+		c := &p.Contributions[i]
+		c.TauG1 = p.TauG1[1]
+		c.TauG2 = p.TauG2[1]
+		c.AlphaG1 = p.AlphaTauG1[0]
+		c.BetaG1 = p.BetaTauG1[0]
+		c.BetaG2 = p.BetaG2
+		c.Key.TauG1S = g1
+		c.Key.TauG1SX = p.TauG1[1]
+		c.Key.AlphaG1S = g1
+		c.Key.AlphaG1SX = p.AlphaTauG1[0]
+		c.Key.BetaG1S = g1
+		c.Key.BetaG1SX = p.BetaTauG1[0]
+		c.Key.TauG2SPX = p.TauG2[1]
+		c.Key.AlphaG2SPX = g2
+		c.Key.BetaG2SPX = p.BetaG2
+		c.PartialHash[0] = byte(i)
+		c.NextChallenge[1] = byte(i)
+	}
+	p.Contributions[0].Name = "first contribution"
+	p.Contributions[1].Type = 1
+	p.Contributions[1].NumIterationsExp = 10
+	p.Contributions[1].BeaconHash = []byte{1, 2, 3, 4}
+
+	return &p
+}
+
+func TestPtauSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	p := newTestPtau(3)
+
+	var buf bytes.Buffer
+	written, err := p.WriteTo(&buf)
+	assert.NoError(err)
+	assert.Equal(int64(buf.Len()), written)
+
+	var q Ptau
+	read, err := q.ReadFrom(bytes.NewReader(buf.Bytes()))
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(*p, q)
+
+	// truncated file
+	assert.NoError(p.Truncate(2))
+	buf.Reset()
+	_, err = p.WriteTo(&buf)
+	assert.NoError(err)
+	q = Ptau{}
+	_, err = q.ReadFrom(bytes.NewReader(buf.Bytes()))
+	assert.NoError(err)
+	assert.Equal(*p, q)
+	assert.Equal(7, len(q.TauG1))
+
+	assert.Error(p.Truncate(3))
+}
+
+func TestTruncatePtau(t *testing.T) {
+	assert := require.New(t)
+
+	p := newTestPtau(3)
+	var buf bytes.Buffer
+	_, err := p.WriteTo(&buf)
+	assert.NoError(err)
+	data := buf.Bytes()
+
+	for _, power := range []uint32{3, 2, 0} {
+		var streamed bytes.Buffer
+		written, err := TruncatePtau(&streamed, bytes.NewReader(data), power)
+		assert.NoError(err)
+		assert.Equal(int64(streamed.Len()), written)
+
+		q := newTestPtau(3)
+		assert.NoError(q.Truncate(power))
+		var expected bytes.Buffer
+		_, err = q.WriteTo(&expected)
+		assert.NoError(err)
+		assert.Equal(expected.Bytes(), streamed.Bytes(), "power %d", power)
+	}
+
+	_, err = TruncatePtau(io.Discard, bytes.NewReader(data), 4)
+	assert.Error(err)
+	_, err = TruncatePtau(io.Discard, bytes.NewReader(data[:len(data)-1]), 2)
+	assert.Error(err)
+}
+
+func TestPtauUntrustedSizes(t *testing.T) {
+	assert := require.New(t)
+
+	p := newTestPtau(1)
+	var buf bytes.Buffer
+	_, err := p.WriteTo(&buf)
+	assert.NoError(err)
+	data := buf.Bytes()
+
+	// the header announces 2³¹ powers, and the sections sizes match; the
+	// points are not allocated before they are read
+	const offsetPower = 12 + 12 + 4 + fp.Bytes
+	const offsetTauG1 = offsetPower + 8
+	huge := bytes.Clone(data)
+	binary.LittleEndian.PutUint32(huge[offsetPower:], 31)
+	binary.LittleEndian.PutUint64(huge[offsetTauG1+4:], (1<<32-1)*ptauSizeG1)
+	var srs SRS
+	assert.ErrorIs(srs.ReadPtau(bytes.NewReader(huge)), io.ErrUnexpectedEOF)
+	var q Ptau
+	_, err = q.ReadFrom(bytes.NewReader(huge))
+	assert.ErrorIs(err, io.ErrUnexpectedEOF)
+	_, err = TruncatePtau(io.Discard, bytes.NewReader(huge), 31)
+	assert.ErrorIs(err, io.ErrUnexpectedEOF)
+
+	// the same for a PPoT file
+	assert.ErrorIs(srs.ReadPPoT(bytes.NewReader(make([]byte, 1024)), 31, PPoTResponse), io.ErrUnexpectedEOF)
+	assert.Error(srs.ReadPPoT(bytes.NewReader(make([]byte, 1024)), 200, PPoTResponse))
+
+	// number of contributions and size of their parameters
+	size := 4
+	for i := range p.Contributions {
+		size += 3*ptauSizeG1 + 2*ptauSizeG2 + ptauSizePublicKey + 216 + 64 + 4 + 4 + len(p.Contributions[i].params())
+	}
+	offsetContributions := len(data) - size
+	huge = bytes.Clone(data)
+	binary.LittleEndian.PutUint64(huge[offsetContributions-8:], 1<<62)
+	binary.LittleEndian.PutUint32(huge[offsetContributions:], 1<<32-1)
+	_, err = q.ReadFrom(bytes.NewReader(huge))
+	assert.ErrorIs(err, io.EOF)
+
+	huge = bytes.Clone(data)
+	binary.LittleEndian.PutUint32(huge[len(data)-len(p.Contributions[1].params())-4:], 1<<32-1)
+	_, err = q.ReadFrom(bytes.NewReader(huge))
+	assert.ErrorIs(err, ErrPtauSectionSize)
+}
+
+func TestSRSReadPtau(t *testing.T) {
+	assert := require.New(t)
+
+	p := newTestPtau(3)
+	var buf bytes.Buffer
+	_, err := p.WriteTo(&buf)
+	assert.NoError(err)
+
+	for _, size := range []int{2, 5, 15, 0} {
+		var srs SRS
+		if size == 0 {
+			assert.NoError(srs.ReadPtau(bytes.NewReader(buf.Bytes())))
+			size = len(p.TauG1)
+		} else {
+			assert.NoError(srs.ReadPtau(bytes.NewReader(buf.Bytes()), size))
+		}
+		assert.Equal(testSrs.Pk.G1[:size], srs.Pk.G1)
+		assert.Equal(testSrs.Vk, srs.Vk)
+
+		fromPtau, err := p.SRS(size)
+		assert.NoError(err)
+		assert.Equal(&srs, fromPtau)
+	}
+}
+
+func TestPtauInvalid(t *testing.T) {
+	assert := require.New(t)
+
+	p := newTestPtau(1)
+	var buf bytes.Buffer
+	_, err := p.WriteTo(&buf)
+	assert.NoError(err)
+	data := buf.Bytes()
+
+	corrupt := func(offset int) []byte {
+		res := bytes.Clone(data)
+		res[offset] ^= 1
+		return res
+	}
+
+	var srs SRS
+	assert.ErrorIs(srs.ReadPtau(bytes.NewReader(corrupt(0))), ErrPtauInvalidMagic)
+	assert.ErrorIs(srs.ReadPtau(bytes.NewReader(corrupt(4))), ErrPtauInvalidVersion)
+
+	// modulus in the header section
+	assert.ErrorIs(srs.ReadPtau(bytes.NewReader(corrupt(12+12+4))), ErrPtauWrongCurve)
+
+	// a coordinate of the first point
+	var q Ptau
+	_, err = q.ReadFrom(bytes.NewReader(corrupt(12 + 12 + 4 + bls12381.SizeOfG1AffineCompressed + 8 + 12)))
+	assert.ErrorIs(err, ErrPtauInvalidPoint)
+
+	// missing sections
+	_, err = q.ReadFrom(bytes.NewReader(data[:12+12+4+bls12381.SizeOfG1AffineCompressed+8]))
+	assert.ErrorIs(err, ErrPtauMissingSection)
+}
+
+func TestSRSReadPPoT(t *testing.T) {
+	assert := require.New(t)
+
+	const power = 3
+	p := newTestPtau(power)
+
+	for _, format := range []PPoTFormat{PPoTChallenge, PPoTResponse} {
+		var buf bytes.Buffer
+		buf.Write(make([]byte, 64))
+		for i := range p.TauG1 {
+			buf.Write(encodeTestPPoTG1(&p.TauG1[i], format))
+		}
+		for i := range p.TauG2 {
+			buf.Write(encodeTestPPoTG2(&p.TauG2[i], format))
+		}
+
+		for _, size := range []int{3, 0} {
+			var srs SRS
+			if size == 0 {
+				assert.NoError(srs.ReadPPoT(bytes.NewReader(buf.Bytes()), power, format))
+				size = len(p.TauG1)
+			} else {
+				assert.NoError(srs.ReadPPoT(bytes.NewReader(buf.Bytes()), power, format, size))
+			}
+			assert.Equal(testSrs.Pk.G1[:size], srs.Pk.G1)
+			assert.Equal(testSrs.Vk, srs.Vk)
+		}
+	}
+
+	// point at infinity
+	var inf, res bls12381.G1Affine
+	res = p.TauG1[1]
+	assert.NoError(decodePPoTG1Uncompressed(&res, encodeTestPPoTG1(&inf, PPoTChallenge)))
+	assert.True(res.IsInfinity())
+	res = p.TauG1[1]
+	assert.NoError(decodePPoTG1Compressed(&res, encodeTestPPoTG1(&inf, PPoTResponse)))
+	assert.True(res.IsInfinity())
+}
+
+func encodeTestPPoTG1(p *bls12381.G1Affine, format PPoTFormat) []byte {
+	if format == PPoTChallenge {
+		b := p.RawBytes()
+		return b[:]
+	}
+	b := p.Bytes()
+	return b[:]
+}
+
+func encodeTestPPoTG2(p *bls12381.G2Affine, format PPoTFormat) []byte {
+	if format == PPoTChallenge {
+		b := p.RawBytes()
+		return b[:]
+	}
+	b := p.Bytes()
+	return b[:]
+}
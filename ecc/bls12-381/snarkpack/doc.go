@@ -0,0 +1,22 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package snarkpack provides the aggregation of Groth16 proofs with inner-pairing-product arguments (SnarkPack), cf https://eprint.iacr.org/2021/529.pdf
+//
+// n Groth16 proofs (Aᵢ, Bᵢ, Cᵢ) for the same verifying key are aggregated in a
+// proof of size O(log n), verified with O(log n) operations in GT and a
+// constant number of pairings. The prover commits to the vectors A, B and C
+// with pairing-based commitments, and proves with the TIPP and MIPP arguments
+// that Z_AB = ∏ᵢ e(Aᵢ, Bᵢ)^{rⁱ} and Z_C = ∑ᵢ rⁱCᵢ are consistent with the
+// commitments, for a random r. The verifier then checks the random linear
+// combination of the Groth16 equations
+//
+//	Z_AB = e(α, β)^{∑ᵢrⁱ} e(∑ᵢ rⁱSᵢ, γ) e(Z_C, δ)
+//
+// where Sᵢ is the commitment to the public inputs of the i-th proof.
+//
+// The commitment keys are derived from two powers-of-τ SRS in G₁ and G₂, for
+// two independent secrets a and b.
+package snarkpack
@@ -0,0 +1,276 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package snarkpack
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+)
+
+var errNotInSubGroup = errors.New("GT element not in the subgroup")
+
+// maxNbRounds bounds the number of rounds of a decoded TippMippProof before
+// any allocation. The ProvingKey holds 2N points and is encoded with 4-byte
+// lengths, so that a proof can't have more than log₂(N) < 32 rounds.
+const maxNbRounds = 32
+
+// ReadFrom decodes ProvingKey data from reader.
+func (pk *ProvingKey) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r, &pk.G1A, &pk.G1B, &pk.G2A, &pk.G2B)
+}
+
+// WriteTo writes binary encoding of the ProvingKey
+func (pk *ProvingKey) WriteTo(w io.Writer) (int64, error) {
+	return encode(w, pk.G1A, pk.G1B, pk.G2A, pk.G2B)
+}
+
+// ReadFrom decodes VerifyingKey data from reader.
+func (vk *VerifyingKey) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r, &vk.G1, &vk.AG1, &vk.BG1, &vk.G2, &vk.AG2, &vk.BG2)
+}
+
+// WriteTo writes binary encoding of the VerifyingKey
+func (vk *VerifyingKey) WriteTo(w io.Writer) (int64, error) {
+	return encode(w, &vk.G1, &vk.AG1, &vk.BG1, &vk.G2, &vk.AG2, &vk.BG2)
+}
+
+// ReadFrom decodes Commitment data from reader. The GT elements are checked to
+// be in the subgroup.
+func (c *Commitment) ReadFrom(r io.Reader) (int64, error) {
+	return readGT(r, &c.T, &c.U)
+}
+
+// WriteTo writes binary encoding of a Commitment
+func (c *Commitment) WriteTo(w io.Writer) (int64, error) {
+	return writeGT(w, &c.T, &c.U)
+}
+
+// ReadFrom decodes TippMippProof data from reader.
+func (proof *TippMippProof) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r,
+		(*commitments)(&proof.ComABL), (*commitments)(&proof.ComABR),
+		(*commitments)(&proof.ComCL), (*commitments)(&proof.ComCR),
+		(*gtVector)(&proof.ZABL), (*gtVector)(&proof.ZABR),
+		(*g1Vector)(&proof.ZCL), (*g1Vector)(&proof.ZCR),
+		&proof.A, &proof.C, &proof.B,
+		&proof.V1, &proof.V2, &proof.W1, &proof.W2,
+		&proof.OpeningV1, &proof.OpeningV2, &proof.OpeningW1, &proof.OpeningW2,
+	)
+}
+
+// WriteTo writes binary encoding of a TippMippProof
+func (proof *TippMippProof) WriteTo(w io.Writer) (int64, error) {
+	return encode(w,
+		(*commitments)(&proof.ComABL), (*commitments)(&proof.ComABR),
+		(*commitments)(&proof.ComCL), (*commitments)(&proof.ComCR),
+		(*gtVector)(&proof.ZABL), (*gtVector)(&proof.ZABR),
+		(*g1Vector)(&proof.ZCL), (*g1Vector)(&proof.ZCR),
+		&proof.A, &proof.C, &proof.B,
+		&proof.V1, &proof.V2, &proof.W1, &proof.W2,
+		&proof.OpeningV1, &proof.OpeningV2, &proof.OpeningW1, &proof.OpeningW2,
+	)
+}
+
+// ReadFrom decodes AggregatedProof data from reader.
+func (proof *AggregatedProof) ReadFrom(r io.Reader) (int64, error) {
+	n, err := decode(r, &proof.ComAB, &proof.ComC)
+	if err != nil {
+		return n, err
+	}
+	m, err := readGT(r, &proof.ZAB)
+	n += m
+	if err != nil {
+		return n, err
+	}
+	m, err = decode(r, &proof.ZC, &proof.TippMipp)
+	return n + m, err
+}
+
+// WriteTo writes binary encoding of an AggregatedProof
+func (proof *AggregatedProof) WriteTo(w io.Writer) (int64, error) {
+	n, err := encode(w, &proof.ComAB, &proof.ComC)
+	if err != nil {
+		return n, err
+	}
+	m, err := writeGT(w, &proof.ZAB)
+	n += m
+	if err != nil {
+		return n, err
+	}
+	m, err = encode(w, &proof.ZC, &proof.TippMipp)
+	return n + m, err
+}
+
+// commitments, gtVector and g1Vector are (de)serialized as their length on 4
+// bytes followed by the elements, the length being at most maxNbRounds.
+type commitments []Commitment
+type gtVector []bls12381.GT
+type g1Vector []bls12381.G1Affine
+
+func (c *commitments) ReadFrom(r io.Reader) (int64, error) {
+	l, n, err := readLen(r)
+	if err != nil {
+		return n, err
+	}
+	*c = make([]Commitment, l)
+	for i := range *c {
+		m, err := (*c)[i].ReadFrom(r)
+		n += m
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func (c *commitments) WriteTo(w io.Writer) (int64, error) {
+	n, err := writeLen(w, len(*c))
+	if err != nil {
+		return n, err
+	}
+	for i := range *c {
+		m, err := (*c)[i].WriteTo(w)
+		n += m
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func (v *gtVector) ReadFrom(r io.Reader) (int64, error) {
+	l, n, err := readLen(r)
+	if err != nil {
+		return n, err
+	}
+	*v = make([]bls12381.GT, l)
+	for i := range *v {
+		m, err := readGT(r, &(*v)[i])
+		n += m
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func (v *gtVector) WriteTo(w io.Writer) (int64, error) {
+	n, err := writeLen(w, len(*v))
+	if err != nil {
+		return n, err
+	}
+	for i := range *v {
+		m, err := writeGT(w, &(*v)[i])
+		n += m
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// g1Vector uses the encoding of []bls12381.G1Affine of the Encoder, with
+// compressed points.
+func (v *g1Vector) ReadFrom(r io.Reader) (int64, error) {
+	l, n, err := readLen(r)
+	if err != nil {
+		return n, err
+	}
+	*v = make([]bls12381.G1Affine, l)
+	dec := bls12381.NewDecoder(r)
+	for i := range *v {
+		if err := dec.Decode(&(*v)[i]); err != nil {
+			return n + dec.BytesRead(), err
+		}
+	}
+	return n + dec.BytesRead(), nil
+}
+
+func (v *g1Vector) WriteTo(w io.Writer) (int64, error) {
+	return encode(w, []bls12381.G1Affine(*v))
+}
+
+// readLen reads a length and checks it against maxNbRounds.
+func readLen(r io.Reader) (int, int64, error) {
+	var buf [4]byte
+	read, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return 0, int64(read), err
+	}
+	l := binary.BigEndian.Uint32(buf[:])
+	if l > maxNbRounds {
+		return 0, int64(read), ErrInvalidProof
+	}
+	return int(l), int64(read), nil
+}
+
+func writeLen(w io.Writer, l int) (int64, error) {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], uint32(l))
+	written, err := w.Write(buf[:])
+	return int64(written), err
+}
+
+func readGT(r io.Reader, elements ...*bls12381.GT) (int64, error) {
+	var n int64
+	var buf [bls12381.SizeOfGT]byte
+	for _, e := range elements {
+		read, err := io.ReadFull(r, buf[:])
+		n += int64(read)
+		if err != nil {
+			return n, err
+		}
+		if err := e.SetBytes(buf[:]); err != nil {
+			return n, err
+		}
+		if !e.IsInSubGroup() {
+			return n, errNotInSubGroup
+		}
+	}
+	return n, nil
+}
+
+func writeGT(w io.Writer, elements ...*bls12381.GT) (int64, error) {
+	var n int64
+	for _, e := range elements {
+		b := e.Bytes()
+		written, err := w.Write(b[:])
+		n += int64(written)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func encode(w io.Writer, toEncode ...interface{}) (int64, error) {
+
+	enc := bls12381.NewEncoder(w)
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+func decode(r io.Reader, toDecode ...interface{}) (int64, error) {
+
+	dec := bls12381.NewDecoder(r)
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
@@ -0,0 +1,545 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package snarkpack
+
+import (
+	"math/big"
+	"math/bits"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// The commitment keys are v₁ = ([aⁱ]G₂)ᵢ, v₂ = ([bⁱ]G₂)ᵢ, w₁ = ([aⁿ⁺ⁱ]G₁)ᵢ and
+// w₂ = ([bⁿ⁺ⁱ]G₁)ᵢ for i < n. A and B are committed to with
+//
+//	T = ∏ᵢ e(Aᵢ, v₁ᵢ) e(w₁ᵢ, Bᵢ),   U = ∏ᵢ e(Aᵢ, v₂ᵢ) e(w₂ᵢ, Bᵢ)
+//
+// and C with T = ∏ᵢ e(Cᵢ, v₁ᵢ), U = ∏ᵢ e(Cᵢ, v₂ᵢ).
+//
+// TIPP proves that Z_AB = ∏ᵢ e(A'ᵢ, Bᵢ) and MIPP that Z_C = ∑ᵢ C'ᵢ, where
+// A'ᵢ = rⁱAᵢ and C'ᵢ = rⁱCᵢ are committed to with the key v'ᵢ = r⁻ⁱvᵢ. Both
+// arguments are run together: in each round, the vectors are split in halves
+// and folded with a challenge x
+//
+//	A' = A_lo + xA_hi,   B' = B_lo + x⁻¹B_hi,   C' = C_lo + xC_hi
+//	v' = v_lo + x⁻¹v_hi, w' = w_lo + xw_hi
+//
+// so that the commitments and the inner products are updated as
+// Com' = Com·L^{x⁻¹}·R^{x} with the cross terms L and R sent by the prover.
+// Finally, the prover sends the folded vectors and keys, which are of size 1.
+// The folded keys are v₁ = [f_v(a)]G₂ and w₁ = [f_w(a)]G₁ (and with b for v₂ and w₂)
+// where
+//
+//	f_v(X) = ∏ⱼ (1 + x⁻¹ⱼ(X/r)^{2ᵏ⁻¹⁻ʲ}),   f_w(X) = Xⁿ ∏ⱼ (1 + xⱼX^{2ᵏ⁻¹⁻ʲ})
+//
+// which the prover shows with KZG openings at a random point.
+
+// Commitment pair-group commitment (T, U) ∈ GT².
+//
+// implements io.ReaderFrom and io.WriterTo
+type Commitment struct {
+	T, U bls12381.GT
+}
+
+// TippMippProof proof of the TIPP and MIPP arguments.
+//
+// implements io.ReaderFrom and io.WriterTo
+type TippMippProof struct {
+	// ComABL, ComABR cross terms of the commitment to A and B in each round
+	ComABL, ComABR []Commitment
+
+	// ComCL, ComCR cross terms of the commitment to C in each round
+	ComCL, ComCR []Commitment
+
+	// ZABL, ZABR cross terms of Z_AB in each round
+	ZABL, ZABR []bls12381.GT
+
+	// ZCL, ZCR cross terms of Z_C in each round
+	ZCL, ZCR []bls12381.G1Affine
+
+	// A, B, C folded vectors
+	A, C bls12381.G1Affine
+	B    bls12381.G2Affine
+
+	// V1, V2, W1, W2 folded commitment keys
+	V1, V2 bls12381.G2Affine
+	W1, W2 bls12381.G1Affine
+
+	// OpeningV1, OpeningV2, OpeningW1, OpeningW2 KZG openings of the folded keys
+	OpeningV1, OpeningV2 bls12381.G2Affine
+	OpeningW1, OpeningW2 bls12381.G1Affine
+}
+
+type commitmentKey struct {
+	v1, v2 []bls12381.G2Affine
+	w1, w2 []bls12381.G1Affine
+}
+
+func (key *commitmentKey) commitAB(a []bls12381.G1Affine, b []bls12381.G2Affine) (Commitment, error) {
+	var res Commitment
+	var err error
+	if res.T, err = pair(concat(a, key.w1), concat(key.v1, b)); err != nil {
+		return Commitment{}, err
+	}
+	if res.U, err = pair(concat(a, key.w2), concat(key.v2, b)); err != nil {
+		return Commitment{}, err
+	}
+	return res, nil
+}
+
+func (key *commitmentKey) commitC(c []bls12381.G1Affine) (Commitment, error) {
+	var res Commitment
+	var err error
+	if res.T, err = pair(c, key.v1); err != nil {
+		return Commitment{}, err
+	}
+	if res.U, err = pair(c, key.v2); err != nil {
+		return Commitment{}, err
+	}
+	return res, nil
+}
+
+// split returns the halves of the key.
+func (key *commitmentKey) split() (lo, hi commitmentKey) {
+	n := len(key.v1) / 2
+	lo = commitmentKey{v1: key.v1[:n], v2: key.v2[:n], w1: key.w1[:n], w2: key.w2[:n]}
+	hi = commitmentKey{v1: key.v1[n:], v2: key.v2[n:], w1: key.w1[n:], w2: key.w2[n:]}
+	return
+}
+
+// proveTippMipp proves that proof.ZAB = ∏ᵢ e(aᵢ, bᵢ) and proof.ZC = ∑ᵢ cᵢ are
+// consistent with proof.ComAB and proof.ComC, where key is the commitment key
+// rescaled by r.
+func proveTippMipp(fs *fiatshamir.Transcript, proof *AggregatedProof, key commitmentKey, a []bls12381.G1Affine, b []bls12381.G2Affine, c []bls12381.G1Affine, r fr.Element, pk ProvingKey) (TippMippProof, error) {
+	n := len(a)
+	nbRounds := log2(n)
+	res := TippMippProof{
+		ComABL: make([]Commitment, nbRounds),
+		ComABR: make([]Commitment, nbRounds),
+		ComCL:  make([]Commitment, nbRounds),
+		ComCR:  make([]Commitment, nbRounds),
+		ZABL:   make([]bls12381.GT, nbRounds),
+		ZABR:   make([]bls12381.GT, nbRounds),
+		ZCL:    make([]bls12381.G1Affine, nbRounds),
+		ZCR:    make([]bls12381.G1Affine, nbRounds),
+	}
+	if err := bindZ(fs, proof); err != nil {
+		return TippMippProof{}, err
+	}
+
+	// scalars of the MIPP argument, equal to 1 before folding
+	s := make([]fr.Element, n)
+	for i := range s {
+		s[i].SetOne()
+	}
+
+	x := make([]fr.Element, nbRounds)
+	xInv := make([]fr.Element, nbRounds)
+	for k := 0; k < nbRounds; k++ {
+		h := len(a) / 2
+		lo, hi := key.split()
+
+		// the cross terms are independent pairing products
+		var errs [10]error
+		parallel.Execute(10, func(start, end int) {
+			for t := start; t < end; t++ {
+				switch t {
+				case 0:
+					res.ComABL[k].T, errs[t] = pair(concat(a[:h], lo.w1), concat(hi.v1, b[h:]))
+				case 1:
+					res.ComABL[k].U, errs[t] = pair(concat(a[:h], lo.w2), concat(hi.v2, b[h:]))
+				case 2:
+					res.ComABR[k].T, errs[t] = pair(concat(a[h:], hi.w1), concat(lo.v1, b[:h]))
+				case 3:
+					res.ComABR[k].U, errs[t] = pair(concat(a[h:], hi.w2), concat(lo.v2, b[:h]))
+				case 4:
+					res.ComCL[k].T, errs[t] = pair(c[:h], hi.v1)
+				case 5:
+					res.ComCL[k].U, errs[t] = pair(c[:h], hi.v2)
+				case 6:
+					res.ComCR[k].T, errs[t] = pair(c[h:], lo.v1)
+				case 7:
+					res.ComCR[k].U, errs[t] = pair(c[h:], lo.v2)
+				case 8:
+					res.ZABL[k], errs[t] = pair(a[:h], b[h:])
+				case 9:
+					res.ZABR[k], errs[t] = pair(a[h:], b[:h])
+				}
+			}
+		}, 10)
+		for _, err := range errs {
+			if err != nil {
+				return TippMippProof{}, err
+			}
+		}
+		if _, err := res.ZCL[k].MultiExp(c[:h], s[h:], ecc.MultiExpConfig{}); err != nil {
+			return TippMippProof{}, err
+		}
+		if _, err := res.ZCR[k].MultiExp(c[h:], s[:h], ecc.MultiExpConfig{}); err != nil {
+			return TippMippProof{}, err
+		}
+
+		var err error
+		if x[k], err = deriveRoundChallenge(fs, k, &res); err != nil {
+			return TippMippProof{}, err
+		}
+		xInv[k].Inverse(&x[k])
+
+		a = foldG1(a, x[k])
+		c = foldG1(c, x[k])
+		b = foldG2(b, xInv[k])
+		key = commitmentKey{
+			v1: foldG2(key.v1, xInv[k]), v2: foldG2(key.v2, xInv[k]),
+			w1: foldG1(key.w1, x[k]), w2: foldG1(key.w2, x[k]),
+		}
+		var t fr.Element
+		for i := 0; i < h; i++ {
+			t.Mul(&s[h+i], &xInv[k])
+			s[i].Add(&s[i], &t)
+		}
+		s = s[:h]
+	}
+	res.A, res.B, res.C = a[0], b[0], c[0]
+	res.V1, res.V2, res.W1, res.W2 = key.v1[0], key.v2[0], key.w1[0], key.w2[0]
+
+	z, err := deriveZ(fs, &res)
+	if err != nil {
+		return TippMippProof{}, err
+	}
+
+	// f_v, whose coefficients are the products of r⁻ⁱ and the x⁻¹ⱼ
+	var rInv fr.Element
+	rInv.Inverse(&r)
+	fv := foldingCoefficients(xInv)
+	rInvPowers := powers(rInv, n)
+	for i := range fv {
+		fv[i].Mul(&fv[i], &rInvPowers[i])
+	}
+	qv := divideByXMinusZ(fv, z)
+	if _, err := res.OpeningV1.MultiExp(pk.G2A[:len(qv)], qv, ecc.MultiExpConfig{}); err != nil {
+		return TippMippProof{}, err
+	}
+	if _, err := res.OpeningV2.MultiExp(pk.G2B[:len(qv)], qv, ecc.MultiExpConfig{}); err != nil {
+		return TippMippProof{}, err
+	}
+
+	// f_w = Xⁿ ∑ᵢ (∏ xⱼ)Xⁱ
+	fw := make([]fr.Element, 2*n)
+	copy(fw[n:], foldingCoefficients(x))
+	qw := divideByXMinusZ(fw, z)
+	if _, err := res.OpeningW1.MultiExp(pk.G1A[:len(qw)], qw, ecc.MultiExpConfig{}); err != nil {
+		return TippMippProof{}, err
+	}
+	if _, err := res.OpeningW2.MultiExp(pk.G1B[:len(qw)], qw, ecc.MultiExpConfig{}); err != nil {
+		return TippMippProof{}, err
+	}
+
+	return res, nil
+}
+
+// verifyTippMipp verifies the TIPP and MIPP arguments of proof, for n proofs.
+func verifyTippMipp(fs *fiatshamir.Transcript, proof *AggregatedProof, n int, r fr.Element, vk VerifyingKey) error {
+	tm := &proof.TippMipp
+	nbRounds := log2(n)
+	for _, l := range []int{len(tm.ComABL), len(tm.ComABR), len(tm.ComCL), len(tm.ComCR), len(tm.ZABL), len(tm.ZABR), len(tm.ZCL), len(tm.ZCR)} {
+		if l != nbRounds {
+			return ErrInvalidProof
+		}
+	}
+	if err := bindZ(fs, proof); err != nil {
+		return err
+	}
+	x := make([]fr.Element, nbRounds)
+	for k := range x {
+		var err error
+		if x[k], err = deriveRoundChallenge(fs, k, tm); err != nil {
+			return err
+		}
+	}
+	xInv := fr.BatchInvert(x)
+	z, err := deriveZ(fs, tm)
+	if err != nil {
+		return err
+	}
+
+	// fold the commitments and the inner products: Com' = Com·L^{x⁻¹}·R^{x}
+	comAB, comC, zAB := proof.ComAB, proof.ComC, proof.ZAB
+	var bx, bxInv big.Int
+	var t bls12381.GT
+	fold := func(acc, l, r *bls12381.GT) {
+		acc.Mul(acc, t.CyclotomicExp(*l, &bxInv))
+		acc.Mul(acc, t.CyclotomicExp(*r, &bx))
+	}
+	for k := range x {
+		x[k].BigInt(&bx)
+		xInv[k].BigInt(&bxInv)
+		fold(&comAB.T, &tm.ComABL[k].T, &tm.ComABR[k].T)
+		fold(&comAB.U, &tm.ComABL[k].U, &tm.ComABR[k].U)
+		fold(&comC.T, &tm.ComCL[k].T, &tm.ComCR[k].T)
+		fold(&comC.U, &tm.ComCL[k].U, &tm.ComCR[k].U)
+		fold(&zAB, &tm.ZABL[k], &tm.ZABR[k])
+	}
+
+	// MIPP: Z_C + ∑ₖ (x⁻¹ₖ Z_CLₖ + xₖZ_CRₖ) = (∏ₖ (1 + x⁻¹ₖ))C
+	points := make([]bls12381.G1Affine, 0, 2*nbRounds+2)
+	scalars := make([]fr.Element, 0, 2*nbRounds+2)
+	points = append(points, proof.ZC, tm.C)
+	var one, s fr.Element
+	one.SetOne()
+	s.SetOne()
+	for k := range xInv {
+		var t fr.Element
+		t.Add(&one, &xInv[k])
+		s.Mul(&s, &t)
+	}
+	s.Neg(&s)
+	scalars = append(scalars, one, s)
+	points = append(points, tm.ZCL...)
+	scalars = append(scalars, xInv...)
+	points = append(points, tm.ZCR...)
+	scalars = append(scalars, x...)
+	var check bls12381.G1Jac
+	if _, err := check.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	if !check.Z.IsZero() {
+		return ErrVerifyAggregation
+	}
+
+	// TIPP: Z_AB = e(A, B), and the commitments to the folded vectors
+	var expected Commitment
+	if expected.T, err = pair([]bls12381.G1Affine{tm.A, tm.W1}, []bls12381.G2Affine{tm.V1, tm.B}); err != nil {
+		return err
+	}
+	if expected.U, err = pair([]bls12381.G1Affine{tm.A, tm.W2}, []bls12381.G2Affine{tm.V2, tm.B}); err != nil {
+		return err
+	}
+	if !expected.T.Equal(&comAB.T) || !expected.U.Equal(&comAB.U) {
+		return ErrVerifyAggregation
+	}
+	if expected.T, err = pair([]bls12381.G1Affine{tm.C}, []bls12381.G2Affine{tm.V1}); err != nil {
+		return err
+	}
+	if expected.U, err = pair([]bls12381.G1Affine{tm.C}, []bls12381.G2Affine{tm.V2}); err != nil {
+		return err
+	}
+	if !expected.T.Equal(&comC.T) || !expected.U.Equal(&comC.U) {
+		return ErrVerifyAggregation
+	}
+	if expected.T, err = pair([]bls12381.G1Affine{tm.A}, []bls12381.G2Affine{tm.B}); err != nil {
+		return err
+	}
+	if !expected.T.Equal(&zAB) {
+		return ErrVerifyAggregation
+	}
+
+	return verifyFoldedKeys(tm, r, z, x, xInv, vk)
+}
+
+// verifyFoldedKeys checks the KZG openings of the folded keys at z, with a
+// single pairing check:
+//
+//	e(G₁, v - [f_v(z)]G₂) = e([a]G₁ - [z]G₁, π_v)
+//	e(w - [f_w(z)]G₁, G₂) = e(π_w, [a]G₂ - [z]G₂)
+//
+// and likewise with b.
+func verifyFoldedKeys(tm *TippMippProof, r, z fr.Element, x, xInv []fr.Element, vk VerifyingKey) error {
+	nbRounds := len(x)
+
+	// zPowers[j] = z^{2ʲ}, zrPowers[j] = (z/r)^{2ʲ}
+	zPowers := make([]fr.Element, nbRounds+1)
+	zrPowers := make([]fr.Element, nbRounds)
+	zPowers[0] = z
+	var rInv fr.Element
+	rInv.Inverse(&r)
+	zrPowers[0].Mul(&z, &rInv)
+	for j := 1; j <= nbRounds; j++ {
+		zPowers[j].Square(&zPowers[j-1])
+		if j < nbRounds {
+			zrPowers[j].Square(&zrPowers[j-1])
+		}
+	}
+	var fv, fw, t, one fr.Element
+	one.SetOne()
+	fv.SetOne()
+	fw.Set(&zPowers[nbRounds]) // zⁿ
+	for j := 0; j < nbRounds; j++ {
+		t.Mul(&xInv[j], &zrPowers[nbRounds-1-j]).Add(&t, &one)
+		fv.Mul(&fv, &t)
+		t.Mul(&x[j], &zPowers[nbRounds-1-j]).Add(&t, &one)
+		fw.Mul(&fw, &t)
+	}
+
+	// random coefficients of the four checks
+	var lambda [4]fr.Element
+	for i := range lambda {
+		if _, err := lambda[i].SetRandom(); err != nil {
+			return err
+		}
+	}
+	var bz, bfv, bfw, bl big.Int
+	z.BigInt(&bz)
+	fv.BigInt(&bfv)
+	fw.BigInt(&bfw)
+
+	var zG1, fwG1 bls12381.G1Affine
+	var zG2, fvG2 bls12381.G2Affine
+	zG1.ScalarMultiplication(&vk.G1, &bz)
+	fwG1.ScalarMultiplication(&vk.G1, &bfw)
+	zG2.ScalarMultiplication(&vk.G2, &bz)
+	fvG2.ScalarMultiplication(&vk.G2, &bfv)
+
+	P := make([]bls12381.G1Affine, 8)
+	Q := make([]bls12381.G2Affine, 8)
+
+	// e(λ₀G₁, v₁ - f_v(z)G₂) e(λ₀(zG₁ - [a]G₁), π_v₁)
+	P[0].ScalarMultiplication(&vk.G1, lambda[0].BigInt(&bl))
+	Q[0].Sub(&tm.V1, &fvG2)
+	P[1].Sub(&zG1, &vk.AG1).ScalarMultiplication(&P[1], &bl)
+	Q[1] = tm.OpeningV1
+
+	// e(λ₁G₁, v₂ - f_v(z)G₂) e(λ₁(zG₁ - [b]G₁), π_v₂)
+	P[2].ScalarMultiplication(&vk.G1, lambda[1].BigInt(&bl))
+	Q[2].Sub(&tm.V2, &fvG2)
+	P[3].Sub(&zG1, &vk.BG1).ScalarMultiplication(&P[3], &bl)
+	Q[3] = tm.OpeningV2
+
+	// e(λ₂(w₁ - f_w(z)G₁), G₂) e(λ₂π_w₁, zG₂ - [a]G₂)
+	P[4].Sub(&tm.W1, &fwG1).ScalarMultiplication(&P[4], lambda[2].BigInt(&bl))
+	Q[4] = vk.G2
+	P[5].ScalarMultiplication(&tm.OpeningW1, &bl)
+	Q[5].Sub(&zG2, &vk.AG2)
+
+	// e(λ₃(w₂ - f_w(z)G₁), G₂) e(λ₃π_w₂, zG₂ - [b]G₂)
+	P[6].Sub(&tm.W2, &fwG1).ScalarMultiplication(&P[6], lambda[3].BigInt(&bl))
+	Q[6] = vk.G2
+	P[7].ScalarMultiplication(&tm.OpeningW2, &bl)
+	Q[7].Sub(&zG2, &vk.BG2)
+
+	ok, err := bls12381.PairingCheck(P, Q)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrVerifyAggregation
+	}
+	return nil
+}
+
+// foldingCoefficients returns the coefficients of ∏ⱼ (1 + cⱼX^{2ᵏ⁻¹⁻ʲ}): the
+// i-th coefficient is the product of the cⱼ for which the j-th most
+// significant bit of i is set.
+func foldingCoefficients(c []fr.Element) []fr.Element {
+	res := make([]fr.Element, 1, 1<<len(c))
+	res[0].SetOne()
+	for j := range c {
+		res = res[:2*len(res)]
+		for i := len(res)/2 - 1; i >= 0; i-- {
+			res[2*i+1].Mul(&res[i], &c[j])
+			res[2*i] = res[i]
+		}
+	}
+	return res
+}
+
+// divideByXMinusZ returns the quotient of f by X - z.
+func divideByXMinusZ(f []fr.Element, z fr.Element) []fr.Element {
+	q := make([]fr.Element, len(f)-1)
+	q[len(q)-1] = f[len(f)-1]
+	for i := len(q) - 1; i > 0; i-- {
+		q[i-1].Mul(&q[i], &z).Add(&q[i-1], &f[i])
+	}
+	return q
+}
+
+// foldG1 returns p_lo + xp_hi.
+func foldG1(p []bls12381.G1Affine, x fr.Element) []bls12381.G1Affine {
+	n := len(p) / 2
+	var bx big.Int
+	x.BigInt(&bx)
+	res := make([]bls12381.G1Jac, n)
+	parallel.Execute(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].FromAffine(&p[n+i])
+			res[i].ScalarMultiplication(&res[i], &bx).AddMixed(&p[i])
+		}
+	})
+	return bls12381.BatchJacobianToAffineG1(res)
+}
+
+// foldG2 returns p_lo + xp_hi.
+func foldG2(p []bls12381.G2Affine, x fr.Element) []bls12381.G2Affine {
+	n := len(p) / 2
+	var bx big.Int
+	x.BigInt(&bx)
+	res := make([]bls12381.G2Affine, n)
+	parallel.Execute(n, func(start, end int) {
+		var t bls12381.G2Jac
+		for i := start; i < end; i++ {
+			t.FromAffine(&p[n+i])
+			t.ScalarMultiplication(&t, &bx).AddMixed(&p[i])
+			res[i].FromJacobian(&t)
+		}
+	})
+	return res
+}
+
+func concat[T any](a, b []T) []T {
+	res := make([]T, 0, len(a)+len(b))
+	res = append(res, a...)
+	return append(res, b...)
+}
+
+// log2 returns log₂(n) for a power of 2.
+func log2(n int) int {
+	return bits.TrailingZeros(uint(n))
+}
+
+// bindZ binds the inner products to the first round challenge.
+func bindZ(fs *fiatshamir.Transcript, proof *AggregatedProof) error {
+	if err := bindGT(fs, "x0", &proof.ZAB); err != nil {
+		return err
+	}
+	return fs.Bind("x0", proof.ZC.Marshal())
+}
+
+// deriveRoundChallenge derives the challenge of the k-th round, binded to the
+// cross terms.
+func deriveRoundChallenge(fs *fiatshamir.Transcript, k int, proof *TippMippProof) (fr.Element, error) {
+	name := "x" + strconv.Itoa(k)
+	if err := bindGT(fs, name,
+		&proof.ComABL[k].T, &proof.ComABL[k].U, &proof.ComABR[k].T, &proof.ComABR[k].U,
+		&proof.ComCL[k].T, &proof.ComCL[k].U, &proof.ComCR[k].T, &proof.ComCR[k].U,
+		&proof.ZABL[k], &proof.ZABR[k]); err != nil {
+		return fr.Element{}, err
+	}
+	if err := fs.Bind(name, proof.ZCL[k].Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+	if err := fs.Bind(name, proof.ZCR[k].Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+	return computeChallenge(fs, name)
+}
+
+// deriveZ derives the opening point of the folded keys, binded to the folded
+// vectors and keys.
+func deriveZ(fs *fiatshamir.Transcript, proof *TippMippProof) (fr.Element, error) {
+	for _, b := range [][]byte{
+		proof.A.Marshal(), proof.B.Marshal(), proof.C.Marshal(),
+		proof.V1.Marshal(), proof.V2.Marshal(), proof.W1.Marshal(), proof.W2.Marshal(),
+	} {
+		if err := fs.Bind("z", b); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	return computeChallenge(fs, "z")
+}
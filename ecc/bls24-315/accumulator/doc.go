@@ -0,0 +1,20 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package accumulator provides a bilinear-map accumulator of a set of field elements, cf https://eprint.iacr.org/2005/123.pdf
+//
+// The set S is accumulated as the KZG commitment [f_S(τ)]G₁ to its
+// characteristic polynomial f_S(X) = ∏_{s∈S}(X - s).
+//
+// A membership witness of y is the commitment to f_S(X)/(X - y), and a
+// non-membership witness is given by the Bézout coefficients a and b such that
+// a(X)f_S(X) + b(X)(X - y) = 1, which exist if and only if y ∉ S. Both extend
+// to batches of elements Y, replacing X - y with f_Y(X) = ∏_{y∈Y}(X - y), and
+// are verified with a pairing check.
+//
+// Witnesses of single elements can be updated after elements are added to or
+// deleted from the set, using the public Update published by the manager of
+// the accumulator, without the knowledge of the set.
+package accumulator
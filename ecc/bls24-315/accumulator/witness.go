@@ -0,0 +1,140 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package accumulator
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+// A witness for y is updated after a batch X of elements is added to or
+// deleted from the set S, with f_X(X) = f_X(y) + (X - y)g(X):
+//
+//   - addition, f_S' = f_S f_X: f_S'/(X - y) = f_X(y)·f_S/(X - y) + f_S·g
+//   - deletion, f_S = f_S' f_X: f_S/(X - y) = f_X(y)·f_S'/(X - y) + f_S'·g
+//
+// so that the witnesses only need the commitments [τⁱf(τ)]G₁ for i < |X|,
+// where f is the characteristic polynomial of the smaller set. The same
+// decomposition applies to the Bézout coefficients of non-membership
+// witnesses.
+
+// Update change of the accumulated set, by a batch of additions or a batch of
+// deletions, allowing to update the witnesses.
+//
+// implements io.ReaderFrom and io.WriterTo
+type Update struct {
+	// Added, Deleted elements added to or deleted from the set, one of them
+	// being empty
+	Added, Deleted []fr.Element
+
+	// U [τⁱf(τ)]G₁ for i < the number of elements, where f is the
+	// characteristic polynomial of the set before the additions or after the
+	// deletions
+	U []bls24315.G1Affine
+}
+
+// Value returns the value of the accumulator after a deletion. The value after
+// an addition can't be computed from the Update.
+func (u *Update) Value() (bls24315.G1Affine, error) {
+	if len(u.Deleted) == 0 || len(u.U) != len(u.Deleted) {
+		return bls24315.G1Affine{}, ErrInvalidUpdate
+	}
+	return u.U[0], nil
+}
+
+// Update updates the witness after the change of the set u. It returns
+// ErrElementNotInSet if w.Element was deleted.
+func (w *MembershipWitness) Update(u *Update) error {
+	fXy, gU, deletion, err := u.decompose(w.Element)
+	if err != nil {
+		return err
+	}
+	if fXy.IsZero() {
+		if deletion {
+			return ErrElementNotInSet
+		}
+		return ErrInvalidUpdate
+	}
+
+	var b big.Int
+	var wJac bls24315.G1Jac
+	wJac.FromAffine(&w.W)
+	if deletion {
+		// W' = (W - ∑ᵢgᵢU'ᵢ)/f_X(y)
+		var fXyInv fr.Element
+		fXyInv.Inverse(&fXy)
+		wJac.SubAssign(&gU)
+		wJac.ScalarMultiplication(&wJac, fXyInv.BigInt(&b))
+	} else {
+		// W' = f_X(y)W + ∑ᵢgᵢUᵢ
+		wJac.ScalarMultiplication(&wJac, fXy.BigInt(&b))
+		wJac.AddAssign(&gU)
+	}
+	w.W.FromJacobian(&wJac)
+	return nil
+}
+
+// Update updates the witness after the change of the set u. It returns
+// ErrElementInSet if w.Element was added.
+func (w *NonMembershipWitness) Update(u *Update) error {
+	fXy, gU, deletion, err := u.decompose(w.Element)
+	if err != nil {
+		return err
+	}
+	if fXy.IsZero() {
+		if deletion {
+			return ErrInvalidUpdate
+		}
+		return ErrElementInSet
+	}
+
+	var b big.Int
+	if deletion {
+		// a' = af_X(y), B' = B + a∑ᵢgᵢU'ᵢ
+		gU.ScalarMultiplication(&gU, w.A.BigInt(&b))
+		w.A.Mul(&w.A, &fXy)
+	} else {
+		// a' = a/f_X(y), B' = B - a'∑ᵢgᵢUᵢ
+		var fXyInv fr.Element
+		fXyInv.Inverse(&fXy)
+		w.A.Mul(&w.A, &fXyInv)
+		gU.ScalarMultiplication(&gU, w.A.BigInt(&b))
+		gU.Neg(&gU)
+	}
+	var bJac bls24315.G1Jac
+	bJac.FromAffine(&w.B)
+	bJac.AddAssign(&gU)
+	w.B.FromJacobian(&bJac)
+	return nil
+}
+
+// decompose returns f_X(y) and ∑ᵢgᵢUᵢ where f_X(X) = f_X(y) + (X - y)g(X), X
+// being the added or deleted elements.
+func (u *Update) decompose(y fr.Element) (fXy fr.Element, gU bls24315.G1Jac, deletion bool, err error) {
+	elements := u.Added
+	if len(u.Deleted) > 0 {
+		if len(u.Added) > 0 {
+			return fXy, gU, false, ErrInvalidUpdate
+		}
+		elements, deletion = u.Deleted, true
+	}
+	if len(u.U) != len(elements) {
+		return fXy, gU, false, ErrInvalidUpdate
+	}
+	if len(elements) == 0 {
+		fXy.SetOne()
+		return fXy, gU, deletion, nil
+	}
+
+	g, fXy := divideByLinear(characteristicPolynomial(elements), y)
+	if _, err := gU.MultiExp(u.U, g, ecc.MultiExpConfig{}); err != nil {
+		return fXy, gU, false, err
+	}
+	return fXy, gU, deletion, nil
+}
@@ -0,0 +1,16 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package bulletproofs provides Bulletproofs range proofs on G1, cf https://eprint.iacr.org/2017/1066.pdf
+//
+// A value v is committed to with a Pedersen commitment V = vG + γH, and a range
+// proof shows that v ∈ [0, 2⁶⁴) without revealing it. Several values can be
+// proven at once with an aggregated proof, whose size grows logarithmically in
+// the number of values, and many proofs can be verified together with a
+// single multi-scalar multiplication.
+//
+// All the generators are obtained by hashing to G1, so that there is no
+// trusted setup.
+package bulletproofs
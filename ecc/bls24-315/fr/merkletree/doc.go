@@ -0,0 +1,17 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package merkletree provides Merkle trees of field elements, for a pluggable
+// 2-to-1 compression function such as the Poseidon2 permutation or MiMC in
+// Miyaguchi–Preneel mode.
+//
+// The nodes are digests of DigestSize field elements. A tree has arity 2, 4, 8
+// or 16, the digest of an internal node being the compression of its children
+// chained from left to right. The leaves are padded with zero digests to the
+// next power of the arity.
+//
+// Multi-proofs open several leaves at once: the siblings shared by the paths
+// of the opened leaves, or computed from the opened leaves, are not included.
+package merkletree
@@ -0,0 +1,267 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package hyperkzg
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr/polynomial"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/kzg"
+	"github.com/stretchr/testify/require"
+)
+
+// Test SRS re-used across tests of the HyperKZG scheme, for polynomials of at
+// most 7 variables.
+const testSrsSize = 256
+
+var testSrs *kzg.SRS
+
+func init() {
+	var alpha fr.Element
+	alpha.SetRandom()
+	var err error
+	testSrs, err = kzg.NewSRS(testSrsSize, alpha.BigInt(new(big.Int)))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func randomPoint(nbVariables int) []fr.Element {
+	res := make([]fr.Element, nbVariables)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+// commitRandom returns nbPolynomials random multilinear polynomials in
+// nbVariables variables, and their commitments with pk.
+func commitRandom(t *testing.T, nbPolynomials, nbVariables int, pk kzg.ProvingKey) ([]polynomial.MultiLin, []kzg.Digest) {
+	ms := make([]polynomial.MultiLin, nbPolynomials)
+	digests := make([]kzg.Digest, nbPolynomials)
+	for i := range ms {
+		ms[i] = polynomial.MultiLin(randomPoint(1 << nbVariables))
+		var err error
+		digests[i], err = Commit(ms[i], pk)
+		require.NoError(t, err)
+	}
+	return ms, digests
+}
+
+func TestOpen(t *testing.T) {
+	assert := require.New(t)
+
+	for _, n := range []int{1, 2, 5, 7} {
+		ms, digests := commitRandom(t, 1, n, testSrs.Pk)
+		m, digest := ms[0], digests[0]
+		point := randomPoint(n)
+
+		proof, err := Open(m, digest, point, sha256.New(), testSrs.Pk)
+		assert.NoError(err)
+		assert.Equal(m.Evaluate(point, nil), proof.ClaimedValue)
+		assert.Len(proof.Folded, n-1)
+
+		assert.NoError(Verify(digest, &proof, point, sha256.New(), testSrs.Vk))
+
+		// wrong claimed value
+		proof.ClaimedValue.SetRandom()
+		assert.Error(Verify(digest, &proof, point, sha256.New(), testSrs.Vk))
+		proof.ClaimedValue = m.Evaluate(point, nil)
+
+		// wrong point
+		point[0].SetRandom()
+		assert.Error(Verify(digest, &proof, point, sha256.New(), testSrs.Vk))
+	}
+}
+
+func TestOpenTampered(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 4
+	ms, digests := commitRandom(t, 2, n, testSrs.Pk)
+	point := randomPoint(n)
+	proof, err := Open(ms[0], digests[0], point, sha256.New(), testSrs.Pk)
+	assert.NoError(err)
+
+	// wrong folded commitment
+	save := proof.Folded[1]
+	proof.Folded[1] = proof.Folded[0]
+	assert.Error(Verify(digests[0], &proof, point, sha256.New(), testSrs.Vk))
+	proof.Folded[1] = save
+
+	// wrong evaluation of a folded polynomial
+	proof.Openings.ClaimedValues[2][1].SetRandom()
+	assert.Error(Verify(digests[0], &proof, point, sha256.New(), testSrs.Vk))
+
+	// malformed proof
+	proof.Folded = proof.Folded[:1]
+	assert.ErrorIs(Verify(digests[0], &proof, point, sha256.New(), testSrs.Vk), ErrInvalidOpeningProof)
+
+	// wrong digest
+	proof, err = Open(ms[0], digests[0], point, sha256.New(), testSrs.Pk)
+	assert.NoError(err)
+	assert.Error(Verify(digests[1], &proof, point, sha256.New(), testSrs.Vk))
+
+	// extra data in the transcript
+	assert.Error(Verify(digests[0], &proof, point, sha256.New(), testSrs.Vk, []byte("data")))
+}
+
+func TestSRSSize(t *testing.T) {
+	assert := require.New(t)
+
+	// the openings need 2ⁿ+3n-2 points: 7 variables is the maximum for the
+	// test SRS, and a polynomial as large as the SRS can't be opened
+	const n = 7
+	ms, digests := commitRandom(t, 1, n, testSrs.Pk)
+	point := randomPoint(n)
+	proof, err := Open(ms[0], digests[0], point, sha256.New(), testSrs.Pk)
+	assert.NoError(err)
+	assert.NoError(Verify(digests[0], &proof, point, sha256.New(), testSrs.Vk))
+
+	m := polynomial.MultiLin(randomPoint(testSrsSize))
+	_, err = Commit(m, testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	_, err = Open(m, digests[0], randomPoint(n+1), sha256.New(), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+
+	// sizes which are not a power of 2, or smaller than 2
+	for _, size := range []int{0, 1, 3, 6} {
+		_, err = Commit(make(polynomial.MultiLin, size), testSrs.Pk)
+		assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	}
+
+	// a truncated ProvingKey of exactly 2⁴+3·4-2 points can be used for 4
+	// variables, and the VerifyingKey does not depend on the truncation
+	truncated := kzg.ProvingKey{G1: testSrs.Pk.G1[:26]}
+	ms, digests = commitRandom(t, 2, 4, truncated)
+	point = randomPoint(4)
+	proof, err = Open(ms[0], digests[0], point, sha256.New(), truncated)
+	assert.NoError(err)
+	assert.NoError(Verify(digests[0], &proof, point, sha256.New(), testSrs.Vk))
+	batchProof, err := BatchOpen(ms, digests, point, sha256.New(), truncated)
+	assert.NoError(err)
+	assert.NoError(BatchVerify(digests, &batchProof, point, sha256.New(), testSrs.Vk))
+	expected, err := Commit(ms[0], testSrs.Pk)
+	assert.NoError(err)
+	assert.Equal(expected, digests[0])
+
+	// one point less
+	truncated.G1 = truncated.G1[:25]
+	_, err = Commit(ms[0], truncated)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	_, err = BatchOpen(ms, digests, point, sha256.New(), truncated)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+
+	// wrong number of coordinates
+	_, err = Open(ms[0], digests[0], randomPoint(3), sha256.New(), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidNbVariables)
+}
+
+func TestBatchOpen(t *testing.T) {
+	assert := require.New(t)
+
+	const n, nbPolynomials = 5, 4
+	ms, digests := commitRandom(t, nbPolynomials, n, testSrs.Pk)
+	point := randomPoint(n)
+
+	proof, err := BatchOpen(ms, digests, point, sha256.New(), testSrs.Pk)
+	assert.NoError(err)
+	for i := range ms {
+		assert.Equal(ms[i].Evaluate(point, nil), proof.ClaimedValues[i])
+	}
+	assert.NoError(BatchVerify(digests, &proof, point, sha256.New(), testSrs.Vk))
+
+	// wrong claimed value
+	proof.ClaimedValues[1].SetRandom()
+	assert.Error(BatchVerify(digests, &proof, point, sha256.New(), testSrs.Vk))
+	proof.ClaimedValues[1] = ms[1].Evaluate(point, nil)
+
+	// swapped claimed values
+	proof.ClaimedValues[0], proof.ClaimedValues[1] = proof.ClaimedValues[1], proof.ClaimedValues[0]
+	assert.Error(BatchVerify(digests, &proof, point, sha256.New(), testSrs.Vk))
+	proof.ClaimedValues[0], proof.ClaimedValues[1] = proof.ClaimedValues[1], proof.ClaimedValues[0]
+
+	// swapped digests
+	digests[0], digests[1] = digests[1], digests[0]
+	assert.Error(BatchVerify(digests, &proof, point, sha256.New(), testSrs.Vk))
+	digests[0], digests[1] = digests[1], digests[0]
+
+	// a digest missing, or no digest at all
+	assert.ErrorIs(BatchVerify(digests[1:], &proof, point, sha256.New(), testSrs.Vk), ErrInvalidNbDigests)
+	_, err = BatchOpen(ms, digests[1:], point, sha256.New(), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidNbDigests)
+	_, err = BatchOpen(nil, nil, point, sha256.New(), testSrs.Pk)
+	assert.ErrorIs(err, ErrZeroNbDigests)
+	assert.ErrorIs(BatchVerify(nil, &BatchOpeningProof{}, point, sha256.New(), testSrs.Vk), ErrZeroNbDigests)
+
+	// polynomials with different numbers of variables
+	smaller, smallerDigests := commitRandom(t, 1, n-1, testSrs.Pk)
+	_, err = BatchOpen(append(ms[:1:1], smaller...), append(digests[:1:1], smallerDigests...), point, sha256.New(), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	_, err = BatchOpen(ms, digests, point[1:], sha256.New(), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidNbVariables)
+}
+
+func TestBatchOpenSingle(t *testing.T) {
+	assert := require.New(t)
+
+	// a batch of one polynomial is a regular opening with a claimed value
+	const n = 6
+	ms, digests := commitRandom(t, 1, n, testSrs.Pk)
+	point := randomPoint(n)
+
+	proof, err := BatchOpen(ms, digests, point, sha256.New(), testSrs.Pk)
+	assert.NoError(err)
+	assert.Equal(proof.ClaimedValues[0], proof.ClaimedValue)
+	assert.NoError(BatchVerify(digests, &proof, point, sha256.New(), testSrs.Vk))
+	assert.NoError(Verify(digests[0], &proof.OpeningProof, point, sha256.New(), testSrs.Vk))
+
+	// the claimed values must agree with the folded opening
+	proof.ClaimedValues[0].SetRandom()
+	assert.ErrorIs(BatchVerify(digests, &proof, point, sha256.New(), testSrs.Vk), ErrVerifyOpeningProof)
+}
+
+func TestSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 4
+	ms, digests := commitRandom(t, 2, n, testSrs.Pk)
+	proof, err := BatchOpen(ms, digests, randomPoint(n), sha256.New(), testSrs.Pk)
+	assert.NoError(err)
+
+	var buf bytes.Buffer
+	written, err := proof.WriteTo(&buf)
+	assert.NoError(err)
+	assert.Equal(int64(buf.Len()), written)
+
+	var decoded BatchOpeningProof
+	read, err := decoded.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(proof, decoded)
+}
+
+func BenchmarkBatchOpen(b *testing.B) {
+	const n, nbPolynomials = 7, 8
+	ms := make([]polynomial.MultiLin, nbPolynomials)
+	digests := make([]kzg.Digest, nbPolynomials)
+	for i := range ms {
+		ms[i] = polynomial.MultiLin(randomPoint(1 << n))
+		var err error
+		if digests[i], err = Commit(ms[i], testSrs.Pk); err != nil {
+			b.Fatal(err)
+		}
+	}
+	point := randomPoint(n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = BatchOpen(ms, digests, point, sha256.New(), testSrs.Pk)
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// HidingBound is the number of openings at distinct points of a hiding
+// commitment which reveal nothing about the committed polynomial, for a SRS
+// created by NewSRS. The blinding polynomials are of degree HidingBound.
+const HidingBound = 2
+
+var ErrNotHiding = errors.New("the SRS does not support hiding commitments")
+
+// The hiding variant of KZG commits to a polynomial f with a random blinding
+// polynomial r of degree len(ProvingKey.Gamma)-1:
+//
+//	C = [f(α)]G₁ + [γr(α)]G₁
+//
+// The opening at a proves f(a) = v by revealing the blinded value r(a) = ṽ
+// and the combined witness H = [(f-v)/(X-a)(α)]G₁ + [γ(r-ṽ)/(X-a)(α)]G₁, and
+// the verifier checks that H is an opening proof of C-[ṽ][γ]G₁ at a.
+
+// HidingOpeningProof KZG proof for opening a hiding commitment at a single point.
+//
+// implements io.ReaderFrom and io.WriterTo
+type HidingOpeningProof struct {
+	// H quotient polynomial (f - f(z))/(x-z) + γ(r - r(z))/(x-z)
+	H bls24315.G1Affine
+
+	// ClaimedValue purported value
+	ClaimedValue fr.Element
+
+	// BlindedValue evaluation r(z) of the blinding polynomial
+	BlindedValue fr.Element
+}
+
+// HidingBatchOpeningProof opening proof for many hiding commitments at the same point
+//
+// implements io.ReaderFrom and io.WriterTo
+type HidingBatchOpeningProof struct {
+	// H quotient polynomial Sum_i gamma**i*((f - f(z))/(x-z) + γ(r - r(z))/(x-z))
+	H bls24315.G1Affine
+
+	// ClaimedValues purported values
+	ClaimedValues []fr.Element
+
+	// BlindedValues evaluations of the blinding polynomials
+	BlindedValues []fr.Element
+}
+
+// setGamma sets the blinding generators of the SRS to [γαⁱ]G₁ for i ≤ HidingBound
+func (srs *SRS) setGamma(alpha fr.Element, bGamma *big.Int) {
+	_, _, gen1Aff, _ := bls24315.Generators()
+	gammas := make([]fr.Element, HidingBound+1)
+	gammas[0].SetBigInt(bGamma)
+	for i := 1; i < len(gammas); i++ {
+		gammas[i].Mul(&gammas[i-1], &alpha)
+	}
+	srs.Pk.Gamma = bls24315.BatchScalarMultiplicationG1(&gen1Aff, gammas)
+	srs.Vk.Gamma = srs.Pk.Gamma[0]
+}
+
+// CommitHiding commits to a polynomial with a random blinding polynomial, which
+// is returned to open the commitment. The polynomial is assumed to be in
+// canonical form, in Montgomery form.
+func CommitHiding(p []fr.Element, pk ProvingKey, nbTasks ...int) (Digest, []fr.Element, error) {
+	if len(pk.Gamma) == 0 {
+		return Digest{}, nil, ErrNotHiding
+	}
+	blinding := make([]fr.Element, len(pk.Gamma))
+	for i := range blinding {
+		if _, err := blinding[i].SetRandom(); err != nil {
+			return Digest{}, nil, err
+		}
+	}
+	digest, err := CommitWithBlinding(p, blinding, pk, nbTasks...)
+	if err != nil {
+		return Digest{}, nil, err
+	}
+	return digest, blinding, nil
+}
+
+// CommitWithBlinding commits to a polynomial with the given blinding polynomial,
+// of size at most len(pk.Gamma).
+func CommitWithBlinding(p, blinding []fr.Element, pk ProvingKey, nbTasks ...int) (Digest, error) {
+	if len(blinding) == 0 || len(blinding) > len(pk.Gamma) {
+		return Digest{}, ErrNotHiding
+	}
+
+	res, err := Commit(p, pk, nbTasks...)
+	if err != nil {
+		return Digest{}, err
+	}
+	var blind bls24315.G1Affine
+	if _, err = blind.MultiExp(pk.Gamma[:len(blinding)], blinding, ecc.MultiExpConfig{}); err != nil {
+		return Digest{}, err
+	}
+	res.Add(&res, &blind)
+
+	return res, nil
+}
+
+// OpenHiding computes an opening proof at point of a polynomial committed
+// with the blinding polynomial blinding.
+func OpenHiding(p, blinding []fr.Element, point fr.Element, pk ProvingKey) (HidingOpeningProof, error) {
+	if len(blinding) == 0 || len(blinding) > len(pk.Gamma) {
+		return HidingOpeningProof{}, ErrNotHiding
+	}
+	proof, err := Open(p, point, pk)
+	if err != nil {
+		return HidingOpeningProof{}, err
+	}
+
+	res := HidingOpeningProof{
+		H:            proof.H,
+		ClaimedValue: proof.ClaimedValue,
+		BlindedValue: eval(blinding, point),
+	}
+	witness, err := blindingWitness(blinding, res.BlindedValue, point, pk)
+	if err != nil {
+		return HidingOpeningProof{}, err
+	}
+	res.H.Add(&res.H, &witness)
+	return res, nil
+}
+
+// VerifyHiding verifies a KZG opening proof of a hiding commitment at a single point
+func VerifyHiding(commitment *Digest, proof *HidingOpeningProof, point fr.Element, vk VerifyingKey) error {
+	if vk.Gamma.IsInfinity() {
+		return ErrNotHiding
+	}
+
+	// C - [ṽ][γ]G₁ is a regular commitment to f, opened by H
+	var unblinded Digest
+	var bBlindedValue big.Int
+	proof.BlindedValue.BigInt(&bBlindedValue)
+	unblinded.ScalarMultiplication(&vk.Gamma, &bBlindedValue)
+	unblinded.Sub(commitment, &unblinded)
+
+	return Verify(&unblinded, &OpeningProof{H: proof.H, ClaimedValue: proof.ClaimedValue}, point, vk)
+}
+
+// BatchOpenSinglePointHiding creates a batch opening proof at point of a list of
+// polynomials committed with the blinding polynomials blindings. It is the
+// hiding counterpart of BatchOpenSinglePoint.
+//
+// * digests is the list of committed polynomials to open, need to derive the challenge using Fiat Shamir.
+// * dataTranscript extra data that might be needed to derive the challenge used for folding
+func BatchOpenSinglePointHiding(polynomials, blindings [][]fr.Element, digests []Digest, point fr.Element, hf hash.Hash, pk ProvingKey, dataTranscript ...[]byte) (HidingBatchOpeningProof, error) {
+
+	nbDigests := len(digests)
+	if nbDigests != len(polynomials) || nbDigests != len(blindings) {
+		return HidingBatchOpeningProof{}, ErrInvalidNbDigests
+	}
+	if nbDigests == 0 {
+		return HidingBatchOpeningProof{}, ErrZeroNbDigests
+	}
+	largestPoly, largestBlinding := 0, 0
+	for i := range polynomials {
+		if len(polynomials[i]) == 0 || len(polynomials[i]) > len(pk.G1) {
+			return HidingBatchOpeningProof{}, ErrInvalidPolynomialSize
+		}
+		if len(blindings[i]) == 0 || len(blindings[i]) > len(pk.Gamma) {
+			return HidingBatchOpeningProof{}, ErrNotHiding
+		}
+		largestPoly = max(largestPoly, len(polynomials[i]))
+		largestBlinding = max(largestBlinding, len(blindings[i]))
+	}
+
+	// compute the purported values and the blinded values
+	res := HidingBatchOpeningProof{
+		ClaimedValues: make([]fr.Element, nbDigests),
+		BlindedValues: make([]fr.Element, nbDigests),
+	}
+	parallel.Execute(nbDigests, func(start, end int) {
+		for i := start; i < end; i++ {
+			res.ClaimedValues[i] = eval(polynomials[i], point)
+			res.BlindedValues[i] = eval(blindings[i], point)
+		}
+	})
+
+	// derive the challenge γ, binded to the point, the commitments and all the values
+	gamma, err := deriveGamma(point, digests, append(res.ClaimedValues[:nbDigests:nbDigests], res.BlindedValues...), hf, dataTranscript...)
+	if err != nil {
+		return HidingBatchOpeningProof{}, err
+	}
+	gammas := make([]fr.Element, nbDigests)
+	gammas[0].SetOne()
+	for i := 1; i < nbDigests; i++ {
+		gammas[i].Mul(&gammas[i-1], &gamma)
+	}
+
+	// compute ∑ᵢγⁱfᵢ and ∑ᵢγⁱrᵢ, and their values at the point
+	foldedPolynomial := foldPolynomials(polynomials, gammas, largestPoly)
+	foldedBlinding := foldPolynomials(blindings, gammas, largestBlinding)
+	var foldedValue, foldedBlindedValue, t fr.Element
+	for i := 0; i < nbDigests; i++ {
+		t.Mul(&res.ClaimedValues[i], &gammas[i])
+		foldedValue.Add(&foldedValue, &t)
+		t.Mul(&res.BlindedValues[i], &gammas[i])
+		foldedBlindedValue.Add(&foldedBlindedValue, &t)
+	}
+
+	// compute H
+	h := dividePolyByXminusA(foldedPolynomial, foldedValue, point)
+	if res.H, err = Commit(h, pk); err != nil {
+		return HidingBatchOpeningProof{}, err
+	}
+	witness, err := blindingWitness(foldedBlinding, foldedBlindedValue, point, pk)
+	if err != nil {
+		return HidingBatchOpeningProof{}, err
+	}
+	res.H.Add(&res.H, &witness)
+
+	return res, nil
+}
+
+// BatchVerifySinglePointHiding verifies a batched opening proof at a single
+// point of a list of hiding commitments.
+//
+// * digests list of digests on which opening proof is done
+// * batchOpeningProof proof of correct opening on the digests
+// * dataTranscript extra data that might be needed to derive the challenge used for the folding
+func BatchVerifySinglePointHiding(digests []Digest, batchOpeningProof *HidingBatchOpeningProof, point fr.Element, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+
+	nbDigests := len(digests)
+	if nbDigests != len(batchOpeningProof.ClaimedValues) || nbDigests != len(batchOpeningProof.BlindedValues) {
+		return ErrInvalidNbDigests
+	}
+	if nbDigests == 0 {
+		return ErrZeroNbDigests
+	}
+
+	values := append(batchOpeningProof.ClaimedValues[:nbDigests:nbDigests], batchOpeningProof.BlindedValues...)
+	gamma, err := deriveGamma(point, digests, values, hf, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	gammas := make([]fr.Element, nbDigests)
+	gammas[0].SetOne()
+	for i := 1; i < nbDigests; i++ {
+		gammas[i].Mul(&gammas[i-1], &gamma)
+	}
+
+	// fold the digests, the claimed values and the blinded values
+	foldedDigest, foldedValue, err := fold(digests, batchOpeningProof.ClaimedValues, gammas)
+	if err != nil {
+		return err
+	}
+	var foldedBlindedValue, t fr.Element
+	for i := 0; i < nbDigests; i++ {
+		t.Mul(&batchOpeningProof.BlindedValues[i], &gammas[i])
+		foldedBlindedValue.Add(&foldedBlindedValue, &t)
+	}
+
+	foldedProof := HidingOpeningProof{
+		H:            batchOpeningProof.H,
+		ClaimedValue: foldedValue,
+		BlindedValue: foldedBlindedValue,
+	}
+	return VerifyHiding(&foldedDigest, &foldedProof, point, vk)
+}
+
+// blindingWitness returns [γ(r-r(a))/(X-a)(α)]G₁
+func blindingWitness(blinding []fr.Element, blindedValue, point fr.Element, pk ProvingKey) (bls24315.G1Affine, error) {
+	var res bls24315.G1Affine
+	if len(blinding) < 2 {
+		// the quotient is zero
+		return res, nil
+	}
+	_blinding := make([]fr.Element, len(blinding))
+	copy(_blinding, blinding)
+	w := dividePolyByXminusA(_blinding, blindedValue, point)
+
+	if _, err := res.MultiExp(pk.Gamma[:len(w)], w, ecc.MultiExpConfig{}); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// foldPolynomials returns ∑ᵢcᵢpᵢ
+func foldPolynomials(polynomials [][]fr.Element, c []fr.Element, size int) []fr.Element {
+	res := make([]fr.Element, size)
+	parallel.Execute(size, func(start, end int) {
+		var t fr.Element
+		for i := range polynomials {
+			for j := start; j < min(end, len(polynomials[i])); j++ {
+				t.Mul(&polynomials[i][j], &c[i])
+				res[j].Add(&res[j], &t)
+			}
+		}
+	})
+	return res
+}
@@ -0,0 +1,336 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var ErrInvalidDomainSize = errors.New("invalid domain size (not a power of 2 or larger than SRS)")
+
+// LagrangeProvingKey is the Lagrange form of a ProvingKey on the set
+// {g, gω, ..., gωⁿ⁻¹}, where ω is a primitive n-th root of unity and g is the
+// coset shift (1 for the subgroup itself).
+//
+// It is used to commit to and open polynomials given by their evaluations on
+// that set, without interpolating them. The opening proofs are regular
+// OpeningProof and BatchOpeningProof, verified with the VerifyingKey of the SRS.
+type LagrangeProvingKey struct {
+	G1         []bls24315.G1Affine // [L₀(τ)]G₁, [L₁(τ)]G₁, ..., [Lₙ₋₁(τ)]G₁
+	CosetShift fr.Element
+
+	points []fr.Element // gωⁱ
+}
+
+// NewLagrangeProvingKey computes the Lagrange form of pk on the subgroup of
+// size size, or on the coset cosetShift·<ω> if cosetShift is provided.
+// size must be a power of 2 smaller than len(pk.G1).
+func NewLagrangeProvingKey(pk ProvingKey, size uint64, cosetShift ...fr.Element) (*LagrangeProvingKey, error) {
+	if size == 0 || bits.OnesCount64(size) != 1 || size > uint64(len(pk.G1)) {
+		return nil, ErrInvalidDomainSize
+	}
+	omega, err := fr.Generator(size)
+	if err != nil {
+		return nil, err
+	}
+
+	res := LagrangeProvingKey{points: make([]fr.Element, size)}
+	res.CosetShift.SetOne()
+	if len(cosetShift) > 0 {
+		res.CosetShift = cosetShift[0]
+	}
+	if res.CosetShift.IsZero() {
+		return nil, errors.New("the coset shift must be non zero")
+	}
+
+	res.points[0] = res.CosetShift
+	for i := 1; i < len(res.points); i++ {
+		res.points[i].Mul(&res.points[i-1], &omega)
+	}
+
+	// Lᵢ(X/g) is the i-th Lagrange polynomial on the coset, so if
+	// Lᵢ = ∑ⱼcⱼXʲ, its coefficients on the coset are cⱼg⁻ʲ. We then compute
+	// the Lagrange form of [g⁻ʲτʲ]G₁.
+	g1 := pk.G1[:size]
+	if !res.CosetShift.IsOne() {
+		var shiftInv fr.Element
+		shiftInv.Inverse(&res.CosetShift)
+		scaled := make([]bls24315.G1Jac, size)
+		parallel.Execute(len(scaled), func(start, end int) {
+			var s fr.Element
+			var sBigInt big.Int
+			s.Exp(shiftInv, big.NewInt(int64(start)))
+			for j := start; j < end; j++ {
+				s.BigInt(&sBigInt)
+				scaled[j].FromAffine(&g1[j])
+				scaled[j].ScalarMultiplication(&scaled[j], &sBigInt)
+				s.Mul(&s, &shiftInv)
+			}
+		})
+		g1 = bls24315.BatchJacobianToAffineG1(scaled)
+	}
+
+	if res.G1, err = ToLagrangeG1(g1); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// LagrangeCache computes the Lagrange forms of a ProvingKey on demand, and
+// caches them per domain size and coset shift. It is safe for concurrent use.
+type LagrangeCache struct {
+	pk   ProvingKey
+	lock sync.Mutex
+	keys map[lagrangeCacheKey]*LagrangeProvingKey
+}
+
+type lagrangeCacheKey struct {
+	size       uint64
+	cosetShift fr.Element
+}
+
+// NewLagrangeCache returns a cache of the Lagrange forms of pk.
+func NewLagrangeCache(pk ProvingKey) *LagrangeCache {
+	return &LagrangeCache{
+		pk:   pk,
+		keys: make(map[lagrangeCacheKey]*LagrangeProvingKey),
+	}
+}
+
+// Get returns the Lagrange form of the ProvingKey on the subgroup of size
+// size, or on the coset cosetShift·<ω> if cosetShift is provided. It is
+// computed on the first call, see NewLagrangeProvingKey.
+func (c *LagrangeCache) Get(size uint64, cosetShift ...fr.Element) (*LagrangeProvingKey, error) {
+	key := lagrangeCacheKey{size: size}
+	key.cosetShift.SetOne()
+	if len(cosetShift) > 0 {
+		key.cosetShift = cosetShift[0]
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if res, ok := c.keys[key]; ok {
+		return res, nil
+	}
+	res, err := NewLagrangeProvingKey(c.pk, size, key.cosetShift)
+	if err != nil {
+		return nil, err
+	}
+	c.keys[key] = res
+	return res, nil
+}
+
+// CommitLagrange commits to the polynomial whose evaluations on the domain of
+// pk are evaluations, using a multi exponentiation with the Lagrange form of
+// the SRS. The result is the same as Commit on the coefficients of the
+// polynomial.
+func CommitLagrange(evaluations []fr.Element, pk *LagrangeProvingKey, nbTasks ...int) (Digest, error) {
+	if len(evaluations) != len(pk.G1) {
+		return Digest{}, ErrInvalidPolynomialSize
+	}
+
+	var res Digest
+	config := ecc.MultiExpConfig{}
+	if len(nbTasks) > 0 {
+		config.NbTasks = nbTasks[0]
+	}
+	if _, err := res.MultiExp(pk.G1, evaluations, config); err != nil {
+		return Digest{}, err
+	}
+	return res, nil
+}
+
+// OpenLagrange computes an opening proof at point of the polynomial whose
+// evaluations on the domain of pk are evaluations. The quotient is computed
+// in Lagrange form, point may be in the domain.
+func OpenLagrange(evaluations []fr.Element, point fr.Element, pk *LagrangeProvingKey) (OpeningProof, error) {
+	if len(evaluations) != len(pk.G1) {
+		return OpeningProof{}, ErrInvalidPolynomialSize
+	}
+
+	w := pk.weights(point)
+	res := OpeningProof{
+		ClaimedValue: w.evaluate(evaluations),
+	}
+
+	h := w.quotient(evaluations, res.ClaimedValue, pk.points)
+	var err error
+	if res.H, err = CommitLagrange(h, pk); err != nil {
+		return OpeningProof{}, err
+	}
+	return res, nil
+}
+
+// BatchOpenSinglePointLagrange creates a batch opening proof at point of a list
+// of polynomials given by their evaluations on the domain of pk. It is the
+// counterpart of BatchOpenSinglePoint, and the proof is verified with
+// BatchVerifySinglePoint.
+//
+// * evaluations is the list of polynomials to open, in Lagrange form.
+// * digests is the list of committed polynomials to open, need to derive the challenge using Fiat Shamir.
+// * dataTranscript extra data that might be needed to derive the challenge used for folding
+func BatchOpenSinglePointLagrange(evaluations [][]fr.Element, digests []Digest, point fr.Element, hf hash.Hash, pk *LagrangeProvingKey, dataTranscript ...[]byte) (BatchOpeningProof, error) {
+
+	nbDigests := len(digests)
+	if nbDigests != len(evaluations) {
+		return BatchOpeningProof{}, ErrInvalidNbDigests
+	}
+	if nbDigests == 0 {
+		return BatchOpeningProof{}, ErrZeroNbDigests
+	}
+	for _, e := range evaluations {
+		if len(e) != len(pk.G1) {
+			return BatchOpeningProof{}, ErrInvalidPolynomialSize
+		}
+	}
+
+	// compute the purported values; the weights are shared by all the polynomials
+	w := pk.weights(point)
+	res := BatchOpeningProof{
+		ClaimedValues: make([]fr.Element, nbDigests),
+	}
+	parallel.Execute(nbDigests, func(start, end int) {
+		for i := start; i < end; i++ {
+			res.ClaimedValues[i] = w.evaluate(evaluations[i])
+		}
+	})
+
+	// derive the challenge γ, binded to the point and the commitments
+	gamma, err := deriveGamma(point, digests, res.ClaimedValues, hf, dataTranscript...)
+	if err != nil {
+		return BatchOpeningProof{}, err
+	}
+
+	// ∑ᵢγⁱfᵢ and ∑ᵢγⁱfᵢ(a)
+	gammas := make([]fr.Element, nbDigests)
+	gammas[0].SetOne()
+	for i := 1; i < nbDigests; i++ {
+		gammas[i].Mul(&gammas[i-1], &gamma)
+	}
+	var foldedValue, t fr.Element
+	for i := range res.ClaimedValues {
+		t.Mul(&res.ClaimedValues[i], &gammas[i])
+		foldedValue.Add(&foldedValue, &t)
+	}
+	folded := make([]fr.Element, len(pk.G1))
+	parallel.Execute(len(folded), func(start, end int) {
+		var t fr.Element
+		for i := range evaluations {
+			for j := start; j < end; j++ {
+				t.Mul(&evaluations[i][j], &gammas[i])
+				folded[j].Add(&folded[j], &t)
+			}
+		}
+	})
+
+	// compute H
+	h := w.quotient(folded, foldedValue, pk.points)
+	if res.H, err = CommitLagrange(h, pk); err != nil {
+		return BatchOpeningProof{}, err
+	}
+	return res, nil
+}
+
+// lagrangeWeights are the values needed to evaluate a polynomial in Lagrange
+// form at a point a, and to compute its quotient by X-a.
+type lagrangeWeights struct {
+	// index of a in the domain, or -1
+	index int
+
+	// 1/(xᵢ-a), or 0 if xᵢ = a
+	inverses []fr.Element
+
+	// Lᵢ(a), nil if a is in the domain
+	lagrange []fr.Element
+}
+
+func (pk *LagrangeProvingKey) weights(a fr.Element) lagrangeWeights {
+	n := len(pk.points)
+	res := lagrangeWeights{index: -1}
+
+	diffs := make([]fr.Element, n)
+	for i := range diffs {
+		diffs[i].Sub(&pk.points[i], &a)
+		if diffs[i].IsZero() {
+			res.index = i
+		}
+	}
+	res.inverses = fr.BatchInvert(diffs)
+	if res.index != -1 {
+		return res
+	}
+
+	// for the set of roots of Xⁿ - gⁿ,
+	// Lᵢ(a) = (aⁿ - gⁿ)xᵢ / (ngⁿ(a - xᵢ))
+	var an, gn, c fr.Element
+	exponent := big.NewInt(int64(n))
+	an.Exp(a, exponent)
+	gn.Exp(pk.CosetShift, exponent)
+	c.SetUint64(uint64(n)).Mul(&c, &gn).Inverse(&c)
+	an.Sub(&gn, &an) // we use 1/(xᵢ - a) = -1/(a - xᵢ)
+	c.Mul(&c, &an)
+
+	res.lagrange = make([]fr.Element, n)
+	parallel.Execute(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			res.lagrange[i].Mul(&pk.points[i], &res.inverses[i]).Mul(&res.lagrange[i], &c)
+		}
+	})
+	return res
+}
+
+// evaluate returns f(a)
+func (w *lagrangeWeights) evaluate(f []fr.Element) fr.Element {
+	if w.index != -1 {
+		return f[w.index]
+	}
+	var res, t fr.Element
+	for i := range f {
+		t.Mul(&f[i], &w.lagrange[i])
+		res.Add(&res, &t)
+	}
+	return res
+}
+
+// quotient returns (f-f(a))/(X-a) in Lagrange form.
+//
+// If a = xₖ is in the domain, the k-th evaluation of the quotient is f'(xₖ),
+// which is computed from the other evaluations qᵢ of the quotient as
+//
+//	f'(xₖ) = -∑_{i≠k} qᵢxᵢ/xₖ
+func (w *lagrangeWeights) quotient(f []fr.Element, fa fr.Element, points []fr.Element) []fr.Element {
+	res := make([]fr.Element, len(f))
+	parallel.Execute(len(f), func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].Sub(&f[i], &fa).Mul(&res[i], &w.inverses[i])
+		}
+	})
+	if w.index == -1 {
+		return res
+	}
+
+	k := w.index
+	var acc, t fr.Element
+	for i := range res {
+		if i == k {
+			continue
+		}
+		t.Mul(&res[i], &points[i])
+		acc.Add(&acc, &t)
+	}
+	t.Inverse(&points[k])
+	res[k].Mul(&acc, &t).Neg(&res[k])
+	return res
+}
@@ -0,0 +1,125 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr/fft"
+	"github.com/stretchr/testify/require"
+)
+
+// randomLagrange returns random evaluations on the domain of pk, and the
+// coefficients of the interpolating polynomial.
+func randomLagrange(pk *LagrangeProvingKey) (evaluations, coefficients []fr.Element) {
+	n := len(pk.G1)
+	evaluations = make([]fr.Element, n)
+	for i := range evaluations {
+		evaluations[i].SetRandom()
+	}
+	coefficients = make([]fr.Element, n)
+	copy(coefficients, evaluations)
+	domain := fft.NewDomain(uint64(n), fft.WithShift(pk.CosetShift))
+	if pk.CosetShift.IsOne() {
+		domain.FFTInverse(coefficients, fft.DIF)
+	} else {
+		domain.FFTInverse(coefficients, fft.DIF, fft.OnCoset())
+	}
+	fft.BitReverse(coefficients)
+	return
+}
+
+func TestLagrangeProvingKey(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 64
+	var shift fr.Element
+	shift.SetUint64(7)
+	cache := NewLagrangeCache(testSrs.Pk)
+
+	for _, pk := range []func() (*LagrangeProvingKey, error){
+		func() (*LagrangeProvingKey, error) { return cache.Get(size) },
+		func() (*LagrangeProvingKey, error) { return cache.Get(size, shift) },
+	} {
+		pk, err := pk()
+		assert.NoError(err)
+		evaluations, coefficients := randomLagrange(pk)
+
+		// commitment
+		expected, err := Commit(coefficients, testSrs.Pk)
+		assert.NoError(err)
+		digest, err := CommitLagrange(evaluations, pk)
+		assert.NoError(err)
+		assert.Equal(expected, digest)
+
+		// opening outside and inside the domain
+		var point fr.Element
+		point.SetRandom()
+		for _, point := range []fr.Element{point, pk.points[5]} {
+			expectedProof, err := Open(coefficients, point, testSrs.Pk)
+			assert.NoError(err)
+			proof, err := OpenLagrange(evaluations, point, pk)
+			assert.NoError(err)
+			assert.Equal(expectedProof, proof)
+			assert.NoError(Verify(&digest, &proof, point, testSrs.Vk))
+		}
+	}
+
+	// the keys are cached
+	pk1, err := cache.Get(size, shift)
+	assert.NoError(err)
+	pk2, err := cache.Get(size, shift)
+	assert.NoError(err)
+	assert.True(pk1 == pk2)
+
+	_, err = cache.Get(size + 1)
+	assert.ErrorIs(err, ErrInvalidDomainSize)
+	_, err = cache.Get(uint64(2 * len(testSrs.Pk.G1)))
+	assert.ErrorIs(err, ErrInvalidDomainSize)
+
+	// the Lagrange form on the subgroup is the one of ToLagrangeG1
+	pk, err := cache.Get(size)
+	assert.NoError(err)
+	assert.NoError(testSrs.ValidateLagrange(ProvingKey{G1: pk.G1}))
+}
+
+func TestBatchOpenSinglePointLagrange(t *testing.T) {
+	assert := require.New(t)
+
+	var shift fr.Element
+	shift.SetUint64(3)
+	pk, err := NewLagrangeProvingKey(testSrs.Pk, 32, shift)
+	assert.NoError(err)
+
+	const nbPolynomials = 5
+	evaluations := make([][]fr.Element, nbPolynomials)
+	digests := make([]Digest, nbPolynomials)
+	for i := range evaluations {
+		evaluations[i], _ = randomLagrange(pk)
+		digests[i], err = CommitLagrange(evaluations[i], pk)
+		assert.NoError(err)
+	}
+
+	hf := sha256.New()
+	var point fr.Element
+	point.SetRandom()
+	for _, point := range []fr.Element{point, pk.points[17]} {
+		proof, err := BatchOpenSinglePointLagrange(evaluations, digests, point, hf, pk)
+		assert.NoError(err)
+		assert.NoError(BatchVerifySinglePoint(digests, &proof, point, hf, testSrs.Vk))
+		if point == pk.points[17] {
+			assert.Equal(evaluations[2][17], proof.ClaimedValues[2])
+		}
+
+		proof.ClaimedValues[1].SetRandom()
+		assert.Error(BatchVerifySinglePoint(digests, &proof, point, hf, testSrs.Vk))
+	}
+
+	_, err = BatchOpenSinglePointLagrange(evaluations, digests[1:], point, hf, pk)
+	assert.ErrorIs(err, ErrInvalidNbDigests)
+}
@@ -0,0 +1,463 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package accumulator
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/polynomial"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/kzg"
+)
+
+var (
+	ErrSetTooLarge         = errors.New("the size of the set exceeds the capacity of the proving key")
+	ErrBatchTooLarge       = errors.New("the number of elements exceeds the capacity of the verifying key")
+	ErrDuplicateElement    = errors.New("the elements must be distinct")
+	ErrElementInSet        = errors.New("the element is in the set")
+	ErrElementNotInSet     = errors.New("the element is not in the set")
+	ErrInvalidUpdate       = errors.New("malformed update")
+	ErrVerifyMembership    = errors.New("can't verify membership")
+	ErrVerifyNonMembership = errors.New("can't verify non-membership")
+)
+
+// VerifyingKey used to verify membership and non-membership witnesses and
+// proofs.
+//
+// implements io.ReaderFrom and io.WriterTo
+type VerifyingKey struct {
+	G1 bls24317.G1Affine
+
+	// G2 [τⁱ]G₂ for i ≤ d, where d is the maximum number of elements of a batch
+	G2 []bls24317.G2Affine
+}
+
+// SRS must be computed through MPC and comprises the kzg.ProvingKey and the
+// VerifyingKey, for the same τ
+type SRS struct {
+	Pk kzg.ProvingKey
+	Vk VerifyingKey
+}
+
+// NewSRS returns a new SRS using alpha as randomness source, allowing to
+// accumulate sets of less than size elements, and to prove batches of at most
+// maxBatchSize elements.
+//
+// In production, a SRS generated through MPC should be used. Unlike
+// kzg.NewSRS, alpha = -1 is not a special value.
+func NewSRS(size, maxBatchSize uint64, bAlpha *big.Int) (*SRS, error) {
+	if maxBatchSize < 1 {
+		return nil, ErrBatchTooLarge
+	}
+	kzgSrs, err := kzg.NewSRS(size, bAlpha)
+	if err != nil {
+		return nil, err
+	}
+
+	var srs SRS
+	srs.Pk = kzgSrs.Pk
+	srs.Vk.G1 = kzgSrs.Vk.G1
+
+	var alpha fr.Element
+	alpha.SetBigInt(bAlpha)
+	alphas := make([]fr.Element, maxBatchSize+1)
+	alphas[0].SetOne()
+	for i := 1; i < len(alphas); i++ {
+		alphas[i].Mul(&alphas[i-1], &alpha)
+	}
+	_, _, _, g2 := bls24317.Generators()
+	srs.Vk.G2 = bls24317.BatchScalarMultiplicationG2(&g2, alphas)
+
+	return &srs, nil
+}
+
+// NewVerifyingKey returns the VerifyingKey of a KZG SRS. It allows to verify
+// the witnesses of single elements and batch proofs of one element.
+func NewVerifyingKey(vk kzg.VerifyingKey) VerifyingKey {
+	return VerifyingKey{
+		G1: vk.G1,
+		G2: []bls24317.G2Affine{vk.G2[0], vk.G2[1]},
+	}
+}
+
+// Accumulator accumulated set, held by its manager who knows the set and the
+// proving key.
+type Accumulator struct {
+	// Value [f_S(τ)]G₁ where f_S(X) = ∏_{s∈S}(X - s)
+	Value bls24317.G1Affine
+
+	elements map[fr.Element]struct{}
+	poly     polynomial.Polynomial
+	pk       kzg.ProvingKey
+}
+
+// MembershipWitness witness that Element is in the accumulated set.
+//
+// implements io.ReaderFrom and io.WriterTo
+type MembershipWitness struct {
+	Element fr.Element
+
+	// W [f_S(τ)/(τ - y)]G₁ where y is Element
+	W bls24317.G1Affine
+}
+
+// NonMembershipWitness witness that Element is not in the accumulated set.
+//
+// implements io.ReaderFrom and io.WriterTo
+type NonMembershipWitness struct {
+	Element fr.Element
+
+	// A, B Bézout coefficients a ∈ 𝔽ᵣ and [b(τ)]G₁ where af_S(X) + b(X)(X - y) = 1
+	A fr.Element
+	B bls24317.G1Affine
+}
+
+// BatchMembershipProof proof that several elements are in the accumulated set.
+//
+// implements io.ReaderFrom and io.WriterTo
+type BatchMembershipProof struct {
+	// W [f_S(τ)/f_Y(τ)]G₁ where Y is the set of the elements
+	W bls24317.G1Affine
+}
+
+// BatchNonMembershipProof proof that several elements are not in the
+// accumulated set.
+//
+// implements io.ReaderFrom and io.WriterTo
+type BatchNonMembershipProof struct {
+	// A, B Bézout coefficients [a(τ)]G₂ and [b(τ)]G₁ where
+	// a(X)f_S(X) + b(X)f_Y(X) = 1
+	A bls24317.G2Affine
+	B bls24317.G1Affine
+}
+
+// New returns an Accumulator of the set of elements, which must be distinct.
+func New(pk kzg.ProvingKey, elements []fr.Element) (*Accumulator, error) {
+	if len(elements) >= len(pk.G1) {
+		return nil, ErrSetTooLarge
+	}
+	if err := checkDistinct(elements); err != nil {
+		return nil, err
+	}
+	acc := Accumulator{
+		elements: make(map[fr.Element]struct{}, len(elements)),
+		poly:     characteristicPolynomial(elements),
+		pk:       pk,
+	}
+	for i := range elements {
+		acc.elements[elements[i]] = struct{}{}
+	}
+	var err error
+	if acc.Value, err = kzg.Commit(acc.poly, pk); err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+// Size returns the number of elements of the set.
+func (acc *Accumulator) Size() int {
+	return len(acc.elements)
+}
+
+// Contains returns true if e is in the set.
+func (acc *Accumulator) Contains(e fr.Element) bool {
+	_, ok := acc.elements[e]
+	return ok
+}
+
+// Add adds the elements, which must be distinct and not in the set, and
+// returns the Update allowing to update the witnesses.
+func (acc *Accumulator) Add(elements ...fr.Element) (Update, error) {
+	if len(acc.elements)+len(elements) >= len(acc.pk.G1) {
+		return Update{}, ErrSetTooLarge
+	}
+	if err := checkDistinct(elements); err != nil {
+		return Update{}, err
+	}
+	for i := range elements {
+		if acc.Contains(elements[i]) {
+			return Update{}, ErrElementInSet
+		}
+	}
+
+	u := Update{Added: append([]fr.Element{}, elements...)}
+	var err error
+	if u.U, err = acc.shiftedCommitments(len(elements)); err != nil {
+		return Update{}, err
+	}
+	for i := range elements {
+		acc.elements[elements[i]] = struct{}{}
+		acc.poly = mulByLinear(acc.poly, elements[i])
+	}
+	if acc.Value, err = kzg.Commit(acc.poly, acc.pk); err != nil {
+		return Update{}, err
+	}
+	return u, nil
+}
+
+// Delete deletes the elements, which must be distinct and in the set, and
+// returns the Update allowing to update the witnesses.
+func (acc *Accumulator) Delete(elements ...fr.Element) (Update, error) {
+	if err := checkDistinct(elements); err != nil {
+		return Update{}, err
+	}
+	for i := range elements {
+		if !acc.Contains(elements[i]) {
+			return Update{}, ErrElementNotInSet
+		}
+	}
+
+	for i := range elements {
+		delete(acc.elements, elements[i])
+		acc.poly, _ = divideByLinear(acc.poly, elements[i])
+	}
+	u := Update{Deleted: append([]fr.Element{}, elements...)}
+	var err error
+	if u.U, err = acc.shiftedCommitments(len(elements)); err != nil {
+		return Update{}, err
+	}
+	if len(u.U) > 0 {
+		acc.Value = u.U[0]
+	}
+	return u, nil
+}
+
+// MembershipWitness returns a witness that e is in the set.
+func (acc *Accumulator) MembershipWitness(e fr.Element) (MembershipWitness, error) {
+	if !acc.Contains(e) {
+		return MembershipWitness{}, ErrElementNotInSet
+	}
+	q, _ := divideByLinear(acc.poly, e)
+	res := MembershipWitness{Element: e}
+	var err error
+	if res.W, err = commit(q, acc.pk.G1); err != nil {
+		return MembershipWitness{}, err
+	}
+	return res, nil
+}
+
+// NonMembershipWitness returns a witness that e is not in the set.
+func (acc *Accumulator) NonMembershipWitness(e fr.Element) (NonMembershipWitness, error) {
+	if acc.Contains(e) {
+		return NonMembershipWitness{}, ErrElementInSet
+	}
+
+	// f_S = q(X)(X - e) + f_S(e), so that a = 1/f_S(e) and b = -q/f_S(e)
+	q, r := divideByLinear(acc.poly, e)
+	res := NonMembershipWitness{Element: e}
+	res.A.Inverse(&r)
+	var err error
+	if res.B, err = commit(q, acc.pk.G1); err != nil {
+		return NonMembershipWitness{}, err
+	}
+	var minusA fr.Element
+	var b big.Int
+	minusA.Neg(&res.A)
+	res.B.ScalarMultiplication(&res.B, minusA.BigInt(&b))
+	return res, nil
+}
+
+// ProveMembership returns a proof that the elements, which must be distinct,
+// are in the set.
+func (acc *Accumulator) ProveMembership(elements []fr.Element) (BatchMembershipProof, error) {
+	if err := checkDistinct(elements); err != nil {
+		return BatchMembershipProof{}, err
+	}
+	q := acc.poly
+	for i := range elements {
+		if !acc.Contains(elements[i]) {
+			return BatchMembershipProof{}, ErrElementNotInSet
+		}
+		q, _ = divideByLinear(q, elements[i])
+	}
+	var res BatchMembershipProof
+	var err error
+	if res.W, err = commit(q, acc.pk.G1); err != nil {
+		return BatchMembershipProof{}, err
+	}
+	return res, nil
+}
+
+// ProveNonMembership returns a proof that the elements are not in the set.
+// The Bézout coefficient a is committed to with vk.G2, which limits the number
+// of elements.
+func (acc *Accumulator) ProveNonMembership(elements []fr.Element, vk VerifyingKey) (BatchNonMembershipProof, error) {
+	if len(elements) == 0 || len(elements) >= len(vk.G2) {
+		return BatchNonMembershipProof{}, ErrBatchTooLarge
+	}
+	for i := range elements {
+		if acc.Contains(elements[i]) {
+			return BatchNonMembershipProof{}, ErrElementInSet
+		}
+	}
+	a, b, err := bezout(acc.poly, characteristicPolynomial(elements))
+	if err != nil {
+		return BatchNonMembershipProof{}, err
+	}
+	var res BatchNonMembershipProof
+	if len(a) > 0 {
+		if _, err := res.A.MultiExp(vk.G2[:len(a)], a, ecc.MultiExpConfig{}); err != nil {
+			return BatchNonMembershipProof{}, err
+		}
+	}
+	if res.B, err = commit(b, acc.pk.G1); err != nil {
+		return BatchNonMembershipProof{}, err
+	}
+	return res, nil
+}
+
+// VerifyMembership verifies that w.Element is in the set accumulated in value,
+// by checking that e(W, [τ - y]G₂) = e(value, G₂).
+func VerifyMembership(value bls24317.G1Affine, w *MembershipWitness, vk VerifyingKey) error {
+	var minusValue bls24317.G1Affine
+	minusValue.Neg(&value)
+	xMinusY, err := commitG2(characteristicPolynomial([]fr.Element{w.Element}), vk)
+	if err != nil {
+		return err
+	}
+	ok, err := bls24317.PairingCheck(
+		[]bls24317.G1Affine{w.W, minusValue},
+		[]bls24317.G2Affine{xMinusY, vk.G2[0]},
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrVerifyMembership
+	}
+	return nil
+}
+
+// VerifyNonMembership verifies that w.Element is not in the set accumulated in
+// value, by checking that e(a·value - G₁, G₂) e(B, [τ - y]G₂) = 1.
+func VerifyNonMembership(value bls24317.G1Affine, w *NonMembershipWitness, vk VerifyingKey) error {
+	var aValue bls24317.G1Affine
+	var b big.Int
+	aValue.ScalarMultiplication(&value, w.A.BigInt(&b))
+	aValue.Sub(&aValue, &vk.G1)
+	xMinusY, err := commitG2(characteristicPolynomial([]fr.Element{w.Element}), vk)
+	if err != nil {
+		return err
+	}
+	ok, err := bls24317.PairingCheck(
+		[]bls24317.G1Affine{aValue, w.B},
+		[]bls24317.G2Affine{vk.G2[0], xMinusY},
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrVerifyNonMembership
+	}
+	return nil
+}
+
+// BatchVerifyMembership verifies that the elements are in the set accumulated
+// in value, by checking that e(W, [f_Y(τ)]G₂) = e(value, G₂).
+func BatchVerifyMembership(value bls24317.G1Affine, elements []fr.Element, proof *BatchMembershipProof, vk VerifyingKey) error {
+	if len(elements) == 0 {
+		return ErrBatchTooLarge
+	}
+	fY, err := commitG2(characteristicPolynomial(elements), vk)
+	if err != nil {
+		return err
+	}
+	var minusValue bls24317.G1Affine
+	minusValue.Neg(&value)
+	ok, err := bls24317.PairingCheck(
+		[]bls24317.G1Affine{proof.W, minusValue},
+		[]bls24317.G2Affine{fY, vk.G2[0]},
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrVerifyMembership
+	}
+	return nil
+}
+
+// BatchVerifyNonMembership verifies that the elements are not in the set
+// accumulated in value, by checking that
+// e(value, A) e(B, [f_Y(τ)]G₂) = e(G₁, G₂).
+func BatchVerifyNonMembership(value bls24317.G1Affine, elements []fr.Element, proof *BatchNonMembershipProof, vk VerifyingKey) error {
+	if len(elements) == 0 {
+		return ErrBatchTooLarge
+	}
+	fY, err := commitG2(characteristicPolynomial(elements), vk)
+	if err != nil {
+		return err
+	}
+	var minusG1 bls24317.G1Affine
+	minusG1.Neg(&vk.G1)
+	ok, err := bls24317.PairingCheck(
+		[]bls24317.G1Affine{value, proof.B, minusG1},
+		[]bls24317.G2Affine{proof.A, fY, vk.G2[0]},
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrVerifyNonMembership
+	}
+	return nil
+}
+
+// shiftedCommitments returns [τⁱf_S(τ)]G₁ for i < n.
+func (acc *Accumulator) shiftedCommitments(n int) ([]bls24317.G1Affine, error) {
+	if len(acc.poly)+n-1 > len(acc.pk.G1) {
+		return nil, ErrSetTooLarge
+	}
+	res := make([]bls24317.G1Affine, n)
+	for i := range res {
+		var err error
+		if res[i], err = commit(acc.poly, acc.pk.G1[i:]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// commit returns ∑ᵢ pᵢbasesᵢ, the point at infinity if p is empty.
+func commit(p []fr.Element, bases []bls24317.G1Affine) (bls24317.G1Affine, error) {
+	var res bls24317.G1Affine
+	if len(p) == 0 {
+		return res, nil
+	}
+	if len(p) > len(bases) {
+		return res, ErrSetTooLarge
+	}
+	if _, err := res.MultiExp(bases[:len(p)], p, ecc.MultiExpConfig{}); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// commitG2 returns [p(τ)]G₂.
+func commitG2(p []fr.Element, vk VerifyingKey) (bls24317.G2Affine, error) {
+	var res bls24317.G2Affine
+	if len(p) > len(vk.G2) {
+		return res, ErrBatchTooLarge
+	}
+	if _, err := res.MultiExp(vk.G2[:len(p)], p, ecc.MultiExpConfig{}); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func checkDistinct(elements []fr.Element) error {
+	seen := make(map[fr.Element]struct{}, len(elements))
+	for i := range elements {
+		if _, ok := seen[elements[i]]; ok {
+			return ErrDuplicateElement
+		}
+		seen[elements[i]] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package accumulator
+
+import (
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+)
+
+// ReadFrom decodes VerifyingKey data from reader.
+func (vk *VerifyingKey) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r, &vk.G1, &vk.G2)
+}
+
+// WriteTo writes binary encoding of the VerifyingKey
+func (vk *VerifyingKey) WriteTo(w io.Writer) (int64, error) {
+	return encode(w, &vk.G1, vk.G2)
+}
+
+// ReadFrom decodes MembershipWitness data from reader.
+func (w *MembershipWitness) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r, &w.Element, &w.W)
+}
+
+// WriteTo writes binary encoding of a MembershipWitness
+func (w *MembershipWitness) WriteTo(writer io.Writer) (int64, error) {
+	return encode(writer, &w.Element, &w.W)
+}
+
+// ReadFrom decodes NonMembershipWitness data from reader.
+func (w *NonMembershipWitness) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r, &w.Element, &w.A, &w.B)
+}
+
+// WriteTo writes binary encoding of a NonMembershipWitness
+func (w *NonMembershipWitness) WriteTo(writer io.Writer) (int64, error) {
+	return encode(writer, &w.Element, &w.A, &w.B)
+}
+
+// ReadFrom decodes BatchMembershipProof data from reader.
+func (proof *BatchMembershipProof) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r, &proof.W)
+}
+
+// WriteTo writes binary encoding of a BatchMembershipProof
+func (proof *BatchMembershipProof) WriteTo(w io.Writer) (int64, error) {
+	return encode(w, &proof.W)
+}
+
+// ReadFrom decodes BatchNonMembershipProof data from reader.
+func (proof *BatchNonMembershipProof) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r, &proof.A, &proof.B)
+}
+
+// WriteTo writes binary encoding of a BatchNonMembershipProof
+func (proof *BatchNonMembershipProof) WriteTo(w io.Writer) (int64, error) {
+	return encode(w, &proof.A, &proof.B)
+}
+
+// ReadFrom decodes Update data from reader.
+func (u *Update) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r, &u.Added, &u.Deleted, &u.U)
+}
+
+// WriteTo writes binary encoding of an Update
+func (u *Update) WriteTo(w io.Writer) (int64, error) {
+	return encode(w, u.Added, u.Deleted, u.U)
+}
+
+func encode(w io.Writer, toEncode ...interface{}) (int64, error) {
+
+	enc := bls24317.NewEncoder(w)
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+func decode(r io.Reader, toDecode ...interface{}) (int64, error) {
+
+	dec := bls24317.NewDecoder(r)
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bulletproofs
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// InnerProductProof proof of knowledge of vectors a, b such that
+// P = ⟨a, G⟩ + ⟨b, H⟩ + ⟨a, b⟩U, for public bases G, H and U.
+//
+// In each round, the vectors and the bases are split in halves and folded
+// with a challenge x:
+//
+//	a' = x a_lo + x⁻¹ a_hi,   G' = x⁻¹ G_lo + x G_hi
+//	b' = x⁻¹ b_lo + x b_hi,   H' = x H_lo + x⁻¹ H_hi
+//
+// and P' = x²L + P + x⁻²R where L, R are the cross terms.
+//
+// implements io.ReaderFrom and io.WriterTo
+type InnerProductProof struct {
+	// L, R cross terms of the rounds
+	L, R []bls24317.G1Affine
+
+	// A, B last values of the folded vectors
+	A, B fr.Element
+}
+
+// proveInnerProduct computes an inner-product argument for the vectors a and b
+// on the bases g, h and u. The slices are modified in place.
+func proveInnerProduct(fs *fiatshamir.Transcript, g, h []bls24317.G1Affine, u bls24317.G1Affine, a, b []fr.Element) (InnerProductProof, error) {
+	nbRounds := log2(len(a))
+	res := InnerProductProof{
+		L: make([]bls24317.G1Affine, nbRounds),
+		R: make([]bls24317.G1Affine, nbRounds),
+	}
+
+	points := make([]bls24317.G1Affine, len(a)+1)
+	scalars := make([]fr.Element, len(a)+1)
+	for k := 0; k < nbRounds; k++ {
+		n := len(a) / 2
+
+		// L = ⟨a_lo, G_hi⟩ + ⟨b_hi, H_lo⟩ + ⟨a_lo, b_hi⟩U
+		copy(points, g[n:])
+		copy(points[n:], h[:n])
+		points[2*n] = u
+		copy(scalars, a[:n])
+		copy(scalars[n:], b[n:])
+		scalars[2*n] = innerProduct(a[:n], b[n:])
+		if _, err := res.L[k].MultiExp(points[:2*n+1], scalars[:2*n+1], ecc.MultiExpConfig{}); err != nil {
+			return InnerProductProof{}, err
+		}
+
+		// R = ⟨a_hi, G_lo⟩ + ⟨b_lo, H_hi⟩ + ⟨a_hi, b_lo⟩U
+		copy(points, g[:n])
+		copy(points[n:], h[n:])
+		copy(scalars, a[n:])
+		copy(scalars[n:], b[:n])
+		scalars[2*n] = innerProduct(a[n:], b[:n])
+		if _, err := res.R[k].MultiExp(points[:2*n+1], scalars[:2*n+1], ecc.MultiExpConfig{}); err != nil {
+			return InnerProductProof{}, err
+		}
+
+		x, err := deriveRoundChallenge(fs, k, &res.L[k], &res.R[k])
+		if err != nil {
+			return InnerProductProof{}, err
+		}
+		var xInv fr.Element
+		xInv.Inverse(&x)
+
+		a = foldScalars(a, x, xInv)
+		b = foldScalars(b, xInv, x)
+		g = foldPoints(g, xInv, x)
+		h = foldPoints(h, x, xInv)
+	}
+	res.A, res.B = a[0], b[0]
+
+	return res, nil
+}
+
+// roundChallenges returns the challenges of the rounds of proof and their inverses.
+func (proof *InnerProductProof) roundChallenges(fs *fiatshamir.Transcript) (x, xInv []fr.Element, err error) {
+	x = make([]fr.Element, len(proof.L))
+	for k := range x {
+		if x[k], err = deriveRoundChallenge(fs, k, &proof.L[k], &proof.R[k]); err != nil {
+			return nil, nil, err
+		}
+	}
+	return x, fr.BatchInvert(x), nil
+}
+
+// foldingScalars returns s such that the folded bases are G' = ⟨s, G⟩. sᵢ is
+// the product of the xₖ or x⁻¹ₖ depending on the k-th most significant bit of
+// i. The folded bases H' are ⟨s', H⟩ where s' is s in reverse order.
+func foldingScalars(x, xInv []fr.Element) []fr.Element {
+	s := make([]fr.Element, 1, 1<<len(x))
+	s[0].SetOne()
+	for k := range x {
+		s = s[:2*len(s)]
+		for i := len(s)/2 - 1; i >= 0; i-- {
+			s[2*i+1].Mul(&s[i], &x[k])
+			s[2*i].Mul(&s[i], &xInv[k])
+		}
+	}
+	return s
+}
+
+// foldScalars returns cLo v_lo + cHi v_hi.
+func foldScalars(v []fr.Element, cLo, cHi fr.Element) []fr.Element {
+	n := len(v) / 2
+	var t fr.Element
+	for i := 0; i < n; i++ {
+		v[i].Mul(&v[i], &cLo)
+		t.Mul(&v[n+i], &cHi)
+		v[i].Add(&v[i], &t)
+	}
+	return v[:n]
+}
+
+// foldPoints returns cLo g_lo + cHi g_hi.
+func foldPoints(g []bls24317.G1Affine, cLo, cHi fr.Element) []bls24317.G1Affine {
+	n := len(g) / 2
+	var bLo, bHi big.Int
+	cLo.BigInt(&bLo)
+	cHi.BigInt(&bHi)
+	res := make([]bls24317.G1Jac, n)
+	parallel.Execute(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			res[i].JointScalarMultiplication(&g[i], &g[n+i], &bLo, &bHi)
+		}
+	})
+	return bls24317.BatchJacobianToAffineG1(res)
+}
+
+func innerProduct(a, b []fr.Element) fr.Element {
+	var res, t fr.Element
+	for i := range a {
+		t.Mul(&a[i], &b[i])
+		res.Add(&res, &t)
+	}
+	return res
+}
+
+// deriveRoundChallenge derives the challenge of the k-th round of the
+// inner-product argument, binded to the cross terms.
+func deriveRoundChallenge(fs *fiatshamir.Transcript, k int, l, r *bls24317.G1Affine) (fr.Element, error) {
+	name := "u" + strconv.Itoa(k)
+	if err := bindPoints(fs, name, l, r); err != nil {
+		return fr.Element{}, err
+	}
+	return computeChallenge(fs, name)
+}
@@ -0,0 +1,539 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/fft"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrDegreeBound      = errors.New("the degree bounds must be size/kʳ for r < the number of rounds")
+	ErrNbPolynomials    = errors.New("the numbers of polynomials and degree bounds differ")
+	ErrPointInDomain    = errors.New("the evaluation point must be outside the domain")
+	ErrNbEvaluations    = errors.New("the number of evaluations does not match the commitment")
+	ErrBatchFRIMismatch = errors.New("the commitment was not built with this FRI")
+)
+
+// Batched DEEP-FRI proves the evaluations yᵢ = pᵢ(z) of committed polynomials
+// pᵢ of degree < dᵢ at a point z outside the domain, by running FRI on the
+// DEEP quotients qᵢ = (pᵢ - yᵢ)/(X - z), which are polynomials of degree < dᵢ
+// if and only if the evaluations are correct.
+//
+// The polynomials are grouped by degree bound, each degree bound being the
+// degree bound nᵣ of a round r of FRI. The codewords of a group are the
+// evaluations of its polynomials on the domain Dᵣ of round r, committed in a
+// single Merkle tree whose leaves pack the values of all the polynomials on
+// the cosets of ⟨ω⟩. With Gᵣ = ∑ᵢ γʲ⁽ⁱ⁾qᵢ the random combination of the DEEP
+// quotients of the group of round r, FRI folds
+//
+//	h₀ = G₀, hᵣ₊₁ = fold(hᵣ, αᵣ) + Gᵣ₊₁
+//
+// so that the smaller polynomials are injected at the round matching their
+// degree bound. The verifier computes Gᵣ on the queried cosets from the
+// openings of the commitment, so that only the folded codewords fold(hᵣ, αᵣ)
+// are committed.
+
+// BatchDigest commitment to polynomials of various degree bounds.
+type BatchDigest struct {
+	// DegreeBounds degree bounds of the polynomials
+	DegreeBounds []uint64
+
+	// Roots Merkle roots of the groups of polynomials of the degree bound of
+	// each round, nil for empty groups
+	Roots [][]byte
+}
+
+// BatchCommitment commitment to polynomials of various degree bounds, with the
+// data needed to prove their evaluations.
+type BatchCommitment struct {
+	Digest BatchDigest
+
+	f           *FRI
+	polynomials [][]fr.Element
+
+	// groups[r] indices of the polynomials of degree bound nᵣ
+	groups [][]int
+
+	// codewords[i] evaluations of the i-th polynomial on the domain of its
+	// round
+	codewords [][]fr.Element
+	trees     []*merkleTree
+}
+
+// BatchProof proof of evaluation of polynomials committed with BatchCommit.
+type BatchProof struct {
+	// Evaluations pᵢ(z), in the order of the polynomials
+	Evaluations []fr.Element
+
+	// Roots Merkle roots of the folded codewords fold(h₀, α₀), …,
+	// fold(h_{R-2}, α_{R-2})
+	Roots [][]byte
+
+	// FinalPolynomial coefficients of h_R, in canonical basis
+	FinalPolynomial []fr.Element
+
+	// Nonce proof of work
+	Nonce uint64
+
+	// GroupQueries for each query, the openings of the groups of each round,
+	// empty for empty groups. The values are ordered by coset element, then by
+	// polynomial.
+	GroupQueries [][]Opening
+
+	// Queries for each query, the openings of the folded codewords
+	Queries [][]Opening
+}
+
+// BatchCommit commits to the polynomials, given in canonical basis, of given
+// degree bounds, which must be degree bounds nᵣ of the rounds of f.
+func (f *FRI) BatchCommit(polynomials [][]fr.Element, degreeBounds []uint64) (*BatchCommitment, error) {
+	if len(polynomials) != len(degreeBounds) {
+		return nil, ErrNbPolynomials
+	}
+	c := BatchCommitment{
+		Digest: BatchDigest{
+			DegreeBounds: append([]uint64{}, degreeBounds...),
+			Roots:        make([][]byte, f.NbRounds()),
+		},
+		f:           f,
+		polynomials: polynomials,
+		codewords:   make([][]fr.Element, len(polynomials)),
+		trees:       make([]*merkleTree, f.NbRounds()),
+	}
+	var err error
+	if c.groups, err = f.groupByRound(degreeBounds); err != nil {
+		return nil, err
+	}
+	for i, p := range polynomials {
+		if uint64(len(p)) > degreeBounds[i] {
+			return nil, ErrPolynomialSize
+		}
+	}
+
+	k := f.config.FoldingFactor
+	for r, group := range c.groups {
+		if len(group) == 0 {
+			continue
+		}
+		domain := fft.NewDomain(f.degreeBounds[r] * uint64(f.config.BlowupFactor))
+		parallel.Execute(len(group), func(start, end int) {
+			for _, i := range group[start:end] {
+				c.codewords[i] = make([]fr.Element, domain.Cardinality)
+				copy(c.codewords[i], polynomials[i])
+				domain.FFT(c.codewords[i], fft.DIF)
+				fft.BitReverse(c.codewords[i])
+			}
+		}, 1)
+		m := int(domain.Cardinality) / k
+		c.trees[r] = newMerkleTree(f.h, m, func(l int, buf []fr.Element) []fr.Element {
+			for t := 0; t < k; t++ {
+				for _, i := range group {
+					buf = append(buf, c.codewords[i][l+t*m])
+				}
+			}
+			return buf
+		})
+		c.Digest.Roots[r] = c.trees[r].root()
+	}
+	return &c, nil
+}
+
+// groupByRound returns the indices of the polynomials of degree bound nᵣ, for
+// each round r.
+func (f *FRI) groupByRound(degreeBounds []uint64) ([][]int, error) {
+	res := make([][]int, f.NbRounds())
+	for i, d := range degreeBounds {
+		r := 0
+		for r < f.NbRounds() && f.degreeBounds[r] != d {
+			r++
+		}
+		if r == f.NbRounds() {
+			return nil, ErrDegreeBound
+		}
+		res[r] = append(res[r], i)
+	}
+	return res, nil
+}
+
+// BatchProve returns a proof of the evaluations at z of the committed
+// polynomials. z must be outside the domain of f, and is typically derived
+// from a transcript after the commitment.
+func (f *FRI) BatchProve(c *BatchCommitment, z fr.Element) (BatchProof, error) {
+	if c.f != f {
+		return BatchProof{}, ErrBatchFRIMismatch
+	}
+	if err := f.checkOutOfDomain(z); err != nil {
+		return BatchProof{}, err
+	}
+	evaluations := make([]fr.Element, len(c.polynomials))
+	for i, p := range c.polynomials {
+		evaluations[i] = evaluate(p, z)
+	}
+	return f.batchProve(c, z, evaluations)
+}
+
+// batchProve returns a proof of the claimed evaluations at z of the committed
+// polynomials.
+func (f *FRI) batchProve(c *BatchCommitment, z fr.Element, evaluations []fr.Element) (BatchProof, error) {
+	proof := BatchProof{Evaluations: evaluations}
+	fs := f.batchTranscript()
+	gamma, err := f.bindBatch(fs, &c.Digest, z, proof.Evaluations)
+	if err != nil {
+		return proof, err
+	}
+	gammas := powers(gamma, len(c.polynomials))
+
+	// hᵣ = fold(hᵣ₋₁, αᵣ₋₁) + Gᵣ
+	k := f.config.FoldingFactor
+	folded := make([][]fr.Element, f.NbRounds())
+	trees := make([]*merkleTree, f.NbRounds())
+	h := make([]fr.Element, f.domain.Cardinality)
+	gInv := f.domain.GeneratorInv
+	for r := range folded {
+		if r > 0 {
+			folded[r] = h
+			trees[r] = commitCodeword(f.h, h, k)
+			proof.Roots = append(proof.Roots, trees[r].root())
+			h = append([]fr.Element{}, h...)
+		}
+		f.addDeepQuotients(h, c, r, z, proof.Evaluations, gammas)
+
+		var root []byte
+		if r > 0 {
+			root = trees[r].root()
+		}
+		alpha, err := challenge(fs, alphaID(r), root)
+		if err != nil {
+			return proof, err
+		}
+		h = f.foldCodeword(h, gInv, alpha)
+		gInv.Exp(gInv, big.NewInt(int64(k)))
+	}
+
+	final := make([]fr.Element, len(h))
+	copy(final, h)
+	fft.NewDomain(uint64(len(final))).FFTInverse(final, fft.DIF)
+	fft.BitReverse(final)
+	proof.FinalPolynomial = final[:f.degreeBounds[f.NbRounds()]]
+
+	seed, err := challengeBytes(fs, grindingID, marshalElements(proof.FinalPolynomial))
+	if err != nil {
+		return proof, err
+	}
+	proof.Nonce = grind(f.h, seed, f.config.GrindingBits)
+	positions, err := f.queryPositions(fs, proof.Nonce)
+	if err != nil {
+		return proof, err
+	}
+
+	proof.GroupQueries = make([][]Opening, len(positions))
+	proof.Queries = make([][]Opening, len(positions))
+	for q, pos := range positions {
+		proof.GroupQueries[q] = make([]Opening, f.NbRounds())
+		proof.Queries[q] = make([]Opening, f.NbRounds()-1)
+		size := int(f.domain.Cardinality)
+		for r := 0; r < f.NbRounds(); r++ {
+			m := size / k
+			l := pos % m
+			if group := c.groups[r]; len(group) > 0 {
+				values := make([]fr.Element, 0, k*len(group))
+				for t := 0; t < k; t++ {
+					for _, i := range group {
+						values = append(values, c.codewords[i][l+t*m])
+					}
+				}
+				proof.GroupQueries[q][r] = Opening{Values: values, Path: c.trees[r].path(l)}
+			}
+			if r > 0 {
+				values := make([]fr.Element, k)
+				for t := range values {
+					values[t] = folded[r][l+t*m]
+				}
+				proof.Queries[q][r-1] = Opening{Values: values, Path: trees[r].path(l)}
+			}
+			pos, size = l, m
+		}
+	}
+	return proof, nil
+}
+
+// addDeepQuotients adds to h, the evaluations of a polynomial on the domain of
+// round r, the evaluations of Gᵣ = ∑ᵢ γʲ⁽ⁱ⁾(pᵢ - yᵢ)/(X - z) for the
+// polynomials of the group of round r.
+func (f *FRI) addDeepQuotients(h []fr.Element, c *BatchCommitment, r int, z fr.Element, evaluations, gammas []fr.Element) {
+	group := c.groups[r]
+	if len(group) == 0 {
+		return
+	}
+	domain := fft.NewDomain(uint64(len(h)))
+	parallel.Execute(len(h), func(start, end int) {
+		// 1/(x - z) on the chunk
+		den := make([]fr.Element, end-start)
+		var x fr.Element
+		x.Exp(domain.Generator, big.NewInt(int64(start)))
+		for j := range den {
+			den[j].Sub(&x, &z)
+			x.Mul(&x, &domain.Generator)
+		}
+		den = fr.BatchInvert(den)
+
+		var g, t fr.Element
+		for j := range den {
+			g.SetZero()
+			for _, i := range group {
+				t.Sub(&c.codewords[i][start+j], &evaluations[i]).Mul(&t, &gammas[i])
+				g.Add(&g, &t)
+			}
+			g.Mul(&g, &den[j])
+			h[start+j].Add(&h[start+j], &g)
+		}
+	})
+}
+
+// BatchVerify verifies the proof of the evaluations proof.Evaluations at z of
+// the polynomials committed in digest.
+func (f *FRI) BatchVerify(digest *BatchDigest, z fr.Element, proof *BatchProof) error {
+	groups, err := f.groupByRound(digest.DegreeBounds)
+	if err != nil {
+		return err
+	}
+	if len(proof.Evaluations) != len(digest.DegreeBounds) {
+		return ErrNbEvaluations
+	}
+	if err := f.checkOutOfDomain(z); err != nil {
+		return err
+	}
+	if err := f.checkBatchShape(digest, groups, proof); err != nil {
+		return err
+	}
+
+	fs := f.batchTranscript()
+	gamma, err := f.bindBatch(fs, digest, z, proof.Evaluations)
+	if err != nil {
+		return err
+	}
+	gammas := powers(gamma, len(proof.Evaluations))
+	alphas := make([]fr.Element, f.NbRounds())
+	for r := range alphas {
+		var root []byte
+		if r > 0 {
+			root = proof.Roots[r-1]
+		}
+		if alphas[r], err = challenge(fs, alphaID(r), root); err != nil {
+			return err
+		}
+	}
+	seed, err := challengeBytes(fs, grindingID, marshalElements(proof.FinalPolynomial))
+	if err != nil {
+		return err
+	}
+	if !checkProofOfWork(f.h, seed, proof.Nonce, f.config.GrindingBits) {
+		return ErrGrinding
+	}
+	positions, err := f.queryPositions(fs, proof.Nonce)
+	if err != nil {
+		return err
+	}
+
+	k := f.config.FoldingFactor
+	gs := make([]fr.Element, f.NbRounds()+1)
+	gs[0] = f.domain.Generator
+	for r := 1; r < len(gs); r++ {
+		gs[r].Exp(gs[r-1], big.NewInt(int64(k)))
+	}
+	var omega fr.Element
+	omega.Inverse(&f.omegaInv[1])
+
+	h := make([]fr.Element, k)
+	xs := make([]fr.Element, k)
+	for q, pos := range positions {
+		var folded fr.Element
+		size := int(f.domain.Cardinality)
+		for r := 0; r < f.NbRounds(); r++ {
+			m := size / k
+			l := pos % m
+
+			// the points of the coset
+			xs[0].Exp(gs[r], big.NewInt(int64(l)))
+			for t := 1; t < k; t++ {
+				xs[t].Mul(&xs[t-1], &omega)
+			}
+
+			for t := range h {
+				h[t].SetZero()
+			}
+			if r > 0 {
+				opening := &proof.Queries[q][r-1]
+				if err := verifyMerklePath(f.h, proof.Roots[r-1], l, opening.Values, opening.Path); err != nil {
+					return err
+				}
+				if !opening.Values[pos/m].Equal(&folded) {
+					return ErrProximityTestFolding
+				}
+				copy(h, opening.Values)
+			}
+			if group := groups[r]; len(group) > 0 {
+				opening := &proof.GroupQueries[q][r]
+				if err := verifyMerklePath(f.h, digest.Roots[r], l, opening.Values, opening.Path); err != nil {
+					return err
+				}
+				deepQuotients(h, xs, z, opening.Values, group, proof.Evaluations, gammas)
+			}
+
+			var xInv fr.Element
+			xInv.Inverse(&xs[0])
+			folded = f.fold(h, xInv, alphas[r])
+			pos, size = l, m
+		}
+		var x fr.Element
+		x.Exp(gs[f.NbRounds()], big.NewInt(int64(pos)))
+		if e := evaluate(proof.FinalPolynomial, x); !e.Equal(&folded) {
+			return ErrProximityTestFolding
+		}
+	}
+	return nil
+}
+
+// deepQuotients adds to h[t] the value of Gᵣ at xs[t], from the values of the
+// polynomials of the group on the coset.
+func deepQuotients(h, xs []fr.Element, z fr.Element, values []fr.Element, group []int, evaluations, gammas []fr.Element) {
+	den := make([]fr.Element, len(xs))
+	for t := range xs {
+		den[t].Sub(&xs[t], &z)
+	}
+	den = fr.BatchInvert(den)
+	var g, tmp fr.Element
+	for t := range xs {
+		g.SetZero()
+		for j, i := range group {
+			tmp.Sub(&values[t*len(group)+j], &evaluations[i]).Mul(&tmp, &gammas[i])
+			g.Add(&g, &tmp)
+		}
+		g.Mul(&g, &den[t])
+		h[t].Add(&h[t], &g)
+	}
+}
+
+// checkBatchShape checks that the proof has the sizes given by the
+// configuration and the digest.
+func (f *FRI) checkBatchShape(digest *BatchDigest, groups [][]int, proof *BatchProof) error {
+	if len(digest.Roots) != f.NbRounds() ||
+		len(proof.Roots) != f.NbRounds()-1 ||
+		uint64(len(proof.FinalPolynomial)) != f.degreeBounds[f.NbRounds()] ||
+		len(proof.GroupQueries) != f.config.NbQueries ||
+		len(proof.Queries) != f.config.NbQueries {
+		return ErrProofShape
+	}
+	k := uint64(f.config.FoldingFactor)
+	for q := range proof.Queries {
+		if len(proof.GroupQueries[q]) != f.NbRounds() || len(proof.Queries[q]) != f.NbRounds()-1 {
+			return ErrProofShape
+		}
+		nbLeaves := f.domain.Cardinality / k
+		for r := 0; r < f.NbRounds(); r++ {
+			nbLevels := bits.TrailingZeros64(nbLeaves)
+			o := &proof.GroupQueries[q][r]
+			if len(groups[r]) > 0 && (len(o.Values) != int(k)*len(groups[r]) || len(o.Path) != nbLevels) {
+				return ErrProofShape
+			}
+			if r > 0 {
+				o = &proof.Queries[q][r-1]
+				if len(o.Values) != int(k) || len(o.Path) != nbLevels {
+					return ErrProofShape
+				}
+			}
+			nbLeaves /= k
+		}
+	}
+	return nil
+}
+
+// checkOutOfDomain returns an error if z is in the domain of the first round,
+// which contains the domains of the other rounds.
+func (f *FRI) checkOutOfDomain(z fr.Element) error {
+	var zN fr.Element
+	zN.Exp(z, new(big.Int).SetUint64(f.domain.Cardinality))
+	if zN.IsOne() {
+		return ErrPointInDomain
+	}
+	return nil
+}
+
+const gammaID = "gamma"
+
+// batchTranscript returns the Fiat Shamir transcript of batched FRI, whose
+// first challenge γ combines the DEEP quotients.
+func (f *FRI) batchTranscript() *fiatshamir.Transcript {
+	ids := make([]string, 0, f.NbRounds()+2+f.config.NbQueries)
+	ids = append(ids, gammaID)
+	for r := 0; r < f.NbRounds(); r++ {
+		ids = append(ids, alphaID(r))
+	}
+	ids = append(ids, grindingID)
+	for q := 0; q < f.config.NbQueries; q++ {
+		ids = append(ids, queryID(q))
+	}
+	return fiatshamir.NewTranscript(f.h, ids...)
+}
+
+// bindBatch binds the digest, the point and the evaluations, and returns γ.
+// The degree bounds are bound first, then each round is bound with its index
+// and a flag set if it has a root, followed by the root, so that the
+// transcript also pins down the rounds of the groups.
+func (f *FRI) bindBatch(fs *fiatshamir.Transcript, digest *BatchDigest, z fr.Element, evaluations []fr.Element) (fr.Element, error) {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(len(digest.DegreeBounds)))
+	if err := fs.Bind(gammaID, buf[:]); err != nil {
+		return fr.Element{}, err
+	}
+	for _, d := range digest.DegreeBounds {
+		binary.BigEndian.PutUint64(buf[:], d)
+		if err := fs.Bind(gammaID, buf[:]); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for r, root := range digest.Roots {
+		var marker [9]byte
+		binary.BigEndian.PutUint64(marker[:8], uint64(r))
+		if root != nil {
+			marker[8] = 1
+		}
+		if err := fs.Bind(gammaID, marker[:]); err != nil {
+			return fr.Element{}, err
+		}
+		if root != nil {
+			if err := fs.Bind(gammaID, root); err != nil {
+				return fr.Element{}, err
+			}
+		}
+	}
+	zb := z.Bytes()
+	if err := fs.Bind(gammaID, zb[:]); err != nil {
+		return fr.Element{}, err
+	}
+	return challenge(fs, gammaID, marshalElements(evaluations))
+}
+
+// powers returns 1, γ, …, γⁿ⁻¹.
+func powers(gamma fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	if n == 0 {
+		return res
+	}
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &gamma)
+	}
+	return res
+}
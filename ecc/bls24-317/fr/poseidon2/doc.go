@@ -0,0 +1,32 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package poseidon2 implements the Poseidon2 permutation, and a hash function
+// in Merkle–Damgård mode on top of it.
+//
+// # Hash function
+//
+// The hasher returned by NewMerkleDamgardHasher implements hash.Hash and
+// hash.StateStorer, and is registered as hash.POSEIDON2_BLS24_317. It uses the
+// permutation P of width 2 and the compression
+//
+//	f(h, m) = P(h ‖ m)[1] + m,
+//
+// the chaining value h being the capacity of the construction. The input is a
+// sequence of field elements m₁, ..., mₙ and the digest is
+//
+//	f(...f(f(h₀, m₁), m₂)..., mₙ), n),
+//
+// where h₀ is zero or derived from a domain separation tag. The length n is
+// absorbed last so that the inputs which differ by trailing zeros have
+// different digests.
+//
+// # Hash input format
+//
+// As for MiMC, the input to the hash function is a byte slice, interpreted as
+// a sequence of field elements. The input byte slice length must be multiple
+// of the field modulus size, and every sequence of bytes for a single field
+// element must be strictly less than the field modulus.
+package poseidon2
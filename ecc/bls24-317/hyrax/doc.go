@@ -0,0 +1,15 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package hyrax provides a transparent commitment scheme for multilinear polynomials (Hyrax), cf https://eprint.iacr.org/2017/1132.pdf
+//
+// The evaluations of a multilinear polynomial on the boolean hypercube are
+// arranged in a matrix whose rows are committed to with Pedersen vector
+// commitments, on bases obtained by hashing to G1. An opening reduces the
+// rows to a single vector using the tensor structure of the point, whose
+// inner product with the remaining part of the point is proven with an
+// inner-product argument. No trusted setup is needed, and the commitments and
+// proofs only involve multi-scalar multiplications in G1.
+package hyrax
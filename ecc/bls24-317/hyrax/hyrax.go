@@ -0,0 +1,424 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package hyrax
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/polynomial"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidPolynomialSize = errors.New("the size of the multilinear polynomial must be a power of 2, at least 2 and small enough for the key")
+	ErrInvalidNbVariables    = errors.New("the number of coordinates of the point must be the number of variables of the polynomial")
+	ErrInvalidCommitment     = errors.New("the number of row commitments does not match the number of variables")
+	ErrInvalidOpeningProof   = errors.New("malformed opening proof")
+	ErrVerifyOpeningProof    = errors.New("can't verify opening proof")
+)
+
+// The evaluations m[0], ..., m[2ⁿ-1] of a multilinear polynomial f(X₁, ..., Xₙ)
+// (X₁ being the most significant bit of the index, as in polynomial.MultiLin)
+// are arranged in a matrix M of 2ⁿʳ rows and 2ⁿᶜ columns, where nᶜ = ⌈n/2⌉ and
+// nʳ = n - nᶜ, so that the row index is given by X₁, ..., Xₙʳ. The commitment
+// is the list of the Pedersen commitments Cᵢ = ⟨Mᵢ, G⟩ to the rows.
+//
+// For a point u = (uʳ, uᶜ), f(u) = Lᵀ M R where L = eq(uʳ, ·) and R = eq(uᶜ, ·).
+// The prover sends f(u), and both parties compute the commitment
+// ∑ᵢ LᵢCᵢ = ⟨LᵀM, G⟩ to the vector v = LᵀM. It remains to prove that ⟨v, R⟩ = f(u),
+// which is done with the inner-product argument of Bulletproofs, for the
+// public vector R.
+
+// Key public parameters of the commitment scheme, obtained by hashing to G1
+// so that no discrete logarithm relation between the points is known.
+//
+// implements io.ReaderFrom and io.WriterTo
+type Key struct {
+	// G bases of the Pedersen commitments to the rows
+	G []bls24317.G1Affine
+
+	// U base of the inner products in the inner-product argument
+	U bls24317.G1Affine
+}
+
+// OpeningProof proof that a committed multilinear polynomial evaluates to
+// ClaimedValue at a point.
+//
+// implements io.ReaderFrom and io.WriterTo
+type OpeningProof struct {
+	// ClaimedValue value of the multilinear polynomial at the point
+	ClaimedValue fr.Element
+
+	// L, R cross terms of the rounds of the inner-product argument
+	L, R []bls24317.G1Affine
+
+	// A last value of the folded vector
+	A fr.Element
+}
+
+// NewKey returns a Key for multilinear polynomials of at most maxNbVariables
+// variables. The points are G[i] = HashToG1(i, dst), with i encoded on 8 bytes,
+// and U = HashToG1("U", dst).
+func NewKey(maxNbVariables int, dst []byte) (Key, error) {
+	if maxNbVariables < 1 || maxNbVariables > 62 {
+		return Key{}, ErrInvalidNbVariables
+	}
+	_, nbColumns := dimensions(maxNbVariables)
+
+	var res Key
+	res.G = make([]bls24317.G1Affine, nbColumns)
+	var err error
+	parallel.Execute(nbColumns, func(start, end int) {
+		var msg [8]byte
+		for i := start; i < end; i++ {
+			binary.BigEndian.PutUint64(msg[:], uint64(i))
+			g, _err := bls24317.HashToG1(msg[:], dst)
+			if _err != nil {
+				err = _err
+				return
+			}
+			res.G[i] = g
+		}
+	})
+	if err != nil {
+		return Key{}, err
+	}
+	if res.U, err = bls24317.HashToG1([]byte("U"), dst); err != nil {
+		return Key{}, err
+	}
+	return res, nil
+}
+
+// Commit returns the commitments to the rows of the matrix of evaluations of m.
+func Commit(m polynomial.MultiLin, key Key, nbTasks ...int) ([]bls24317.G1Affine, error) {
+	n, err := nbVariables(m, key)
+	if err != nil {
+		return nil, err
+	}
+	nbRows, nbColumns := dimensions(n)
+
+	config := ecc.MultiExpConfig{}
+	if len(nbTasks) > 0 {
+		config.NbTasks = nbTasks[0]
+	}
+	res := make([]bls24317.G1Affine, nbRows)
+	for i := range res {
+		if _, err := res[i].MultiExp(key.G[:nbColumns], m[i*nbColumns:(i+1)*nbColumns], config); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// Open computes an opening proof of the multilinear polynomial m, committed in
+// commitment, at point.
+//
+// * dataTranscript extra data that might be needed to derive the challenges
+func Open(m polynomial.MultiLin, commitment []bls24317.G1Affine, point []fr.Element, hf hash.Hash, key Key, dataTranscript ...[]byte) (OpeningProof, error) {
+	n, err := nbVariables(m, key)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	if len(point) != n {
+		return OpeningProof{}, ErrInvalidNbVariables
+	}
+	nbRows, nbColumns := dimensions(n)
+	nbRounds := bitLen(nbColumns)
+	if len(commitment) != nbRows {
+		return OpeningProof{}, ErrInvalidCommitment
+	}
+
+	// v = LᵀM
+	l := eq(point[:n-nbRounds])
+	a := make([]fr.Element, nbColumns)
+	parallel.Execute(nbColumns, func(start, end int) {
+		var t fr.Element
+		for i := range l {
+			row := m[i*nbColumns : (i+1)*nbColumns]
+			for j := start; j < end; j++ {
+				t.Mul(&row[j], &l[i])
+				a[j].Add(&a[j], &t)
+			}
+		}
+	})
+	b := eq(point[n-nbRounds:])
+
+	var res OpeningProof
+	res.ClaimedValue = innerProduct(a, b)
+
+	fs := newTranscript(hf, nbRounds)
+	u, err := deriveBase(fs, commitment, point, res.ClaimedValue, key, dataTranscript...)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	g := make([]bls24317.G1Affine, nbColumns)
+	copy(g, key.G)
+	res.L = make([]bls24317.G1Affine, nbRounds)
+	res.R = make([]bls24317.G1Affine, nbRounds)
+	for k := 0; k < nbRounds; k++ {
+		h := len(a) / 2
+
+		// L = ⟨a_lo, G_hi⟩ + ⟨a_lo, b_hi⟩U, R = ⟨a_hi, G_lo⟩ + ⟨a_hi, b_lo⟩U
+		cL, cR := innerProduct(a[:h], b[h:]), innerProduct(a[h:], b[:h])
+		if _, err := res.L[k].MultiExp(append(g[h:], u), append(a[:h:h], cL), ecc.MultiExpConfig{}); err != nil {
+			return OpeningProof{}, err
+		}
+		if _, err := res.R[k].MultiExp(append(g[:h:h], u), append(a[h:], cR), ecc.MultiExpConfig{}); err != nil {
+			return OpeningProof{}, err
+		}
+
+		x, err := deriveRoundChallenge(fs, k, &res.L[k], &res.R[k])
+		if err != nil {
+			return OpeningProof{}, err
+		}
+		var xInv fr.Element
+		xInv.Inverse(&x)
+
+		// a' = x a_lo + x⁻¹ a_hi, b' = x⁻¹ b_lo + x b_hi, G' = x⁻¹ G_lo + x G_hi
+		a = foldScalars(a, x, xInv)
+		b = foldScalars(b, xInv, x)
+		g = foldPoints(g, xInv, x)
+	}
+	res.A = a[0]
+
+	return res, nil
+}
+
+// Verify verifies that the multilinear polynomial committed in commitment
+// evaluates to proof.ClaimedValue at point.
+func Verify(commitment []bls24317.G1Affine, proof *OpeningProof, point []fr.Element, hf hash.Hash, key Key, dataTranscript ...[]byte) error {
+	n := len(point)
+	if n == 0 || n > 62 {
+		return ErrInvalidNbVariables
+	}
+	nbRows, nbColumns := dimensions(n)
+	nbRounds := bitLen(nbColumns)
+	if len(commitment) != nbRows {
+		return ErrInvalidCommitment
+	}
+	if nbColumns > len(key.G) {
+		return ErrInvalidNbVariables
+	}
+	if len(proof.L) != nbRounds || len(proof.R) != nbRounds {
+		return ErrInvalidOpeningProof
+	}
+
+	fs := newTranscript(hf, nbRounds)
+	u, err := deriveBase(fs, commitment, point, proof.ClaimedValue, key, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	x := make([]fr.Element, nbRounds)
+	for k := range x {
+		if x[k], err = deriveRoundChallenge(fs, k, &proof.L[k], &proof.R[k]); err != nil {
+			return err
+		}
+	}
+	xInv := fr.BatchInvert(x)
+
+	// the folded bases are G' = ⟨s, G⟩, where sᵢ is the product of the xₖ or
+	// x⁻¹ₖ depending on the k-th most significant bit of i.
+	s := make([]fr.Element, 1, nbColumns)
+	s[0].SetOne()
+	for k := range x {
+		s = s[:2*len(s)]
+		for i := len(s)/2 - 1; i >= 0; i-- {
+			s[2*i+1].Mul(&s[i], &x[k])
+			s[2*i].Mul(&s[i], &xInv[k])
+		}
+	}
+	b := eq(point[n-nbRounds:])
+	bFolded := innerProduct(s, b)
+
+	// check that ∑ᵢ LᵢCᵢ + ∑ₖ (x²ₖLₖ + x⁻²ₖRₖ) + wf(u)U = A⟨s, G⟩ + A b' wU
+	// with a single multi-scalar multiplication
+	points := make([]bls24317.G1Affine, 0, nbRows+2*nbRounds+nbColumns+1)
+	points = append(points, commitment...)
+	points = append(points, proof.L...)
+	points = append(points, proof.R...)
+	points = append(points, key.G[:nbColumns]...)
+	points = append(points, u)
+
+	scalars := make([]fr.Element, 0, cap(points))
+	scalars = append(scalars, eq(point[:n-nbRounds])...)
+	for k := range x {
+		var t fr.Element
+		scalars = append(scalars, *t.Square(&x[k]))
+	}
+	for k := range xInv {
+		var t fr.Element
+		scalars = append(scalars, *t.Square(&xInv[k]))
+	}
+	for i := range s {
+		var t fr.Element
+		t.Mul(&s[i], &proof.A).Neg(&t)
+		scalars = append(scalars, t)
+	}
+	var t fr.Element
+	t.Mul(&bFolded, &proof.A).Sub(&proof.ClaimedValue, &t)
+	scalars = append(scalars, t)
+
+	var check bls24317.G1Jac
+	if _, err := check.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	if !check.Z.IsZero() {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// nbVariables returns the number of variables of m, and checks that m can be
+// committed to with key.
+func nbVariables(m polynomial.MultiLin, key Key) (int, error) {
+	if len(m) < 2 || len(m)&(len(m)-1) != 0 {
+		return 0, ErrInvalidPolynomialSize
+	}
+	n := m.NumVars()
+	if _, nbColumns := dimensions(n); nbColumns > len(key.G) {
+		return 0, ErrInvalidPolynomialSize
+	}
+	return n, nil
+}
+
+// dimensions returns the number of rows and columns of the matrix of
+// evaluations of a polynomial in n variables.
+func dimensions(n int) (nbRows, nbColumns int) {
+	nbColumns = 1 << ((n + 1) / 2)
+	nbRows = 1 << (n / 2)
+	return
+}
+
+// bitLen returns log₂(n) for a power of 2.
+func bitLen(n int) int {
+	return bits.TrailingZeros(uint(n))
+}
+
+// eq returns the evaluations of eq(q, ·) on the boolean hypercube.
+func eq(q []fr.Element) []fr.Element {
+	res := make(polynomial.MultiLin, 1<<len(q))
+	res[0].SetOne()
+	res.Eq(q)
+	return res
+}
+
+func innerProduct(a, b []fr.Element) fr.Element {
+	var res, t fr.Element
+	for i := range a {
+		t.Mul(&a[i], &b[i])
+		res.Add(&res, &t)
+	}
+	return res
+}
+
+// foldScalars returns cLo v_lo + cHi v_hi.
+func foldScalars(v []fr.Element, cLo, cHi fr.Element) []fr.Element {
+	h := len(v) / 2
+	res := make([]fr.Element, h)
+	var t fr.Element
+	for i := range res {
+		res[i].Mul(&v[i], &cLo)
+		t.Mul(&v[h+i], &cHi)
+		res[i].Add(&res[i], &t)
+	}
+	return res
+}
+
+// foldPoints returns cLo g_lo + cHi g_hi.
+func foldPoints(g []bls24317.G1Affine, cLo, cHi fr.Element) []bls24317.G1Affine {
+	h := len(g) / 2
+	var bLo, bHi big.Int
+	cLo.BigInt(&bLo)
+	cHi.BigInt(&bHi)
+	res := make([]bls24317.G1Jac, h)
+	parallel.Execute(h, func(start, end int) {
+		var lo, hi bls24317.G1Jac
+		for i := start; i < end; i++ {
+			lo.FromAffine(&g[i])
+			hi.FromAffine(&g[h+i])
+			res[i].ScalarMultiplication(&lo, &bLo)
+			hi.ScalarMultiplication(&hi, &bHi)
+			res[i].AddAssign(&hi)
+		}
+	})
+	return bls24317.BatchJacobianToAffineG1(res)
+}
+
+func newTranscript(hf hash.Hash, nbRounds int) *fiatshamir.Transcript {
+	challenges := make([]string, nbRounds+1)
+	challenges[0] = "w"
+	for k := 0; k < nbRounds; k++ {
+		challenges[k+1] = "x" + strconv.Itoa(k)
+	}
+	return fiatshamir.NewTranscript(hf, challenges...)
+}
+
+// deriveBase derives the challenge w, binded to the commitment, the point and
+// the claimed value, and returns wU. Scaling U by a challenge prevents the
+// prover from choosing the claimed value after the commitment.
+func deriveBase(fs *fiatshamir.Transcript, commitment []bls24317.G1Affine, point []fr.Element, claimedValue fr.Element, key Key, dataTranscript ...[]byte) (bls24317.G1Affine, error) {
+	for i := range commitment {
+		if err := fs.Bind("w", commitment[i].Marshal()); err != nil {
+			return bls24317.G1Affine{}, err
+		}
+	}
+	for i := range point {
+		if err := fs.Bind("w", point[i].Marshal()); err != nil {
+			return bls24317.G1Affine{}, err
+		}
+	}
+	if err := fs.Bind("w", claimedValue.Marshal()); err != nil {
+		return bls24317.G1Affine{}, err
+	}
+	for i := range dataTranscript {
+		if err := fs.Bind("w", dataTranscript[i]); err != nil {
+			return bls24317.G1Affine{}, err
+		}
+	}
+	b, err := fs.ComputeChallenge("w")
+	if err != nil {
+		return bls24317.G1Affine{}, err
+	}
+	var w fr.Element
+	w.SetBytes(b)
+	var bw big.Int
+	w.BigInt(&bw)
+	var res bls24317.G1Affine
+	res.ScalarMultiplication(&key.U, &bw)
+	return res, nil
+}
+
+// deriveRoundChallenge derives the challenge of the k-th round of the
+// inner-product argument, binded to the cross terms.
+func deriveRoundChallenge(fs *fiatshamir.Transcript, k int, l, r *bls24317.G1Affine) (fr.Element, error) {
+	name := "x" + strconv.Itoa(k)
+	if err := fs.Bind(name, l.Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+	if err := fs.Bind(name, r.Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+	b, err := fs.ComputeChallenge(name)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	if res.IsZero() {
+		return fr.Element{}, ErrVerifyOpeningProof
+	}
+	return res, nil
+}
@@ -34,12 +34,17 @@ type Digest = bls24317.G1Affine
 // ProvingKey used to create or open commitments
 type ProvingKey struct {
 	G1 []bls24317.G1Affine // [G₁ [α]G₁ , [α²]G₁, ... ]
+
+	// Gamma are the blinding generators [γ]G₁, [γα]G₁, ... used for hiding
+	// commitments. It is empty if the SRS does not support hiding commitments.
+	Gamma []bls24317.G1Affine
 }
 
 // VerifyingKey used to verify opening proofs
 type VerifyingKey struct {
 	G2    [2]bls24317.G2Affine // [G₂, [α]G₂ ]
 	G1    bls24317.G1Affine
+	Gamma bls24317.G1Affine                                               // [γ]G₁, or the point at infinity if the SRS does not support hiding commitments
 	Lines [2][2][len(bls24317.LoopCounter) - 1]bls24317.LineEvaluationAff // precomputed pairing lines corresponding to G₂, [α]G₂
 }
 
@@ -68,8 +73,11 @@ func eval(p []fr.Element, point fr.Element) fr.Element {
 //
 // Set Alpha = -1 to generate quickly a balanced, valid SRS (useful for benchmarking).
 //
+// If bGamma is provided, the SRS supports hiding commitments with the blinding
+// generators [γ]G₁, [γα]G₁, ..., [γα^HidingBound]G₁ (see CommitHiding).
+//
 // implements io.ReaderFrom and io.WriterTo
-func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
+func NewSRS(size uint64, bAlpha *big.Int, bGamma ...*big.Int) (*SRS, error) {
 
 	if size < 2 {
 		return nil, ErrMinSRSSize
@@ -112,6 +120,9 @@ func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
 		srs.Vk.G2[1].ScalarMultiplication(&srs.Vk.G2[0], &bt)
 		srs.Vk.Lines[0] = bls24317.PrecomputeLines(srs.Vk.G2[0])
 		srs.Vk.Lines[1] = bls24317.PrecomputeLines(srs.Vk.G2[1])
+		if len(bGamma) > 0 {
+			srs.setGamma(t, bGamma[0])
+		}
 		return &srs, nil
 	}
 	srs.Pk.G1[0] = gen1Aff
@@ -128,6 +139,9 @@ func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
 	}
 	g1s := bls24317.BatchScalarMultiplicationG1(&gen1Aff, alphas)
 	copy(srs.Pk.G1[1:], g1s)
+	if len(bGamma) > 0 {
+		srs.setGamma(alpha, bGamma[0])
+	}
 
 	return &srs, nil
 }
@@ -0,0 +1,131 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package accumulator
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// Polynomials are in canonical form, the i-th coefficient being the one of Xⁱ.
+
+// characteristicPolynomial returns ∏ᵢ(X - eᵢ).
+func characteristicPolynomial(elements []fr.Element) []fr.Element {
+	res := make([]fr.Element, 1, len(elements)+1)
+	res[0].SetOne()
+	for i := range elements {
+		res = mulByLinear(res, elements[i])
+	}
+	return res
+}
+
+// mulByLinear returns p(X)(X - e), possibly reusing the memory of p.
+func mulByLinear(p []fr.Element, e fr.Element) []fr.Element {
+	p = append(p, fr.Element{})
+	var t fr.Element
+	for i := len(p) - 1; i > 0; i-- {
+		t.Mul(&p[i], &e)
+		p[i].Sub(&p[i-1], &t)
+	}
+	p[0].Mul(&p[0], &e).Neg(&p[0])
+	return p
+}
+
+// divideByLinear returns the quotient and the remainder p(e) of the division
+// of p by X - e.
+func divideByLinear(p []fr.Element, e fr.Element) ([]fr.Element, fr.Element) {
+	q := make([]fr.Element, len(p)-1)
+	var r fr.Element
+	r.Set(&p[len(p)-1])
+	for i := len(p) - 2; i >= 0; i-- {
+		q[i] = r
+		r.Mul(&r, &e).Add(&r, &p[i])
+	}
+	return q, r
+}
+
+// mul returns p·q.
+func mul(p, q []fr.Element) []fr.Element {
+	if len(p) == 0 || len(q) == 0 {
+		return nil
+	}
+	res := make([]fr.Element, len(p)+len(q)-1)
+	var t fr.Element
+	for i := range p {
+		for j := range q {
+			t.Mul(&p[i], &q[j])
+			res[i+j].Add(&res[i+j], &t)
+		}
+	}
+	return res
+}
+
+// sub returns p - q.
+func sub(p, q []fr.Element) []fr.Element {
+	res := make([]fr.Element, max(len(p), len(q)))
+	copy(res, p)
+	for i := range q {
+		res[i].Sub(&res[i], &q[i])
+	}
+	return trim(res)
+}
+
+// trim removes the leading zero coefficients of p.
+func trim(p []fr.Element) []fr.Element {
+	for len(p) > 0 && p[len(p)-1].IsZero() {
+		p = p[:len(p)-1]
+	}
+	return p
+}
+
+// divMod returns the quotient and the remainder of the division of p by d,
+// whose leading coefficient is non-zero.
+func divMod(p, d []fr.Element) (q, r []fr.Element) {
+	r = trim(append([]fr.Element{}, p...))
+	if len(r) < len(d) {
+		return nil, r
+	}
+	var lInv, t fr.Element
+	lInv.Inverse(&d[len(d)-1])
+	q = make([]fr.Element, len(r)-len(d)+1)
+	for i := len(q) - 1; i >= 0; i-- {
+		q[i].Mul(&r[i+len(d)-1], &lInv)
+		for j := range d {
+			t.Mul(&q[i], &d[j])
+			r[i+j].Sub(&r[i+j], &t)
+		}
+	}
+	return q, trim(r[:len(d)-1])
+}
+
+// bezout returns a and b such that a·f + b·g = 1, with deg a < deg g and
+// deg b < deg f, where g is monic and deg g ≥ 1. It returns ErrElementInSet if
+// f and g have a common root.
+func bezout(f, g []fr.Element) (a, b []fr.Element, err error) {
+	// extended Euclidean algorithm on (g, f mod g), keeping uᵢ such that
+	// uᵢf ≡ rᵢ mod g
+	_, r1 := divMod(f, g)
+	r0 := g
+	var u0, u1 []fr.Element
+	u1 = []fr.Element{fr.One()}
+	for len(r1) > 1 {
+		q, r := divMod(r0, r1)
+		r0, r1 = r1, r
+		u0, u1 = u1, sub(u0, mul(q, u1))
+	}
+	if len(r1) == 0 {
+		return nil, nil, ErrElementInSet
+	}
+
+	// a = u/r, b = (1 - af)/g
+	var rInv fr.Element
+	rInv.Inverse(&r1[0])
+	a = make([]fr.Element, len(u1))
+	for i := range a {
+		a[i].Mul(&u1[i], &rInv)
+	}
+	b, _ = divMod(sub([]fr.Element{fr.One()}, mul(a, f)), g)
+	return a, b, nil
+}
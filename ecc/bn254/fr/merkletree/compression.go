@@ -0,0 +1,99 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package merkletree
+
+import (
+	"errors"
+	"hash"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+var ErrInvalidHashSize = errors.New("the size of the hash must be the size of a digest")
+
+// Compression 2-to-1 compression function of digests. The digest of a node of
+// arity k is C(...C(C(d₀, d₁), d₂)..., dₖ₋₁) where the dᵢ are the digests of
+// its children.
+//
+// Implementations must be safe for concurrent use, as trees are built in
+// parallel.
+type Compression interface {
+	Compress(left, right *Digest) Digest
+}
+
+// Permutation permutation of 2·DigestSize field elements, such as Poseidon2.
+type Permutation interface {
+	// Permutation applies the permutation on input, and stores the result in
+	// input.
+	Permutation(input []fr.Element) error
+}
+
+// NewPermutationCompression returns the compression
+// (l, r) ↦ P(l ‖ r)[DigestSize:] + r, where P is the permutation, of width
+// 2·DigestSize.
+func NewPermutationCompression(p Permutation) (Compression, error) {
+	var input [2 * DigestSize]fr.Element
+	if err := p.Permutation(input[:]); err != nil {
+		return nil, err
+	}
+	return permutationCompression{p}, nil
+}
+
+type permutationCompression struct {
+	p Permutation
+}
+
+func (c permutationCompression) Compress(left, right *Digest) Digest {
+	var input [2 * DigestSize]fr.Element
+	copy(input[:DigestSize], left[:])
+	copy(input[DigestSize:], right[:])
+
+	// the width was checked when creating the compression
+	_ = c.p.Permutation(input[:])
+
+	var res Digest
+	for i := range res {
+		res[i].Add(&input[DigestSize+i], &right[i])
+	}
+	return res
+}
+
+// NewHashCompression returns the compression (l, r) ↦ H(l ‖ r), where H is a
+// hash function created by newHash and the digests are written as the
+// big-endian encodings of their elements. The output of H, of
+// DigestSize·fr.Bytes bytes, is reduced to DigestSize elements.
+//
+// With MiMC, this is the compression in Miyaguchi–Preneel mode.
+func NewHashCompression(newHash func() hash.Hash) (Compression, error) {
+	if newHash().Size() != DigestSize*fr.Bytes {
+		return nil, ErrInvalidHashSize
+	}
+	return &hashCompression{pool: sync.Pool{New: func() any { return newHash() }}}, nil
+}
+
+type hashCompression struct {
+	pool sync.Pool
+}
+
+func (c *hashCompression) Compress(left, right *Digest) Digest {
+	h := c.pool.Get().(hash.Hash)
+	defer c.pool.Put(h)
+	h.Reset()
+	for _, d := range []*Digest{left, right} {
+		for i := range d {
+			b := d[i].Bytes()
+			h.Write(b[:])
+		}
+	}
+	sum := h.Sum(nil)
+
+	var res Digest
+	for i := range res {
+		res[i].SetBytes(sum[i*fr.Bytes : (i+1)*fr.Bytes])
+	}
+	return res
+}
@@ -0,0 +1,39 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package merkletree
+
+import (
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+// WriteTo writes binary encoding of a MultiProof: the number of elements of the
+// siblings, on 4 bytes, followed by the elements.
+func (proof *MultiProof) WriteTo(w io.Writer) (int64, error) {
+	v := make(fr.Vector, 0, len(proof.Siblings)*DigestSize)
+	for i := range proof.Siblings {
+		v = append(v, proof.Siblings[i][:]...)
+	}
+	return v.WriteTo(w)
+}
+
+// ReadFrom decodes MultiProof data from reader.
+func (proof *MultiProof) ReadFrom(r io.Reader) (int64, error) {
+	var v fr.Vector
+	n, err := v.ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+	if len(v)%DigestSize != 0 {
+		return n, ErrInvalidProof
+	}
+	proof.Siblings = make([]Digest, len(v)/DigestSize)
+	for i := range proof.Siblings {
+		copy(proof.Siblings[i][:], v[i*DigestSize:(i+1)*DigestSize])
+	}
+	return n, nil
+}
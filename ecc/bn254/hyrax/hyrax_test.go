@@ -0,0 +1,240 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package hyrax
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/polynomial"
+	"github.com/stretchr/testify/require"
+)
+
+// Key re-used across tests of the Hyrax scheme. It has 2⁵ columns, which is
+// enough for polynomials of up to 10 variables.
+var testKey Key
+
+func init() {
+	var err error
+	testKey, err = NewKey(9, []byte("hyrax test"))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func randomElements(size int) []fr.Element {
+	res := make([]fr.Element, size)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+// openAndVerify commits to m, opens it at point and checks the proof.
+func openAndVerify(t *testing.T, m polynomial.MultiLin, point []fr.Element, key Key) ([]bn254.G1Affine, OpeningProof) {
+	assert := require.New(t)
+
+	commitment, err := Commit(m, key)
+	assert.NoError(err)
+	proof, err := Open(m, commitment, point, sha256.New(), key)
+	assert.NoError(err)
+	assert.Equal(m.Evaluate(point, nil), proof.ClaimedValue)
+	assert.NoError(Verify(commitment, &proof, point, sha256.New(), key))
+	return commitment, proof
+}
+
+func TestOpen(t *testing.T) {
+	assert := require.New(t)
+
+	for _, n := range []int{2, 4, 8, 10} {
+		point := randomElements(n)
+		commitment, proof := openAndVerify(t, randomElements(1<<n), point, testKey)
+
+		// the matrix is square
+		assert.Len(commitment, 1<<(n/2))
+		assert.Len(proof.L, n/2)
+
+		// wrong claimed value
+		proof.ClaimedValue.SetRandom()
+		assert.Error(Verify(commitment, &proof, point, sha256.New(), testKey))
+
+		// wrong point
+		point[n-1].SetRandom()
+		assert.Error(Verify(commitment, &proof, point, sha256.New(), testKey))
+	}
+}
+
+func TestOddNbVariables(t *testing.T) {
+	assert := require.New(t)
+
+	for _, n := range []int{1, 3, 5, 7, 9} {
+		// the matrix has twice as many columns as rows
+		nbRows, nbColumns := 1<<(n/2), 1<<((n+1)/2)
+		m := polynomial.MultiLin(randomElements(1 << n))
+		point := randomElements(n)
+		commitment, proof := openAndVerify(t, m, point, testKey)
+		assert.Len(commitment, nbRows)
+		assert.Len(proof.L, (n+1)/2)
+		assert.Len(proof.R, (n+1)/2)
+
+		// the commitments are the ones of the consecutive rows, of nbColumns
+		// evaluations each
+		for i := range commitment {
+			var expected bn254.G1Affine
+			_, err := expected.MultiExp(testKey.G[:nbColumns], m[i*nbColumns:(i+1)*nbColumns], ecc.MultiExpConfig{})
+			assert.NoError(err)
+			assert.Equal(expected, commitment[i], "row %d of %d variables", i, n)
+		}
+
+		// the row is selected by the n/2 most significant variables: on the
+		// last row, f is the multilinear polynomial of the row in the
+		// remaining variables
+		for i := 0; i < n/2; i++ {
+			point[i].SetOne()
+		}
+		lastRow := polynomial.MultiLin(m[(nbRows-1)*nbColumns:])
+		proof, err := Open(m, commitment, point, sha256.New(), testKey)
+		assert.NoError(err)
+		assert.Equal(lastRow.Evaluate(point[n/2:], nil), proof.ClaimedValue)
+		assert.NoError(Verify(commitment, &proof, point, sha256.New(), testKey))
+	}
+
+	// 4 and 5 variables give the same number of rows, but not the same
+	// number of rounds in the inner-product argument
+	point := randomElements(5)
+	commitment, proof := openAndVerify(t, randomElements(1<<5), point, testKey)
+	assert.ErrorIs(Verify(commitment, &proof, point[:4], sha256.New(), testKey), ErrInvalidOpeningProof)
+	_, err := Open(randomElements(1<<4), commitment, point, sha256.New(), testKey)
+	assert.ErrorIs(err, ErrInvalidNbVariables)
+}
+
+func TestOpenTampered(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 6
+	m := polynomial.MultiLin(randomElements(1 << n))
+	point := randomElements(n)
+	commitment, proof := openAndVerify(t, m, point, testKey)
+
+	// wrong row commitment
+	save := commitment[1]
+	commitment[1] = commitment[0]
+	assert.Error(Verify(commitment, &proof, point, sha256.New(), testKey))
+	commitment[1] = save
+
+	// wrong cross term
+	proof.L[0], proof.R[0] = proof.R[0], proof.L[0]
+	assert.Error(Verify(commitment, &proof, point, sha256.New(), testKey))
+	proof.L[0], proof.R[0] = proof.R[0], proof.L[0]
+
+	// wrong final value
+	proof.A.SetRandom()
+	assert.Error(Verify(commitment, &proof, point, sha256.New(), testKey))
+
+	// malformed proof
+	proof.L = proof.L[1:]
+	assert.ErrorIs(Verify(commitment, &proof, point, sha256.New(), testKey), ErrInvalidOpeningProof)
+
+	// malformed commitment
+	assert.ErrorIs(Verify(commitment[1:], &proof, point, sha256.New(), testKey), ErrInvalidCommitment)
+
+	// extra data in the transcript
+	proof, err := Open(m, commitment, point, sha256.New(), testKey)
+	assert.NoError(err)
+	assert.Error(Verify(commitment, &proof, point, sha256.New(), testKey, []byte("data")))
+}
+
+func TestKeySize(t *testing.T) {
+	assert := require.New(t)
+
+	// the key only bounds the number of columns: a key for 9 variables can
+	// commit to 10 variables, but not to 11
+	_, err := Commit(make(polynomial.MultiLin, 1<<11), testKey)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	point := randomElements(11)
+	assert.ErrorIs(Verify(make([]bn254.G1Affine, 1<<5), &OpeningProof{}, point, sha256.New(), testKey), ErrInvalidNbVariables)
+
+	// a key for 8 variables has 2⁴ columns, too few for 9 variables
+	key, err := NewKey(8, []byte("hyrax test"))
+	assert.NoError(err)
+	assert.Len(key.G, 1<<4)
+	_, err = Commit(make(polynomial.MultiLin, 1<<9), key)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+
+	_, err = Commit(make(polynomial.MultiLin, 3), testKey)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	_, err = Commit(make(polynomial.MultiLin, 1), testKey)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	_, err = NewKey(0, []byte("hyrax test"))
+	assert.ErrorIs(err, ErrInvalidNbVariables)
+}
+
+func TestNewKey(t *testing.T) {
+	assert := require.New(t)
+
+	// the key is deterministic, and a smaller key is a prefix of a larger one
+	key, err := NewKey(4, []byte("hyrax test"))
+	assert.NoError(err)
+	assert.Equal(testKey.G[:len(key.G)], key.G)
+	assert.Equal(testKey.U, key.U)
+
+	g, err := bn254.HashToG1([]byte{0, 0, 0, 0, 0, 0, 0, 3}, []byte("hyrax test"))
+	assert.NoError(err)
+	assert.Equal(g, key.G[3])
+
+	other, err := NewKey(4, []byte("other"))
+	assert.NoError(err)
+	assert.NotEqual(key.G, other.G)
+}
+
+func TestSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 5
+	_, proof := openAndVerify(t, randomElements(1<<n), randomElements(n), testKey)
+
+	var buf bytes.Buffer
+	written, err := proof.WriteTo(&buf)
+	assert.NoError(err)
+	assert.Equal(int64(buf.Len()), written)
+
+	var decoded OpeningProof
+	read, err := decoded.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(proof, decoded)
+
+	buf.Reset()
+	written, err = testKey.WriteTo(&buf)
+	assert.NoError(err)
+	var key Key
+	read, err = key.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(testKey, key)
+}
+
+func BenchmarkOpen(b *testing.B) {
+	const n = 15
+	key, err := NewKey(n, []byte("hyrax bench"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	m := polynomial.MultiLin(randomElements(1 << n))
+	point := randomElements(n)
+	commitment, err := Commit(m, key)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Open(m, commitment, point, sha256.New(), key)
+	}
+}
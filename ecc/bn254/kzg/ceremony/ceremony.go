@@ -0,0 +1,369 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ceremony
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidProofOfKnowledge = errors.New("invalid proof of knowledge of the contribution")
+	ErrInvalidUpdate           = errors.New("the SRS is not consistent with the contributions")
+	ErrSRSMismatch             = errors.New("the SRS sizes or generators do not match")
+	ErrBeaconSize              = errors.New("the beacon exceeds the maximal size")
+)
+
+const (
+	// batchSize is the number of points scaled at once by a task when contributing
+	batchSize = 1 << 12
+
+	// maxBeaconSize is the maximal size in bytes of the random beacon
+	maxBeaconSize = 1 << 12
+
+	beaconDST    = "KZG-CEREMONY-BEACON"
+	challengeDST = "KZG-CEREMONY-POK"
+)
+
+// Contribution is the public record of an update of the SRS with a secret x.
+//
+// It contains a Schnorr proof of knowledge of x in G₁, (R, Z) verifying
+// [Z]G₁ = R + [c]XG₁ for the challenge c, and XG₁ is tied to the public key
+// by e(XG₁, G₂) = e(G₁, [x]G₂).
+//
+// implements io.ReaderFrom and io.WriterTo
+type Contribution struct {
+	Tau       curve.G1Affine // [τ]G₁ after the update
+	PublicKey curve.G2Affine // [x]G₂
+	XG1       curve.G1Affine // [x]G₁
+	R         curve.G1Affine // commitment [r]G₁ of the Schnorr proof
+	Z         fr.Element     // response r + c⋅x of the Schnorr proof
+}
+
+// Ceremony is the state of a powers of τ ceremony: the beacon it started
+// from, the current SRS and the list of contributions.
+//
+// implements io.ReaderFrom and io.WriterTo
+type Ceremony struct {
+	Beacon        []byte
+	SRS           kzg.SRS
+	Contributions []Contribution
+}
+
+// NewCeremony returns a ceremony for a SRS of the given size, starting from
+// the τ derived from a public random beacon.
+func NewCeremony(size uint64, beacon []byte) (*Ceremony, error) {
+	if len(beacon) > maxBeaconSize {
+		return nil, ErrBeaconSize
+	}
+	tau, err := beaconToTau(beacon)
+	if err != nil {
+		return nil, err
+	}
+	var bTau big.Int
+	srs, err := kzg.NewSRS(size, tau.BigInt(&bTau))
+	if err != nil {
+		return nil, err
+	}
+	return &Ceremony{
+		Beacon: append([]byte{}, beacon...),
+		SRS:    *srs,
+	}, nil
+}
+
+// Contribute updates the SRS of the ceremony with a fresh secret and records
+// the proof of the update.
+func (c *Ceremony) Contribute() error {
+	contribution, err := Contribute(&c.SRS)
+	if err != nil {
+		return err
+	}
+	c.Contributions = append(c.Contributions, contribution)
+	return nil
+}
+
+// Verify checks that the SRS of the ceremony is the SRS derived from the
+// beacon, updated by all the contributions.
+//
+// The points of the SRS are assumed to be in the correct subgroup, which is
+// the case if it was deserialized with ReadFrom.
+func (c *Ceremony) Verify() error {
+	tau, err := beaconToTau(c.Beacon)
+	if err != nil {
+		return err
+	}
+	_, _, g1, g2 := curve.Generators()
+	if len(c.SRS.Pk.G1) < 2 || !c.SRS.Pk.G1[0].Equal(&g1) || !c.SRS.Vk.G2[0].Equal(&g2) {
+		return ErrSRSMismatch
+	}
+	// the SRS derived from the beacon does not support hiding commitments
+	if len(c.SRS.Pk.Gamma) != 0 || !c.SRS.Vk.Gamma.IsInfinity() {
+		return ErrSRSMismatch
+	}
+	var bTau big.Int
+	var tauG1 curve.G1Affine
+	tauG1.ScalarMultiplication(&g1, tau.BigInt(&bTau))
+
+	return VerifyChain(&c.SRS, &tauG1, c.Contributions)
+}
+
+// Contribute updates srs in place with a fresh secret x, such that
+// [τⁱ]G₁ becomes [(xτ)ⁱ]G₁ and [τ]G₂ becomes [xτ]G₂, and returns the
+// proof of the update.
+//
+// The points are processed in parallel by batches.
+func Contribute(srs *kzg.SRS) (Contribution, error) {
+	var res Contribution
+	if len(srs.Pk.G1) < 2 {
+		return res, kzg.ErrMinSRSSize
+	}
+
+	var x, r fr.Element
+	for x.IsZero() {
+		if _, err := x.SetRandom(); err != nil {
+			return res, err
+		}
+	}
+	if _, err := r.SetRandom(); err != nil {
+		return res, err
+	}
+	var bx, br big.Int
+	x.BigInt(&bx)
+	r.BigInt(&br)
+
+	prevTau := srs.Pk.G1[1]
+
+	// update the SRS
+	scalePowers(srs.Pk.G1, x)
+	scalePowers(srs.Pk.Gamma, x) // [γτⁱ]G₁ are powers of τ too
+	srs.Vk.G2[1].ScalarMultiplication(&srs.Vk.G2[1], &bx)
+	srs.Vk.Lines[1] = curve.PrecomputeLines(srs.Vk.G2[1])
+
+	// proof of knowledge of x
+	res.Tau = srs.Pk.G1[1]
+	res.PublicKey.ScalarMultiplication(&srs.Vk.G2[0], &bx)
+	secrets := []fr.Element{x, r}
+	xr := curve.BatchScalarMultiplicationG1(&srs.Vk.G1, secrets)
+	res.XG1, res.R = xr[0], xr[1]
+	challenge, err := res.challenge(&prevTau)
+	if err != nil {
+		return res, err
+	}
+	res.Z.Mul(&challenge, &x).Add(&res.Z, &r)
+
+	// the secrets are toxic waste
+	x.SetZero()
+	r.SetZero()
+	secrets[0].SetZero()
+	secrets[1].SetZero()
+	bx.SetUint64(0)
+	br.SetUint64(0)
+
+	return res, nil
+}
+
+// VerifyContribution checks that next is prev updated with the secret of
+// contribution c.
+//
+// The points of the SRS are assumed to be in the correct subgroup, which is
+// the case if they were deserialized with ReadFrom.
+func VerifyContribution(prev, next *kzg.SRS, c *Contribution) error {
+	if len(prev.Pk.G1) != len(next.Pk.G1) || len(prev.Pk.G1) < 2 ||
+		!prev.Pk.G1[0].Equal(&next.Pk.G1[0]) ||
+		!prev.Vk.G1.Equal(&next.Vk.G1) ||
+		!prev.Vk.G2[0].Equal(&next.Vk.G2[0]) ||
+		len(prev.Pk.Gamma) != len(next.Pk.Gamma) ||
+		!prev.Vk.Gamma.Equal(&next.Vk.Gamma) {
+		return ErrSRSMismatch
+	}
+	return VerifyChain(next, &prev.Pk.G1[1], []Contribution{*c})
+}
+
+// VerifyChain checks that srs is the SRS with [τ]G₁ = initialTau updated by the
+// list of contributions.
+//
+// It verifies the proof of knowledge of each contribution, that each one
+// updates the [τ]G₁ of the previous one, and that srs is made of the powers
+// of the last [τ], including the blinding generators [γτⁱ]G₁ of a SRS which
+// supports hiding commitments. All the pairing equations are batched in a
+// single randomized pairing check.
+//
+// The points of the SRS are assumed to be in the correct subgroup, which is
+// the case if they were deserialized with ReadFrom.
+func VerifyChain(srs *kzg.SRS, initialTau *curve.G1Affine, contributions []Contribution) error {
+	n := len(srs.Pk.G1)
+	if n < 2 || !srs.Pk.G1[0].Equal(&srs.Vk.G1) {
+		return ErrSRSMismatch
+	}
+	m := len(srs.Pk.Gamma)
+	if m != 0 && !srs.Pk.Gamma[0].Equal(&srs.Vk.Gamma) {
+		return ErrSRSMismatch
+	}
+	g1 := &srs.Vk.G1
+
+	// Schnorr proofs
+	prevTau := initialTau
+	for i := range contributions {
+		c := &contributions[i]
+		if c.Tau.IsInfinity() || c.XG1.IsInfinity() || c.PublicKey.IsInfinity() {
+			return ErrInvalidProofOfKnowledge
+		}
+		challenge, err := c.challenge(prevTau)
+		if err != nil {
+			return err
+		}
+		var bz, bc big.Int
+		var lhs, rhs curve.G1Affine
+		lhs.ScalarMultiplication(g1, c.Z.BigInt(&bz))
+		rhs.ScalarMultiplication(&c.XG1, challenge.BigInt(&bc))
+		rhs.Add(&rhs, &c.R)
+		if !lhs.Equal(&rhs) {
+			return ErrInvalidProofOfKnowledge
+		}
+		prevTau = &c.Tau
+	}
+	if !prevTau.Equal(&srs.Pk.G1[1]) {
+		return ErrInvalidUpdate
+	}
+
+	// For each contribution k, with random ρₖ and σₖ, we check
+	// 	e(ρₖ[τₖ]G₁ + σₖ[xₖ]G₁, G₂) = e(ρₖ[τₖ₋₁]G₁ + σₖG₁, [xₖ]G₂)
+	// and for the final SRS, with random λ, γ and μ,
+	// 	e(λ[τ]G₁ + ∑ᵢγⁱ[τⁱ⁺¹]G₁ + μ∑ᵢγⁱ[γτⁱ⁺¹]G₁, G₂) = e(λG₁ + ∑ᵢγⁱ[τⁱ]G₁ + μ∑ᵢγⁱ[γτⁱ]G₁, [τ]G₂)
+	// all the equations are summed up in a single pairing check.
+	nbContributions := len(contributions)
+	randomNumbers := make([]fr.Element, 2*nbContributions+3)
+	for i := range randomNumbers {
+		if _, err := randomNumbers[i].SetRandom(); err != nil {
+			return err
+		}
+	}
+	lambda, gamma, mu := randomNumbers[2*nbContributions], randomNumbers[2*nbContributions+1], randomNumbers[2*nbContributions+2]
+
+	P := make([]curve.G1Affine, nbContributions+2)
+	Q := make([]curve.G2Affine, nbContributions+2)
+
+	// G₂ column
+	gammas := make([]fr.Element, n-1)
+	gammas[0].SetOne()
+	for i := 1; i < len(gammas); i++ {
+		gammas[i].Mul(&gammas[i-1], &gamma)
+	}
+	var mus []fr.Element
+	if m > 1 {
+		mus = make([]fr.Element, m-1)
+		mus[0] = mu
+		for i := 1; i < len(mus); i++ {
+			mus[i].Mul(&mus[i-1], &gamma)
+		}
+	}
+	points := make([]curve.G1Affine, 0, 2*nbContributions+n-1+len(mus))
+	scalars := make([]fr.Element, 0, 2*nbContributions+n-1+len(mus))
+	for i := range contributions {
+		points = append(points, contributions[i].Tau, contributions[i].XG1)
+		scalars = append(scalars, randomNumbers[2*i], randomNumbers[2*i+1])
+	}
+	points = append(points, srs.Pk.G1[1:]...)
+	scalars = append(scalars, gammas...)
+	scalars[2*nbContributions].Add(&scalars[2*nbContributions], &lambda)
+	if m > 1 {
+		points = append(points, srs.Pk.Gamma[1:]...)
+		scalars = append(scalars, mus...)
+	}
+	config := ecc.MultiExpConfig{}
+	if _, err := P[0].MultiExp(points, scalars, config); err != nil {
+		return err
+	}
+	Q[0] = srs.Vk.G2[0]
+
+	// [τ]G₂ column
+	gammas[0].Add(&gammas[0], &lambda)
+	points = append(points[:0], srs.Pk.G1[:n-1]...)
+	scalars = append(scalars[:0], gammas...)
+	if m > 1 {
+		points = append(points, srs.Pk.Gamma[:m-1]...)
+		scalars = append(scalars, mus...)
+	}
+	if _, err := P[1].MultiExp(points, scalars, config); err != nil {
+		return err
+	}
+	P[1].Neg(&P[1])
+	Q[1] = srs.Vk.G2[1]
+
+	// public keys columns
+	prevTau = initialTau
+	for i := range contributions {
+		if _, err := P[i+2].MultiExp([]curve.G1Affine{*prevTau, *g1}, randomNumbers[2*i:2*i+2], config); err != nil {
+			return err
+		}
+		P[i+2].Neg(&P[i+2])
+		Q[i+2] = contributions[i].PublicKey
+		prevTau = &contributions[i].Tau
+	}
+
+	ok, err := curve.PairingCheck(P, Q)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrInvalidUpdate
+	}
+	return nil
+}
+
+// challenge returns the Fiat-Shamir challenge of the Schnorr proof, bound to
+// the [τ]G₁ the contribution updates.
+func (c *Contribution) challenge(prevTau *curve.G1Affine) (fr.Element, error) {
+	msg := make([]byte, 0, 4*curve.SizeOfG1AffineUncompressed+curve.SizeOfG2AffineUncompressed)
+	for _, p := range []*curve.G1Affine{prevTau, &c.Tau, &c.XG1, &c.R} {
+		b := p.RawBytes()
+		msg = append(msg, b[:]...)
+	}
+	b := c.PublicKey.RawBytes()
+	msg = append(msg, b[:]...)
+	res, err := fr.Hash(msg, []byte(challengeDST), 1)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	return res[0], nil
+}
+
+// beaconToTau derives the initial τ of a ceremony from the random beacon
+func beaconToTau(beacon []byte) (fr.Element, error) {
+	res, err := fr.Hash(beacon, []byte(beaconDST), 1)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	return res[0], nil
+}
+
+// scalePowers sets points[i] to [xⁱ]points[i]. The points are processed in
+// parallel, by batches of batchSize points; see the package documentation for
+// why they are not scaled with BatchScalarMultiplicationG1.
+func scalePowers(points []curve.G1Affine, x fr.Element) {
+	nbBatches := (len(points) + batchSize - 1) / batchSize
+	parallel.Execute(nbBatches, func(start, end int) {
+		jac := make([]curve.G1Jac, batchSize)
+		var xi fr.Element
+		var bxi big.Int
+		for b := start; b < end; b++ {
+			batch := points[b*batchSize : min((b+1)*batchSize, len(points))]
+			xi.Exp(x, big.NewInt(int64(b*batchSize)))
+			for i := range batch {
+				jac[i].FromAffine(&batch[i])
+				jac[i].ScalarMultiplication(&jac[i], xi.BigInt(&bxi))
+				xi.Mul(&xi, &x)
+			}
+			copy(batch, curve.BatchJacobianToAffineG1(jac[:len(batch)]))
+		}
+	})
+}
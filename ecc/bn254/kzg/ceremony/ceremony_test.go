@@ -0,0 +1,228 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ceremony
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"testing"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/stretchr/testify/require"
+)
+
+var testBeacon = []byte("test beacon")
+
+// cloneSRS returns a deep copy of srs
+func cloneSRS(srs *kzg.SRS) *kzg.SRS {
+	res := *srs
+	res.Pk.G1 = append([]curve.G1Affine{}, srs.Pk.G1...)
+	res.Pk.Gamma = append([]curve.G1Affine{}, srs.Pk.Gamma...)
+	return &res
+}
+
+func TestCeremony(t *testing.T) {
+	assert := require.New(t)
+
+	c, err := NewCeremony(64, testBeacon)
+	assert.NoError(err)
+	assert.NoError(c.Verify(), "the initial SRS should verify")
+
+	for i := 0; i < 3; i++ {
+		assert.NoError(c.Contribute())
+		assert.NoError(c.Verify())
+	}
+
+	// the SRS can be used for KZG
+	p := make([]fr.Element, 40)
+	for i := range p {
+		p[i].SetRandom()
+	}
+	digest, err := kzg.Commit(p, c.SRS.Pk)
+	assert.NoError(err)
+	var point fr.Element
+	point.SetRandom()
+	proof, err := kzg.Open(p, point, c.SRS.Pk)
+	assert.NoError(err)
+	assert.NoError(kzg.Verify(&digest, &proof, point, c.SRS.Vk))
+
+	// wrong beacon
+	beacon := c.Beacon
+	c.Beacon = []byte("another beacon")
+	assert.Error(c.Verify())
+	c.Beacon = beacon
+
+	// missing contribution
+	contributions := c.Contributions
+	c.Contributions = append(contributions[:1:1], contributions[2:]...)
+	assert.Error(c.Verify())
+	c.Contributions = contributions
+
+	// tampered SRS
+	c.SRS.Pk.G1[5], c.SRS.Pk.G1[6] = c.SRS.Pk.G1[6], c.SRS.Pk.G1[5]
+	assert.ErrorIs(c.Verify(), ErrInvalidUpdate)
+	c.SRS.Pk.G1[5], c.SRS.Pk.G1[6] = c.SRS.Pk.G1[6], c.SRS.Pk.G1[5]
+	assert.NoError(c.Verify())
+}
+
+func TestVerifyContribution(t *testing.T) {
+	assert := require.New(t)
+
+	c, err := NewCeremony(32, testBeacon)
+	assert.NoError(err)
+
+	prev := cloneSRS(&c.SRS)
+	contribution, err := Contribute(&c.SRS)
+	assert.NoError(err)
+	next := &c.SRS
+	assert.NoError(VerifyContribution(prev, next, &contribution))
+
+	// the contribution does not apply to another SRS
+	assert.Error(VerifyContribution(next, next, &contribution))
+
+	// invalid proof of knowledge
+	tampered := contribution
+	tampered.Z.SetOne()
+	assert.ErrorIs(VerifyContribution(prev, next, &tampered), ErrInvalidProofOfKnowledge)
+
+	// the public key is bound to the proof of knowledge
+	tampered = contribution
+	tampered.PublicKey = next.Vk.G2[0]
+	assert.ErrorIs(VerifyContribution(prev, next, &tampered), ErrInvalidProofOfKnowledge)
+
+	// the G₂ part of the SRS was not updated
+	stale := cloneSRS(next)
+	stale.Vk.G2[1] = prev.Vk.G2[1]
+	assert.ErrorIs(VerifyContribution(prev, stale, &contribution), ErrInvalidUpdate)
+
+	// different sizes
+	truncated := cloneSRS(next)
+	truncated.Pk.G1 = truncated.Pk.G1[:16]
+	assert.ErrorIs(VerifyContribution(prev, truncated, &contribution), ErrSRSMismatch)
+}
+
+func TestVerifyContributionHiding(t *testing.T) {
+	assert := require.New(t)
+
+	srs, err := kzg.NewSRS(32, big.NewInt(7), big.NewInt(42))
+	assert.NoError(err)
+	prev := cloneSRS(srs)
+	contribution, err := Contribute(srs)
+	assert.NoError(err)
+	next := srs
+	assert.NoError(VerifyContribution(prev, next, &contribution))
+
+	// the blinding generators are updated too
+	p := make([]fr.Element, 20)
+	for i := range p {
+		p[i].SetRandom()
+	}
+	digest, blinding, err := kzg.CommitHiding(p, next.Pk)
+	assert.NoError(err)
+	var point fr.Element
+	point.SetRandom()
+	proof, err := kzg.OpenHiding(p, blinding, point, next.Pk)
+	assert.NoError(err)
+	assert.NoError(kzg.VerifyHiding(&digest, &proof, point, next.Vk))
+
+	// the blinding generators were not updated
+	stale := cloneSRS(next)
+	copy(stale.Pk.Gamma, prev.Pk.Gamma)
+	assert.ErrorIs(VerifyContribution(prev, stale, &contribution), ErrInvalidUpdate)
+
+	// arbitrary blinding generator
+	tampered := cloneSRS(next)
+	tampered.Pk.Gamma[1] = next.Pk.G1[1]
+	assert.ErrorIs(VerifyContribution(prev, tampered, &contribution), ErrInvalidUpdate)
+
+	// the blinding generator of the VerifyingKey is not the one of the ProvingKey
+	tampered = cloneSRS(next)
+	tampered.Vk.Gamma = next.Pk.G1[1]
+	assert.ErrorIs(VerifyContribution(prev, tampered, &contribution), ErrSRSMismatch)
+	assert.ErrorIs(VerifyChain(tampered, &prev.Pk.G1[1], []Contribution{contribution}), ErrSRSMismatch)
+}
+
+func TestCeremonySerialization(t *testing.T) {
+	assert := require.New(t)
+
+	c, err := NewCeremony(16, testBeacon)
+	assert.NoError(err)
+	assert.NoError(c.Contribute())
+	assert.NoError(c.Contribute())
+
+	var buf bytes.Buffer
+	written, err := c.WriteTo(&buf)
+	assert.NoError(err)
+
+	var reconstructed Ceremony
+	read, err := reconstructed.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(*c, reconstructed)
+	assert.NoError(reconstructed.Verify())
+
+	// the sizes are bounded before any allocation
+	var huge bytes.Buffer
+	huge.Write([]byte{0xff, 0xff, 0xff, 0xff})
+	_, err = reconstructed.ReadFrom(&huge)
+	assert.ErrorIs(err, ErrBeaconSize)
+
+	buf.Reset()
+	_, err = c.WriteTo(&buf)
+	assert.NoError(err)
+	data := buf.Bytes()
+	offset := len(data) - 4 - len(c.Contributions)*contributionSize(t)
+	binary.BigEndian.PutUint32(data[offset:], 1<<32-1)
+	_, err = reconstructed.ReadFrom(bytes.NewReader(data))
+	assert.ErrorIs(err, io.EOF)
+
+	_, err = NewCeremony(16, make([]byte, maxBeaconSize+1))
+	assert.ErrorIs(err, ErrBeaconSize)
+}
+
+// contributionSize returns the size of the encoding of a Contribution
+func contributionSize(t *testing.T) int {
+	var buf bytes.Buffer
+	var c Contribution
+	_, err := c.WriteTo(&buf)
+	require.NoError(t, err)
+	return buf.Len()
+}
+
+func BenchmarkContribute(b *testing.B) {
+	c, err := NewCeremony(1<<14, testBeacon)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err = Contribute(&c.SRS); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkVerifyContribution(b *testing.B) {
+	c, err := NewCeremony(1<<14, testBeacon)
+	if err != nil {
+		b.Fatal(err)
+	}
+	prev := cloneSRS(&c.SRS)
+	contribution, err := Contribute(&c.SRS)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err = VerifyContribution(prev, &c.SRS, &contribution); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
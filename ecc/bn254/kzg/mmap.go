@@ -0,0 +1,360 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	gounsafe "unsafe"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+	"github.com/consensys/gnark-crypto/utils/unsafe"
+)
+
+// A mapped SRS file is made of
+//   - a header (mappedHeader) followed by the unsafe marker,
+//   - the VerifyingKey and the blinding generators of the ProvingKey, with raw encoding,
+//   - the points of the ProvingKey, in their memory representation, starting
+//     at an offset aligned on mappedAlignment.
+//
+// The checksum of the header is the SHA-256 of the concatenation of the SHA-256
+// of the VerifyingKey and of the SHA-256 of each chunk of mappedChunkSize bytes
+// of the ProvingKey, so that it can be computed in parallel.
+const (
+	mappedMagic   = "gnarkSRS"
+	mappedVersion = 1
+
+	// mappedAlignment is the alignment of the ProvingKey points in the file; it
+	// is a multiple of the page size of the common platforms.
+	mappedAlignment = 1 << 16
+
+	// mappedChunkSize is the size of the chunks of the ProvingKey hashed independently
+	mappedChunkSize = 1 << 26
+
+	sizeOfG1Affine = uint64(gounsafe.Sizeof(bn254.G1Affine{}))
+)
+
+var (
+	ErrMappedChecksum = errors.New("mapped SRS: checksum mismatch")
+	ErrMappedHeader   = errors.New("mapped SRS: invalid header")
+)
+
+// mappedHeader is the integrity header of a mapped SRS file
+type mappedHeader struct {
+	Magic     [8]byte
+	Version   uint32
+	CurveID   uint32 // ecc.ID
+	PointSize uint64 // size of the memory representation of a G1Affine
+	NbPoints  uint64
+	VkOffset  uint64
+	VkSize    uint64
+	PkOffset  uint64
+	Checksum  [sha256.Size]byte
+}
+
+// sizeOfMappedHeader is the size of the encoded header, followed by the marker
+var sizeOfMappedHeader = uint64(binary.Size(mappedHeader{})) + 8
+
+// MappedSRS is a SRS whose ProvingKey points are a view on a memory mapped
+// file: they are loaded lazily by the operating system and never copied on
+// the heap. The points must not be modified, and the SRS must not be used
+// after Close.
+type MappedSRS struct {
+	SRS
+	mapping *unsafe.Mapping
+}
+
+// MappedOption configures OpenMappedSRS
+type MappedOption func(*mappedConfig)
+
+type mappedConfig struct {
+	noChecksum bool
+}
+
+// NoChecksum skips the verification of the checksum of the file, which
+// requires reading it entirely.
+func NoChecksum() MappedOption {
+	return func(c *mappedConfig) {
+		c.noChecksum = true
+	}
+}
+
+// OpenMappedSRS maps the SRS file at path in memory, without copying the
+// points of the ProvingKey.
+//
+// The file is either written by WriteMapped or MappedSRSWriter, in which case
+// its header and checksum are verified, or by WriteDump. In both cases, the
+// file must have been written on the same architecture.
+func OpenMappedSRS(path string, options ...MappedOption) (*MappedSRS, error) {
+	var config mappedConfig
+	for _, o := range options {
+		o(&config)
+	}
+
+	mapping, err := unsafe.Mmap(path)
+	if err != nil {
+		return nil, err
+	}
+	res := &MappedSRS{mapping: mapping}
+	data := mapping.Bytes()
+
+	if bytes.HasPrefix(data, []byte(mappedMagic)) {
+		err = res.init(data, &config)
+	} else {
+		err = res.initFromDump(data)
+	}
+	if err != nil {
+		mapping.Close()
+		return nil, err
+	}
+	return res, nil
+}
+
+// Close unmaps the file.
+func (m *MappedSRS) Close() error {
+	m.Pk.G1 = nil
+	return m.mapping.Close()
+}
+
+func (m *MappedSRS) init(data []byte, config *mappedConfig) error {
+	var header mappedHeader
+	r := bytes.NewReader(data)
+	if err := binary.Read(r, binary.LittleEndian, &header); err != nil {
+		return err
+	}
+	if err := unsafe.ReadMarker(r); err != nil {
+		return err
+	}
+	if header.Version != mappedVersion {
+		return fmt.Errorf("%w: unsupported version %d", ErrMappedHeader, header.Version)
+	}
+	if ecc.ID(header.CurveID) != ecc.BN254 {
+		return fmt.Errorf("%w: the file contains a SRS for %s", ErrMappedHeader, ecc.ID(header.CurveID))
+	}
+	if header.PointSize != sizeOfG1Affine {
+		return fmt.Errorf("%w: the file was not written on the same architecture", ErrMappedHeader)
+	}
+	dataSize := uint64(len(data))
+	if header.VkOffset > dataSize || header.VkSize > dataSize-header.VkOffset ||
+		header.PkOffset > dataSize || header.NbPoints > (dataSize-header.PkOffset)/sizeOfG1Affine {
+		return fmt.Errorf("%w: the file is truncated", ErrMappedHeader)
+	}
+
+	vk := data[header.VkOffset : header.VkOffset+header.VkSize]
+	pk := data[header.PkOffset : header.PkOffset+header.NbPoints*sizeOfG1Affine]
+	if !config.noChecksum && mappedChecksum(vk, pk) != header.Checksum {
+		return ErrMappedChecksum
+	}
+
+	r = bytes.NewReader(vk)
+	if _, err := m.Vk.ReadFrom(r); err != nil {
+		return err
+	}
+	if err := bn254.NewDecoder(r).Decode(&m.Pk.Gamma); err != nil {
+		return err
+	}
+	if len(m.Pk.Gamma) == 0 {
+		m.Pk.Gamma = nil
+	}
+	var err error
+	m.Pk.G1, err = unsafe.CastSlice[[]bn254.G1Affine](pk, int(header.NbPoints))
+	return err
+}
+
+// initFromDump maps a file written by WriteDump
+func (m *MappedSRS) initFromDump(data []byte) error {
+	r := bytes.NewReader(data)
+	if _, err := m.Vk.ReadFrom(r); err != nil {
+		return err
+	}
+	if err := unsafe.ReadMarker(r); err != nil {
+		return err
+	}
+	var nbPoints uint64
+	if err := binary.Read(r, binary.LittleEndian, &nbPoints); err != nil {
+		return err
+	}
+	offset := uint64(len(data) - r.Len())
+	if nbPoints > (uint64(len(data))-offset)/sizeOfG1Affine {
+		return io.ErrUnexpectedEOF
+	}
+	var err error
+	if m.Pk.G1, err = unsafe.CastSlice[[]bn254.G1Affine](data[offset:], int(nbPoints)); err != nil {
+		return err
+	}
+
+	// the blinding generators follow the points, if the SRS supports hiding
+	// commitments
+	m.Pk.Gamma = nil
+	if m.Vk.Gamma.IsInfinity() {
+		return nil
+	}
+	r = bytes.NewReader(data[offset+nbPoints*sizeOfG1Affine:])
+	m.Pk.Gamma, _, err = unsafe.ReadSlice[[]bn254.G1Affine](r)
+	return err
+}
+
+// WriteMapped writes the SRS to a file at path, in a layout suited to memory
+// mapping with OpenMappedSRS.
+// @unsafe: as WriteDump, the format is platform dependent.
+func (srs *SRS) WriteMapped(path string) error {
+	w, err := CreateMappedSRS(path, &srs.Vk, srs.Pk.Gamma, len(srs.Pk.G1))
+	if err != nil {
+		return err
+	}
+	if err = w.Write(srs.Pk.G1); err != nil {
+		w.f.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// MappedSRSWriter writes a SRS file suited to memory mapping with
+// OpenMappedSRS. The points of the ProvingKey are streamed with Write, so that
+// a large SRS never has to be entirely in memory.
+type MappedSRSWriter struct {
+	f      *os.File
+	w      *bufio.Writer
+	header mappedHeader
+
+	written uint64 // number of points written
+
+	chunkHashes []byte    // digests of the hashed chunks
+	chunk       hash.Hash // current chunk
+	chunkSize   int       // bytes hashed in the current chunk
+}
+
+// CreateMappedSRS creates the file at path to write a SRS made of vk, and of a
+// ProvingKey with the blinding generators gamma (which may be empty) and
+// nbPoints points.
+func CreateMappedSRS(path string, vk *VerifyingKey, gamma []bn254.G1Affine, nbPoints int) (*MappedSRSWriter, error) {
+	var vkBuf bytes.Buffer
+	if _, err := vk.WriteRawTo(&vkBuf); err != nil {
+		return nil, err
+	}
+	if err := bn254.NewEncoder(&vkBuf, bn254.RawEncoding()).Encode(gamma); err != nil {
+		return nil, err
+	}
+
+	res := &MappedSRSWriter{chunk: sha256.New()}
+	copy(res.header.Magic[:], mappedMagic)
+	res.header.Version = mappedVersion
+	res.header.CurveID = uint32(ecc.BN254)
+	res.header.PointSize = sizeOfG1Affine
+	res.header.NbPoints = uint64(nbPoints)
+	res.header.VkOffset = sizeOfMappedHeader
+	res.header.VkSize = uint64(vkBuf.Len())
+	res.header.PkOffset = (res.header.VkOffset + res.header.VkSize + mappedAlignment - 1) / mappedAlignment * mappedAlignment
+
+	vkHash := sha256.Sum256(vkBuf.Bytes())
+	res.chunkHashes = append(res.chunkHashes, vkHash[:]...)
+
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	res.f = f
+	res.w = bufio.NewWriterSize(f, 1<<20)
+
+	// the header is written on Close, once the checksum is known
+	if _, err = f.Seek(int64(res.header.VkOffset), io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	padding := make([]byte, res.header.PkOffset-res.header.VkOffset-res.header.VkSize)
+	for _, b := range [][]byte{vkBuf.Bytes(), padding} {
+		if _, err = res.w.Write(b); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// Write appends points to the ProvingKey.
+func (w *MappedSRSWriter) Write(points []bn254.G1Affine) error {
+	if w.written+uint64(len(points)) > w.header.NbPoints {
+		return errors.New("mapped SRS: too many points written")
+	}
+	if len(points) == 0 {
+		return nil
+	}
+	data := gounsafe.Slice((*byte)(gounsafe.Pointer(&points[0])), uint64(len(points))*sizeOfG1Affine)
+	if _, err := w.w.Write(data); err != nil {
+		return err
+	}
+	w.written += uint64(len(points))
+
+	for len(data) > 0 {
+		n := min(len(data), mappedChunkSize-w.chunkSize)
+		w.chunk.Write(data[:n])
+		w.chunkSize += n
+		data = data[n:]
+		if w.chunkSize == mappedChunkSize {
+			w.chunkHashes = w.chunk.Sum(w.chunkHashes)
+			w.chunk.Reset()
+			w.chunkSize = 0
+		}
+	}
+	return nil
+}
+
+// Close writes the header of the file and closes it. All the points of the
+// ProvingKey must have been written.
+func (w *MappedSRSWriter) Close() error {
+	err := w.finalize()
+	if errClose := w.f.Close(); err == nil {
+		err = errClose
+	}
+	return err
+}
+
+// finalize flushes the points and writes the header with the checksum
+func (w *MappedSRSWriter) finalize() error {
+	if w.written != w.header.NbPoints {
+		return fmt.Errorf("mapped SRS: %d points written, expected %d", w.written, w.header.NbPoints)
+	}
+	if w.chunkSize != 0 {
+		w.chunkHashes = w.chunk.Sum(w.chunkHashes)
+	}
+	w.header.Checksum = sha256.Sum256(w.chunkHashes)
+
+	if err := w.w.Flush(); err != nil {
+		return err
+	}
+	if _, err := w.f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := binary.Write(w.f, binary.LittleEndian, &w.header); err != nil {
+		return err
+	}
+	return unsafe.WriteMarker(w.f)
+}
+
+// mappedChecksum returns the checksum of a mapped SRS; the chunks of the
+// ProvingKey are hashed in parallel.
+func mappedChecksum(vk, pk []byte) [sha256.Size]byte {
+	nbChunks := (len(pk) + mappedChunkSize - 1) / mappedChunkSize
+	hashes := make([]byte, (nbChunks+1)*sha256.Size)
+	vkHash := sha256.Sum256(vk)
+	copy(hashes, vkHash[:])
+	parallel.Execute(nbChunks, func(start, end int) {
+		for i := start; i < end; i++ {
+			h := sha256.Sum256(pk[i*mappedChunkSize : min((i+1)*mappedChunkSize, len(pk))])
+			copy(hashes[(i+1)*sha256.Size:], h[:])
+		}
+	})
+	return sha256.Sum256(hashes)
+}
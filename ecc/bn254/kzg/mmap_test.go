@@ -0,0 +1,120 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMappedSRS(t *testing.T) {
+	t.Run("non-hiding", func(t *testing.T) { testMappedSRS(t, testSrs) })
+	t.Run("hiding", func(t *testing.T) { testMappedSRS(t, newTestHidingSRS(t)) })
+}
+
+func testMappedSRS(t *testing.T, testSrs *SRS) {
+	assert := require.New(t)
+	path := filepath.Join(t.TempDir(), "srs.mmap")
+
+	assert.NoError(testSrs.WriteMapped(path))
+	srs, err := OpenMappedSRS(path)
+	assert.NoError(err)
+	defer srs.Close()
+
+	assert.Equal(testSrs.Vk, srs.Vk)
+	assert.Equal(testSrs.Pk.G1, srs.Pk.G1)
+	assert.Equal(testSrs.Pk.Gamma, srs.Pk.Gamma)
+
+	// the mapped proving key can be used directly
+	p := make([]fr.Element, 60)
+	for i := range p {
+		p[i].SetRandom()
+	}
+	expected, err := Commit(p, testSrs.Pk)
+	assert.NoError(err)
+	digest, err := Commit(p, srs.Pk)
+	assert.NoError(err)
+	assert.Equal(expected, digest)
+
+	assert.NoError(srs.Close())
+	assert.Nil(srs.Pk.G1)
+}
+
+func TestMappedSRSWriter(t *testing.T) {
+	assert := require.New(t)
+	path := filepath.Join(t.TempDir(), "srs.mmap")
+
+	w, err := CreateMappedSRS(path, &testSrs.Vk, testSrs.Pk.Gamma, len(testSrs.Pk.G1))
+	assert.NoError(err)
+	for i := 0; i < len(testSrs.Pk.G1); i += 100 {
+		assert.NoError(w.Write(testSrs.Pk.G1[i:min(i+100, len(testSrs.Pk.G1))]))
+	}
+	assert.Error(w.Write(testSrs.Pk.G1[:1]), "more points than announced")
+	assert.NoError(w.Close())
+
+	srs, err := OpenMappedSRS(path)
+	assert.NoError(err)
+	assert.Equal(testSrs.Pk.G1, srs.Pk.G1)
+	assert.NoError(srs.Close())
+
+	// missing points
+	w, err = CreateMappedSRS(path, &testSrs.Vk, testSrs.Pk.Gamma, 10)
+	assert.NoError(err)
+	assert.NoError(w.Write(testSrs.Pk.G1[:5]))
+	assert.Error(w.Close())
+	// the file is closed on error, once
+	assert.ErrorIs(w.f.Close(), os.ErrClosed)
+}
+
+func TestMappedSRSChecksum(t *testing.T) {
+	assert := require.New(t)
+	path := filepath.Join(t.TempDir(), "srs.mmap")
+
+	assert.NoError(testSrs.WriteMapped(path))
+	data, err := os.ReadFile(path)
+	assert.NoError(err)
+	data[len(data)-1] ^= 1
+	assert.NoError(os.WriteFile(path, data, 0600))
+
+	_, err = OpenMappedSRS(path)
+	assert.ErrorIs(err, ErrMappedChecksum)
+
+	srs, err := OpenMappedSRS(path, NoChecksum())
+	assert.NoError(err)
+	assert.NotEqual(testSrs.Pk.G1, srs.Pk.G1)
+	assert.NoError(srs.Close())
+
+	// truncated file
+	assert.NoError(os.WriteFile(path, data[:len(data)-1], 0600))
+	_, err = OpenMappedSRS(path, NoChecksum())
+	assert.ErrorIs(err, ErrMappedHeader)
+}
+
+func TestMappedSRSFromDump(t *testing.T) {
+	t.Run("non-hiding", func(t *testing.T) { testMappedSRSFromDump(t, testSrs) })
+	t.Run("hiding", func(t *testing.T) { testMappedSRSFromDump(t, newTestHidingSRS(t)) })
+}
+
+func testMappedSRSFromDump(t *testing.T, testSrs *SRS) {
+	assert := require.New(t)
+	path := filepath.Join(t.TempDir(), "srs.dump")
+
+	f, err := os.Create(path)
+	assert.NoError(err)
+	assert.NoError(testSrs.WriteDump(f))
+	assert.NoError(f.Close())
+
+	srs, err := OpenMappedSRS(path)
+	assert.NoError(err)
+	defer srs.Close()
+	assert.Equal(testSrs.Vk, srs.Vk)
+	assert.Equal(testSrs.Pk.G1, srs.Pk.G1)
+	assert.Equal(testSrs.Pk.Gamma, srs.Pk.Gamma)
+}
@@ -0,0 +1,245 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/fft"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var ErrInvalidSRS = errors.New("invalid SRS")
+
+// Validate checks that the SRS is well formed. It checks that
+//   - all the points are on the curve and in the correct subgroup,
+//   - Pk.G1[0] = Vk.G1 and the precomputed lines match Vk.G2,
+//   - Pk.G1[i] = [τⁱ]G₁ where [τ]G₂ = Vk.G2[1],
+//   - if the SRS supports hiding commitments, Pk.Gamma[0] = Vk.Gamma and
+//     Pk.Gamma[i] = [γτⁱ]G₁.
+//
+// The last relation is checked with a single randomized pairing equation
+//
+//	e(∑ᵢγⁱPk.G1[i+1], G₂) = e(∑ᵢγⁱPk.G1[i], [τ]G₂)
+//
+// for a random γ. If it fails, the range of points is bisected to report the
+// first power that does not match.
+func (srs *SRS) Validate() error {
+	if len(srs.Pk.G1) < 2 {
+		return ErrMinSRSSize
+	}
+
+	// verifying key
+	if srs.Vk.G1.IsInfinity() || !srs.Vk.G1.IsOnCurve() || !srs.Vk.G1.IsInSubGroup() {
+		return fmt.Errorf("%w: Vk.G1 is not a valid generator", ErrInvalidSRS)
+	}
+	for i := range srs.Vk.G2 {
+		if srs.Vk.G2[i].IsInfinity() || !srs.Vk.G2[i].IsOnCurve() || !srs.Vk.G2[i].IsInSubGroup() {
+			return fmt.Errorf("%w: Vk.G2[%d] is not a valid point", ErrInvalidSRS, i)
+		}
+		if srs.Vk.Lines[i] != bn254.PrecomputeLines(srs.Vk.G2[i]) {
+			return fmt.Errorf("%w: Vk.Lines[%d] does not match Vk.G2[%d]", ErrInvalidSRS, i, i)
+		}
+	}
+	if !srs.Pk.G1[0].Equal(&srs.Vk.G1) {
+		return fmt.Errorf("%w: Pk.G1[0] ≠ Vk.G1", ErrInvalidSRS)
+	}
+
+	// proving key
+	if err := checkPoints(srs.Pk.G1, "Pk.G1"); err != nil {
+		return err
+	}
+
+	if err := srs.validatePowers(srs.Pk.G1, "Pk.G1"); err != nil {
+		return err
+	}
+
+	// blinding generators
+	if len(srs.Pk.Gamma) == 0 {
+		if !srs.Vk.Gamma.IsInfinity() {
+			return fmt.Errorf("%w: Vk.Gamma is set but Pk.Gamma is empty", ErrInvalidSRS)
+		}
+		return nil
+	}
+	if srs.Vk.Gamma.IsInfinity() || !srs.Pk.Gamma[0].Equal(&srs.Vk.Gamma) {
+		return fmt.Errorf("%w: Pk.Gamma[0] ≠ Vk.Gamma", ErrInvalidSRS)
+	}
+	if err := checkPoints(srs.Pk.Gamma, "Pk.Gamma"); err != nil {
+		return err
+	}
+	return srs.validatePowers(srs.Pk.Gamma, "Pk.Gamma")
+}
+
+// validatePowers checks that points[i+1] = [τ]points[i], and reports the
+// first index that does not match.
+func (srs *SRS) validatePowers(points []bn254.G1Affine, name string) error {
+	n := len(points) - 1
+	if n < 1 {
+		return nil
+	}
+	ok, err := srs.checkPowers(points, 0, n)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+	start, end := 0, n
+	for end-start > 1 {
+		mid := (start + end) / 2
+		if ok, err = srs.checkPowers(points, start, mid); err != nil {
+			return err
+		}
+		if ok {
+			start = mid
+		} else {
+			end = mid
+		}
+	}
+	return fmt.Errorf("%w: %s[%d] ≠ [τ]%s[%d]", ErrInvalidSRS, name, start+1, name, start)
+}
+
+// ValidateLagrange checks that pk is the Lagrange form of the SRS on the
+// domain of size len(pk.G1), as returned by ToLagrangeG1, i.e. that
+// pk.G1[i] = [Lᵢ(τ)]G₁ where Lᵢ is the i-th Lagrange polynomial.
+//
+// srs must be valid (see Validate). The points of pk are checked to be on the
+// curve and in the correct subgroup, and the relation is checked with a single
+// randomized equation
+//
+//	∑ᵢrᵢpk.G1[i] = ∑ⱼcⱼ[τʲ]G₁
+//
+// where c is the inverse FFT of the random vector r. If it fails, the range of
+// points is bisected to report the first point that does not match.
+func (srs *SRS) ValidateLagrange(pk ProvingKey) error {
+	n := len(pk.G1)
+	if n == 0 || bits.OnesCount(uint(n)) != 1 {
+		return fmt.Errorf("%w: the size of the Lagrange form must be a power of 2", ErrInvalidSRS)
+	}
+	if n > len(srs.Pk.G1) {
+		return fmt.Errorf("%w: the Lagrange form is larger than the SRS", ErrInvalidSRS)
+	}
+	if err := checkPoints(pk.G1, "Lagrange G1"); err != nil {
+		return err
+	}
+
+	domain := fft.NewDomain(uint64(n))
+	ok, err := srs.checkLagrange(pk.G1, domain, 0, n)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+	start, end := 0, n
+	for end-start > 1 {
+		mid := (start + end) / 2
+		if ok, err = srs.checkLagrange(pk.G1, domain, start, mid); err != nil {
+			return err
+		}
+		if ok {
+			start = mid
+		} else {
+			end = mid
+		}
+	}
+	return fmt.Errorf("%w: Lagrange G1[%d] ≠ [L_%d(τ)]G₁", ErrInvalidSRS, start, start)
+}
+
+// checkPoints checks in parallel that the points are on the curve and in the
+// correct subgroup, and returns an error identifying the first invalid point.
+func checkPoints(points []bn254.G1Affine, name string) error {
+	firstInvalid := len(points)
+	var lock sync.Mutex
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			if !points[i].IsOnCurve() || !points[i].IsInSubGroup() {
+				lock.Lock()
+				if i < firstInvalid {
+					firstInvalid = i
+				}
+				lock.Unlock()
+				return
+			}
+		}
+	})
+	if firstInvalid != len(points) {
+		return fmt.Errorf("%w: %s[%d] is not on the curve or not in the correct subgroup", ErrInvalidSRS, name, firstInvalid)
+	}
+	return nil
+}
+
+// checkPowers returns true if points[i+1] = [τ]points[i] for start ≤ i < end,
+// using a randomized pairing equation.
+func (srs *SRS) checkPowers(points []bn254.G1Affine, start, end int) (bool, error) {
+	gammas, err := randomPowers(end - start)
+	if err != nil {
+		return false, err
+	}
+
+	var P [2]bn254.G1Affine
+	config := ecc.MultiExpConfig{}
+	if _, err = P[0].MultiExp(points[start+1:end+1], gammas, config); err != nil {
+		return false, err
+	}
+	if _, err = P[1].MultiExp(points[start:end], gammas, config); err != nil {
+		return false, err
+	}
+	P[1].Neg(&P[1])
+
+	// the pairing may modify the lines in place, so we work on a copy
+	lines := srs.Vk.Lines
+	return bn254.PairingCheckFixedQ(P[:], lines[:])
+}
+
+// checkLagrange returns true if lagrange[i] = [Lᵢ(τ)]G₁ for start ≤ i < end,
+// using a randomized equation.
+func (srs *SRS) checkLagrange(lagrange []bn254.G1Affine, domain *fft.Domain, start, end int) (bool, error) {
+	n := len(lagrange)
+	r := make([]fr.Element, n)
+	gammas, err := randomPowers(end - start)
+	if err != nil {
+		return false, err
+	}
+	copy(r[start:end], gammas)
+
+	// coefficients of the polynomial interpolating r on the domain
+	domain.FFTInverse(r, fft.DIF)
+	fft.BitReverse(r)
+
+	var lhs, rhs bn254.G1Affine
+	config := ecc.MultiExpConfig{}
+	if _, err = lhs.MultiExp(lagrange[start:end], gammas, config); err != nil {
+		return false, err
+	}
+	if _, err = rhs.MultiExp(srs.Pk.G1[:n], r, config); err != nil {
+		return false, err
+	}
+	return lhs.Equal(&rhs), nil
+}
+
+// randomPowers returns [1, γ, γ², ..., γⁿ⁻¹] for a random γ
+func randomPowers(n int) ([]fr.Element, error) {
+	res := make([]fr.Element, n)
+	if n == 0 {
+		return res, nil
+	}
+	var gamma fr.Element
+	if _, err := gamma.SetRandom(); err != nil {
+		return nil, err
+	}
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &gamma)
+	}
+	return res, nil
+}
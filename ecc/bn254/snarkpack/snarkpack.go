@@ -0,0 +1,370 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package snarkpack
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrMinSRSSize          = errors.New("the SRS size must be a power of 2, at least 2")
+	ErrInvalidNbProofs     = errors.New("the number of proofs must be positive and at most the SRS size")
+	ErrInvalidPublicInputs = errors.New("the number of public inputs does not match the verifying key")
+	ErrInvalidProof        = errors.New("malformed aggregated proof")
+	ErrVerifyAggregation   = errors.New("can't verify aggregated proof")
+	ErrVerifyGroth16       = errors.New("the aggregated Groth16 equation does not hold")
+)
+
+// Groth16Proof a Groth16 proof (A, B, C), verified with
+// e(A, B) = e(α, β) e(S, γ) e(C, δ) where S = K₀ + ∑ⱼ xⱼKⱼ₊₁ for the public
+// inputs x.
+type Groth16Proof struct {
+	Ar, Krs bn254.G1Affine
+	Bs      bn254.G2Affine
+}
+
+// Groth16VerifyingKey the part of a Groth16 verifying key needed to verify an
+// aggregated proof.
+type Groth16VerifyingKey struct {
+	Alpha              bn254.G1Affine
+	Beta, Gamma, Delta bn254.G2Affine
+
+	// K commitments to the public inputs, K[0] being the constant term
+	K []bn254.G1Affine
+}
+
+// ProvingKey commitment keys of the prover, for two secrets a and b.
+//
+// implements io.ReaderFrom and io.WriterTo
+type ProvingKey struct {
+	// G1A, G1B [aⁱ]G₁ and [bⁱ]G₁ for i < 2N
+	G1A, G1B []bn254.G1Affine
+
+	// G2A, G2B [aⁱ]G₂ and [bⁱ]G₂ for i < N
+	G2A, G2B []bn254.G2Affine
+}
+
+// VerifyingKey verifying key of the commitment keys.
+//
+// implements io.ReaderFrom and io.WriterTo
+type VerifyingKey struct {
+	// G1, AG1, BG1 [1]G₁, [a]G₁ and [b]G₁
+	G1, AG1, BG1 bn254.G1Affine
+
+	// G2, AG2, BG2 [1]G₂, [a]G₂ and [b]G₂
+	G2, AG2, BG2 bn254.G2Affine
+}
+
+// SRS commitment keys allowing to aggregate up to N proofs.
+type SRS struct {
+	Pk ProvingKey
+	Vk VerifyingKey
+}
+
+// NewSRS returns a SRS allowing to aggregate up to size proofs, for the
+// secrets a and b. In practice, the powers of a and b come from two distinct
+// powers-of-τ ceremonies.
+//
+// This is for testing purposes only: the knowledge of a or b allows to forge
+// aggregated proofs.
+func NewSRS(size uint64, bA, bB *big.Int) (*SRS, error) {
+	if size < 2 || size&(size-1) != 0 {
+		return nil, ErrMinSRSSize
+	}
+
+	var srs SRS
+	_, _, g1, g2 := bn254.Generators()
+	srs.Vk.G1, srs.Vk.G2 = g1, g2
+	srs.Vk.AG1.ScalarMultiplication(&g1, bA)
+	srs.Vk.BG1.ScalarMultiplication(&g1, bB)
+	srs.Vk.AG2.ScalarMultiplication(&g2, bA)
+	srs.Vk.BG2.ScalarMultiplication(&g2, bB)
+
+	var a, b fr.Element
+	a.SetBigInt(bA)
+	b.SetBigInt(bB)
+	aPowers := powers(a, int(2*size))
+	bPowers := powers(b, int(2*size))
+	srs.Pk.G1A = bn254.BatchScalarMultiplicationG1(&g1, aPowers)
+	srs.Pk.G1B = bn254.BatchScalarMultiplicationG1(&g1, bPowers)
+	srs.Pk.G2A = bn254.BatchScalarMultiplicationG2(&g2, aPowers[:size])
+	srs.Pk.G2B = bn254.BatchScalarMultiplicationG2(&g2, bPowers[:size])
+
+	return &srs, nil
+}
+
+// AggregatedProof aggregation of n Groth16 proofs.
+//
+// implements io.ReaderFrom and io.WriterTo
+type AggregatedProof struct {
+	// ComAB commitment to the vectors A and B
+	ComAB Commitment
+
+	// ComC commitment to the vector C
+	ComC Commitment
+
+	// ZAB ∏ᵢ e(Aᵢ, Bᵢ)^{rⁱ}
+	ZAB bn254.GT
+
+	// ZC ∑ᵢ rⁱCᵢ
+	ZC bn254.G1Affine
+
+	// TippMipp proof that ZAB and ZC are consistent with the commitments
+	TippMipp TippMippProof
+}
+
+// Aggregate aggregates Groth16 proofs, where proofs[i] is a proof for the
+// public inputs publicInputs[i]. The number of proofs is padded to the next
+// power of 2 by repeating the last proof.
+//
+// * dataTranscript extra data that might be needed to derive the challenges
+func Aggregate(proofs []Groth16Proof, publicInputs [][]fr.Element, hf hash.Hash, pk ProvingKey, dataTranscript ...[]byte) (AggregatedProof, error) {
+	if len(proofs) != len(publicInputs) {
+		return AggregatedProof{}, ErrInvalidPublicInputs
+	}
+	n, err := paddedSize(len(proofs), len(pk.G2A))
+	if err != nil {
+		return AggregatedProof{}, err
+	}
+	if 2*n > len(pk.G1A) || 2*n > len(pk.G1B) || n > len(pk.G2B) {
+		return AggregatedProof{}, ErrInvalidNbProofs
+	}
+
+	a := make([]bn254.G1Affine, n)
+	b := make([]bn254.G2Affine, n)
+	c := make([]bn254.G1Affine, n)
+	for i := 0; i < n; i++ {
+		p := &proofs[min(i, len(proofs)-1)]
+		a[i], b[i], c[i] = p.Ar, p.Bs, p.Krs
+	}
+	key := commitmentKey{
+		v1: pk.G2A[:n], v2: pk.G2B[:n],
+		w1: pk.G1A[n : 2*n], w2: pk.G1B[n : 2*n],
+	}
+
+	var res AggregatedProof
+	if res.ComAB, err = key.commitAB(a, b); err != nil {
+		return AggregatedProof{}, err
+	}
+	if res.ComC, err = key.commitC(c); err != nil {
+		return AggregatedProof{}, err
+	}
+
+	fs := newTranscript(hf, n)
+	r, err := deriveR(fs, publicInputs, &res, dataTranscript...)
+	if err != nil {
+		return AggregatedProof{}, err
+	}
+
+	// A'ᵢ = rⁱAᵢ, C'ᵢ = rⁱCᵢ, and the key v'ᵢ = r⁻ⁱvᵢ so that the commitments
+	// to A' and C' with v' are the commitments to A and C with v.
+	rPowers := powers(r, n)
+	var rInv fr.Element
+	rInvPowers := powers(*rInv.Inverse(&r), n)
+	a = scaleG1(a, rPowers)
+	c = scaleG1(c, rPowers)
+	key.v1 = scaleG2(key.v1, rInvPowers)
+	key.v2 = scaleG2(key.v2, rInvPowers)
+
+	if res.ZAB, err = pair(a, b); err != nil {
+		return AggregatedProof{}, err
+	}
+	var zc bn254.G1Jac
+	for i := range c {
+		zc.AddMixed(&c[i])
+	}
+	res.ZC.FromJacobian(&zc)
+
+	res.TippMipp, err = proveTippMipp(fs, &res, key, a, b, c, r, pk)
+	if err != nil {
+		return AggregatedProof{}, err
+	}
+	return res, nil
+}
+
+// Verify verifies an aggregated proof of Groth16 proofs for the public inputs
+// publicInputs and the Groth16 verifying key vk.
+func Verify(proof *AggregatedProof, publicInputs [][]fr.Element, vk *Groth16VerifyingKey, hf hash.Hash, srsVk VerifyingKey, dataTranscript ...[]byte) error {
+	n, err := paddedSize(len(publicInputs), math.MaxInt)
+	if err != nil {
+		return err
+	}
+	for i := range publicInputs {
+		if len(publicInputs[i])+1 != len(vk.K) {
+			return ErrInvalidPublicInputs
+		}
+	}
+
+	fs := newTranscript(hf, n)
+	r, err := deriveR(fs, publicInputs, proof, dataTranscript...)
+	if err != nil {
+		return err
+	}
+	if err := verifyTippMipp(fs, proof, n, r, srsVk); err != nil {
+		return err
+	}
+
+	// Z_AB = e(α, β)^{∑ᵢrⁱ} e(∑ᵢ rⁱSᵢ, γ) e(Z_C, δ)
+	// ∑ᵢ rⁱSᵢ = (∑ᵢ rⁱ)K₀ + ∑ⱼ (∑ᵢ rⁱxᵢⱼ)Kⱼ₊₁
+	rPowers := powers(r, n)
+	scalars := make([]fr.Element, len(vk.K))
+	for i := range rPowers {
+		scalars[0].Add(&scalars[0], &rPowers[i])
+		inputs := publicInputs[min(i, len(publicInputs)-1)]
+		var t fr.Element
+		for j := range inputs {
+			t.Mul(&rPowers[i], &inputs[j])
+			scalars[j+1].Add(&scalars[j+1], &t)
+		}
+	}
+	var s, alpha bn254.G1Affine
+	if _, err := s.MultiExp(vk.K, scalars, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	var bSum big.Int
+	alpha.ScalarMultiplication(&vk.Alpha, scalars[0].BigInt(&bSum))
+	right, err := pair(
+		[]bn254.G1Affine{alpha, s, proof.ZC},
+		[]bn254.G2Affine{vk.Beta, vk.Gamma, vk.Delta},
+	)
+	if err != nil {
+		return err
+	}
+	if !right.Equal(&proof.ZAB) {
+		return ErrVerifyGroth16
+	}
+	return nil
+}
+
+// paddedSize returns the number of aggregated proofs, padded to the next power
+// of 2 (at least 2), if it is at most max.
+func paddedSize(nbProofs, max int) (int, error) {
+	if nbProofs < 1 || nbProofs > max {
+		return 0, ErrInvalidNbProofs
+	}
+	n := int(ecc.NextPowerOfTwo(uint64(nbProofs)))
+	if n < 2 {
+		n = 2
+	}
+	if n > max {
+		return 0, ErrInvalidNbProofs
+	}
+	return n, nil
+}
+
+// pair returns ∏ᵢ e(Pᵢ, Qᵢ).
+func pair(P []bn254.G1Affine, Q []bn254.G2Affine) (bn254.GT, error) {
+	ml, err := bn254.MillerLoop(P, Q)
+	if err != nil {
+		return bn254.GT{}, err
+	}
+	return bn254.FinalExponentiation(&ml), nil
+}
+
+// scaleG1 returns (sᵢPᵢ)ᵢ.
+func scaleG1(points []bn254.G1Affine, scalars []fr.Element) []bn254.G1Affine {
+	res := make([]bn254.G1Jac, len(points))
+	parallel.Execute(len(points), func(start, end int) {
+		var b big.Int
+		for i := start; i < end; i++ {
+			res[i].FromAffine(&points[i])
+			res[i].ScalarMultiplication(&res[i], scalars[i].BigInt(&b))
+		}
+	})
+	return bn254.BatchJacobianToAffineG1(res)
+}
+
+// scaleG2 returns (sᵢQᵢ)ᵢ.
+func scaleG2(points []bn254.G2Affine, scalars []fr.Element) []bn254.G2Affine {
+	res := make([]bn254.G2Affine, len(points))
+	parallel.Execute(len(points), func(start, end int) {
+		var b big.Int
+		for i := start; i < end; i++ {
+			res[i].ScalarMultiplication(&points[i], scalars[i].BigInt(&b))
+		}
+	})
+	return res
+}
+
+// powers returns 1, x, ..., xⁿ⁻¹.
+func powers(x fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &x)
+	}
+	return res
+}
+
+func newTranscript(hf hash.Hash, n int) *fiatshamir.Transcript {
+	challenges := []string{"r"}
+	for k := 1; k < n; k <<= 1 {
+		challenges = append(challenges, "x"+strconv.Itoa(len(challenges)-1))
+	}
+	challenges = append(challenges, "z")
+	return fiatshamir.NewTranscript(hf, challenges...)
+}
+
+// deriveR derives the challenge r, binded to the public inputs and the
+// commitments to the proofs.
+func deriveR(fs *fiatshamir.Transcript, publicInputs [][]fr.Element, proof *AggregatedProof, dataTranscript ...[]byte) (fr.Element, error) {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(len(publicInputs)))
+	if err := fs.Bind("r", buf[:]); err != nil {
+		return fr.Element{}, err
+	}
+	for i := range publicInputs {
+		for j := range publicInputs[i] {
+			if err := fs.Bind("r", publicInputs[i][j].Marshal()); err != nil {
+				return fr.Element{}, err
+			}
+		}
+	}
+	if err := bindGT(fs, "r", &proof.ComAB.T, &proof.ComAB.U, &proof.ComC.T, &proof.ComC.U); err != nil {
+		return fr.Element{}, err
+	}
+	for i := range dataTranscript {
+		if err := fs.Bind("r", dataTranscript[i]); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	return computeChallenge(fs, "r")
+}
+
+func bindGT(fs *fiatshamir.Transcript, name string, elements ...*bn254.GT) error {
+	for _, e := range elements {
+		b := e.Bytes()
+		if err := fs.Bind(name, b[:]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// computeChallenge returns the challenge name as a non-zero field element.
+func computeChallenge(fs *fiatshamir.Transcript, name string) (fr.Element, error) {
+	b, err := fs.ComputeChallenge(name)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var res fr.Element
+	res.SetBytes(b)
+	if res.IsZero() {
+		return fr.Element{}, ErrVerifyAggregation
+	}
+	return res, nil
+}
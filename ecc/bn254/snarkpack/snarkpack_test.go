@@ -0,0 +1,263 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package snarkpack
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/stretchr/testify/require"
+)
+
+// SRS re-used across tests of the aggregation
+var testSrs *SRS
+
+func init() {
+	var err error
+	testSrs, err = NewSRS(16, big.NewInt(42), big.NewInt(1789))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// groth16Setup simulates a Groth16 setup for nbPublicInputs public inputs,
+// keeping the discrete logarithms to compute valid proofs without a circuit.
+type groth16Setup struct {
+	alpha, beta, gamma, delta fr.Element
+	k                         []fr.Element
+	vk                        Groth16VerifyingKey
+}
+
+func newGroth16Setup(nbPublicInputs int) groth16Setup {
+	var res groth16Setup
+	res.alpha.SetRandom()
+	res.beta.SetRandom()
+	res.gamma.SetRandom()
+	res.delta.SetRandom()
+	res.k = make([]fr.Element, nbPublicInputs+1)
+	for i := range res.k {
+		res.k[i].SetRandom()
+	}
+
+	_, _, g1, g2 := bn254.Generators()
+	var b big.Int
+	res.vk.Alpha.ScalarMultiplication(&g1, res.alpha.BigInt(&b))
+	res.vk.Beta.ScalarMultiplication(&g2, res.beta.BigInt(&b))
+	res.vk.Gamma.ScalarMultiplication(&g2, res.gamma.BigInt(&b))
+	res.vk.Delta.ScalarMultiplication(&g2, res.delta.BigInt(&b))
+	res.vk.K = bn254.BatchScalarMultiplicationG1(&g1, res.k)
+	return res
+}
+
+// prove returns a valid proof for the public inputs: A = [a]G₁, B = [b]G₂ and
+// C = [(ab - αβ - sγ)/δ]G₁ with s = k₀ + ∑ⱼ xⱼkⱼ₊₁.
+func (setup *groth16Setup) prove(publicInputs []fr.Element) Groth16Proof {
+	var a, b, c, s, t fr.Element
+	a.SetRandom()
+	b.SetRandom()
+	s.Set(&setup.k[0])
+	for j := range publicInputs {
+		t.Mul(&publicInputs[j], &setup.k[j+1])
+		s.Add(&s, &t)
+	}
+	c.Mul(&a, &b)
+	t.Mul(&setup.alpha, &setup.beta)
+	c.Sub(&c, &t)
+	t.Mul(&s, &setup.gamma)
+	c.Sub(&c, &t)
+	t.Inverse(&setup.delta)
+	c.Mul(&c, &t)
+
+	_, _, g1, g2 := bn254.Generators()
+	var res Groth16Proof
+	var bi big.Int
+	res.Ar.ScalarMultiplication(&g1, a.BigInt(&bi))
+	res.Bs.ScalarMultiplication(&g2, b.BigInt(&bi))
+	res.Krs.ScalarMultiplication(&g1, c.BigInt(&bi))
+	return res
+}
+
+func (setup *groth16Setup) proveAll(nbProofs int) ([]Groth16Proof, [][]fr.Element) {
+	proofs := make([]Groth16Proof, nbProofs)
+	publicInputs := make([][]fr.Element, nbProofs)
+	for i := range proofs {
+		publicInputs[i] = make([]fr.Element, len(setup.k)-1)
+		for j := range publicInputs[i] {
+			publicInputs[i][j].SetRandom()
+		}
+		proofs[i] = setup.prove(publicInputs[i])
+	}
+	return proofs, publicInputs
+}
+
+func TestAggregate(t *testing.T) {
+	assert := require.New(t)
+
+	setup := newGroth16Setup(3)
+	for _, nbProofs := range []int{1, 3, 8, 16} {
+		proofs, publicInputs := setup.proveAll(nbProofs)
+
+		proof, err := Aggregate(proofs, publicInputs, sha256.New(), testSrs.Pk)
+		assert.NoError(err)
+		assert.NoError(Verify(&proof, publicInputs, &setup.vk, sha256.New(), testSrs.Vk))
+
+		// wrong public input
+		publicInputs[nbProofs-1][0].SetRandom()
+		assert.Error(Verify(&proof, publicInputs, &setup.vk, sha256.New(), testSrs.Vk))
+	}
+
+	proofs, publicInputs := setup.proveAll(4)
+	proof, err := Aggregate(proofs, publicInputs, sha256.New(), testSrs.Pk, []byte("data"))
+	assert.NoError(err)
+	assert.NoError(Verify(&proof, publicInputs, &setup.vk, sha256.New(), testSrs.Vk, []byte("data")))
+	assert.Error(Verify(&proof, publicInputs, &setup.vk, sha256.New(), testSrs.Vk))
+
+	// missing proof
+	assert.Error(Verify(&proof, publicInputs[1:], &setup.vk, sha256.New(), testSrs.Vk, []byte("data")))
+
+	_, err = Aggregate(nil, nil, sha256.New(), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidNbProofs)
+	proofs, publicInputs = setup.proveAll(17)
+	_, err = Aggregate(proofs, publicInputs, sha256.New(), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidNbProofs)
+	_, err = Aggregate(proofs[:2], publicInputs[:1], sha256.New(), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPublicInputs)
+}
+
+func TestAggregateInvalidProof(t *testing.T) {
+	assert := require.New(t)
+
+	setup := newGroth16Setup(2)
+	proofs, publicInputs := setup.proveAll(5)
+
+	// a proof for other public inputs
+	other := make([]fr.Element, 2)
+	other[0].SetRandom()
+	other[1].SetRandom()
+	proofs[2] = setup.prove(other)
+
+	proof, err := Aggregate(proofs, publicInputs, sha256.New(), testSrs.Pk)
+	assert.NoError(err)
+	assert.ErrorIs(Verify(&proof, publicInputs, &setup.vk, sha256.New(), testSrs.Vk), ErrVerifyGroth16)
+}
+
+func TestAggregatedProofTampered(t *testing.T) {
+	assert := require.New(t)
+
+	setup := newGroth16Setup(1)
+	proofs, publicInputs := setup.proveAll(8)
+	proof, err := Aggregate(proofs, publicInputs, sha256.New(), testSrs.Pk)
+	assert.NoError(err)
+
+	_, _, g1, _ := bn254.Generators()
+
+	tampered := proof
+	tampered.ZC.Add(&tampered.ZC, &g1)
+	assert.Error(Verify(&tampered, publicInputs, &setup.vk, sha256.New(), testSrs.Vk))
+
+	tampered = proof
+	tampered.TippMipp.C.Add(&tampered.TippMipp.C, &g1)
+	assert.Error(Verify(&tampered, publicInputs, &setup.vk, sha256.New(), testSrs.Vk))
+
+	tampered = proof
+	tampered.TippMipp.W1.Add(&tampered.TippMipp.W1, &g1)
+	assert.Error(Verify(&tampered, publicInputs, &setup.vk, sha256.New(), testSrs.Vk))
+
+	tampered = proof
+	tampered.TippMipp.OpeningW2.Add(&tampered.TippMipp.OpeningW2, &g1)
+	assert.Error(Verify(&tampered, publicInputs, &setup.vk, sha256.New(), testSrs.Vk))
+
+	tampered = proof
+	tampered.ZAB, tampered.ComAB.T = proof.ComAB.T, proof.ZAB
+	assert.Error(Verify(&tampered, publicInputs, &setup.vk, sha256.New(), testSrs.Vk))
+
+	tampered = proof
+	tampered.TippMipp.ZCL = proof.TippMipp.ZCL[1:]
+	assert.ErrorIs(Verify(&tampered, publicInputs, &setup.vk, sha256.New(), testSrs.Vk), ErrInvalidProof)
+}
+
+func TestSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	setup := newGroth16Setup(2)
+	proofs, publicInputs := setup.proveAll(4)
+	proof, err := Aggregate(proofs, publicInputs, sha256.New(), testSrs.Pk)
+	assert.NoError(err)
+
+	var buf bytes.Buffer
+	written, err := proof.WriteTo(&buf)
+	assert.NoError(err)
+	assert.Equal(int64(buf.Len()), written)
+
+	var decoded AggregatedProof
+	read, err := decoded.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(proof, decoded)
+	assert.NoError(Verify(&decoded, publicInputs, &setup.vk, sha256.New(), testSrs.Vk))
+
+	// the number of rounds is bounded before any allocation: ComABL comes
+	// after ComAB, ComC, ZAB and ZC
+	buf.Reset()
+	_, err = proof.WriteTo(&buf)
+	assert.NoError(err)
+	data := buf.Bytes()
+	offset := 5*bn254.SizeOfGT + bn254.SizeOfG1AffineCompressed
+	assert.Equal(uint32(2), binary.BigEndian.Uint32(data[offset:]))
+	binary.BigEndian.PutUint32(data[offset:], 1<<32-1)
+	_, err = decoded.ReadFrom(bytes.NewReader(data))
+	assert.ErrorIs(err, ErrInvalidProof)
+	huge := []byte{0xff, 0xff, 0xff, 0xff}
+	_, err = new(gtVector).ReadFrom(bytes.NewReader(huge))
+	assert.ErrorIs(err, ErrInvalidProof)
+	_, err = new(g1Vector).ReadFrom(bytes.NewReader(huge))
+	assert.ErrorIs(err, ErrInvalidProof)
+
+	buf.Reset()
+	written, err = testSrs.Pk.WriteTo(&buf)
+	assert.NoError(err)
+	var pk ProvingKey
+	read, err = pk.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(testSrs.Pk, pk)
+
+	buf.Reset()
+	written, err = testSrs.Vk.WriteTo(&buf)
+	assert.NoError(err)
+	var vk VerifyingKey
+	read, err = vk.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(testSrs.Vk, vk)
+}
+
+func BenchmarkAggregate(b *testing.B) {
+	setup := newGroth16Setup(2)
+	proofs, publicInputs := setup.proveAll(16)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Aggregate(proofs, publicInputs, sha256.New(), testSrs.Pk)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	setup := newGroth16Setup(2)
+	proofs, publicInputs := setup.proveAll(16)
+	proof, err := Aggregate(proofs, publicInputs, sha256.New(), testSrs.Pk)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Verify(&proof, publicInputs, &setup.vk, sha256.New(), testSrs.Vk)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bulletproofs
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+// ReadFrom decodes Generators data from reader.
+func (gens *Generators) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r, &gens.G, &gens.H, &gens.Gs, &gens.Hs, &gens.U)
+}
+
+// WriteTo writes binary encoding of Generators
+func (gens *Generators) WriteTo(w io.Writer) (int64, error) {
+	return encode(w, &gens.G, &gens.H, gens.Gs, gens.Hs, &gens.U)
+}
+
+// ReadFrom decodes InnerProductProof data from reader.
+func (proof *InnerProductProof) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r, &proof.L, &proof.R, &proof.A, &proof.B)
+}
+
+// WriteTo writes binary encoding of a InnerProductProof
+func (proof *InnerProductProof) WriteTo(w io.Writer) (int64, error) {
+	return encode(w, proof.L, proof.R, &proof.A, &proof.B)
+}
+
+// ReadFrom decodes RangeProof data from reader.
+func (proof *RangeProof) ReadFrom(r io.Reader) (int64, error) {
+	return decode(r, &proof.A, &proof.S, &proof.T1, &proof.T2, &proof.TauX, &proof.Mu, &proof.T, &proof.InnerProduct)
+}
+
+// WriteTo writes binary encoding of a RangeProof
+func (proof *RangeProof) WriteTo(w io.Writer) (int64, error) {
+	return encode(w, &proof.A, &proof.S, &proof.T1, &proof.T2, &proof.TauX, &proof.Mu, &proof.T, &proof.InnerProduct)
+}
+
+var errUnsupportedType = errors.New("unsupported type")
+
+// secp256k1 has no Encoder: points are written uncompressed with
+// RawBytes, field elements in big-endian, and slices are prefixed with their
+// length on 4 bytes.
+
+func encode(w io.Writer, toEncode ...interface{}) (int64, error) {
+	var n int64
+	write := func(b []byte) error {
+		written, err := w.Write(b)
+		n += int64(written)
+		return err
+	}
+	writeLen := func(l int) error {
+		var buf [4]byte
+		binary.BigEndian.PutUint32(buf[:], uint32(l))
+		return write(buf[:])
+	}
+	for _, v := range toEncode {
+		var err error
+		switch t := v.(type) {
+		case *secp256k1.G1Affine:
+			b := t.RawBytes()
+			err = write(b[:])
+		case []secp256k1.G1Affine:
+			if err = writeLen(len(t)); err != nil {
+				return n, err
+			}
+			for i := range t {
+				b := t[i].RawBytes()
+				if err = write(b[:]); err != nil {
+					return n, err
+				}
+			}
+		case *fr.Element:
+			b := t.Bytes()
+			err = write(b[:])
+		case io.WriterTo:
+			var written int64
+			written, err = t.WriteTo(w)
+			n += written
+		default:
+			err = errUnsupportedType
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func decode(r io.Reader, toDecode ...interface{}) (int64, error) {
+	var n int64
+	var buf [secp256k1.SizeOfG1AffineUncompressed]byte
+	read := func(b []byte) error {
+		read, err := io.ReadFull(r, b)
+		n += int64(read)
+		return err
+	}
+	for _, v := range toDecode {
+		var err error
+		switch t := v.(type) {
+		case *secp256k1.G1Affine:
+			if err = read(buf[:]); err == nil {
+				_, err = t.SetBytes(buf[:])
+			}
+		case *[]secp256k1.G1Affine:
+			if err = read(buf[:4]); err != nil {
+				return n, err
+			}
+			*t = make([]secp256k1.G1Affine, binary.BigEndian.Uint32(buf[:4]))
+			for i := range *t {
+				if err = read(buf[:]); err != nil {
+					return n, err
+				}
+				if _, err = (*t)[i].SetBytes(buf[:]); err != nil {
+					return n, err
+				}
+			}
+		case *fr.Element:
+			if err = read(buf[:fr.Bytes]); err == nil {
+				err = t.SetBytesCanonical(buf[:fr.Bytes])
+			}
+		case io.ReaderFrom:
+			var read int64
+			read, err = t.ReadFrom(r)
+			n += read
+		default:
+			err = errUnsupportedType
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
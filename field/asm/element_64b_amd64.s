@@ -0,0 +1,803 @@
+// Code generated by gnark-crypto/generator. DO NOT EDIT.
+#include "textflag.h"
+#include "funcdata.h"
+#include "go_asm.h"
+
+// mul(res, x, y *Element) res = x * y (mod q) in Montgomery form
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVQ x+8(FP), BX
+	MOVQ 0(BX), AX
+	MOVQ y+16(FP), BX
+	MULQ 0(BX)         // DX:AX = x * y
+	MOVQ AX, R15
+	SHLQ $32, R15
+	ADDQ AX, R15       // m = lo + lo << 32
+	MOVQ R15, R14
+	SHLQ $32, R14      // u = m << 32
+	MOVQ R15, CX
+	SHRQ $32, CX
+	NEGQ CX
+	ADDQ R15, CX       // h = m - (m >> 32)
+	CMPQ R15, R14
+	SBBQ $0, CX        // h = high word of m * q
+	SUBQ CX, DX        // hi = hi - h
+	SBBQ R14, R14
+	MOVL R14, R14      // 2³² - 1 if hi < h, 0 otherwise
+	SUBQ R14, DX       // adds q if hi < h
+	MOVQ res+0(FP), BX
+	MOVQ DX, 0(BX)
+	RET
+
+// Butterfly(a, b *Element) sets a = a + b; b = a - b
+TEXT ·Butterfly(SB), NOSPLIT, $0-16
+	MOVQ    a+0(FP), AX
+	MOVQ    b+8(FP), DX
+	MOVQ    0(AX), CX
+	MOVQ    0(DX), BX
+	MOVL    $0xffffffff, R8 // e = 2³² - 1 = 2⁶⁴ mod q
+	MOVQ    CX, SI
+	ADDQ    BX, SI          // s = a + b
+	SBBQ    DI, DI
+	ANDQ    R8, DI
+	ADDQ    DI, SI          // s = s + e if a + b overflows
+	MOVQ    SI, DI
+	ADDQ    R8, DI          // t = s - q
+	CMOVQCS DI, SI          // s = t if s >= q
+	SUBQ    BX, CX          // a = a - b
+	SBBQ    DI, DI
+	ANDQ    R8, DI
+	SUBQ    DI, CX          // a = a + q if a < b
+	MOVQ    SI, 0(AX)
+	MOVQ    CX, 0(DX)
+	RET
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	MOVQ         $0xffffffff00000001, SI
+	VPBROADCASTQ SI, Z31
+	MOVL         $0xffffffff, SI
+	VPBROADCASTQ SI, Z30
+	VPTERNLOGD   $0xff, Z29, Z29, Z29
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+loop_1:
+	TESTQ     BX, BX
+	JEQ       done_2          // n == 0, we are done
+	VMOVDQU64 0(DX), Z0
+	VMOVDQU64 0(CX), Z1
+	VPSUBQ    Z1, Z31, Z2
+	VPCMPUQ   $1, Z2, Z0, K1
+	VPSUBQ    Z2, Z0, Z0
+	VPADDQ    Z31, Z0, K1, Z0
+	VMOVDQU64 Z0, 0(AX)
+
+	// increment pointers to visit next element
+	ADDQ $64, AX
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX      // decrement n
+	JMP  loop_1
+
+done_2:
+	VZEROUPPER
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	MOVQ         $0xffffffff00000001, SI
+	VPBROADCASTQ SI, Z31
+	MOVL         $0xffffffff, SI
+	VPBROADCASTQ SI, Z30
+	VPTERNLOGD   $0xff, Z29, Z29, Z29
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+loop_3:
+	TESTQ     BX, BX
+	JEQ       done_4          // n == 0, we are done
+	VMOVDQU64 0(DX), Z0
+	VMOVDQU64 0(CX), Z1
+	VPCMPUQ   $1, Z1, Z0, K1
+	VPSUBQ    Z1, Z0, Z0
+	VPADDQ    Z31, Z0, K1, Z0
+	VMOVDQU64 Z0, 0(AX)
+
+	// increment pointers to visit next element
+	ADDQ $64, AX
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX      // decrement n
+	JMP  loop_3
+
+done_4:
+	VZEROUPPER
+	RET
+
+// sumVec(t, a *Element, n uint64) t[0...8] = partial sums of a[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·sumVec(SB), NOSPLIT, $0-24
+	MOVQ         $0xffffffff00000001, BX
+	VPBROADCASTQ BX, Z31
+	MOVL         $0xffffffff, BX
+	VPBROADCASTQ BX, Z30
+	VPTERNLOGD   $0xff, Z29, Z29, Z29
+	MOVQ         t+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         n+16(FP), CX
+	VPXORQ       Z7, Z7, Z7
+
+loop_5:
+	TESTQ     CX, CX
+	JEQ       done_6          // n == 0, we are done
+	VMOVDQU64 0(DX), Z0
+	VPSUBQ    Z0, Z31, Z1
+	VPCMPUQ   $1, Z1, Z7, K1
+	VPSUBQ    Z1, Z7, Z7
+	VPADDQ    Z31, Z7, K1, Z7
+
+	// increment pointers to visit next element
+	ADDQ $64, DX
+	DECQ CX      // decrement n
+	JMP  loop_5
+
+done_6:
+	VMOVDQU64 Z7, 0(AX)
+	VZEROUPPER
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·mulVec(SB), NOSPLIT, $0-32
+	MOVQ         $0xffffffff00000001, SI
+	VPBROADCASTQ SI, Z31
+	MOVL         $0xffffffff, SI
+	VPBROADCASTQ SI, Z30
+	VPTERNLOGD   $0xff, Z29, Z29, Z29
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+loop_7:
+	TESTQ     BX, BX
+	JEQ       done_8          // n == 0, we are done
+	VMOVDQU64 0(DX), Z0
+	VMOVDQU64 0(CX), Z1
+	VPSRLQ    $32, Z0, Z2
+	VPSRLQ    $32, Z1, Z3
+	VPMULUDQ  Z1, Z0, Z4
+	VPMULUDQ  Z3, Z0, Z5
+	VPMULUDQ  Z1, Z2, Z6
+	VPMULUDQ  Z3, Z2, Z2
+	VPSRLQ    $32, Z4, Z3
+	VPADDQ    Z3, Z5, Z5
+	VPANDQ    Z30, Z5, Z3
+	VPADDQ    Z3, Z6, Z6
+	VPSRLQ    $32, Z5, Z5
+	VPADDQ    Z5, Z2, Z2
+	VPSRLQ    $32, Z6, Z3
+	VPADDQ    Z3, Z2, Z2
+	VPSLLQ    $32, Z6, Z6
+	VPANDQ    Z30, Z4, Z4
+	VPORQ     Z6, Z4, Z4
+	VPSLLQ    $32, Z4, Z3
+	VPADDQ    Z3, Z4, Z4
+	VPSLLQ    $32, Z4, Z3
+	VPCMPUQ   $1, Z3, Z4, K2
+	VPSRLQ    $32, Z4, Z5
+	VPSUBQ    Z5, Z4, Z4
+	VPADDQ    Z29, Z4, K2, Z4
+	VPCMPUQ   $1, Z4, Z2, K2
+	VPSUBQ    Z4, Z2, Z0
+	VPADDQ    Z31, Z0, K2, Z0
+	VMOVDQU64 Z0, 0(AX)
+
+	// increment pointers to visit next element
+	ADDQ $64, AX
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX      // decrement n
+	JMP  loop_7
+
+done_8:
+	VZEROUPPER
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b
+// n is the number of blocks of 8 elements to process
+TEXT ·scalarMulVec(SB), NOSPLIT, $0-32
+	MOVQ         $0xffffffff00000001, SI
+	VPBROADCASTQ SI, Z31
+	MOVL         $0xffffffff, SI
+	VPBROADCASTQ SI, Z30
+	VPTERNLOGD   $0xff, Z29, Z29, Z29
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+	MOVQ         0(CX), SI
+	VPBROADCASTQ SI, Z12
+
+loop_9:
+	TESTQ     BX, BX
+	JEQ       done_10         // n == 0, we are done
+	VMOVDQU64 0(DX), Z0
+	VPSRLQ    $32, Z0, Z2
+	VPSRLQ    $32, Z12, Z3
+	VPMULUDQ  Z12, Z0, Z4
+	VPMULUDQ  Z3, Z0, Z5
+	VPMULUDQ  Z12, Z2, Z6
+	VPMULUDQ  Z3, Z2, Z2
+	VPSRLQ    $32, Z4, Z3
+	VPADDQ    Z3, Z5, Z5
+	VPANDQ    Z30, Z5, Z3
+	VPADDQ    Z3, Z6, Z6
+	VPSRLQ    $32, Z5, Z5
+	VPADDQ    Z5, Z2, Z2
+	VPSRLQ    $32, Z6, Z3
+	VPADDQ    Z3, Z2, Z2
+	VPSLLQ    $32, Z6, Z6
+	VPANDQ    Z30, Z4, Z4
+	VPORQ     Z6, Z4, Z4
+	VPSLLQ    $32, Z4, Z3
+	VPADDQ    Z3, Z4, Z4
+	VPSLLQ    $32, Z4, Z3
+	VPCMPUQ   $1, Z3, Z4, K2
+	VPSRLQ    $32, Z4, Z5
+	VPSUBQ    Z5, Z4, Z4
+	VPADDQ    Z29, Z4, K2, Z4
+	VPCMPUQ   $1, Z4, Z2, K2
+	VPSUBQ    Z4, Z2, Z0
+	VPADDQ    Z31, Z0, K2, Z0
+	VMOVDQU64 Z0, 0(AX)
+
+	// increment pointers to visit next element
+	ADDQ $64, AX
+	ADDQ $64, DX
+	DECQ BX      // decrement n
+	JMP  loop_9
+
+done_10:
+	VZEROUPPER
+	RET
+
+// innerProdVec(t, a, b *Element, n uint64) t[0...8] = partial sums of a[0...n] * b[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·innerProdVec(SB), NOSPLIT, $0-32
+	MOVQ         $0xffffffff00000001, SI
+	VPBROADCASTQ SI, Z31
+	MOVL         $0xffffffff, SI
+	VPBROADCASTQ SI, Z30
+	VPTERNLOGD   $0xff, Z29, Z29, Z29
+	MOVQ         t+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+	VPXORQ       Z7, Z7, Z7
+
+loop_11:
+	TESTQ     BX, BX
+	JEQ       done_12         // n == 0, we are done
+	VMOVDQU64 0(DX), Z0
+	VMOVDQU64 0(CX), Z1
+	VPSRLQ    $32, Z0, Z2
+	VPSRLQ    $32, Z1, Z3
+	VPMULUDQ  Z1, Z0, Z4
+	VPMULUDQ  Z3, Z0, Z5
+	VPMULUDQ  Z1, Z2, Z6
+	VPMULUDQ  Z3, Z2, Z2
+	VPSRLQ    $32, Z4, Z3
+	VPADDQ    Z3, Z5, Z5
+	VPANDQ    Z30, Z5, Z3
+	VPADDQ    Z3, Z6, Z6
+	VPSRLQ    $32, Z5, Z5
+	VPADDQ    Z5, Z2, Z2
+	VPSRLQ    $32, Z6, Z3
+	VPADDQ    Z3, Z2, Z2
+	VPSLLQ    $32, Z6, Z6
+	VPANDQ    Z30, Z4, Z4
+	VPORQ     Z6, Z4, Z4
+	VPSLLQ    $32, Z4, Z3
+	VPADDQ    Z3, Z4, Z4
+	VPSLLQ    $32, Z4, Z3
+	VPCMPUQ   $1, Z3, Z4, K2
+	VPSRLQ    $32, Z4, Z5
+	VPSUBQ    Z5, Z4, Z4
+	VPADDQ    Z29, Z4, K2, Z4
+	VPCMPUQ   $1, Z4, Z2, K2
+	VPSUBQ    Z4, Z2, Z0
+	VPADDQ    Z31, Z0, K2, Z0
+	VPSUBQ    Z0, Z31, Z1
+	VPCMPUQ   $1, Z1, Z7, K1
+	VPSUBQ    Z1, Z7, Z7
+	VPADDQ    Z31, Z7, K1, Z7
+
+	// increment pointers to visit next element
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX      // decrement n
+	JMP  loop_11
+
+done_12:
+	VMOVDQU64 Z7, 0(AX)
+	VZEROUPPER
+	RET
+
+// addVecAVX2(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·addVecAVX2(SB), NOSPLIT, $0-32
+	MOVQ         $0xffffffff00000001, SI
+	MOVQ         SI, X15
+	VPBROADCASTQ X15, Y15
+	MOVL         $0xffffffff, SI
+	MOVQ         SI, X14
+	VPBROADCASTQ X14, Y14
+	MOVQ         $0x8000000000000000, SI
+	MOVQ         SI, X13
+	VPBROADCASTQ X13, Y13
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+loop_13:
+	TESTQ    BX, BX
+	JEQ      done_14     // n == 0, we are done
+	VMOVDQU  0(DX), Y0
+	VMOVDQU  0(CX), Y1
+	VPSUBQ   Y1, Y15, Y2
+	VPXOR    Y13, Y0, Y4
+	VPXOR    Y13, Y2, Y3
+	VPCMPGTQ Y4, Y3, Y3
+	VPSUBQ   Y2, Y0, Y0
+	VPAND    Y15, Y3, Y3
+	VPADDQ   Y3, Y0, Y0
+	VMOVDQU  Y0, 0(AX)
+	VMOVDQU  32(DX), Y0
+	VMOVDQU  32(CX), Y1
+	VPSUBQ   Y1, Y15, Y2
+	VPXOR    Y13, Y0, Y4
+	VPXOR    Y13, Y2, Y3
+	VPCMPGTQ Y4, Y3, Y3
+	VPSUBQ   Y2, Y0, Y0
+	VPAND    Y15, Y3, Y3
+	VPADDQ   Y3, Y0, Y0
+	VMOVDQU  Y0, 32(AX)
+
+	// increment pointers to visit next element
+	ADDQ $64, AX
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX      // decrement n
+	JMP  loop_13
+
+done_14:
+	VZEROUPPER
+	RET
+
+// subVecAVX2(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·subVecAVX2(SB), NOSPLIT, $0-32
+	MOVQ         $0xffffffff00000001, SI
+	MOVQ         SI, X15
+	VPBROADCASTQ X15, Y15
+	MOVL         $0xffffffff, SI
+	MOVQ         SI, X14
+	VPBROADCASTQ X14, Y14
+	MOVQ         $0x8000000000000000, SI
+	MOVQ         SI, X13
+	VPBROADCASTQ X13, Y13
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+loop_15:
+	TESTQ    BX, BX
+	JEQ      done_16     // n == 0, we are done
+	VMOVDQU  0(DX), Y0
+	VMOVDQU  0(CX), Y1
+	VPXOR    Y13, Y0, Y3
+	VPXOR    Y13, Y1, Y2
+	VPCMPGTQ Y3, Y2, Y2
+	VPSUBQ   Y1, Y0, Y0
+	VPAND    Y15, Y2, Y2
+	VPADDQ   Y2, Y0, Y0
+	VMOVDQU  Y0, 0(AX)
+	VMOVDQU  32(DX), Y0
+	VMOVDQU  32(CX), Y1
+	VPXOR    Y13, Y0, Y3
+	VPXOR    Y13, Y1, Y2
+	VPCMPGTQ Y3, Y2, Y2
+	VPSUBQ   Y1, Y0, Y0
+	VPAND    Y15, Y2, Y2
+	VPADDQ   Y2, Y0, Y0
+	VMOVDQU  Y0, 32(AX)
+
+	// increment pointers to visit next element
+	ADDQ $64, AX
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX      // decrement n
+	JMP  loop_15
+
+done_16:
+	VZEROUPPER
+	RET
+
+// sumVecAVX2(t, a *Element, n uint64) t[0...8] = partial sums of a[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·sumVecAVX2(SB), NOSPLIT, $0-24
+	MOVQ         $0xffffffff00000001, BX
+	MOVQ         BX, X15
+	VPBROADCASTQ X15, Y15
+	MOVL         $0xffffffff, BX
+	MOVQ         BX, X14
+	VPBROADCASTQ X14, Y14
+	MOVQ         $0x8000000000000000, BX
+	MOVQ         BX, X13
+	VPBROADCASTQ X13, Y13
+	MOVQ         t+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         n+16(FP), CX
+	VPXOR        Y7, Y7, Y7
+	VPXOR        Y8, Y8, Y8
+
+loop_17:
+	TESTQ    CX, CX
+	JEQ      done_18     // n == 0, we are done
+	VMOVDQU  0(DX), Y0
+	VPSUBQ   Y0, Y15, Y1
+	VPXOR    Y13, Y7, Y3
+	VPXOR    Y13, Y1, Y2
+	VPCMPGTQ Y3, Y2, Y2
+	VPSUBQ   Y1, Y7, Y7
+	VPAND    Y15, Y2, Y2
+	VPADDQ   Y2, Y7, Y7
+	VMOVDQU  32(DX), Y0
+	VPSUBQ   Y0, Y15, Y1
+	VPXOR    Y13, Y8, Y3
+	VPXOR    Y13, Y1, Y2
+	VPCMPGTQ Y3, Y2, Y2
+	VPSUBQ   Y1, Y8, Y8
+	VPAND    Y15, Y2, Y2
+	VPADDQ   Y2, Y8, Y8
+
+	// increment pointers to visit next element
+	ADDQ $64, DX
+	DECQ CX      // decrement n
+	JMP  loop_17
+
+done_18:
+	VMOVDQU Y7, 0(AX)
+	VMOVDQU Y8, 32(AX)
+	VZEROUPPER
+	RET
+
+// mulVecAVX2(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·mulVecAVX2(SB), NOSPLIT, $0-32
+	MOVQ         $0xffffffff00000001, SI
+	MOVQ         SI, X15
+	VPBROADCASTQ X15, Y15
+	MOVL         $0xffffffff, SI
+	MOVQ         SI, X14
+	VPBROADCASTQ X14, Y14
+	MOVQ         $0x8000000000000000, SI
+	MOVQ         SI, X13
+	VPBROADCASTQ X13, Y13
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+loop_19:
+	TESTQ    BX, BX
+	JEQ      done_20     // n == 0, we are done
+	VMOVDQU  0(DX), Y0
+	VMOVDQU  0(CX), Y1
+	VPSRLQ   $32, Y0, Y2
+	VPSRLQ   $32, Y1, Y3
+	VPMULUDQ Y1, Y0, Y4
+	VPMULUDQ Y3, Y0, Y5
+	VPMULUDQ Y1, Y2, Y6
+	VPMULUDQ Y3, Y2, Y2
+	VPSRLQ   $32, Y4, Y3
+	VPADDQ   Y3, Y5, Y5
+	VPAND    Y14, Y5, Y3
+	VPADDQ   Y3, Y6, Y6
+	VPSRLQ   $32, Y5, Y5
+	VPADDQ   Y5, Y2, Y2
+	VPSRLQ   $32, Y6, Y3
+	VPADDQ   Y3, Y2, Y2
+	VPSLLQ   $32, Y6, Y6
+	VPAND    Y14, Y4, Y4
+	VPOR     Y6, Y4, Y4
+	VPSLLQ   $32, Y4, Y3
+	VPADDQ   Y3, Y4, Y4
+	VPSLLQ   $32, Y4, Y3
+	VPXOR    Y13, Y4, Y6
+	VPXOR    Y13, Y3, Y3
+	VPCMPGTQ Y6, Y3, Y3
+	VPSRLQ   $32, Y4, Y5
+	VPSUBQ   Y5, Y4, Y4
+	VPADDQ   Y3, Y4, Y4
+	VPXOR    Y13, Y2, Y6
+	VPXOR    Y13, Y4, Y5
+	VPCMPGTQ Y6, Y5, Y5
+	VPSUBQ   Y4, Y2, Y0
+	VPAND    Y15, Y5, Y5
+	VPADDQ   Y5, Y0, Y0
+	VMOVDQU  Y0, 0(AX)
+	VMOVDQU  32(DX), Y0
+	VMOVDQU  32(CX), Y1
+	VPSRLQ   $32, Y0, Y2
+	VPSRLQ   $32, Y1, Y3
+	VPMULUDQ Y1, Y0, Y4
+	VPMULUDQ Y3, Y0, Y5
+	VPMULUDQ Y1, Y2, Y6
+	VPMULUDQ Y3, Y2, Y2
+	VPSRLQ   $32, Y4, Y3
+	VPADDQ   Y3, Y5, Y5
+	VPAND    Y14, Y5, Y3
+	VPADDQ   Y3, Y6, Y6
+	VPSRLQ   $32, Y5, Y5
+	VPADDQ   Y5, Y2, Y2
+	VPSRLQ   $32, Y6, Y3
+	VPADDQ   Y3, Y2, Y2
+	VPSLLQ   $32, Y6, Y6
+	VPAND    Y14, Y4, Y4
+	VPOR     Y6, Y4, Y4
+	VPSLLQ   $32, Y4, Y3
+	VPADDQ   Y3, Y4, Y4
+	VPSLLQ   $32, Y4, Y3
+	VPXOR    Y13, Y4, Y6
+	VPXOR    Y13, Y3, Y3
+	VPCMPGTQ Y6, Y3, Y3
+	VPSRLQ   $32, Y4, Y5
+	VPSUBQ   Y5, Y4, Y4
+	VPADDQ   Y3, Y4, Y4
+	VPXOR    Y13, Y2, Y6
+	VPXOR    Y13, Y4, Y5
+	VPCMPGTQ Y6, Y5, Y5
+	VPSUBQ   Y4, Y2, Y0
+	VPAND    Y15, Y5, Y5
+	VPADDQ   Y5, Y0, Y0
+	VMOVDQU  Y0, 32(AX)
+
+	// increment pointers to visit next element
+	ADDQ $64, AX
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX      // decrement n
+	JMP  loop_19
+
+done_20:
+	VZEROUPPER
+	RET
+
+// scalarMulVecAVX2(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b
+// n is the number of blocks of 8 elements to process
+TEXT ·scalarMulVecAVX2(SB), NOSPLIT, $0-32
+	MOVQ         $0xffffffff00000001, SI
+	MOVQ         SI, X15
+	VPBROADCASTQ X15, Y15
+	MOVL         $0xffffffff, SI
+	MOVQ         SI, X14
+	VPBROADCASTQ X14, Y14
+	MOVQ         $0x8000000000000000, SI
+	MOVQ         SI, X13
+	VPBROADCASTQ X13, Y13
+	MOVQ         res+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+	MOVQ         0(CX), SI
+	MOVQ         SI, X12
+	VPBROADCASTQ X12, Y12
+
+loop_21:
+	TESTQ    BX, BX
+	JEQ      done_22      // n == 0, we are done
+	VMOVDQU  0(DX), Y0
+	VPSRLQ   $32, Y0, Y2
+	VPSRLQ   $32, Y12, Y3
+	VPMULUDQ Y12, Y0, Y4
+	VPMULUDQ Y3, Y0, Y5
+	VPMULUDQ Y12, Y2, Y6
+	VPMULUDQ Y3, Y2, Y2
+	VPSRLQ   $32, Y4, Y3
+	VPADDQ   Y3, Y5, Y5
+	VPAND    Y14, Y5, Y3
+	VPADDQ   Y3, Y6, Y6
+	VPSRLQ   $32, Y5, Y5
+	VPADDQ   Y5, Y2, Y2
+	VPSRLQ   $32, Y6, Y3
+	VPADDQ   Y3, Y2, Y2
+	VPSLLQ   $32, Y6, Y6
+	VPAND    Y14, Y4, Y4
+	VPOR     Y6, Y4, Y4
+	VPSLLQ   $32, Y4, Y3
+	VPADDQ   Y3, Y4, Y4
+	VPSLLQ   $32, Y4, Y3
+	VPXOR    Y13, Y4, Y6
+	VPXOR    Y13, Y3, Y3
+	VPCMPGTQ Y6, Y3, Y3
+	VPSRLQ   $32, Y4, Y5
+	VPSUBQ   Y5, Y4, Y4
+	VPADDQ   Y3, Y4, Y4
+	VPXOR    Y13, Y2, Y6
+	VPXOR    Y13, Y4, Y5
+	VPCMPGTQ Y6, Y5, Y5
+	VPSUBQ   Y4, Y2, Y0
+	VPAND    Y15, Y5, Y5
+	VPADDQ   Y5, Y0, Y0
+	VMOVDQU  Y0, 0(AX)
+	VMOVDQU  32(DX), Y0
+	VPSRLQ   $32, Y0, Y2
+	VPSRLQ   $32, Y12, Y3
+	VPMULUDQ Y12, Y0, Y4
+	VPMULUDQ Y3, Y0, Y5
+	VPMULUDQ Y12, Y2, Y6
+	VPMULUDQ Y3, Y2, Y2
+	VPSRLQ   $32, Y4, Y3
+	VPADDQ   Y3, Y5, Y5
+	VPAND    Y14, Y5, Y3
+	VPADDQ   Y3, Y6, Y6
+	VPSRLQ   $32, Y5, Y5
+	VPADDQ   Y5, Y2, Y2
+	VPSRLQ   $32, Y6, Y3
+	VPADDQ   Y3, Y2, Y2
+	VPSLLQ   $32, Y6, Y6
+	VPAND    Y14, Y4, Y4
+	VPOR     Y6, Y4, Y4
+	VPSLLQ   $32, Y4, Y3
+	VPADDQ   Y3, Y4, Y4
+	VPSLLQ   $32, Y4, Y3
+	VPXOR    Y13, Y4, Y6
+	VPXOR    Y13, Y3, Y3
+	VPCMPGTQ Y6, Y3, Y3
+	VPSRLQ   $32, Y4, Y5
+	VPSUBQ   Y5, Y4, Y4
+	VPADDQ   Y3, Y4, Y4
+	VPXOR    Y13, Y2, Y6
+	VPXOR    Y13, Y4, Y5
+	VPCMPGTQ Y6, Y5, Y5
+	VPSUBQ   Y4, Y2, Y0
+	VPAND    Y15, Y5, Y5
+	VPADDQ   Y5, Y0, Y0
+	VMOVDQU  Y0, 32(AX)
+
+	// increment pointers to visit next element
+	ADDQ $64, AX
+	ADDQ $64, DX
+	DECQ BX      // decrement n
+	JMP  loop_21
+
+done_22:
+	VZEROUPPER
+	RET
+
+// innerProdVecAVX2(t, a, b *Element, n uint64) t[0...8] = partial sums of a[0...n] * b[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·innerProdVecAVX2(SB), NOSPLIT, $0-32
+	MOVQ         $0xffffffff00000001, SI
+	MOVQ         SI, X15
+	VPBROADCASTQ X15, Y15
+	MOVL         $0xffffffff, SI
+	MOVQ         SI, X14
+	VPBROADCASTQ X14, Y14
+	MOVQ         $0x8000000000000000, SI
+	MOVQ         SI, X13
+	VPBROADCASTQ X13, Y13
+	MOVQ         t+0(FP), AX
+	MOVQ         a+8(FP), DX
+	MOVQ         b+16(FP), CX
+	MOVQ         n+24(FP), BX
+	VPXOR        Y7, Y7, Y7
+	VPXOR        Y8, Y8, Y8
+
+loop_23:
+	TESTQ    BX, BX
+	JEQ      done_24     // n == 0, we are done
+	VMOVDQU  0(DX), Y0
+	VMOVDQU  0(CX), Y1
+	VPSRLQ   $32, Y0, Y2
+	VPSRLQ   $32, Y1, Y3
+	VPMULUDQ Y1, Y0, Y4
+	VPMULUDQ Y3, Y0, Y5
+	VPMULUDQ Y1, Y2, Y6
+	VPMULUDQ Y3, Y2, Y2
+	VPSRLQ   $32, Y4, Y3
+	VPADDQ   Y3, Y5, Y5
+	VPAND    Y14, Y5, Y3
+	VPADDQ   Y3, Y6, Y6
+	VPSRLQ   $32, Y5, Y5
+	VPADDQ   Y5, Y2, Y2
+	VPSRLQ   $32, Y6, Y3
+	VPADDQ   Y3, Y2, Y2
+	VPSLLQ   $32, Y6, Y6
+	VPAND    Y14, Y4, Y4
+	VPOR     Y6, Y4, Y4
+	VPSLLQ   $32, Y4, Y3
+	VPADDQ   Y3, Y4, Y4
+	VPSLLQ   $32, Y4, Y3
+	VPXOR    Y13, Y4, Y6
+	VPXOR    Y13, Y3, Y3
+	VPCMPGTQ Y6, Y3, Y3
+	VPSRLQ   $32, Y4, Y5
+	VPSUBQ   Y5, Y4, Y4
+	VPADDQ   Y3, Y4, Y4
+	VPXOR    Y13, Y2, Y6
+	VPXOR    Y13, Y4, Y5
+	VPCMPGTQ Y6, Y5, Y5
+	VPSUBQ   Y4, Y2, Y0
+	VPAND    Y15, Y5, Y5
+	VPADDQ   Y5, Y0, Y0
+	VPSUBQ   Y0, Y15, Y1
+	VPXOR    Y13, Y7, Y3
+	VPXOR    Y13, Y1, Y2
+	VPCMPGTQ Y3, Y2, Y2
+	VPSUBQ   Y1, Y7, Y7
+	VPAND    Y15, Y2, Y2
+	VPADDQ   Y2, Y7, Y7
+	VMOVDQU  32(DX), Y0
+	VMOVDQU  32(CX), Y1
+	VPSRLQ   $32, Y0, Y2
+	VPSRLQ   $32, Y1, Y3
+	VPMULUDQ Y1, Y0, Y4
+	VPMULUDQ Y3, Y0, Y5
+	VPMULUDQ Y1, Y2, Y6
+	VPMULUDQ Y3, Y2, Y2
+	VPSRLQ   $32, Y4, Y3
+	VPADDQ   Y3, Y5, Y5
+	VPAND    Y14, Y5, Y3
+	VPADDQ   Y3, Y6, Y6
+	VPSRLQ   $32, Y5, Y5
+	VPADDQ   Y5, Y2, Y2
+	VPSRLQ   $32, Y6, Y3
+	VPADDQ   Y3, Y2, Y2
+	VPSLLQ   $32, Y6, Y6
+	VPAND    Y14, Y4, Y4
+	VPOR     Y6, Y4, Y4
+	VPSLLQ   $32, Y4, Y3
+	VPADDQ   Y3, Y4, Y4
+	VPSLLQ   $32, Y4, Y3
+	VPXOR    Y13, Y4, Y6
+	VPXOR    Y13, Y3, Y3
+	VPCMPGTQ Y6, Y3, Y3
+	VPSRLQ   $32, Y4, Y5
+	VPSUBQ   Y5, Y4, Y4
+	VPADDQ   Y3, Y4, Y4
+	VPXOR    Y13, Y2, Y6
+	VPXOR    Y13, Y4, Y5
+	VPCMPGTQ Y6, Y5, Y5
+	VPSUBQ   Y4, Y2, Y0
+	VPAND    Y15, Y5, Y5
+	VPADDQ   Y5, Y0, Y0
+	VPSUBQ   Y0, Y15, Y1
+	VPXOR    Y13, Y8, Y3
+	VPXOR    Y13, Y1, Y2
+	VPCMPGTQ Y3, Y2, Y2
+	VPSUBQ   Y1, Y8, Y8
+	VPAND    Y15, Y2, Y2
+	VPADDQ   Y2, Y8, Y8
+
+	// increment pointers to visit next element
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX      // decrement n
+	JMP  loop_23
+
+done_24:
+	VMOVDQU Y7, 0(AX)
+	VMOVDQU Y8, 32(AX)
+	VZEROUPPER
+	RET
@@ -0,0 +1,297 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/babybear"
+)
+
+// SizeOfE2 number of bytes of the encoding of an E2
+const SizeOfE2 = 2 * babybear.Bytes
+
+// ErrCanonical is returned when decoding a coordinate larger than the modulus
+var ErrCanonical = errors.New("invalid encoding: coordinate is not canonical")
+
+// E2 is a degree two finite field extension of babybear.Element, E2 = babybear[u]/(u² - 11)
+type E2 struct {
+	A0, A1 babybear.Element
+}
+
+// nonResidue u² = 11, a quadratic non-residue of babybear
+var nonResidue = babybear.NewElement(11)
+
+// Equal returns true if z equals x, false otherwise
+func (z *E2) Equal(x *E2) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1)
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *E2) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *E2) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero()
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E2) SetZero() *E2 {
+	z.A0.SetZero()
+	z.A1.SetZero()
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E2) SetOne() *E2 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *E2) Set(x *E2) *E2 {
+	z.A0 = x.A0
+	z.A1 = x.A1
+	return z
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E2) SetRandom() (*E2, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Add sets z = x + y and returns z
+func (z *E2) Add(x, y *E2) *E2 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *E2) Sub(x, y *E2) *E2 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *E2) Double(x *E2) *E2 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *E2) Neg(x *E2) *E2 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Halve sets z to z/2
+func (z *E2) Halve() {
+	z.A0.Halve()
+	z.A1.Halve()
+}
+
+// Conjugate sets z = A0 - A1·u and returns z
+func (z *E2) Conjugate(x *E2) *E2 {
+	z.A0 = x.A0
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *E2) Mul(x, y *E2) *E2 {
+	var a, b, c babybear.Element
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	a.Mul(&a, &b)
+	b.Mul(&x.A0, &y.A0)
+	c.Mul(&x.A1, &y.A1)
+	z.A1.Sub(&a, &b).Sub(&z.A1, &c)
+	c.Mul(&c, &nonResidue)
+	z.A0.Add(&b, &c)
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *E2) Square(x *E2) *E2 {
+	// (a0 + a1·u)² = a0² + 11·a1² + 2·a0·a1·u
+	var a, b babybear.Element
+	a.Square(&x.A0)
+	b.Square(&x.A1).Mul(&b, &nonResidue)
+	z.A1.Mul(&x.A0, &x.A1).Double(&z.A1)
+	z.A0.Add(&a, &b)
+	return z
+}
+
+// MulByElement sets z = x·y, y in babybear, and returns z
+func (z *E2) MulByElement(x *E2, y *babybear.Element) *E2 {
+	z.A0.Mul(&x.A0, y)
+	z.A1.Mul(&x.A1, y)
+	return z
+}
+
+// MulByNonResidue sets z = x·u and returns z
+func (z *E2) MulByNonResidue(x *E2) *E2 {
+	a := x.A0
+	z.A0.Mul(&x.A1, &nonResidue)
+	z.A1 = a
+	return z
+}
+
+// norm returns the norm x·x̄ = A0² - 11·A1² of x, in babybear
+func (x *E2) norm() babybear.Element {
+	var a, b babybear.Element
+	a.Square(&x.A0)
+	b.Square(&x.A1).Mul(&b, &nonResidue)
+	return *a.Sub(&a, &b)
+}
+
+// Inverse sets z = 1/x, 0 if x = 0, and returns z
+func (z *E2) Inverse(x *E2) *E2 {
+	// 1/x = x̄/(x·x̄)
+	n := x.norm()
+	n.Inverse(&n)
+	z.Conjugate(x)
+	return z.MulByElement(z, &n)
+}
+
+// Frobenius sets z = xᵖ and returns z; since uᵖ = -u, it is the conjugate of x
+func (z *E2) Frobenius(x *E2) *E2 {
+	return z.Conjugate(x)
+}
+
+// Legendre returns 1 if x is a non-zero square, -1 if it is not a square and 0
+// if x = 0. x is a square in E2 iff its norm is a square in babybear.
+func (x *E2) Legendre() int {
+	n := x.norm()
+	return n.Legendre()
+}
+
+// Sqrt sets z = √x and returns z. If x is not a square, Sqrt leaves z
+// unchanged and returns nil.
+func (z *E2) Sqrt(x *E2) *E2 {
+	var c, d babybear.Element
+	if x.A1.IsZero() {
+		if c.Sqrt(&x.A0) != nil {
+			z.A0 = c
+			z.A1.SetZero()
+			return z
+		}
+		// A0/11 is a square and √A0 = √(A0/11)·u
+		d.Div(&x.A0, &nonResidue)
+		d.Sqrt(&d)
+		z.A0.SetZero()
+		z.A1 = d
+		return z
+	}
+
+	// if x = (c + d·u)², then c² = (A0 ± √N(x))/2 and d = A1/(2c). The product
+	// of the two candidates for c² is 11·A1²/4, a non-square, so that
+	// exactly one of them is a non-zero square.
+	n := x.norm()
+	if n.Sqrt(&n) == nil {
+		return nil
+	}
+	c.Add(&x.A0, &n).Halve()
+	if c.Legendre() != 1 {
+		c.Sub(&x.A0, &n).Halve()
+	}
+	c.Sqrt(&c)
+	d.Double(&c).Inverse(&d).Mul(&d, &x.A1)
+	z.A0 = c
+	z.A1 = d
+	return z
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *E2) Exp(x E2, k *big.Int) *E2 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		x.Inverse(&x)
+		e = new(big.Int).Neg(k)
+	}
+	z.SetOne()
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+	return z
+}
+
+// BatchInvertE2 returns a new slice with every element inverted, using the
+// Montgomery batch inversion trick. Zero elements are mapped to zero.
+func BatchInvertE2(a []E2) []E2 {
+	res := make([]E2, len(a))
+	if len(a) == 0 {
+		return res
+	}
+	var accumulator E2
+	accumulator.SetOne()
+	for i := range a {
+		if a[i].IsZero() {
+			continue
+		}
+		res[i] = accumulator
+		accumulator.Mul(&accumulator, &a[i])
+	}
+	accumulator.Inverse(&accumulator)
+	for i := len(a) - 1; i >= 0; i-- {
+		if a[i].IsZero() {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+	return res
+}
+
+// Bytes returns the big endian encoding of A0 followed by the one of A1
+func (z *E2) Bytes() (res [SizeOfE2]byte) {
+	b := z.A0.Bytes()
+	copy(res[:], b[:])
+	b = z.A1.Bytes()
+	copy(res[babybear.Bytes:], b[:])
+	return
+}
+
+// SetBytesCanonical sets z from the encoding returned by Bytes. It returns an
+// error if the encoding has the wrong size or a coordinate is not canonical.
+func (z *E2) SetBytesCanonical(e []byte) error {
+	if len(e) != SizeOfE2 {
+		return errors.New("invalid E2 encoding size")
+	}
+	if err := z.A0.SetBytesCanonical(e[:babybear.Bytes]); err != nil {
+		return ErrCanonical
+	}
+	if err := z.A1.SetBytesCanonical(e[babybear.Bytes:]); err != nil {
+		return ErrCanonical
+	}
+	return nil
+}
+
+// String returns z as A0+A1*u
+func (z *E2) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*u"
+}
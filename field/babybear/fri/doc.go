@@ -0,0 +1,14 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package fri provides FRI, a proof of proximity to Reed–Solomon codes,
+// over babybear.
+//
+// The committed codewords are evaluations of polynomials over babybear, many of
+// them being packed in the rows of a single Merkle tree. They are combined with
+// random powers of a challenge in the extension E4 of degree 4, and the
+// folding challenges and the folded codewords live in E4, so that the
+// soundness does not depend on the small size of babybear.
+package fri
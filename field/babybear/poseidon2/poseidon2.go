@@ -0,0 +1,275 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon2
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/consensys/gnark-crypto/field/babybear/merkletree"
+)
+
+var (
+	ErrInvalidSizebuffer = errors.New("the size of the input should be a multiple of the width of the permutation")
+	ErrInvalidNbDigests  = errors.New("the numbers of digests don't match")
+)
+
+// DigestSize number of field elements of a digest, so that a digest has at
+// least 248 bits.
+const DigestSize = merkletree.DigestSize
+
+// Parameters parameters of a Poseidon2 permutation.
+type Parameters struct {
+	// Width number of elements of the state
+	Width int
+
+	// NbFullRounds number of full rounds, half of them before the partial
+	// rounds and half of them after
+	NbFullRounds int
+
+	// NbPartialRounds number of partial rounds
+	NbPartialRounds int
+
+	// DiagInternalMatrix diagonal V of the internal matrix 1 + Diag(V)
+	DiagInternalMatrix []babybear.Element
+
+	// RoundKeys round keys of each round; the ones of the partial rounds have
+	// a single element
+	RoundKeys [][]babybear.Element
+}
+
+// NewParameters returns the default parameters of the permutation of the
+// given width, which must be 16 or 24.
+func NewParameters(width int) (*Parameters, error) {
+	raw, ok := rawParameters[width]
+	if !ok {
+		return nil, fmt.Errorf("poseidon2: unsupported width %d", width)
+	}
+	p := &Parameters{
+		Width:              width,
+		NbFullRounds:       raw.nbFullRounds,
+		NbPartialRounds:    raw.nbPartialRounds,
+		DiagInternalMatrix: make([]babybear.Element, width),
+		RoundKeys:          make([][]babybear.Element, raw.nbFullRounds+raw.nbPartialRounds),
+	}
+	for i := range p.DiagInternalMatrix {
+		p.DiagInternalMatrix[i].SetUint64(uint64(raw.diag[i]))
+	}
+	rf := raw.nbFullRounds / 2
+	keys := raw.roundKeys
+	for i := range p.RoundKeys {
+		n := width
+		if i >= rf && i < rf+raw.nbPartialRounds {
+			n = 1
+		}
+		p.RoundKeys[i] = make([]babybear.Element, n)
+		for j := range p.RoundKeys[i] {
+			p.RoundKeys[i][j].SetUint64(uint64(keys[j]))
+		}
+		keys = keys[n:]
+	}
+	return p, nil
+}
+
+// Permutation Poseidon2 permutation of a given width.
+//
+// It is safe for concurrent use.
+type Permutation struct {
+	params *Parameters
+
+	// roundKeys the round keys in the order they are used, for the vectorized
+	// implementation
+	roundKeys []babybear.Element
+}
+
+// NewPermutation returns the permutation of the given width, which
+// must be 16 or 24; it panics otherwise.
+func NewPermutation(width int) *Permutation {
+	params, err := NewParameters(width)
+	if err != nil {
+		panic(err)
+	}
+	return NewPermutationWithParameters(params)
+}
+
+// NewPermutationWithParameters returns the permutation with the given
+// parameters; the width must be a multiple of 4, at least 8.
+func NewPermutationWithParameters(params *Parameters) *Permutation {
+	if params.Width%4 != 0 || params.Width < 8 {
+		panic("poseidon2: the width must be a multiple of 4, at least 8")
+	}
+	p := &Permutation{params: params}
+	for i := range params.RoundKeys {
+		p.roundKeys = append(p.roundKeys, params.RoundKeys[i]...)
+	}
+	return p
+}
+
+// Parameters returns the parameters of the permutation.
+func (p *Permutation) Parameters() *Parameters {
+	return p.params
+}
+
+// Permutation applies the permutation on input, and stores the result in
+// input. The size of input must be the width.
+func (p *Permutation) Permutation(input []babybear.Element) error {
+	if len(input) != p.params.Width {
+		return ErrInvalidSizebuffer
+	}
+	p.permutation(input)
+	return nil
+}
+
+// BatchPermutation applies the permutation on each of the consecutive states
+// of states, whose size must be a multiple of the width.
+func (p *Permutation) BatchPermutation(states []babybear.Element) error {
+	if len(states)%p.params.Width != 0 {
+		return ErrInvalidSizebuffer
+	}
+	p.batchPermutation(states)
+	return nil
+}
+
+func (p *Permutation) batchPermutationGeneric(states []babybear.Element) {
+	for i := 0; i < len(states); i += p.params.Width {
+		p.permutation(states[i : i+p.params.Width])
+	}
+}
+
+func (p *Permutation) permutation(x []babybear.Element) {
+	rf := p.params.NbFullRounds / 2
+	rp := p.params.NbPartialRounds
+
+	p.matMulExternalInPlace(x)
+
+	for i := 0; i < rf; i++ {
+		p.fullRound(x, p.params.RoundKeys[i])
+	}
+	for i := rf; i < rf+rp; i++ {
+		x[0].Add(&x[0], &p.params.RoundKeys[i][0])
+		sBox(&x[0])
+		p.matMulInternalInPlace(x)
+	}
+	for i := rf + rp; i < p.params.NbFullRounds+rp; i++ {
+		p.fullRound(x, p.params.RoundKeys[i])
+	}
+}
+
+func (p *Permutation) fullRound(x, roundKeys []babybear.Element) {
+	for i := range x {
+		x[i].Add(&x[i], &roundKeys[i])
+		sBox(&x[i])
+	}
+	p.matMulExternalInPlace(x)
+}
+
+// sBox sets x = x^7
+func sBox(x *babybear.Element) {
+	var x2 babybear.Element
+	x2.Square(x)
+	var x3 babybear.Element
+	x3.Mul(x, &x2)
+	x2.Square(&x2)
+	x.Mul(&x3, &x2)
+}
+
+// matMulM4InPlace sets s = M₄·s where
+//
+//	    (2 3 1 1)
+//	M₄ = (1 2 3 1)
+//	    (1 1 2 3)
+//	    (3 1 1 2)
+func matMulM4InPlace(s []babybear.Element) {
+	var t01, t23, t0123, t01233, t01123 babybear.Element
+	t01.Add(&s[0], &s[1])
+	t23.Add(&s[2], &s[3])
+	t0123.Add(&t01, &t23)
+	t01123.Add(&t0123, &s[1])
+	t01233.Add(&t0123, &s[3])
+	s[3].Double(&s[0]).Add(&s[3], &t01233) // 3s0+s1+s2+2s3
+	s[1].Double(&s[2]).Add(&s[1], &t01123) // s0+2s1+3s2+s3
+	s[0].Add(&t01, &t01123)                // 2s0+3s1+s2+s3
+	s[2].Add(&t23, &t01233)                // s0+s1+2s2+3s3
+}
+
+// matMulExternalInPlace sets x = circ(2M₄, M₄, ..., M₄)·x
+func (p *Permutation) matMulExternalInPlace(x []babybear.Element) {
+	for i := 0; i < len(x); i += 4 {
+		matMulM4InPlace(x[i : i+4])
+	}
+	var s [4]babybear.Element
+	for i := 0; i < len(x); i += 4 {
+		for j := range s {
+			s[j].Add(&s[j], &x[i+j])
+		}
+	}
+	for i := range x {
+		x[i].Add(&x[i], &s[i%4])
+	}
+}
+
+// matMulInternalInPlace sets x = (1 + Diag(V))·x
+func (p *Permutation) matMulInternalInPlace(x []babybear.Element) {
+	var sum babybear.Element
+	for i := range x {
+		sum.Add(&sum, &x[i])
+	}
+	for i := range x {
+		x[i].Mul(&x[i], &p.params.DiagInternalMatrix[i]).
+			Add(&x[i], &sum)
+	}
+}
+
+// Compression 2-to-1 compression (l, r) ↦ P(l ‖ r ‖ 0)[:DigestSize] of the
+// Merkle trees, where P is a permutation of width at least
+// 2·DigestSize.
+//
+// It implements merkletree.Compression.
+type Compression struct {
+	p *Permutation
+}
+
+// NewCompression returns the compression with the permutation of the given
+// width.
+func NewCompression(width int) Compression {
+	p := NewPermutation(width)
+	if p.params.Width < 2*DigestSize {
+		panic("poseidon2: the width must be at least 2·DigestSize")
+	}
+	return Compression{p: p}
+}
+
+// Compress returns the compression of left and right.
+func (c Compression) Compress(left, right *merkletree.Digest) merkletree.Digest {
+	state := make([]babybear.Element, c.p.params.Width)
+	copy(state, left[:])
+	copy(state[DigestSize:], right[:])
+	c.p.permutation(state)
+
+	var res merkletree.Digest
+	copy(res[:], state)
+	return res
+}
+
+// BatchCompress sets res[i] to the compression of left[i] and right[i], with
+// the permutations applied by BatchPermutation.
+func (c Compression) BatchCompress(res, left, right []merkletree.Digest) error {
+	if len(left) != len(right) || len(res) != len(left) {
+		return ErrInvalidNbDigests
+	}
+	width := c.p.params.Width
+	states := make([]babybear.Element, len(left)*width)
+	for i := range left {
+		copy(states[i*width:], left[i][:])
+		copy(states[i*width+DigestSize:], right[i][:])
+	}
+	c.p.batchPermutation(states)
+	for i := range res {
+		copy(res[i][:], states[i*width:])
+	}
+	return nil
+}
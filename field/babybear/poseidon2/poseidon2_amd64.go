@@ -0,0 +1,69 @@
+//go:build !purego
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon2
+
+import (
+	"golang.org/x/sys/cpu"
+
+	"github.com/consensys/gnark-crypto/field/babybear"
+)
+
+var supportAvx512 = cpu.X86.HasAVX512 && cpu.X86.HasAVX512DQ
+
+// lanes number of states permuted at once by the assembly
+const lanes = 16
+
+// permutation16AVX512 applies the permutation of width 16 on 16 states, transposed so
+// that the i-th row of 16 elements holds the i-th element of each state
+//
+//go:noescape
+func permutation16AVX512(states, roundKeys, diag *babybear.Element)
+
+// permutation24AVX512 applies the permutation of width 24 on 16 states, transposed so
+// that the i-th row of 16 elements holds the i-th element of each state
+//
+//go:noescape
+func permutation24AVX512(states, roundKeys, diag *babybear.Element)
+
+func (p *Permutation) batchPermutation(states []babybear.Element) {
+	width := p.params.Width
+	n := len(states) / width
+	var kernel func(states, roundKeys, diag *babybear.Element)
+	// the numbers of rounds are fixed in the assembly
+	raw, ok := rawParameters[width]
+	if ok && supportAvx512 && raw.nbFullRounds == p.params.NbFullRounds && raw.nbPartialRounds == p.params.NbPartialRounds {
+		switch width {
+		case 16:
+			kernel = permutation16AVX512
+		case 24:
+			kernel = permutation24AVX512
+		}
+	}
+	if kernel == nil || n < lanes {
+		p.batchPermutationGeneric(states)
+		return
+	}
+
+	// the assembly permutes blocks of lanes states, the rest is done in Go
+	transposed := make([]babybear.Element, width*lanes)
+	for s := 0; s+lanes <= n; s += lanes {
+		block := states[s*width : (s+lanes)*width]
+		for i := 0; i < lanes; i++ {
+			for j := 0; j < width; j++ {
+				transposed[j*lanes+i] = block[i*width+j]
+			}
+		}
+		kernel(&transposed[0], &p.roundKeys[0], &p.params.DiagInternalMatrix[0])
+		for i := 0; i < lanes; i++ {
+			for j := 0; j < width; j++ {
+				block[i*width+j] = transposed[j*lanes+i]
+			}
+		}
+	}
+	p.batchPermutationGeneric(states[n/lanes*lanes*width:])
+}
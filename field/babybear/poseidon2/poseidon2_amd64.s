@@ -0,0 +1,7172 @@
+//go:build !purego
+
+// Code generated by gnark-crypto/generator. DO NOT EDIT.
+#include "textflag.h"
+#include "funcdata.h"
+#include "go_asm.h"
+
+// permutation16AVX512(states, roundKeys, diag *Element) applies the poseidon2 permutation
+// of width 16 on the transposed states
+TEXT ·permutation16AVX512(SB), NOSPLIT, $0-24
+	MOVQ         $0x78000001, BX
+	VPBROADCASTD BX, Z31
+	MOVQ         $0x88000001, BX
+	VPBROADCASTD BX, Z30
+	MOVQ         $0x5555, BX
+	KMOVW        BX, K3
+	MOVQ         states+0(FP), AX
+	MOVQ         roundKeys+8(FP), DX
+	MOVQ         diag+16(FP), CX
+	VMOVDQU32    0(AX), Z0
+	VMOVDQU32    64(AX), Z1
+	VMOVDQU32    128(AX), Z2
+	VMOVDQU32    192(AX), Z3
+	VMOVDQU32    256(AX), Z4
+	VMOVDQU32    320(AX), Z5
+	VMOVDQU32    384(AX), Z6
+	VMOVDQU32    448(AX), Z7
+	VMOVDQU32    512(AX), Z8
+	VMOVDQU32    576(AX), Z9
+	VMOVDQU32    640(AX), Z10
+	VMOVDQU32    704(AX), Z11
+	VMOVDQU32    768(AX), Z12
+	VMOVDQU32    832(AX), Z13
+	VMOVDQU32    896(AX), Z14
+	VMOVDQU32    960(AX), Z15
+
+	// the initial external matrix
+	VPADDD  Z1, Z0, Z16
+	VPSUBD  Z31, Z16, Z20
+	VPMINUD Z20, Z16, Z16
+	VPADDD  Z3, Z2, Z17
+	VPSUBD  Z31, Z17, Z20
+	VPMINUD Z20, Z17, Z17
+	VPADDD  Z17, Z16, Z18
+	VPSUBD  Z31, Z18, Z20
+	VPMINUD Z20, Z18, Z18
+	VPADDD  Z3, Z18, Z19
+	VPSUBD  Z31, Z19, Z20
+	VPMINUD Z20, Z19, Z19
+	VPADDD  Z1, Z18, Z18
+	VPSUBD  Z31, Z18, Z20
+	VPMINUD Z20, Z18, Z18
+	VPADDD  Z0, Z0, Z0
+	VPSUBD  Z31, Z0, Z20
+	VPMINUD Z20, Z0, Z0
+	VPADDD  Z0, Z19, Z3
+	VPSUBD  Z31, Z3, Z20
+	VPMINUD Z20, Z3, Z3
+	VPADDD  Z2, Z2, Z2
+	VPSUBD  Z31, Z2, Z20
+	VPMINUD Z20, Z2, Z2
+	VPADDD  Z2, Z18, Z1
+	VPSUBD  Z31, Z1, Z20
+	VPMINUD Z20, Z1, Z1
+	VPADDD  Z16, Z18, Z0
+	VPSUBD  Z31, Z0, Z20
+	VPMINUD Z20, Z0, Z0
+	VPADDD  Z17, Z19, Z2
+	VPSUBD  Z31, Z2, Z20
+	VPMINUD Z20, Z2, Z2
+	VPADDD  Z5, Z4, Z16
+	VPSUBD  Z31, Z16, Z20
+	VPMINUD Z20, Z16, Z16
+	VPADDD  Z7, Z6, Z17
+	VPSUBD  Z31, Z17, Z20
+	VPMINUD Z20, Z17, Z17
+	VPADDD  Z17, Z16, Z18
+	VPSUBD  Z31, Z18, Z20
+	VPMINUD Z20, Z18, Z18
+	VPADDD  Z7, Z18, Z19
+	VPSUBD  Z31, Z19, Z20
+	VPMINUD Z20, Z19, Z19
+	VPADDD  Z5, Z18, Z18
+	VPSUBD  Z31, Z18, Z20
+	VPMINUD Z20, Z18, Z18
+	VPADDD  Z4, Z4, Z4
+	VPSUBD  Z31, Z4, Z20
+	VPMINUD Z20, Z4, Z4
+	VPADDD  Z4, Z19, Z7
+	VPSUBD  Z31, Z7, Z20
+	VPMINUD Z20, Z7, Z7
+	VPADDD  Z6, Z6, Z6
+	VPSUBD  Z31, Z6, Z20
+	VPMINUD Z20, Z6, Z6
+	VPADDD  Z6, Z18, Z5
+	VPSUBD  Z31, Z5, Z20
+	VPMINUD Z20, Z5, Z5
+	VPADDD  Z16, Z18, Z4
+	VPSUBD  Z31, Z4, Z20
+	VPMINUD Z20, Z4, Z4
+	VPADDD  Z17, Z19, Z6
+	VPSUBD  Z31, Z6, Z20
+	VPMINUD Z20, Z6, Z6
+	VPADDD  Z9, Z8, Z16
+	VPSUBD  Z31, Z16, Z20
+	VPMINUD Z20, Z16, Z16
+	VPADDD  Z11, Z10, Z17
+	VPSUBD  Z31, Z17, Z20
+	VPMINUD Z20, Z17, Z17
+	VPADDD  Z17, Z16, Z18
+	VPSUBD  Z31, Z18, Z20
+	VPMINUD Z20, Z18, Z18
+	VPADDD  Z11, Z18, Z19
+	VPSUBD  Z31, Z19, Z20
+	VPMINUD Z20, Z19, Z19
+	VPADDD  Z9, Z18, Z18
+	VPSUBD  Z31, Z18, Z20
+	VPMINUD Z20, Z18, Z18
+	VPADDD  Z8, Z8, Z8
+	VPSUBD  Z31, Z8, Z20
+	VPMINUD Z20, Z8, Z8
+	VPADDD  Z8, Z19, Z11
+	VPSUBD  Z31, Z11, Z20
+	VPMINUD Z20, Z11, Z11
+	VPADDD  Z10, Z10, Z10
+	VPSUBD  Z31, Z10, Z20
+	VPMINUD Z20, Z10, Z10
+	VPADDD  Z10, Z18, Z9
+	VPSUBD  Z31, Z9, Z20
+	VPMINUD Z20, Z9, Z9
+	VPADDD  Z16, Z18, Z8
+	VPSUBD  Z31, Z8, Z20
+	VPMINUD Z20, Z8, Z8
+	VPADDD  Z17, Z19, Z10
+	VPSUBD  Z31, Z10, Z20
+	VPMINUD Z20, Z10, Z10
+	VPADDD  Z13, Z12, Z16
+	VPSUBD  Z31, Z16, Z20
+	VPMINUD Z20, Z16, Z16
+	VPADDD  Z15, Z14, Z17
+	VPSUBD  Z31, Z17, Z20
+	VPMINUD Z20, Z17, Z17
+	VPADDD  Z17, Z16, Z18
+	VPSUBD  Z31, Z18, Z20
+	VPMINUD Z20, Z18, Z18
+	VPADDD  Z15, Z18, Z19
+	VPSUBD  Z31, Z19, Z20
+	VPMINUD Z20, Z19, Z19
+	VPADDD  Z13, Z18, Z18
+	VPSUBD  Z31, Z18, Z20
+	VPMINUD Z20, Z18, Z18
+	VPADDD  Z12, Z12, Z12
+	VPSUBD  Z31, Z12, Z20
+	VPMINUD Z20, Z12, Z12
+	VPADDD  Z12, Z19, Z15
+	VPSUBD  Z31, Z15, Z20
+	VPMINUD Z20, Z15, Z15
+	VPADDD  Z14, Z14, Z14
+	VPSUBD  Z31, Z14, Z20
+	VPMINUD Z20, Z14, Z14
+	VPADDD  Z14, Z18, Z13
+	VPSUBD  Z31, Z13, Z20
+	VPMINUD Z20, Z13, Z13
+	VPADDD  Z16, Z18, Z12
+	VPSUBD  Z31, Z12, Z20
+	VPMINUD Z20, Z12, Z12
+	VPADDD  Z17, Z19, Z14
+	VPSUBD  Z31, Z14, Z20
+	VPMINUD Z20, Z14, Z14
+	VPADDD  Z4, Z0, Z16
+	VPSUBD  Z31, Z16, Z20
+	VPMINUD Z20, Z16, Z16
+	VPADDD  Z8, Z16, Z16
+	VPSUBD  Z31, Z16, Z20
+	VPMINUD Z20, Z16, Z16
+	VPADDD  Z12, Z16, Z16
+	VPSUBD  Z31, Z16, Z20
+	VPMINUD Z20, Z16, Z16
+	VPADDD  Z5, Z1, Z17
+	VPSUBD  Z31, Z17, Z20
+	VPMINUD Z20, Z17, Z17
+	VPADDD  Z9, Z17, Z17
+	VPSUBD  Z31, Z17, Z20
+	VPMINUD Z20, Z17, Z17
+	VPADDD  Z13, Z17, Z17
+	VPSUBD  Z31, Z17, Z20
+	VPMINUD Z20, Z17, Z17
+	VPADDD  Z6, Z2, Z18
+	VPSUBD  Z31, Z18, Z20
+	VPMINUD Z20, Z18, Z18
+	VPADDD  Z10, Z18, Z18
+	VPSUBD  Z31, Z18, Z20
+	VPMINUD Z20, Z18, Z18
+	VPADDD  Z14, Z18, Z18
+	VPSUBD  Z31, Z18, Z20
+	VPMINUD Z20, Z18, Z18
+	VPADDD  Z7, Z3, Z19
+	VPSUBD  Z31, Z19, Z20
+	VPMINUD Z20, Z19, Z19
+	VPADDD  Z11, Z19, Z19
+	VPSUBD  Z31, Z19, Z20
+	VPMINUD Z20, Z19, Z19
+	VPADDD  Z15, Z19, Z19
+	VPSUBD  Z31, Z19, Z20
+	VPMINUD Z20, Z19, Z19
+	VPADDD  Z16, Z0, Z0
+	VPSUBD  Z31, Z0, Z20
+	VPMINUD Z20, Z0, Z0
+	VPADDD  Z17, Z1, Z1
+	VPSUBD  Z31, Z1, Z20
+	VPMINUD Z20, Z1, Z1
+	VPADDD  Z18, Z2, Z2
+	VPSUBD  Z31, Z2, Z20
+	VPMINUD Z20, Z2, Z2
+	VPADDD  Z19, Z3, Z3
+	VPSUBD  Z31, Z3, Z20
+	VPMINUD Z20, Z3, Z3
+	VPADDD  Z16, Z4, Z4
+	VPSUBD  Z31, Z4, Z20
+	VPMINUD Z20, Z4, Z4
+	VPADDD  Z17, Z5, Z5
+	VPSUBD  Z31, Z5, Z20
+	VPMINUD Z20, Z5, Z5
+	VPADDD  Z18, Z6, Z6
+	VPSUBD  Z31, Z6, Z20
+	VPMINUD Z20, Z6, Z6
+	VPADDD  Z19, Z7, Z7
+	VPSUBD  Z31, Z7, Z20
+	VPMINUD Z20, Z7, Z7
+	VPADDD  Z16, Z8, Z8
+	VPSUBD  Z31, Z8, Z20
+	VPMINUD Z20, Z8, Z8
+	VPADDD  Z17, Z9, Z9
+	VPSUBD  Z31, Z9, Z20
+	VPMINUD Z20, Z9, Z9
+	VPADDD  Z18, Z10, Z10
+	VPSUBD  Z31, Z10, Z20
+	VPMINUD Z20, Z10, Z10
+	VPADDD  Z19, Z11, Z11
+	VPSUBD  Z31, Z11, Z20
+	VPMINUD Z20, Z11, Z11
+	VPADDD  Z16, Z12, Z12
+	VPSUBD  Z31, Z12, Z20
+	VPMINUD Z20, Z12, Z12
+	VPADDD  Z17, Z13, Z13
+	VPSUBD  Z31, Z13, Z20
+	VPMINUD Z20, Z13, Z13
+	VPADDD  Z18, Z14, Z14
+	VPSUBD  Z31, Z14, Z20
+	VPMINUD Z20, Z14, Z14
+	VPADDD  Z19, Z15, Z15
+	VPSUBD  Z31, Z15, Z20
+	VPMINUD Z20, Z15, Z15
+
+	// the first half of the full rounds
+	MOVQ $4, BX
+
+loop_1:
+	TESTQ        BX, BX
+	JEQ          done_2        // n == 0, we are done
+	VPBROADCASTD 0(DX), Z16
+	VPADDD       Z16, Z0, Z0
+	VPSUBD       Z31, Z0, Z17
+	VPMINUD      Z17, Z0, Z0
+	VPBROADCASTD 4(DX), Z16
+	VPADDD       Z16, Z1, Z1
+	VPSUBD       Z31, Z1, Z17
+	VPMINUD      Z17, Z1, Z1
+	VPBROADCASTD 8(DX), Z16
+	VPADDD       Z16, Z2, Z2
+	VPSUBD       Z31, Z2, Z17
+	VPMINUD      Z17, Z2, Z2
+	VPBROADCASTD 12(DX), Z16
+	VPADDD       Z16, Z3, Z3
+	VPSUBD       Z31, Z3, Z17
+	VPMINUD      Z17, Z3, Z3
+	VPBROADCASTD 16(DX), Z16
+	VPADDD       Z16, Z4, Z4
+	VPSUBD       Z31, Z4, Z17
+	VPMINUD      Z17, Z4, Z4
+	VPBROADCASTD 20(DX), Z16
+	VPADDD       Z16, Z5, Z5
+	VPSUBD       Z31, Z5, Z17
+	VPMINUD      Z17, Z5, Z5
+	VPBROADCASTD 24(DX), Z16
+	VPADDD       Z16, Z6, Z6
+	VPSUBD       Z31, Z6, Z17
+	VPMINUD      Z17, Z6, Z6
+	VPBROADCASTD 28(DX), Z16
+	VPADDD       Z16, Z7, Z7
+	VPSUBD       Z31, Z7, Z17
+	VPMINUD      Z17, Z7, Z7
+	VPBROADCASTD 32(DX), Z16
+	VPADDD       Z16, Z8, Z8
+	VPSUBD       Z31, Z8, Z17
+	VPMINUD      Z17, Z8, Z8
+	VPBROADCASTD 36(DX), Z16
+	VPADDD       Z16, Z9, Z9
+	VPSUBD       Z31, Z9, Z17
+	VPMINUD      Z17, Z9, Z9
+	VPBROADCASTD 40(DX), Z16
+	VPADDD       Z16, Z10, Z10
+	VPSUBD       Z31, Z10, Z17
+	VPMINUD      Z17, Z10, Z10
+	VPBROADCASTD 44(DX), Z16
+	VPADDD       Z16, Z11, Z11
+	VPSUBD       Z31, Z11, Z17
+	VPMINUD      Z17, Z11, Z11
+	VPBROADCASTD 48(DX), Z16
+	VPADDD       Z16, Z12, Z12
+	VPSUBD       Z31, Z12, Z17
+	VPMINUD      Z17, Z12, Z12
+	VPBROADCASTD 52(DX), Z16
+	VPADDD       Z16, Z13, Z13
+	VPSUBD       Z31, Z13, Z17
+	VPMINUD      Z17, Z13, Z13
+	VPBROADCASTD 56(DX), Z16
+	VPADDD       Z16, Z14, Z14
+	VPSUBD       Z31, Z14, Z17
+	VPMINUD      Z17, Z14, Z14
+	VPBROADCASTD 60(DX), Z16
+	VPADDD       Z16, Z15, Z15
+	VPSUBD       Z31, Z15, Z17
+	VPMINUD      Z17, Z15, Z15
+	VMOVSHDUP    Z0, Z18
+	VMOVSHDUP    Z0, Z19
+	VPMULUDQ     Z0, Z0, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z0, Z19
+	VPMULUDQ     Z0, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z0
+	VMOVSHDUP    Z1, Z18
+	VMOVSHDUP    Z1, Z19
+	VPMULUDQ     Z1, Z1, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z1, Z19
+	VPMULUDQ     Z1, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z1
+	VMOVSHDUP    Z2, Z18
+	VMOVSHDUP    Z2, Z19
+	VPMULUDQ     Z2, Z2, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z2, Z19
+	VPMULUDQ     Z2, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z2
+	VMOVSHDUP    Z3, Z18
+	VMOVSHDUP    Z3, Z19
+	VPMULUDQ     Z3, Z3, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z3, Z19
+	VPMULUDQ     Z3, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z3
+	VMOVSHDUP    Z4, Z18
+	VMOVSHDUP    Z4, Z19
+	VPMULUDQ     Z4, Z4, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z4, Z19
+	VPMULUDQ     Z4, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z4
+	VMOVSHDUP    Z5, Z18
+	VMOVSHDUP    Z5, Z19
+	VPMULUDQ     Z5, Z5, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z5, Z19
+	VPMULUDQ     Z5, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z5
+	VMOVSHDUP    Z6, Z18
+	VMOVSHDUP    Z6, Z19
+	VPMULUDQ     Z6, Z6, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z6, Z19
+	VPMULUDQ     Z6, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z6
+	VMOVSHDUP    Z7, Z18
+	VMOVSHDUP    Z7, Z19
+	VPMULUDQ     Z7, Z7, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z7, Z19
+	VPMULUDQ     Z7, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z7
+	VMOVSHDUP    Z8, Z18
+	VMOVSHDUP    Z8, Z19
+	VPMULUDQ     Z8, Z8, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z8, Z19
+	VPMULUDQ     Z8, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z8
+	VMOVSHDUP    Z9, Z18
+	VMOVSHDUP    Z9, Z19
+	VPMULUDQ     Z9, Z9, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z9, Z19
+	VPMULUDQ     Z9, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z9
+	VMOVSHDUP    Z10, Z18
+	VMOVSHDUP    Z10, Z19
+	VPMULUDQ     Z10, Z10, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z10, Z19
+	VPMULUDQ     Z10, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z10
+	VMOVSHDUP    Z11, Z18
+	VMOVSHDUP    Z11, Z19
+	VPMULUDQ     Z11, Z11, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z11, Z19
+	VPMULUDQ     Z11, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z11
+	VMOVSHDUP    Z12, Z18
+	VMOVSHDUP    Z12, Z19
+	VPMULUDQ     Z12, Z12, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z12, Z19
+	VPMULUDQ     Z12, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z12
+	VMOVSHDUP    Z13, Z18
+	VMOVSHDUP    Z13, Z19
+	VPMULUDQ     Z13, Z13, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z13, Z19
+	VPMULUDQ     Z13, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z13
+	VMOVSHDUP    Z14, Z18
+	VMOVSHDUP    Z14, Z19
+	VPMULUDQ     Z14, Z14, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z14, Z19
+	VPMULUDQ     Z14, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z14
+	VMOVSHDUP    Z15, Z18
+	VMOVSHDUP    Z15, Z19
+	VPMULUDQ     Z15, Z15, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z15, Z19
+	VPMULUDQ     Z15, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z15
+	VPADDD       Z1, Z0, Z16
+	VPSUBD       Z31, Z16, Z20
+	VPMINUD      Z20, Z16, Z16
+	VPADDD       Z3, Z2, Z17
+	VPSUBD       Z31, Z17, Z20
+	VPMINUD      Z20, Z17, Z17
+	VPADDD       Z17, Z16, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z3, Z18, Z19
+	VPSUBD       Z31, Z19, Z20
+	VPMINUD      Z20, Z19, Z19
+	VPADDD       Z1, Z18, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z0, Z0, Z0
+	VPSUBD       Z31, Z0, Z20
+	VPMINUD      Z20, Z0, Z0
+	VPADDD       Z0, Z19, Z3
+	VPSUBD       Z31, Z3, Z20
+	VPMINUD      Z20, Z3, Z3
+	VPADDD       Z2, Z2, Z2
+	VPSUBD       Z31, Z2, Z20
+	VPMINUD      Z20, Z2, Z2
+	VPADDD       Z2, Z18, Z1
+	VPSUBD       Z31, Z1, Z20
+	VPMINUD      Z20, Z1, Z1
+	VPADDD       Z16, Z18, Z0
+	VPSUBD       Z31, Z0, Z20
+	VPMINUD      Z20, Z0, Z0
+	VPADDD       Z17, Z19, Z2
+	VPSUBD       Z31, Z2, Z20
+	VPMINUD      Z20, Z2, Z2
+	VPADDD       Z5, Z4, Z16
+	VPSUBD       Z31, Z16, Z20
+	VPMINUD      Z20, Z16, Z16
+	VPADDD       Z7, Z6, Z17
+	VPSUBD       Z31, Z17, Z20
+	VPMINUD      Z20, Z17, Z17
+	VPADDD       Z17, Z16, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z7, Z18, Z19
+	VPSUBD       Z31, Z19, Z20
+	VPMINUD      Z20, Z19, Z19
+	VPADDD       Z5, Z18, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z4, Z4, Z4
+	VPSUBD       Z31, Z4, Z20
+	VPMINUD      Z20, Z4, Z4
+	VPADDD       Z4, Z19, Z7
+	VPSUBD       Z31, Z7, Z20
+	VPMINUD      Z20, Z7, Z7
+	VPADDD       Z6, Z6, Z6
+	VPSUBD       Z31, Z6, Z20
+	VPMINUD      Z20, Z6, Z6
+	VPADDD       Z6, Z18, Z5
+	VPSUBD       Z31, Z5, Z20
+	VPMINUD      Z20, Z5, Z5
+	VPADDD       Z16, Z18, Z4
+	VPSUBD       Z31, Z4, Z20
+	VPMINUD      Z20, Z4, Z4
+	VPADDD       Z17, Z19, Z6
+	VPSUBD       Z31, Z6, Z20
+	VPMINUD      Z20, Z6, Z6
+	VPADDD       Z9, Z8, Z16
+	VPSUBD       Z31, Z16, Z20
+	VPMINUD      Z20, Z16, Z16
+	VPADDD       Z11, Z10, Z17
+	VPSUBD       Z31, Z17, Z20
+	VPMINUD      Z20, Z17, Z17
+	VPADDD       Z17, Z16, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z11, Z18, Z19
+	VPSUBD       Z31, Z19, Z20
+	VPMINUD      Z20, Z19, Z19
+	VPADDD       Z9, Z18, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z8, Z8, Z8
+	VPSUBD       Z31, Z8, Z20
+	VPMINUD      Z20, Z8, Z8
+	VPADDD       Z8, Z19, Z11
+	VPSUBD       Z31, Z11, Z20
+	VPMINUD      Z20, Z11, Z11
+	VPADDD       Z10, Z10, Z10
+	VPSUBD       Z31, Z10, Z20
+	VPMINUD      Z20, Z10, Z10
+	VPADDD       Z10, Z18, Z9
+	VPSUBD       Z31, Z9, Z20
+	VPMINUD      Z20, Z9, Z9
+	VPADDD       Z16, Z18, Z8
+	VPSUBD       Z31, Z8, Z20
+	VPMINUD      Z20, Z8, Z8
+	VPADDD       Z17, Z19, Z10
+	VPSUBD       Z31, Z10, Z20
+	VPMINUD      Z20, Z10, Z10
+	VPADDD       Z13, Z12, Z16
+	VPSUBD       Z31, Z16, Z20
+	VPMINUD      Z20, Z16, Z16
+	VPADDD       Z15, Z14, Z17
+	VPSUBD       Z31, Z17, Z20
+	VPMINUD      Z20, Z17, Z17
+	VPADDD       Z17, Z16, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z15, Z18, Z19
+	VPSUBD       Z31, Z19, Z20
+	VPMINUD      Z20, Z19, Z19
+	VPADDD       Z13, Z18, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z12, Z12, Z12
+	VPSUBD       Z31, Z12, Z20
+	VPMINUD      Z20, Z12, Z12
+	VPADDD       Z12, Z19, Z15
+	VPSUBD       Z31, Z15, Z20
+	VPMINUD      Z20, Z15, Z15
+	VPADDD       Z14, Z14, Z14
+	VPSUBD       Z31, Z14, Z20
+	VPMINUD      Z20, Z14, Z14
+	VPADDD       Z14, Z18, Z13
+	VPSUBD       Z31, Z13, Z20
+	VPMINUD      Z20, Z13, Z13
+	VPADDD       Z16, Z18, Z12
+	VPSUBD       Z31, Z12, Z20
+	VPMINUD      Z20, Z12, Z12
+	VPADDD       Z17, Z19, Z14
+	VPSUBD       Z31, Z14, Z20
+	VPMINUD      Z20, Z14, Z14
+	VPADDD       Z4, Z0, Z16
+	VPSUBD       Z31, Z16, Z20
+	VPMINUD      Z20, Z16, Z16
+	VPADDD       Z8, Z16, Z16
+	VPSUBD       Z31, Z16, Z20
+	VPMINUD      Z20, Z16, Z16
+	VPADDD       Z12, Z16, Z16
+	VPSUBD       Z31, Z16, Z20
+	VPMINUD      Z20, Z16, Z16
+	VPADDD       Z5, Z1, Z17
+	VPSUBD       Z31, Z17, Z20
+	VPMINUD      Z20, Z17, Z17
+	VPADDD       Z9, Z17, Z17
+	VPSUBD       Z31, Z17, Z20
+	VPMINUD      Z20, Z17, Z17
+	VPADDD       Z13, Z17, Z17
+	VPSUBD       Z31, Z17, Z20
+	VPMINUD      Z20, Z17, Z17
+	VPADDD       Z6, Z2, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z10, Z18, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z14, Z18, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z7, Z3, Z19
+	VPSUBD       Z31, Z19, Z20
+	VPMINUD      Z20, Z19, Z19
+	VPADDD       Z11, Z19, Z19
+	VPSUBD       Z31, Z19, Z20
+	VPMINUD      Z20, Z19, Z19
+	VPADDD       Z15, Z19, Z19
+	VPSUBD       Z31, Z19, Z20
+	VPMINUD      Z20, Z19, Z19
+	VPADDD       Z16, Z0, Z0
+	VPSUBD       Z31, Z0, Z20
+	VPMINUD      Z20, Z0, Z0
+	VPADDD       Z17, Z1, Z1
+	VPSUBD       Z31, Z1, Z20
+	VPMINUD      Z20, Z1, Z1
+	VPADDD       Z18, Z2, Z2
+	VPSUBD       Z31, Z2, Z20
+	VPMINUD      Z20, Z2, Z2
+	VPADDD       Z19, Z3, Z3
+	VPSUBD       Z31, Z3, Z20
+	VPMINUD      Z20, Z3, Z3
+	VPADDD       Z16, Z4, Z4
+	VPSUBD       Z31, Z4, Z20
+	VPMINUD      Z20, Z4, Z4
+	VPADDD       Z17, Z5, Z5
+	VPSUBD       Z31, Z5, Z20
+	VPMINUD      Z20, Z5, Z5
+	VPADDD       Z18, Z6, Z6
+	VPSUBD       Z31, Z6, Z20
+	VPMINUD      Z20, Z6, Z6
+	VPADDD       Z19, Z7, Z7
+	VPSUBD       Z31, Z7, Z20
+	VPMINUD      Z20, Z7, Z7
+	VPADDD       Z16, Z8, Z8
+	VPSUBD       Z31, Z8, Z20
+	VPMINUD      Z20, Z8, Z8
+	VPADDD       Z17, Z9, Z9
+	VPSUBD       Z31, Z9, Z20
+	VPMINUD      Z20, Z9, Z9
+	VPADDD       Z18, Z10, Z10
+	VPSUBD       Z31, Z10, Z20
+	VPMINUD      Z20, Z10, Z10
+	VPADDD       Z19, Z11, Z11
+	VPSUBD       Z31, Z11, Z20
+	VPMINUD      Z20, Z11, Z11
+	VPADDD       Z16, Z12, Z12
+	VPSUBD       Z31, Z12, Z20
+	VPMINUD      Z20, Z12, Z12
+	VPADDD       Z17, Z13, Z13
+	VPSUBD       Z31, Z13, Z20
+	VPMINUD      Z20, Z13, Z13
+	VPADDD       Z18, Z14, Z14
+	VPSUBD       Z31, Z14, Z20
+	VPMINUD      Z20, Z14, Z14
+	VPADDD       Z19, Z15, Z15
+	VPSUBD       Z31, Z15, Z20
+	VPMINUD      Z20, Z15, Z15
+	ADDQ         $64, DX
+	DECQ         BX            // decrement n
+	JMP          loop_1
+
+done_2:
+	// the partial rounds
+	MOVQ $13, BX
+
+loop_3:
+	TESTQ        BX, BX
+	JEQ          done_4        // n == 0, we are done
+	VPBROADCASTD 0(DX), Z16
+	VPADDD       Z16, Z0, Z0
+	VPSUBD       Z31, Z0, Z17
+	VPMINUD      Z17, Z0, Z0
+	VMOVSHDUP    Z0, Z18
+	VMOVSHDUP    Z0, Z19
+	VPMULUDQ     Z0, Z0, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z0, Z19
+	VPMULUDQ     Z0, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z0
+	VPADDD       Z1, Z0, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPADDD       Z2, Z16, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPADDD       Z3, Z16, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPADDD       Z4, Z16, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPADDD       Z5, Z16, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPADDD       Z6, Z16, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPADDD       Z7, Z16, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPADDD       Z8, Z16, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPADDD       Z9, Z16, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPADDD       Z10, Z16, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPADDD       Z11, Z16, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPADDD       Z12, Z16, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPADDD       Z13, Z16, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPADDD       Z14, Z16, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPADDD       Z15, Z16, Z16
+	VPSUBD       Z31, Z16, Z18
+	VPMINUD      Z18, Z16, Z16
+	VPBROADCASTD 0(CX), Z17
+	VMOVSHDUP    Z0, Z18
+	VPMULUDQ     Z17, Z0, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z0
+	VPADDD       Z16, Z0, Z0
+	VPSUBD       Z31, Z0, Z18
+	VPMINUD      Z18, Z0, Z0
+	VPBROADCASTD 4(CX), Z17
+	VMOVSHDUP    Z1, Z18
+	VPMULUDQ     Z17, Z1, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z1
+	VPADDD       Z16, Z1, Z1
+	VPSUBD       Z31, Z1, Z18
+	VPMINUD      Z18, Z1, Z1
+	VPBROADCASTD 8(CX), Z17
+	VMOVSHDUP    Z2, Z18
+	VPMULUDQ     Z17, Z2, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z2
+	VPADDD       Z16, Z2, Z2
+	VPSUBD       Z31, Z2, Z18
+	VPMINUD      Z18, Z2, Z2
+	VPBROADCASTD 12(CX), Z17
+	VMOVSHDUP    Z3, Z18
+	VPMULUDQ     Z17, Z3, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z3
+	VPADDD       Z16, Z3, Z3
+	VPSUBD       Z31, Z3, Z18
+	VPMINUD      Z18, Z3, Z3
+	VPBROADCASTD 16(CX), Z17
+	VMOVSHDUP    Z4, Z18
+	VPMULUDQ     Z17, Z4, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z4
+	VPADDD       Z16, Z4, Z4
+	VPSUBD       Z31, Z4, Z18
+	VPMINUD      Z18, Z4, Z4
+	VPBROADCASTD 20(CX), Z17
+	VMOVSHDUP    Z5, Z18
+	VPMULUDQ     Z17, Z5, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z5
+	VPADDD       Z16, Z5, Z5
+	VPSUBD       Z31, Z5, Z18
+	VPMINUD      Z18, Z5, Z5
+	VPBROADCASTD 24(CX), Z17
+	VMOVSHDUP    Z6, Z18
+	VPMULUDQ     Z17, Z6, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z6
+	VPADDD       Z16, Z6, Z6
+	VPSUBD       Z31, Z6, Z18
+	VPMINUD      Z18, Z6, Z6
+	VPBROADCASTD 28(CX), Z17
+	VMOVSHDUP    Z7, Z18
+	VPMULUDQ     Z17, Z7, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z7
+	VPADDD       Z16, Z7, Z7
+	VPSUBD       Z31, Z7, Z18
+	VPMINUD      Z18, Z7, Z7
+	VPBROADCASTD 32(CX), Z17
+	VMOVSHDUP    Z8, Z18
+	VPMULUDQ     Z17, Z8, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z8
+	VPADDD       Z16, Z8, Z8
+	VPSUBD       Z31, Z8, Z18
+	VPMINUD      Z18, Z8, Z8
+	VPBROADCASTD 36(CX), Z17
+	VMOVSHDUP    Z9, Z18
+	VPMULUDQ     Z17, Z9, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z9
+	VPADDD       Z16, Z9, Z9
+	VPSUBD       Z31, Z9, Z18
+	VPMINUD      Z18, Z9, Z9
+	VPBROADCASTD 40(CX), Z17
+	VMOVSHDUP    Z10, Z18
+	VPMULUDQ     Z17, Z10, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z10
+	VPADDD       Z16, Z10, Z10
+	VPSUBD       Z31, Z10, Z18
+	VPMINUD      Z18, Z10, Z10
+	VPBROADCASTD 44(CX), Z17
+	VMOVSHDUP    Z11, Z18
+	VPMULUDQ     Z17, Z11, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z11
+	VPADDD       Z16, Z11, Z11
+	VPSUBD       Z31, Z11, Z18
+	VPMINUD      Z18, Z11, Z11
+	VPBROADCASTD 48(CX), Z17
+	VMOVSHDUP    Z12, Z18
+	VPMULUDQ     Z17, Z12, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z12
+	VPADDD       Z16, Z12, Z12
+	VPSUBD       Z31, Z12, Z18
+	VPMINUD      Z18, Z12, Z12
+	VPBROADCASTD 52(CX), Z17
+	VMOVSHDUP    Z13, Z18
+	VPMULUDQ     Z17, Z13, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z13
+	VPADDD       Z16, Z13, Z13
+	VPSUBD       Z31, Z13, Z18
+	VPMINUD      Z18, Z13, Z13
+	VPBROADCASTD 56(CX), Z17
+	VMOVSHDUP    Z14, Z18
+	VPMULUDQ     Z17, Z14, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z14
+	VPADDD       Z16, Z14, Z14
+	VPSUBD       Z31, Z14, Z18
+	VPMINUD      Z18, Z14, Z14
+	VPBROADCASTD 60(CX), Z17
+	VMOVSHDUP    Z15, Z18
+	VPMULUDQ     Z17, Z15, Z20
+	VPMULUDQ     Z17, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z15
+	VPADDD       Z16, Z15, Z15
+	VPSUBD       Z31, Z15, Z18
+	VPMINUD      Z18, Z15, Z15
+	ADDQ         $4, DX
+	DECQ         BX            // decrement n
+	JMP          loop_3
+
+done_4:
+	// the second half of the full rounds
+	MOVQ $4, BX
+
+loop_5:
+	TESTQ        BX, BX
+	JEQ          done_6        // n == 0, we are done
+	VPBROADCASTD 0(DX), Z16
+	VPADDD       Z16, Z0, Z0
+	VPSUBD       Z31, Z0, Z17
+	VPMINUD      Z17, Z0, Z0
+	VPBROADCASTD 4(DX), Z16
+	VPADDD       Z16, Z1, Z1
+	VPSUBD       Z31, Z1, Z17
+	VPMINUD      Z17, Z1, Z1
+	VPBROADCASTD 8(DX), Z16
+	VPADDD       Z16, Z2, Z2
+	VPSUBD       Z31, Z2, Z17
+	VPMINUD      Z17, Z2, Z2
+	VPBROADCASTD 12(DX), Z16
+	VPADDD       Z16, Z3, Z3
+	VPSUBD       Z31, Z3, Z17
+	VPMINUD      Z17, Z3, Z3
+	VPBROADCASTD 16(DX), Z16
+	VPADDD       Z16, Z4, Z4
+	VPSUBD       Z31, Z4, Z17
+	VPMINUD      Z17, Z4, Z4
+	VPBROADCASTD 20(DX), Z16
+	VPADDD       Z16, Z5, Z5
+	VPSUBD       Z31, Z5, Z17
+	VPMINUD      Z17, Z5, Z5
+	VPBROADCASTD 24(DX), Z16
+	VPADDD       Z16, Z6, Z6
+	VPSUBD       Z31, Z6, Z17
+	VPMINUD      Z17, Z6, Z6
+	VPBROADCASTD 28(DX), Z16
+	VPADDD       Z16, Z7, Z7
+	VPSUBD       Z31, Z7, Z17
+	VPMINUD      Z17, Z7, Z7
+	VPBROADCASTD 32(DX), Z16
+	VPADDD       Z16, Z8, Z8
+	VPSUBD       Z31, Z8, Z17
+	VPMINUD      Z17, Z8, Z8
+	VPBROADCASTD 36(DX), Z16
+	VPADDD       Z16, Z9, Z9
+	VPSUBD       Z31, Z9, Z17
+	VPMINUD      Z17, Z9, Z9
+	VPBROADCASTD 40(DX), Z16
+	VPADDD       Z16, Z10, Z10
+	VPSUBD       Z31, Z10, Z17
+	VPMINUD      Z17, Z10, Z10
+	VPBROADCASTD 44(DX), Z16
+	VPADDD       Z16, Z11, Z11
+	VPSUBD       Z31, Z11, Z17
+	VPMINUD      Z17, Z11, Z11
+	VPBROADCASTD 48(DX), Z16
+	VPADDD       Z16, Z12, Z12
+	VPSUBD       Z31, Z12, Z17
+	VPMINUD      Z17, Z12, Z12
+	VPBROADCASTD 52(DX), Z16
+	VPADDD       Z16, Z13, Z13
+	VPSUBD       Z31, Z13, Z17
+	VPMINUD      Z17, Z13, Z13
+	VPBROADCASTD 56(DX), Z16
+	VPADDD       Z16, Z14, Z14
+	VPSUBD       Z31, Z14, Z17
+	VPMINUD      Z17, Z14, Z14
+	VPBROADCASTD 60(DX), Z16
+	VPADDD       Z16, Z15, Z15
+	VPSUBD       Z31, Z15, Z17
+	VPMINUD      Z17, Z15, Z15
+	VMOVSHDUP    Z0, Z18
+	VMOVSHDUP    Z0, Z19
+	VPMULUDQ     Z0, Z0, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z0, Z19
+	VPMULUDQ     Z0, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z0
+	VMOVSHDUP    Z1, Z18
+	VMOVSHDUP    Z1, Z19
+	VPMULUDQ     Z1, Z1, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z1, Z19
+	VPMULUDQ     Z1, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z1
+	VMOVSHDUP    Z2, Z18
+	VMOVSHDUP    Z2, Z19
+	VPMULUDQ     Z2, Z2, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z2, Z19
+	VPMULUDQ     Z2, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z2
+	VMOVSHDUP    Z3, Z18
+	VMOVSHDUP    Z3, Z19
+	VPMULUDQ     Z3, Z3, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z3, Z19
+	VPMULUDQ     Z3, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z3
+	VMOVSHDUP    Z4, Z18
+	VMOVSHDUP    Z4, Z19
+	VPMULUDQ     Z4, Z4, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z4, Z19
+	VPMULUDQ     Z4, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z4
+	VMOVSHDUP    Z5, Z18
+	VMOVSHDUP    Z5, Z19
+	VPMULUDQ     Z5, Z5, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z5, Z19
+	VPMULUDQ     Z5, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z5
+	VMOVSHDUP    Z6, Z18
+	VMOVSHDUP    Z6, Z19
+	VPMULUDQ     Z6, Z6, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z6, Z19
+	VPMULUDQ     Z6, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z6
+	VMOVSHDUP    Z7, Z18
+	VMOVSHDUP    Z7, Z19
+	VPMULUDQ     Z7, Z7, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z7, Z19
+	VPMULUDQ     Z7, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z7
+	VMOVSHDUP    Z8, Z18
+	VMOVSHDUP    Z8, Z19
+	VPMULUDQ     Z8, Z8, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z8, Z19
+	VPMULUDQ     Z8, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z8
+	VMOVSHDUP    Z9, Z18
+	VMOVSHDUP    Z9, Z19
+	VPMULUDQ     Z9, Z9, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z9, Z19
+	VPMULUDQ     Z9, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z9
+	VMOVSHDUP    Z10, Z18
+	VMOVSHDUP    Z10, Z19
+	VPMULUDQ     Z10, Z10, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z10, Z19
+	VPMULUDQ     Z10, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z10
+	VMOVSHDUP    Z11, Z18
+	VMOVSHDUP    Z11, Z19
+	VPMULUDQ     Z11, Z11, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z11, Z19
+	VPMULUDQ     Z11, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z11
+	VMOVSHDUP    Z12, Z18
+	VMOVSHDUP    Z12, Z19
+	VPMULUDQ     Z12, Z12, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z12, Z19
+	VPMULUDQ     Z12, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z12
+	VMOVSHDUP    Z13, Z18
+	VMOVSHDUP    Z13, Z19
+	VPMULUDQ     Z13, Z13, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z13, Z19
+	VPMULUDQ     Z13, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z13
+	VMOVSHDUP    Z14, Z18
+	VMOVSHDUP    Z14, Z19
+	VPMULUDQ     Z14, Z14, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z14, Z19
+	VPMULUDQ     Z14, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z14
+	VMOVSHDUP    Z15, Z18
+	VMOVSHDUP    Z15, Z19
+	VPMULUDQ     Z15, Z15, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z15, Z19
+	VPMULUDQ     Z15, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z17
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z16, Z19
+	VPMULUDQ     Z16, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z16
+	VMOVSHDUP    Z16, Z18
+	VMOVSHDUP    Z17, Z19
+	VPMULUDQ     Z17, Z16, Z20
+	VPMULUDQ     Z19, Z18, Z18
+	VPMULUDQ     Z30, Z20, Z21
+	VPMULUDQ     Z31, Z21, Z21
+	VPMULUDQ     Z30, Z18, Z19
+	VPMULUDQ     Z31, Z19, Z19
+	VPSUBQ       Z21, Z20, Z20
+	VPSUBQ       Z19, Z18, Z18
+	VMOVSHDUP    Z20, K3, Z18
+	VPADDD       Z31, Z18, Z19
+	VPMINUD      Z19, Z18, Z15
+	VPADDD       Z1, Z0, Z16
+	VPSUBD       Z31, Z16, Z20
+	VPMINUD      Z20, Z16, Z16
+	VPADDD       Z3, Z2, Z17
+	VPSUBD       Z31, Z17, Z20
+	VPMINUD      Z20, Z17, Z17
+	VPADDD       Z17, Z16, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z3, Z18, Z19
+	VPSUBD       Z31, Z19, Z20
+	VPMINUD      Z20, Z19, Z19
+	VPADDD       Z1, Z18, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z0, Z0, Z0
+	VPSUBD       Z31, Z0, Z20
+	VPMINUD      Z20, Z0, Z0
+	VPADDD       Z0, Z19, Z3
+	VPSUBD       Z31, Z3, Z20
+	VPMINUD      Z20, Z3, Z3
+	VPADDD       Z2, Z2, Z2
+	VPSUBD       Z31, Z2, Z20
+	VPMINUD      Z20, Z2, Z2
+	VPADDD       Z2, Z18, Z1
+	VPSUBD       Z31, Z1, Z20
+	VPMINUD      Z20, Z1, Z1
+	VPADDD       Z16, Z18, Z0
+	VPSUBD       Z31, Z0, Z20
+	VPMINUD      Z20, Z0, Z0
+	VPADDD       Z17, Z19, Z2
+	VPSUBD       Z31, Z2, Z20
+	VPMINUD      Z20, Z2, Z2
+	VPADDD       Z5, Z4, Z16
+	VPSUBD       Z31, Z16, Z20
+	VPMINUD      Z20, Z16, Z16
+	VPADDD       Z7, Z6, Z17
+	VPSUBD       Z31, Z17, Z20
+	VPMINUD      Z20, Z17, Z17
+	VPADDD       Z17, Z16, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z7, Z18, Z19
+	VPSUBD       Z31, Z19, Z20
+	VPMINUD      Z20, Z19, Z19
+	VPADDD       Z5, Z18, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z4, Z4, Z4
+	VPSUBD       Z31, Z4, Z20
+	VPMINUD      Z20, Z4, Z4
+	VPADDD       Z4, Z19, Z7
+	VPSUBD       Z31, Z7, Z20
+	VPMINUD      Z20, Z7, Z7
+	VPADDD       Z6, Z6, Z6
+	VPSUBD       Z31, Z6, Z20
+	VPMINUD      Z20, Z6, Z6
+	VPADDD       Z6, Z18, Z5
+	VPSUBD       Z31, Z5, Z20
+	VPMINUD      Z20, Z5, Z5
+	VPADDD       Z16, Z18, Z4
+	VPSUBD       Z31, Z4, Z20
+	VPMINUD      Z20, Z4, Z4
+	VPADDD       Z17, Z19, Z6
+	VPSUBD       Z31, Z6, Z20
+	VPMINUD      Z20, Z6, Z6
+	VPADDD       Z9, Z8, Z16
+	VPSUBD       Z31, Z16, Z20
+	VPMINUD      Z20, Z16, Z16
+	VPADDD       Z11, Z10, Z17
+	VPSUBD       Z31, Z17, Z20
+	VPMINUD      Z20, Z17, Z17
+	VPADDD       Z17, Z16, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z11, Z18, Z19
+	VPSUBD       Z31, Z19, Z20
+	VPMINUD      Z20, Z19, Z19
+	VPADDD       Z9, Z18, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z8, Z8, Z8
+	VPSUBD       Z31, Z8, Z20
+	VPMINUD      Z20, Z8, Z8
+	VPADDD       Z8, Z19, Z11
+	VPSUBD       Z31, Z11, Z20
+	VPMINUD      Z20, Z11, Z11
+	VPADDD       Z10, Z10, Z10
+	VPSUBD       Z31, Z10, Z20
+	VPMINUD      Z20, Z10, Z10
+	VPADDD       Z10, Z18, Z9
+	VPSUBD       Z31, Z9, Z20
+	VPMINUD      Z20, Z9, Z9
+	VPADDD       Z16, Z18, Z8
+	VPSUBD       Z31, Z8, Z20
+	VPMINUD      Z20, Z8, Z8
+	VPADDD       Z17, Z19, Z10
+	VPSUBD       Z31, Z10, Z20
+	VPMINUD      Z20, Z10, Z10
+	VPADDD       Z13, Z12, Z16
+	VPSUBD       Z31, Z16, Z20
+	VPMINUD      Z20, Z16, Z16
+	VPADDD       Z15, Z14, Z17
+	VPSUBD       Z31, Z17, Z20
+	VPMINUD      Z20, Z17, Z17
+	VPADDD       Z17, Z16, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z15, Z18, Z19
+	VPSUBD       Z31, Z19, Z20
+	VPMINUD      Z20, Z19, Z19
+	VPADDD       Z13, Z18, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z12, Z12, Z12
+	VPSUBD       Z31, Z12, Z20
+	VPMINUD      Z20, Z12, Z12
+	VPADDD       Z12, Z19, Z15
+	VPSUBD       Z31, Z15, Z20
+	VPMINUD      Z20, Z15, Z15
+	VPADDD       Z14, Z14, Z14
+	VPSUBD       Z31, Z14, Z20
+	VPMINUD      Z20, Z14, Z14
+	VPADDD       Z14, Z18, Z13
+	VPSUBD       Z31, Z13, Z20
+	VPMINUD      Z20, Z13, Z13
+	VPADDD       Z16, Z18, Z12
+	VPSUBD       Z31, Z12, Z20
+	VPMINUD      Z20, Z12, Z12
+	VPADDD       Z17, Z19, Z14
+	VPSUBD       Z31, Z14, Z20
+	VPMINUD      Z20, Z14, Z14
+	VPADDD       Z4, Z0, Z16
+	VPSUBD       Z31, Z16, Z20
+	VPMINUD      Z20, Z16, Z16
+	VPADDD       Z8, Z16, Z16
+	VPSUBD       Z31, Z16, Z20
+	VPMINUD      Z20, Z16, Z16
+	VPADDD       Z12, Z16, Z16
+	VPSUBD       Z31, Z16, Z20
+	VPMINUD      Z20, Z16, Z16
+	VPADDD       Z5, Z1, Z17
+	VPSUBD       Z31, Z17, Z20
+	VPMINUD      Z20, Z17, Z17
+	VPADDD       Z9, Z17, Z17
+	VPSUBD       Z31, Z17, Z20
+	VPMINUD      Z20, Z17, Z17
+	VPADDD       Z13, Z17, Z17
+	VPSUBD       Z31, Z17, Z20
+	VPMINUD      Z20, Z17, Z17
+	VPADDD       Z6, Z2, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z10, Z18, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z14, Z18, Z18
+	VPSUBD       Z31, Z18, Z20
+	VPMINUD      Z20, Z18, Z18
+	VPADDD       Z7, Z3, Z19
+	VPSUBD       Z31, Z19, Z20
+	VPMINUD      Z20, Z19, Z19
+	VPADDD       Z11, Z19, Z19
+	VPSUBD       Z31, Z19, Z20
+	VPMINUD      Z20, Z19, Z19
+	VPADDD       Z15, Z19, Z19
+	VPSUBD       Z31, Z19, Z20
+	VPMINUD      Z20, Z19, Z19
+	VPADDD       Z16, Z0, Z0
+	VPSUBD       Z31, Z0, Z20
+	VPMINUD      Z20, Z0, Z0
+	VPADDD       Z17, Z1, Z1
+	VPSUBD       Z31, Z1, Z20
+	VPMINUD      Z20, Z1, Z1
+	VPADDD       Z18, Z2, Z2
+	VPSUBD       Z31, Z2, Z20
+	VPMINUD      Z20, Z2, Z2
+	VPADDD       Z19, Z3, Z3
+	VPSUBD       Z31, Z3, Z20
+	VPMINUD      Z20, Z3, Z3
+	VPADDD       Z16, Z4, Z4
+	VPSUBD       Z31, Z4, Z20
+	VPMINUD      Z20, Z4, Z4
+	VPADDD       Z17, Z5, Z5
+	VPSUBD       Z31, Z5, Z20
+	VPMINUD      Z20, Z5, Z5
+	VPADDD       Z18, Z6, Z6
+	VPSUBD       Z31, Z6, Z20
+	VPMINUD      Z20, Z6, Z6
+	VPADDD       Z19, Z7, Z7
+	VPSUBD       Z31, Z7, Z20
+	VPMINUD      Z20, Z7, Z7
+	VPADDD       Z16, Z8, Z8
+	VPSUBD       Z31, Z8, Z20
+	VPMINUD      Z20, Z8, Z8
+	VPADDD       Z17, Z9, Z9
+	VPSUBD       Z31, Z9, Z20
+	VPMINUD      Z20, Z9, Z9
+	VPADDD       Z18, Z10, Z10
+	VPSUBD       Z31, Z10, Z20
+	VPMINUD      Z20, Z10, Z10
+	VPADDD       Z19, Z11, Z11
+	VPSUBD       Z31, Z11, Z20
+	VPMINUD      Z20, Z11, Z11
+	VPADDD       Z16, Z12, Z12
+	VPSUBD       Z31, Z12, Z20
+	VPMINUD      Z20, Z12, Z12
+	VPADDD       Z17, Z13, Z13
+	VPSUBD       Z31, Z13, Z20
+	VPMINUD      Z20, Z13, Z13
+	VPADDD       Z18, Z14, Z14
+	VPSUBD       Z31, Z14, Z20
+	VPMINUD      Z20, Z14, Z14
+	VPADDD       Z19, Z15, Z15
+	VPSUBD       Z31, Z15, Z20
+	VPMINUD      Z20, Z15, Z15
+	ADDQ         $64, DX
+	DECQ         BX            // decrement n
+	JMP          loop_5
+
+done_6:
+	VMOVDQU32 Z0, 0(AX)
+	VMOVDQU32 Z1, 64(AX)
+	VMOVDQU32 Z2, 128(AX)
+	VMOVDQU32 Z3, 192(AX)
+	VMOVDQU32 Z4, 256(AX)
+	VMOVDQU32 Z5, 320(AX)
+	VMOVDQU32 Z6, 384(AX)
+	VMOVDQU32 Z7, 448(AX)
+	VMOVDQU32 Z8, 512(AX)
+	VMOVDQU32 Z9, 576(AX)
+	VMOVDQU32 Z10, 640(AX)
+	VMOVDQU32 Z11, 704(AX)
+	VMOVDQU32 Z12, 768(AX)
+	VMOVDQU32 Z13, 832(AX)
+	VMOVDQU32 Z14, 896(AX)
+	VMOVDQU32 Z15, 960(AX)
+	VZEROUPPER
+	RET
+
+// permutation24AVX512(states, roundKeys, diag *Element) applies the poseidon2 permutation
+// of width 24 on the transposed states
+TEXT ·permutation24AVX512(SB), NOSPLIT, $0-24
+	MOVQ         $0x78000001, BX
+	VPBROADCASTD BX, Z31
+	MOVQ         $0x88000001, BX
+	VPBROADCASTD BX, Z30
+	MOVQ         $0x5555, BX
+	KMOVW        BX, K3
+	MOVQ         states+0(FP), AX
+	MOVQ         roundKeys+8(FP), DX
+	MOVQ         diag+16(FP), CX
+	VMOVDQU32    0(AX), Z0
+	VMOVDQU32    64(AX), Z1
+	VMOVDQU32    128(AX), Z2
+	VMOVDQU32    192(AX), Z3
+	VMOVDQU32    256(AX), Z4
+	VMOVDQU32    320(AX), Z5
+	VMOVDQU32    384(AX), Z6
+	VMOVDQU32    448(AX), Z7
+	VMOVDQU32    512(AX), Z8
+	VMOVDQU32    576(AX), Z9
+	VMOVDQU32    640(AX), Z10
+	VMOVDQU32    704(AX), Z11
+	VMOVDQU32    768(AX), Z12
+	VMOVDQU32    832(AX), Z13
+	VMOVDQU32    896(AX), Z14
+	VMOVDQU32    960(AX), Z15
+	VMOVDQU32    1024(AX), Z16
+	VMOVDQU32    1088(AX), Z17
+	VMOVDQU32    1152(AX), Z18
+	VMOVDQU32    1216(AX), Z19
+	VMOVDQU32    1280(AX), Z20
+	VMOVDQU32    1344(AX), Z21
+	VMOVDQU32    1408(AX), Z22
+	VMOVDQU32    1472(AX), Z23
+
+	// the initial external matrix
+	VPADDD  Z1, Z0, Z24
+	VPSUBD  Z31, Z24, Z28
+	VPMINUD Z28, Z24, Z24
+	VPADDD  Z3, Z2, Z25
+	VPSUBD  Z31, Z25, Z28
+	VPMINUD Z28, Z25, Z25
+	VPADDD  Z25, Z24, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z3, Z26, Z27
+	VPSUBD  Z31, Z27, Z28
+	VPMINUD Z28, Z27, Z27
+	VPADDD  Z1, Z26, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z0, Z0, Z0
+	VPSUBD  Z31, Z0, Z28
+	VPMINUD Z28, Z0, Z0
+	VPADDD  Z0, Z27, Z3
+	VPSUBD  Z31, Z3, Z28
+	VPMINUD Z28, Z3, Z3
+	VPADDD  Z2, Z2, Z2
+	VPSUBD  Z31, Z2, Z28
+	VPMINUD Z28, Z2, Z2
+	VPADDD  Z2, Z26, Z1
+	VPSUBD  Z31, Z1, Z28
+	VPMINUD Z28, Z1, Z1
+	VPADDD  Z24, Z26, Z0
+	VPSUBD  Z31, Z0, Z28
+	VPMINUD Z28, Z0, Z0
+	VPADDD  Z25, Z27, Z2
+	VPSUBD  Z31, Z2, Z28
+	VPMINUD Z28, Z2, Z2
+	VPADDD  Z5, Z4, Z24
+	VPSUBD  Z31, Z24, Z28
+	VPMINUD Z28, Z24, Z24
+	VPADDD  Z7, Z6, Z25
+	VPSUBD  Z31, Z25, Z28
+	VPMINUD Z28, Z25, Z25
+	VPADDD  Z25, Z24, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z7, Z26, Z27
+	VPSUBD  Z31, Z27, Z28
+	VPMINUD Z28, Z27, Z27
+	VPADDD  Z5, Z26, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z4, Z4, Z4
+	VPSUBD  Z31, Z4, Z28
+	VPMINUD Z28, Z4, Z4
+	VPADDD  Z4, Z27, Z7
+	VPSUBD  Z31, Z7, Z28
+	VPMINUD Z28, Z7, Z7
+	VPADDD  Z6, Z6, Z6
+	VPSUBD  Z31, Z6, Z28
+	VPMINUD Z28, Z6, Z6
+	VPADDD  Z6, Z26, Z5
+	VPSUBD  Z31, Z5, Z28
+	VPMINUD Z28, Z5, Z5
+	VPADDD  Z24, Z26, Z4
+	VPSUBD  Z31, Z4, Z28
+	VPMINUD Z28, Z4, Z4
+	VPADDD  Z25, Z27, Z6
+	VPSUBD  Z31, Z6, Z28
+	VPMINUD Z28, Z6, Z6
+	VPADDD  Z9, Z8, Z24
+	VPSUBD  Z31, Z24, Z28
+	VPMINUD Z28, Z24, Z24
+	VPADDD  Z11, Z10, Z25
+	VPSUBD  Z31, Z25, Z28
+	VPMINUD Z28, Z25, Z25
+	VPADDD  Z25, Z24, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z11, Z26, Z27
+	VPSUBD  Z31, Z27, Z28
+	VPMINUD Z28, Z27, Z27
+	VPADDD  Z9, Z26, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z8, Z8, Z8
+	VPSUBD  Z31, Z8, Z28
+	VPMINUD Z28, Z8, Z8
+	VPADDD  Z8, Z27, Z11
+	VPSUBD  Z31, Z11, Z28
+	VPMINUD Z28, Z11, Z11
+	VPADDD  Z10, Z10, Z10
+	VPSUBD  Z31, Z10, Z28
+	VPMINUD Z28, Z10, Z10
+	VPADDD  Z10, Z26, Z9
+	VPSUBD  Z31, Z9, Z28
+	VPMINUD Z28, Z9, Z9
+	VPADDD  Z24, Z26, Z8
+	VPSUBD  Z31, Z8, Z28
+	VPMINUD Z28, Z8, Z8
+	VPADDD  Z25, Z27, Z10
+	VPSUBD  Z31, Z10, Z28
+	VPMINUD Z28, Z10, Z10
+	VPADDD  Z13, Z12, Z24
+	VPSUBD  Z31, Z24, Z28
+	VPMINUD Z28, Z24, Z24
+	VPADDD  Z15, Z14, Z25
+	VPSUBD  Z31, Z25, Z28
+	VPMINUD Z28, Z25, Z25
+	VPADDD  Z25, Z24, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z15, Z26, Z27
+	VPSUBD  Z31, Z27, Z28
+	VPMINUD Z28, Z27, Z27
+	VPADDD  Z13, Z26, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z12, Z12, Z12
+	VPSUBD  Z31, Z12, Z28
+	VPMINUD Z28, Z12, Z12
+	VPADDD  Z12, Z27, Z15
+	VPSUBD  Z31, Z15, Z28
+	VPMINUD Z28, Z15, Z15
+	VPADDD  Z14, Z14, Z14
+	VPSUBD  Z31, Z14, Z28
+	VPMINUD Z28, Z14, Z14
+	VPADDD  Z14, Z26, Z13
+	VPSUBD  Z31, Z13, Z28
+	VPMINUD Z28, Z13, Z13
+	VPADDD  Z24, Z26, Z12
+	VPSUBD  Z31, Z12, Z28
+	VPMINUD Z28, Z12, Z12
+	VPADDD  Z25, Z27, Z14
+	VPSUBD  Z31, Z14, Z28
+	VPMINUD Z28, Z14, Z14
+	VPADDD  Z17, Z16, Z24
+	VPSUBD  Z31, Z24, Z28
+	VPMINUD Z28, Z24, Z24
+	VPADDD  Z19, Z18, Z25
+	VPSUBD  Z31, Z25, Z28
+	VPMINUD Z28, Z25, Z25
+	VPADDD  Z25, Z24, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z19, Z26, Z27
+	VPSUBD  Z31, Z27, Z28
+	VPMINUD Z28, Z27, Z27
+	VPADDD  Z17, Z26, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z16, Z16, Z16
+	VPSUBD  Z31, Z16, Z28
+	VPMINUD Z28, Z16, Z16
+	VPADDD  Z16, Z27, Z19
+	VPSUBD  Z31, Z19, Z28
+	VPMINUD Z28, Z19, Z19
+	VPADDD  Z18, Z18, Z18
+	VPSUBD  Z31, Z18, Z28
+	VPMINUD Z28, Z18, Z18
+	VPADDD  Z18, Z26, Z17
+	VPSUBD  Z31, Z17, Z28
+	VPMINUD Z28, Z17, Z17
+	VPADDD  Z24, Z26, Z16
+	VPSUBD  Z31, Z16, Z28
+	VPMINUD Z28, Z16, Z16
+	VPADDD  Z25, Z27, Z18
+	VPSUBD  Z31, Z18, Z28
+	VPMINUD Z28, Z18, Z18
+	VPADDD  Z21, Z20, Z24
+	VPSUBD  Z31, Z24, Z28
+	VPMINUD Z28, Z24, Z24
+	VPADDD  Z23, Z22, Z25
+	VPSUBD  Z31, Z25, Z28
+	VPMINUD Z28, Z25, Z25
+	VPADDD  Z25, Z24, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z23, Z26, Z27
+	VPSUBD  Z31, Z27, Z28
+	VPMINUD Z28, Z27, Z27
+	VPADDD  Z21, Z26, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z20, Z20, Z20
+	VPSUBD  Z31, Z20, Z28
+	VPMINUD Z28, Z20, Z20
+	VPADDD  Z20, Z27, Z23
+	VPSUBD  Z31, Z23, Z28
+	VPMINUD Z28, Z23, Z23
+	VPADDD  Z22, Z22, Z22
+	VPSUBD  Z31, Z22, Z28
+	VPMINUD Z28, Z22, Z22
+	VPADDD  Z22, Z26, Z21
+	VPSUBD  Z31, Z21, Z28
+	VPMINUD Z28, Z21, Z21
+	VPADDD  Z24, Z26, Z20
+	VPSUBD  Z31, Z20, Z28
+	VPMINUD Z28, Z20, Z20
+	VPADDD  Z25, Z27, Z22
+	VPSUBD  Z31, Z22, Z28
+	VPMINUD Z28, Z22, Z22
+	VPADDD  Z4, Z0, Z24
+	VPSUBD  Z31, Z24, Z28
+	VPMINUD Z28, Z24, Z24
+	VPADDD  Z8, Z24, Z24
+	VPSUBD  Z31, Z24, Z28
+	VPMINUD Z28, Z24, Z24
+	VPADDD  Z12, Z24, Z24
+	VPSUBD  Z31, Z24, Z28
+	VPMINUD Z28, Z24, Z24
+	VPADDD  Z16, Z24, Z24
+	VPSUBD  Z31, Z24, Z28
+	VPMINUD Z28, Z24, Z24
+	VPADDD  Z20, Z24, Z24
+	VPSUBD  Z31, Z24, Z28
+	VPMINUD Z28, Z24, Z24
+	VPADDD  Z5, Z1, Z25
+	VPSUBD  Z31, Z25, Z28
+	VPMINUD Z28, Z25, Z25
+	VPADDD  Z9, Z25, Z25
+	VPSUBD  Z31, Z25, Z28
+	VPMINUD Z28, Z25, Z25
+	VPADDD  Z13, Z25, Z25
+	VPSUBD  Z31, Z25, Z28
+	VPMINUD Z28, Z25, Z25
+	VPADDD  Z17, Z25, Z25
+	VPSUBD  Z31, Z25, Z28
+	VPMINUD Z28, Z25, Z25
+	VPADDD  Z21, Z25, Z25
+	VPSUBD  Z31, Z25, Z28
+	VPMINUD Z28, Z25, Z25
+	VPADDD  Z6, Z2, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z10, Z26, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z14, Z26, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z18, Z26, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z22, Z26, Z26
+	VPSUBD  Z31, Z26, Z28
+	VPMINUD Z28, Z26, Z26
+	VPADDD  Z7, Z3, Z27
+	VPSUBD  Z31, Z27, Z28
+	VPMINUD Z28, Z27, Z27
+	VPADDD  Z11, Z27, Z27
+	VPSUBD  Z31, Z27, Z28
+	VPMINUD Z28, Z27, Z27
+	VPADDD  Z15, Z27, Z27
+	VPSUBD  Z31, Z27, Z28
+	VPMINUD Z28, Z27, Z27
+	VPADDD  Z19, Z27, Z27
+	VPSUBD  Z31, Z27, Z28
+	VPMINUD Z28, Z27, Z27
+	VPADDD  Z23, Z27, Z27
+	VPSUBD  Z31, Z27, Z28
+	VPMINUD Z28, Z27, Z27
+	VPADDD  Z24, Z0, Z0
+	VPSUBD  Z31, Z0, Z28
+	VPMINUD Z28, Z0, Z0
+	VPADDD  Z25, Z1, Z1
+	VPSUBD  Z31, Z1, Z28
+	VPMINUD Z28, Z1, Z1
+	VPADDD  Z26, Z2, Z2
+	VPSUBD  Z31, Z2, Z28
+	VPMINUD Z28, Z2, Z2
+	VPADDD  Z27, Z3, Z3
+	VPSUBD  Z31, Z3, Z28
+	VPMINUD Z28, Z3, Z3
+	VPADDD  Z24, Z4, Z4
+	VPSUBD  Z31, Z4, Z28
+	VPMINUD Z28, Z4, Z4
+	VPADDD  Z25, Z5, Z5
+	VPSUBD  Z31, Z5, Z28
+	VPMINUD Z28, Z5, Z5
+	VPADDD  Z26, Z6, Z6
+	VPSUBD  Z31, Z6, Z28
+	VPMINUD Z28, Z6, Z6
+	VPADDD  Z27, Z7, Z7
+	VPSUBD  Z31, Z7, Z28
+	VPMINUD Z28, Z7, Z7
+	VPADDD  Z24, Z8, Z8
+	VPSUBD  Z31, Z8, Z28
+	VPMINUD Z28, Z8, Z8
+	VPADDD  Z25, Z9, Z9
+	VPSUBD  Z31, Z9, Z28
+	VPMINUD Z28, Z9, Z9
+	VPADDD  Z26, Z10, Z10
+	VPSUBD  Z31, Z10, Z28
+	VPMINUD Z28, Z10, Z10
+	VPADDD  Z27, Z11, Z11
+	VPSUBD  Z31, Z11, Z28
+	VPMINUD Z28, Z11, Z11
+	VPADDD  Z24, Z12, Z12
+	VPSUBD  Z31, Z12, Z28
+	VPMINUD Z28, Z12, Z12
+	VPADDD  Z25, Z13, Z13
+	VPSUBD  Z31, Z13, Z28
+	VPMINUD Z28, Z13, Z13
+	VPADDD  Z26, Z14, Z14
+	VPSUBD  Z31, Z14, Z28
+	VPMINUD Z28, Z14, Z14
+	VPADDD  Z27, Z15, Z15
+	VPSUBD  Z31, Z15, Z28
+	VPMINUD Z28, Z15, Z15
+	VPADDD  Z24, Z16, Z16
+	VPSUBD  Z31, Z16, Z28
+	VPMINUD Z28, Z16, Z16
+	VPADDD  Z25, Z17, Z17
+	VPSUBD  Z31, Z17, Z28
+	VPMINUD Z28, Z17, Z17
+	VPADDD  Z26, Z18, Z18
+	VPSUBD  Z31, Z18, Z28
+	VPMINUD Z28, Z18, Z18
+	VPADDD  Z27, Z19, Z19
+	VPSUBD  Z31, Z19, Z28
+	VPMINUD Z28, Z19, Z19
+	VPADDD  Z24, Z20, Z20
+	VPSUBD  Z31, Z20, Z28
+	VPMINUD Z28, Z20, Z20
+	VPADDD  Z25, Z21, Z21
+	VPSUBD  Z31, Z21, Z28
+	VPMINUD Z28, Z21, Z21
+	VPADDD  Z26, Z22, Z22
+	VPSUBD  Z31, Z22, Z28
+	VPMINUD Z28, Z22, Z22
+	VPADDD  Z27, Z23, Z23
+	VPSUBD  Z31, Z23, Z28
+	VPMINUD Z28, Z23, Z23
+
+	// the first half of the full rounds
+	MOVQ $4, BX
+
+loop_7:
+	TESTQ        BX, BX
+	JEQ          done_8        // n == 0, we are done
+	VPBROADCASTD 0(DX), Z24
+	VPADDD       Z24, Z0, Z0
+	VPSUBD       Z31, Z0, Z25
+	VPMINUD      Z25, Z0, Z0
+	VPBROADCASTD 4(DX), Z24
+	VPADDD       Z24, Z1, Z1
+	VPSUBD       Z31, Z1, Z25
+	VPMINUD      Z25, Z1, Z1
+	VPBROADCASTD 8(DX), Z24
+	VPADDD       Z24, Z2, Z2
+	VPSUBD       Z31, Z2, Z25
+	VPMINUD      Z25, Z2, Z2
+	VPBROADCASTD 12(DX), Z24
+	VPADDD       Z24, Z3, Z3
+	VPSUBD       Z31, Z3, Z25
+	VPMINUD      Z25, Z3, Z3
+	VPBROADCASTD 16(DX), Z24
+	VPADDD       Z24, Z4, Z4
+	VPSUBD       Z31, Z4, Z25
+	VPMINUD      Z25, Z4, Z4
+	VPBROADCASTD 20(DX), Z24
+	VPADDD       Z24, Z5, Z5
+	VPSUBD       Z31, Z5, Z25
+	VPMINUD      Z25, Z5, Z5
+	VPBROADCASTD 24(DX), Z24
+	VPADDD       Z24, Z6, Z6
+	VPSUBD       Z31, Z6, Z25
+	VPMINUD      Z25, Z6, Z6
+	VPBROADCASTD 28(DX), Z24
+	VPADDD       Z24, Z7, Z7
+	VPSUBD       Z31, Z7, Z25
+	VPMINUD      Z25, Z7, Z7
+	VPBROADCASTD 32(DX), Z24
+	VPADDD       Z24, Z8, Z8
+	VPSUBD       Z31, Z8, Z25
+	VPMINUD      Z25, Z8, Z8
+	VPBROADCASTD 36(DX), Z24
+	VPADDD       Z24, Z9, Z9
+	VPSUBD       Z31, Z9, Z25
+	VPMINUD      Z25, Z9, Z9
+	VPBROADCASTD 40(DX), Z24
+	VPADDD       Z24, Z10, Z10
+	VPSUBD       Z31, Z10, Z25
+	VPMINUD      Z25, Z10, Z10
+	VPBROADCASTD 44(DX), Z24
+	VPADDD       Z24, Z11, Z11
+	VPSUBD       Z31, Z11, Z25
+	VPMINUD      Z25, Z11, Z11
+	VPBROADCASTD 48(DX), Z24
+	VPADDD       Z24, Z12, Z12
+	VPSUBD       Z31, Z12, Z25
+	VPMINUD      Z25, Z12, Z12
+	VPBROADCASTD 52(DX), Z24
+	VPADDD       Z24, Z13, Z13
+	VPSUBD       Z31, Z13, Z25
+	VPMINUD      Z25, Z13, Z13
+	VPBROADCASTD 56(DX), Z24
+	VPADDD       Z24, Z14, Z14
+	VPSUBD       Z31, Z14, Z25
+	VPMINUD      Z25, Z14, Z14
+	VPBROADCASTD 60(DX), Z24
+	VPADDD       Z24, Z15, Z15
+	VPSUBD       Z31, Z15, Z25
+	VPMINUD      Z25, Z15, Z15
+	VPBROADCASTD 64(DX), Z24
+	VPADDD       Z24, Z16, Z16
+	VPSUBD       Z31, Z16, Z25
+	VPMINUD      Z25, Z16, Z16
+	VPBROADCASTD 68(DX), Z24
+	VPADDD       Z24, Z17, Z17
+	VPSUBD       Z31, Z17, Z25
+	VPMINUD      Z25, Z17, Z17
+	VPBROADCASTD 72(DX), Z24
+	VPADDD       Z24, Z18, Z18
+	VPSUBD       Z31, Z18, Z25
+	VPMINUD      Z25, Z18, Z18
+	VPBROADCASTD 76(DX), Z24
+	VPADDD       Z24, Z19, Z19
+	VPSUBD       Z31, Z19, Z25
+	VPMINUD      Z25, Z19, Z19
+	VPBROADCASTD 80(DX), Z24
+	VPADDD       Z24, Z20, Z20
+	VPSUBD       Z31, Z20, Z25
+	VPMINUD      Z25, Z20, Z20
+	VPBROADCASTD 84(DX), Z24
+	VPADDD       Z24, Z21, Z21
+	VPSUBD       Z31, Z21, Z25
+	VPMINUD      Z25, Z21, Z21
+	VPBROADCASTD 88(DX), Z24
+	VPADDD       Z24, Z22, Z22
+	VPSUBD       Z31, Z22, Z25
+	VPMINUD      Z25, Z22, Z22
+	VPBROADCASTD 92(DX), Z24
+	VPADDD       Z24, Z23, Z23
+	VPSUBD       Z31, Z23, Z25
+	VPMINUD      Z25, Z23, Z23
+	VMOVSHDUP    Z0, Z26
+	VMOVSHDUP    Z0, Z27
+	VPMULUDQ     Z0, Z0, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z0, Z27
+	VPMULUDQ     Z0, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z0
+	VMOVSHDUP    Z1, Z26
+	VMOVSHDUP    Z1, Z27
+	VPMULUDQ     Z1, Z1, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z1, Z27
+	VPMULUDQ     Z1, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z1
+	VMOVSHDUP    Z2, Z26
+	VMOVSHDUP    Z2, Z27
+	VPMULUDQ     Z2, Z2, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z2, Z27
+	VPMULUDQ     Z2, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z2
+	VMOVSHDUP    Z3, Z26
+	VMOVSHDUP    Z3, Z27
+	VPMULUDQ     Z3, Z3, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z3, Z27
+	VPMULUDQ     Z3, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z3
+	VMOVSHDUP    Z4, Z26
+	VMOVSHDUP    Z4, Z27
+	VPMULUDQ     Z4, Z4, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z4, Z27
+	VPMULUDQ     Z4, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z4
+	VMOVSHDUP    Z5, Z26
+	VMOVSHDUP    Z5, Z27
+	VPMULUDQ     Z5, Z5, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z5, Z27
+	VPMULUDQ     Z5, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z5
+	VMOVSHDUP    Z6, Z26
+	VMOVSHDUP    Z6, Z27
+	VPMULUDQ     Z6, Z6, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z6, Z27
+	VPMULUDQ     Z6, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z6
+	VMOVSHDUP    Z7, Z26
+	VMOVSHDUP    Z7, Z27
+	VPMULUDQ     Z7, Z7, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z7, Z27
+	VPMULUDQ     Z7, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z7
+	VMOVSHDUP    Z8, Z26
+	VMOVSHDUP    Z8, Z27
+	VPMULUDQ     Z8, Z8, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z8, Z27
+	VPMULUDQ     Z8, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z8
+	VMOVSHDUP    Z9, Z26
+	VMOVSHDUP    Z9, Z27
+	VPMULUDQ     Z9, Z9, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z9, Z27
+	VPMULUDQ     Z9, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z9
+	VMOVSHDUP    Z10, Z26
+	VMOVSHDUP    Z10, Z27
+	VPMULUDQ     Z10, Z10, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z10, Z27
+	VPMULUDQ     Z10, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z10
+	VMOVSHDUP    Z11, Z26
+	VMOVSHDUP    Z11, Z27
+	VPMULUDQ     Z11, Z11, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z11, Z27
+	VPMULUDQ     Z11, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z11
+	VMOVSHDUP    Z12, Z26
+	VMOVSHDUP    Z12, Z27
+	VPMULUDQ     Z12, Z12, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z12, Z27
+	VPMULUDQ     Z12, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z12
+	VMOVSHDUP    Z13, Z26
+	VMOVSHDUP    Z13, Z27
+	VPMULUDQ     Z13, Z13, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z13, Z27
+	VPMULUDQ     Z13, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z13
+	VMOVSHDUP    Z14, Z26
+	VMOVSHDUP    Z14, Z27
+	VPMULUDQ     Z14, Z14, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z14, Z27
+	VPMULUDQ     Z14, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z14
+	VMOVSHDUP    Z15, Z26
+	VMOVSHDUP    Z15, Z27
+	VPMULUDQ     Z15, Z15, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z15, Z27
+	VPMULUDQ     Z15, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z15
+	VMOVSHDUP    Z16, Z26
+	VMOVSHDUP    Z16, Z27
+	VPMULUDQ     Z16, Z16, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z16, Z27
+	VPMULUDQ     Z16, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z16
+	VMOVSHDUP    Z17, Z26
+	VMOVSHDUP    Z17, Z27
+	VPMULUDQ     Z17, Z17, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z17, Z27
+	VPMULUDQ     Z17, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z17
+	VMOVSHDUP    Z18, Z26
+	VMOVSHDUP    Z18, Z27
+	VPMULUDQ     Z18, Z18, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z18, Z27
+	VPMULUDQ     Z18, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z18
+	VMOVSHDUP    Z19, Z26
+	VMOVSHDUP    Z19, Z27
+	VPMULUDQ     Z19, Z19, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z19, Z27
+	VPMULUDQ     Z19, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z19
+	VMOVSHDUP    Z20, Z26
+	VMOVSHDUP    Z20, Z27
+	VPMULUDQ     Z20, Z20, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z20, Z27
+	VPMULUDQ     Z20, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z20
+	VMOVSHDUP    Z21, Z26
+	VMOVSHDUP    Z21, Z27
+	VPMULUDQ     Z21, Z21, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z21, Z27
+	VPMULUDQ     Z21, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z21
+	VMOVSHDUP    Z22, Z26
+	VMOVSHDUP    Z22, Z27
+	VPMULUDQ     Z22, Z22, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z22, Z27
+	VPMULUDQ     Z22, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z22
+	VMOVSHDUP    Z23, Z26
+	VMOVSHDUP    Z23, Z27
+	VPMULUDQ     Z23, Z23, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z23, Z27
+	VPMULUDQ     Z23, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z23
+	VPADDD       Z1, Z0, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z3, Z2, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z25, Z24, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z3, Z26, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z1, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z0, Z0, Z0
+	VPSUBD       Z31, Z0, Z28
+	VPMINUD      Z28, Z0, Z0
+	VPADDD       Z0, Z27, Z3
+	VPSUBD       Z31, Z3, Z28
+	VPMINUD      Z28, Z3, Z3
+	VPADDD       Z2, Z2, Z2
+	VPSUBD       Z31, Z2, Z28
+	VPMINUD      Z28, Z2, Z2
+	VPADDD       Z2, Z26, Z1
+	VPSUBD       Z31, Z1, Z28
+	VPMINUD      Z28, Z1, Z1
+	VPADDD       Z24, Z26, Z0
+	VPSUBD       Z31, Z0, Z28
+	VPMINUD      Z28, Z0, Z0
+	VPADDD       Z25, Z27, Z2
+	VPSUBD       Z31, Z2, Z28
+	VPMINUD      Z28, Z2, Z2
+	VPADDD       Z5, Z4, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z7, Z6, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z25, Z24, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z7, Z26, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z5, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z4, Z4, Z4
+	VPSUBD       Z31, Z4, Z28
+	VPMINUD      Z28, Z4, Z4
+	VPADDD       Z4, Z27, Z7
+	VPSUBD       Z31, Z7, Z28
+	VPMINUD      Z28, Z7, Z7
+	VPADDD       Z6, Z6, Z6
+	VPSUBD       Z31, Z6, Z28
+	VPMINUD      Z28, Z6, Z6
+	VPADDD       Z6, Z26, Z5
+	VPSUBD       Z31, Z5, Z28
+	VPMINUD      Z28, Z5, Z5
+	VPADDD       Z24, Z26, Z4
+	VPSUBD       Z31, Z4, Z28
+	VPMINUD      Z28, Z4, Z4
+	VPADDD       Z25, Z27, Z6
+	VPSUBD       Z31, Z6, Z28
+	VPMINUD      Z28, Z6, Z6
+	VPADDD       Z9, Z8, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z11, Z10, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z25, Z24, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z11, Z26, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z9, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z8, Z8, Z8
+	VPSUBD       Z31, Z8, Z28
+	VPMINUD      Z28, Z8, Z8
+	VPADDD       Z8, Z27, Z11
+	VPSUBD       Z31, Z11, Z28
+	VPMINUD      Z28, Z11, Z11
+	VPADDD       Z10, Z10, Z10
+	VPSUBD       Z31, Z10, Z28
+	VPMINUD      Z28, Z10, Z10
+	VPADDD       Z10, Z26, Z9
+	VPSUBD       Z31, Z9, Z28
+	VPMINUD      Z28, Z9, Z9
+	VPADDD       Z24, Z26, Z8
+	VPSUBD       Z31, Z8, Z28
+	VPMINUD      Z28, Z8, Z8
+	VPADDD       Z25, Z27, Z10
+	VPSUBD       Z31, Z10, Z28
+	VPMINUD      Z28, Z10, Z10
+	VPADDD       Z13, Z12, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z15, Z14, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z25, Z24, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z15, Z26, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z13, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z12, Z12, Z12
+	VPSUBD       Z31, Z12, Z28
+	VPMINUD      Z28, Z12, Z12
+	VPADDD       Z12, Z27, Z15
+	VPSUBD       Z31, Z15, Z28
+	VPMINUD      Z28, Z15, Z15
+	VPADDD       Z14, Z14, Z14
+	VPSUBD       Z31, Z14, Z28
+	VPMINUD      Z28, Z14, Z14
+	VPADDD       Z14, Z26, Z13
+	VPSUBD       Z31, Z13, Z28
+	VPMINUD      Z28, Z13, Z13
+	VPADDD       Z24, Z26, Z12
+	VPSUBD       Z31, Z12, Z28
+	VPMINUD      Z28, Z12, Z12
+	VPADDD       Z25, Z27, Z14
+	VPSUBD       Z31, Z14, Z28
+	VPMINUD      Z28, Z14, Z14
+	VPADDD       Z17, Z16, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z19, Z18, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z25, Z24, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z19, Z26, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z17, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z16, Z16, Z16
+	VPSUBD       Z31, Z16, Z28
+	VPMINUD      Z28, Z16, Z16
+	VPADDD       Z16, Z27, Z19
+	VPSUBD       Z31, Z19, Z28
+	VPMINUD      Z28, Z19, Z19
+	VPADDD       Z18, Z18, Z18
+	VPSUBD       Z31, Z18, Z28
+	VPMINUD      Z28, Z18, Z18
+	VPADDD       Z18, Z26, Z17
+	VPSUBD       Z31, Z17, Z28
+	VPMINUD      Z28, Z17, Z17
+	VPADDD       Z24, Z26, Z16
+	VPSUBD       Z31, Z16, Z28
+	VPMINUD      Z28, Z16, Z16
+	VPADDD       Z25, Z27, Z18
+	VPSUBD       Z31, Z18, Z28
+	VPMINUD      Z28, Z18, Z18
+	VPADDD       Z21, Z20, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z23, Z22, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z25, Z24, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z23, Z26, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z21, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z20, Z20, Z20
+	VPSUBD       Z31, Z20, Z28
+	VPMINUD      Z28, Z20, Z20
+	VPADDD       Z20, Z27, Z23
+	VPSUBD       Z31, Z23, Z28
+	VPMINUD      Z28, Z23, Z23
+	VPADDD       Z22, Z22, Z22
+	VPSUBD       Z31, Z22, Z28
+	VPMINUD      Z28, Z22, Z22
+	VPADDD       Z22, Z26, Z21
+	VPSUBD       Z31, Z21, Z28
+	VPMINUD      Z28, Z21, Z21
+	VPADDD       Z24, Z26, Z20
+	VPSUBD       Z31, Z20, Z28
+	VPMINUD      Z28, Z20, Z20
+	VPADDD       Z25, Z27, Z22
+	VPSUBD       Z31, Z22, Z28
+	VPMINUD      Z28, Z22, Z22
+	VPADDD       Z4, Z0, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z8, Z24, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z12, Z24, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z16, Z24, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z20, Z24, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z5, Z1, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z9, Z25, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z13, Z25, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z17, Z25, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z21, Z25, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z6, Z2, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z10, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z14, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z18, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z22, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z7, Z3, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z11, Z27, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z15, Z27, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z19, Z27, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z23, Z27, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z24, Z0, Z0
+	VPSUBD       Z31, Z0, Z28
+	VPMINUD      Z28, Z0, Z0
+	VPADDD       Z25, Z1, Z1
+	VPSUBD       Z31, Z1, Z28
+	VPMINUD      Z28, Z1, Z1
+	VPADDD       Z26, Z2, Z2
+	VPSUBD       Z31, Z2, Z28
+	VPMINUD      Z28, Z2, Z2
+	VPADDD       Z27, Z3, Z3
+	VPSUBD       Z31, Z3, Z28
+	VPMINUD      Z28, Z3, Z3
+	VPADDD       Z24, Z4, Z4
+	VPSUBD       Z31, Z4, Z28
+	VPMINUD      Z28, Z4, Z4
+	VPADDD       Z25, Z5, Z5
+	VPSUBD       Z31, Z5, Z28
+	VPMINUD      Z28, Z5, Z5
+	VPADDD       Z26, Z6, Z6
+	VPSUBD       Z31, Z6, Z28
+	VPMINUD      Z28, Z6, Z6
+	VPADDD       Z27, Z7, Z7
+	VPSUBD       Z31, Z7, Z28
+	VPMINUD      Z28, Z7, Z7
+	VPADDD       Z24, Z8, Z8
+	VPSUBD       Z31, Z8, Z28
+	VPMINUD      Z28, Z8, Z8
+	VPADDD       Z25, Z9, Z9
+	VPSUBD       Z31, Z9, Z28
+	VPMINUD      Z28, Z9, Z9
+	VPADDD       Z26, Z10, Z10
+	VPSUBD       Z31, Z10, Z28
+	VPMINUD      Z28, Z10, Z10
+	VPADDD       Z27, Z11, Z11
+	VPSUBD       Z31, Z11, Z28
+	VPMINUD      Z28, Z11, Z11
+	VPADDD       Z24, Z12, Z12
+	VPSUBD       Z31, Z12, Z28
+	VPMINUD      Z28, Z12, Z12
+	VPADDD       Z25, Z13, Z13
+	VPSUBD       Z31, Z13, Z28
+	VPMINUD      Z28, Z13, Z13
+	VPADDD       Z26, Z14, Z14
+	VPSUBD       Z31, Z14, Z28
+	VPMINUD      Z28, Z14, Z14
+	VPADDD       Z27, Z15, Z15
+	VPSUBD       Z31, Z15, Z28
+	VPMINUD      Z28, Z15, Z15
+	VPADDD       Z24, Z16, Z16
+	VPSUBD       Z31, Z16, Z28
+	VPMINUD      Z28, Z16, Z16
+	VPADDD       Z25, Z17, Z17
+	VPSUBD       Z31, Z17, Z28
+	VPMINUD      Z28, Z17, Z17
+	VPADDD       Z26, Z18, Z18
+	VPSUBD       Z31, Z18, Z28
+	VPMINUD      Z28, Z18, Z18
+	VPADDD       Z27, Z19, Z19
+	VPSUBD       Z31, Z19, Z28
+	VPMINUD      Z28, Z19, Z19
+	VPADDD       Z24, Z20, Z20
+	VPSUBD       Z31, Z20, Z28
+	VPMINUD      Z28, Z20, Z20
+	VPADDD       Z25, Z21, Z21
+	VPSUBD       Z31, Z21, Z28
+	VPMINUD      Z28, Z21, Z21
+	VPADDD       Z26, Z22, Z22
+	VPSUBD       Z31, Z22, Z28
+	VPMINUD      Z28, Z22, Z22
+	VPADDD       Z27, Z23, Z23
+	VPSUBD       Z31, Z23, Z28
+	VPMINUD      Z28, Z23, Z23
+	ADDQ         $96, DX
+	DECQ         BX            // decrement n
+	JMP          loop_7
+
+done_8:
+	// the partial rounds
+	MOVQ $21, BX
+
+loop_9:
+	TESTQ        BX, BX
+	JEQ          done_10       // n == 0, we are done
+	VPBROADCASTD 0(DX), Z24
+	VPADDD       Z24, Z0, Z0
+	VPSUBD       Z31, Z0, Z25
+	VPMINUD      Z25, Z0, Z0
+	VMOVSHDUP    Z0, Z26
+	VMOVSHDUP    Z0, Z27
+	VPMULUDQ     Z0, Z0, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z0, Z27
+	VPMULUDQ     Z0, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z0
+	VPADDD       Z1, Z0, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z2, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z3, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z4, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z5, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z6, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z7, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z8, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z9, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z10, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z11, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z12, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z13, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z14, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z15, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z16, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z17, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z18, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z19, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z20, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z21, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z22, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPADDD       Z23, Z24, Z24
+	VPSUBD       Z31, Z24, Z26
+	VPMINUD      Z26, Z24, Z24
+	VPBROADCASTD 0(CX), Z25
+	VMOVSHDUP    Z0, Z26
+	VPMULUDQ     Z25, Z0, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z0
+	VPADDD       Z24, Z0, Z0
+	VPSUBD       Z31, Z0, Z26
+	VPMINUD      Z26, Z0, Z0
+	VPBROADCASTD 4(CX), Z25
+	VMOVSHDUP    Z1, Z26
+	VPMULUDQ     Z25, Z1, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z1
+	VPADDD       Z24, Z1, Z1
+	VPSUBD       Z31, Z1, Z26
+	VPMINUD      Z26, Z1, Z1
+	VPBROADCASTD 8(CX), Z25
+	VMOVSHDUP    Z2, Z26
+	VPMULUDQ     Z25, Z2, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z2
+	VPADDD       Z24, Z2, Z2
+	VPSUBD       Z31, Z2, Z26
+	VPMINUD      Z26, Z2, Z2
+	VPBROADCASTD 12(CX), Z25
+	VMOVSHDUP    Z3, Z26
+	VPMULUDQ     Z25, Z3, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z3
+	VPADDD       Z24, Z3, Z3
+	VPSUBD       Z31, Z3, Z26
+	VPMINUD      Z26, Z3, Z3
+	VPBROADCASTD 16(CX), Z25
+	VMOVSHDUP    Z4, Z26
+	VPMULUDQ     Z25, Z4, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z4
+	VPADDD       Z24, Z4, Z4
+	VPSUBD       Z31, Z4, Z26
+	VPMINUD      Z26, Z4, Z4
+	VPBROADCASTD 20(CX), Z25
+	VMOVSHDUP    Z5, Z26
+	VPMULUDQ     Z25, Z5, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z5
+	VPADDD       Z24, Z5, Z5
+	VPSUBD       Z31, Z5, Z26
+	VPMINUD      Z26, Z5, Z5
+	VPBROADCASTD 24(CX), Z25
+	VMOVSHDUP    Z6, Z26
+	VPMULUDQ     Z25, Z6, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z6
+	VPADDD       Z24, Z6, Z6
+	VPSUBD       Z31, Z6, Z26
+	VPMINUD      Z26, Z6, Z6
+	VPBROADCASTD 28(CX), Z25
+	VMOVSHDUP    Z7, Z26
+	VPMULUDQ     Z25, Z7, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z7
+	VPADDD       Z24, Z7, Z7
+	VPSUBD       Z31, Z7, Z26
+	VPMINUD      Z26, Z7, Z7
+	VPBROADCASTD 32(CX), Z25
+	VMOVSHDUP    Z8, Z26
+	VPMULUDQ     Z25, Z8, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z8
+	VPADDD       Z24, Z8, Z8
+	VPSUBD       Z31, Z8, Z26
+	VPMINUD      Z26, Z8, Z8
+	VPBROADCASTD 36(CX), Z25
+	VMOVSHDUP    Z9, Z26
+	VPMULUDQ     Z25, Z9, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z9
+	VPADDD       Z24, Z9, Z9
+	VPSUBD       Z31, Z9, Z26
+	VPMINUD      Z26, Z9, Z9
+	VPBROADCASTD 40(CX), Z25
+	VMOVSHDUP    Z10, Z26
+	VPMULUDQ     Z25, Z10, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z10
+	VPADDD       Z24, Z10, Z10
+	VPSUBD       Z31, Z10, Z26
+	VPMINUD      Z26, Z10, Z10
+	VPBROADCASTD 44(CX), Z25
+	VMOVSHDUP    Z11, Z26
+	VPMULUDQ     Z25, Z11, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z11
+	VPADDD       Z24, Z11, Z11
+	VPSUBD       Z31, Z11, Z26
+	VPMINUD      Z26, Z11, Z11
+	VPBROADCASTD 48(CX), Z25
+	VMOVSHDUP    Z12, Z26
+	VPMULUDQ     Z25, Z12, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z12
+	VPADDD       Z24, Z12, Z12
+	VPSUBD       Z31, Z12, Z26
+	VPMINUD      Z26, Z12, Z12
+	VPBROADCASTD 52(CX), Z25
+	VMOVSHDUP    Z13, Z26
+	VPMULUDQ     Z25, Z13, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z13
+	VPADDD       Z24, Z13, Z13
+	VPSUBD       Z31, Z13, Z26
+	VPMINUD      Z26, Z13, Z13
+	VPBROADCASTD 56(CX), Z25
+	VMOVSHDUP    Z14, Z26
+	VPMULUDQ     Z25, Z14, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z14
+	VPADDD       Z24, Z14, Z14
+	VPSUBD       Z31, Z14, Z26
+	VPMINUD      Z26, Z14, Z14
+	VPBROADCASTD 60(CX), Z25
+	VMOVSHDUP    Z15, Z26
+	VPMULUDQ     Z25, Z15, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z15
+	VPADDD       Z24, Z15, Z15
+	VPSUBD       Z31, Z15, Z26
+	VPMINUD      Z26, Z15, Z15
+	VPBROADCASTD 64(CX), Z25
+	VMOVSHDUP    Z16, Z26
+	VPMULUDQ     Z25, Z16, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z16
+	VPADDD       Z24, Z16, Z16
+	VPSUBD       Z31, Z16, Z26
+	VPMINUD      Z26, Z16, Z16
+	VPBROADCASTD 68(CX), Z25
+	VMOVSHDUP    Z17, Z26
+	VPMULUDQ     Z25, Z17, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z17
+	VPADDD       Z24, Z17, Z17
+	VPSUBD       Z31, Z17, Z26
+	VPMINUD      Z26, Z17, Z17
+	VPBROADCASTD 72(CX), Z25
+	VMOVSHDUP    Z18, Z26
+	VPMULUDQ     Z25, Z18, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z18
+	VPADDD       Z24, Z18, Z18
+	VPSUBD       Z31, Z18, Z26
+	VPMINUD      Z26, Z18, Z18
+	VPBROADCASTD 76(CX), Z25
+	VMOVSHDUP    Z19, Z26
+	VPMULUDQ     Z25, Z19, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z19
+	VPADDD       Z24, Z19, Z19
+	VPSUBD       Z31, Z19, Z26
+	VPMINUD      Z26, Z19, Z19
+	VPBROADCASTD 80(CX), Z25
+	VMOVSHDUP    Z20, Z26
+	VPMULUDQ     Z25, Z20, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z20
+	VPADDD       Z24, Z20, Z20
+	VPSUBD       Z31, Z20, Z26
+	VPMINUD      Z26, Z20, Z20
+	VPBROADCASTD 84(CX), Z25
+	VMOVSHDUP    Z21, Z26
+	VPMULUDQ     Z25, Z21, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z21
+	VPADDD       Z24, Z21, Z21
+	VPSUBD       Z31, Z21, Z26
+	VPMINUD      Z26, Z21, Z21
+	VPBROADCASTD 88(CX), Z25
+	VMOVSHDUP    Z22, Z26
+	VPMULUDQ     Z25, Z22, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z22
+	VPADDD       Z24, Z22, Z22
+	VPSUBD       Z31, Z22, Z26
+	VPMINUD      Z26, Z22, Z22
+	VPBROADCASTD 92(CX), Z25
+	VMOVSHDUP    Z23, Z26
+	VPMULUDQ     Z25, Z23, Z28
+	VPMULUDQ     Z25, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z23
+	VPADDD       Z24, Z23, Z23
+	VPSUBD       Z31, Z23, Z26
+	VPMINUD      Z26, Z23, Z23
+	ADDQ         $4, DX
+	DECQ         BX            // decrement n
+	JMP          loop_9
+
+done_10:
+	// the second half of the full rounds
+	MOVQ $4, BX
+
+loop_11:
+	TESTQ        BX, BX
+	JEQ          done_12       // n == 0, we are done
+	VPBROADCASTD 0(DX), Z24
+	VPADDD       Z24, Z0, Z0
+	VPSUBD       Z31, Z0, Z25
+	VPMINUD      Z25, Z0, Z0
+	VPBROADCASTD 4(DX), Z24
+	VPADDD       Z24, Z1, Z1
+	VPSUBD       Z31, Z1, Z25
+	VPMINUD      Z25, Z1, Z1
+	VPBROADCASTD 8(DX), Z24
+	VPADDD       Z24, Z2, Z2
+	VPSUBD       Z31, Z2, Z25
+	VPMINUD      Z25, Z2, Z2
+	VPBROADCASTD 12(DX), Z24
+	VPADDD       Z24, Z3, Z3
+	VPSUBD       Z31, Z3, Z25
+	VPMINUD      Z25, Z3, Z3
+	VPBROADCASTD 16(DX), Z24
+	VPADDD       Z24, Z4, Z4
+	VPSUBD       Z31, Z4, Z25
+	VPMINUD      Z25, Z4, Z4
+	VPBROADCASTD 20(DX), Z24
+	VPADDD       Z24, Z5, Z5
+	VPSUBD       Z31, Z5, Z25
+	VPMINUD      Z25, Z5, Z5
+	VPBROADCASTD 24(DX), Z24
+	VPADDD       Z24, Z6, Z6
+	VPSUBD       Z31, Z6, Z25
+	VPMINUD      Z25, Z6, Z6
+	VPBROADCASTD 28(DX), Z24
+	VPADDD       Z24, Z7, Z7
+	VPSUBD       Z31, Z7, Z25
+	VPMINUD      Z25, Z7, Z7
+	VPBROADCASTD 32(DX), Z24
+	VPADDD       Z24, Z8, Z8
+	VPSUBD       Z31, Z8, Z25
+	VPMINUD      Z25, Z8, Z8
+	VPBROADCASTD 36(DX), Z24
+	VPADDD       Z24, Z9, Z9
+	VPSUBD       Z31, Z9, Z25
+	VPMINUD      Z25, Z9, Z9
+	VPBROADCASTD 40(DX), Z24
+	VPADDD       Z24, Z10, Z10
+	VPSUBD       Z31, Z10, Z25
+	VPMINUD      Z25, Z10, Z10
+	VPBROADCASTD 44(DX), Z24
+	VPADDD       Z24, Z11, Z11
+	VPSUBD       Z31, Z11, Z25
+	VPMINUD      Z25, Z11, Z11
+	VPBROADCASTD 48(DX), Z24
+	VPADDD       Z24, Z12, Z12
+	VPSUBD       Z31, Z12, Z25
+	VPMINUD      Z25, Z12, Z12
+	VPBROADCASTD 52(DX), Z24
+	VPADDD       Z24, Z13, Z13
+	VPSUBD       Z31, Z13, Z25
+	VPMINUD      Z25, Z13, Z13
+	VPBROADCASTD 56(DX), Z24
+	VPADDD       Z24, Z14, Z14
+	VPSUBD       Z31, Z14, Z25
+	VPMINUD      Z25, Z14, Z14
+	VPBROADCASTD 60(DX), Z24
+	VPADDD       Z24, Z15, Z15
+	VPSUBD       Z31, Z15, Z25
+	VPMINUD      Z25, Z15, Z15
+	VPBROADCASTD 64(DX), Z24
+	VPADDD       Z24, Z16, Z16
+	VPSUBD       Z31, Z16, Z25
+	VPMINUD      Z25, Z16, Z16
+	VPBROADCASTD 68(DX), Z24
+	VPADDD       Z24, Z17, Z17
+	VPSUBD       Z31, Z17, Z25
+	VPMINUD      Z25, Z17, Z17
+	VPBROADCASTD 72(DX), Z24
+	VPADDD       Z24, Z18, Z18
+	VPSUBD       Z31, Z18, Z25
+	VPMINUD      Z25, Z18, Z18
+	VPBROADCASTD 76(DX), Z24
+	VPADDD       Z24, Z19, Z19
+	VPSUBD       Z31, Z19, Z25
+	VPMINUD      Z25, Z19, Z19
+	VPBROADCASTD 80(DX), Z24
+	VPADDD       Z24, Z20, Z20
+	VPSUBD       Z31, Z20, Z25
+	VPMINUD      Z25, Z20, Z20
+	VPBROADCASTD 84(DX), Z24
+	VPADDD       Z24, Z21, Z21
+	VPSUBD       Z31, Z21, Z25
+	VPMINUD      Z25, Z21, Z21
+	VPBROADCASTD 88(DX), Z24
+	VPADDD       Z24, Z22, Z22
+	VPSUBD       Z31, Z22, Z25
+	VPMINUD      Z25, Z22, Z22
+	VPBROADCASTD 92(DX), Z24
+	VPADDD       Z24, Z23, Z23
+	VPSUBD       Z31, Z23, Z25
+	VPMINUD      Z25, Z23, Z23
+	VMOVSHDUP    Z0, Z26
+	VMOVSHDUP    Z0, Z27
+	VPMULUDQ     Z0, Z0, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z0, Z27
+	VPMULUDQ     Z0, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z0
+	VMOVSHDUP    Z1, Z26
+	VMOVSHDUP    Z1, Z27
+	VPMULUDQ     Z1, Z1, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z1, Z27
+	VPMULUDQ     Z1, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z1
+	VMOVSHDUP    Z2, Z26
+	VMOVSHDUP    Z2, Z27
+	VPMULUDQ     Z2, Z2, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z2, Z27
+	VPMULUDQ     Z2, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z2
+	VMOVSHDUP    Z3, Z26
+	VMOVSHDUP    Z3, Z27
+	VPMULUDQ     Z3, Z3, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z3, Z27
+	VPMULUDQ     Z3, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z3
+	VMOVSHDUP    Z4, Z26
+	VMOVSHDUP    Z4, Z27
+	VPMULUDQ     Z4, Z4, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z4, Z27
+	VPMULUDQ     Z4, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z4
+	VMOVSHDUP    Z5, Z26
+	VMOVSHDUP    Z5, Z27
+	VPMULUDQ     Z5, Z5, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z5, Z27
+	VPMULUDQ     Z5, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z5
+	VMOVSHDUP    Z6, Z26
+	VMOVSHDUP    Z6, Z27
+	VPMULUDQ     Z6, Z6, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z6, Z27
+	VPMULUDQ     Z6, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z6
+	VMOVSHDUP    Z7, Z26
+	VMOVSHDUP    Z7, Z27
+	VPMULUDQ     Z7, Z7, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z7, Z27
+	VPMULUDQ     Z7, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z7
+	VMOVSHDUP    Z8, Z26
+	VMOVSHDUP    Z8, Z27
+	VPMULUDQ     Z8, Z8, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z8, Z27
+	VPMULUDQ     Z8, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z8
+	VMOVSHDUP    Z9, Z26
+	VMOVSHDUP    Z9, Z27
+	VPMULUDQ     Z9, Z9, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z9, Z27
+	VPMULUDQ     Z9, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z9
+	VMOVSHDUP    Z10, Z26
+	VMOVSHDUP    Z10, Z27
+	VPMULUDQ     Z10, Z10, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z10, Z27
+	VPMULUDQ     Z10, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z10
+	VMOVSHDUP    Z11, Z26
+	VMOVSHDUP    Z11, Z27
+	VPMULUDQ     Z11, Z11, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z11, Z27
+	VPMULUDQ     Z11, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z11
+	VMOVSHDUP    Z12, Z26
+	VMOVSHDUP    Z12, Z27
+	VPMULUDQ     Z12, Z12, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z12, Z27
+	VPMULUDQ     Z12, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z12
+	VMOVSHDUP    Z13, Z26
+	VMOVSHDUP    Z13, Z27
+	VPMULUDQ     Z13, Z13, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z13, Z27
+	VPMULUDQ     Z13, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z13
+	VMOVSHDUP    Z14, Z26
+	VMOVSHDUP    Z14, Z27
+	VPMULUDQ     Z14, Z14, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z14, Z27
+	VPMULUDQ     Z14, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z14
+	VMOVSHDUP    Z15, Z26
+	VMOVSHDUP    Z15, Z27
+	VPMULUDQ     Z15, Z15, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z15, Z27
+	VPMULUDQ     Z15, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z15
+	VMOVSHDUP    Z16, Z26
+	VMOVSHDUP    Z16, Z27
+	VPMULUDQ     Z16, Z16, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z16, Z27
+	VPMULUDQ     Z16, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z16
+	VMOVSHDUP    Z17, Z26
+	VMOVSHDUP    Z17, Z27
+	VPMULUDQ     Z17, Z17, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z17, Z27
+	VPMULUDQ     Z17, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z17
+	VMOVSHDUP    Z18, Z26
+	VMOVSHDUP    Z18, Z27
+	VPMULUDQ     Z18, Z18, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z18, Z27
+	VPMULUDQ     Z18, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z18
+	VMOVSHDUP    Z19, Z26
+	VMOVSHDUP    Z19, Z27
+	VPMULUDQ     Z19, Z19, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z19, Z27
+	VPMULUDQ     Z19, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z19
+	VMOVSHDUP    Z20, Z26
+	VMOVSHDUP    Z20, Z27
+	VPMULUDQ     Z20, Z20, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z20, Z27
+	VPMULUDQ     Z20, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z20
+	VMOVSHDUP    Z21, Z26
+	VMOVSHDUP    Z21, Z27
+	VPMULUDQ     Z21, Z21, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z21, Z27
+	VPMULUDQ     Z21, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z21
+	VMOVSHDUP    Z22, Z26
+	VMOVSHDUP    Z22, Z27
+	VPMULUDQ     Z22, Z22, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z22, Z27
+	VPMULUDQ     Z22, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z22
+	VMOVSHDUP    Z23, Z26
+	VMOVSHDUP    Z23, Z27
+	VPMULUDQ     Z23, Z23, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z23, Z27
+	VPMULUDQ     Z23, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z25
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z24, Z27
+	VPMULUDQ     Z24, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z24
+	VMOVSHDUP    Z24, Z26
+	VMOVSHDUP    Z25, Z27
+	VPMULUDQ     Z25, Z24, Z28
+	VPMULUDQ     Z27, Z26, Z26
+	VPMULUDQ     Z30, Z28, Z29
+	VPMULUDQ     Z31, Z29, Z29
+	VPMULUDQ     Z30, Z26, Z27
+	VPMULUDQ     Z31, Z27, Z27
+	VPSUBQ       Z29, Z28, Z28
+	VPSUBQ       Z27, Z26, Z26
+	VMOVSHDUP    Z28, K3, Z26
+	VPADDD       Z31, Z26, Z27
+	VPMINUD      Z27, Z26, Z23
+	VPADDD       Z1, Z0, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z3, Z2, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z25, Z24, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z3, Z26, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z1, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z0, Z0, Z0
+	VPSUBD       Z31, Z0, Z28
+	VPMINUD      Z28, Z0, Z0
+	VPADDD       Z0, Z27, Z3
+	VPSUBD       Z31, Z3, Z28
+	VPMINUD      Z28, Z3, Z3
+	VPADDD       Z2, Z2, Z2
+	VPSUBD       Z31, Z2, Z28
+	VPMINUD      Z28, Z2, Z2
+	VPADDD       Z2, Z26, Z1
+	VPSUBD       Z31, Z1, Z28
+	VPMINUD      Z28, Z1, Z1
+	VPADDD       Z24, Z26, Z0
+	VPSUBD       Z31, Z0, Z28
+	VPMINUD      Z28, Z0, Z0
+	VPADDD       Z25, Z27, Z2
+	VPSUBD       Z31, Z2, Z28
+	VPMINUD      Z28, Z2, Z2
+	VPADDD       Z5, Z4, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z7, Z6, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z25, Z24, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z7, Z26, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z5, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z4, Z4, Z4
+	VPSUBD       Z31, Z4, Z28
+	VPMINUD      Z28, Z4, Z4
+	VPADDD       Z4, Z27, Z7
+	VPSUBD       Z31, Z7, Z28
+	VPMINUD      Z28, Z7, Z7
+	VPADDD       Z6, Z6, Z6
+	VPSUBD       Z31, Z6, Z28
+	VPMINUD      Z28, Z6, Z6
+	VPADDD       Z6, Z26, Z5
+	VPSUBD       Z31, Z5, Z28
+	VPMINUD      Z28, Z5, Z5
+	VPADDD       Z24, Z26, Z4
+	VPSUBD       Z31, Z4, Z28
+	VPMINUD      Z28, Z4, Z4
+	VPADDD       Z25, Z27, Z6
+	VPSUBD       Z31, Z6, Z28
+	VPMINUD      Z28, Z6, Z6
+	VPADDD       Z9, Z8, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z11, Z10, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z25, Z24, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z11, Z26, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z9, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z8, Z8, Z8
+	VPSUBD       Z31, Z8, Z28
+	VPMINUD      Z28, Z8, Z8
+	VPADDD       Z8, Z27, Z11
+	VPSUBD       Z31, Z11, Z28
+	VPMINUD      Z28, Z11, Z11
+	VPADDD       Z10, Z10, Z10
+	VPSUBD       Z31, Z10, Z28
+	VPMINUD      Z28, Z10, Z10
+	VPADDD       Z10, Z26, Z9
+	VPSUBD       Z31, Z9, Z28
+	VPMINUD      Z28, Z9, Z9
+	VPADDD       Z24, Z26, Z8
+	VPSUBD       Z31, Z8, Z28
+	VPMINUD      Z28, Z8, Z8
+	VPADDD       Z25, Z27, Z10
+	VPSUBD       Z31, Z10, Z28
+	VPMINUD      Z28, Z10, Z10
+	VPADDD       Z13, Z12, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z15, Z14, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z25, Z24, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z15, Z26, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z13, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z12, Z12, Z12
+	VPSUBD       Z31, Z12, Z28
+	VPMINUD      Z28, Z12, Z12
+	VPADDD       Z12, Z27, Z15
+	VPSUBD       Z31, Z15, Z28
+	VPMINUD      Z28, Z15, Z15
+	VPADDD       Z14, Z14, Z14
+	VPSUBD       Z31, Z14, Z28
+	VPMINUD      Z28, Z14, Z14
+	VPADDD       Z14, Z26, Z13
+	VPSUBD       Z31, Z13, Z28
+	VPMINUD      Z28, Z13, Z13
+	VPADDD       Z24, Z26, Z12
+	VPSUBD       Z31, Z12, Z28
+	VPMINUD      Z28, Z12, Z12
+	VPADDD       Z25, Z27, Z14
+	VPSUBD       Z31, Z14, Z28
+	VPMINUD      Z28, Z14, Z14
+	VPADDD       Z17, Z16, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z19, Z18, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z25, Z24, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z19, Z26, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z17, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z16, Z16, Z16
+	VPSUBD       Z31, Z16, Z28
+	VPMINUD      Z28, Z16, Z16
+	VPADDD       Z16, Z27, Z19
+	VPSUBD       Z31, Z19, Z28
+	VPMINUD      Z28, Z19, Z19
+	VPADDD       Z18, Z18, Z18
+	VPSUBD       Z31, Z18, Z28
+	VPMINUD      Z28, Z18, Z18
+	VPADDD       Z18, Z26, Z17
+	VPSUBD       Z31, Z17, Z28
+	VPMINUD      Z28, Z17, Z17
+	VPADDD       Z24, Z26, Z16
+	VPSUBD       Z31, Z16, Z28
+	VPMINUD      Z28, Z16, Z16
+	VPADDD       Z25, Z27, Z18
+	VPSUBD       Z31, Z18, Z28
+	VPMINUD      Z28, Z18, Z18
+	VPADDD       Z21, Z20, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z23, Z22, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z25, Z24, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z23, Z26, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z21, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z20, Z20, Z20
+	VPSUBD       Z31, Z20, Z28
+	VPMINUD      Z28, Z20, Z20
+	VPADDD       Z20, Z27, Z23
+	VPSUBD       Z31, Z23, Z28
+	VPMINUD      Z28, Z23, Z23
+	VPADDD       Z22, Z22, Z22
+	VPSUBD       Z31, Z22, Z28
+	VPMINUD      Z28, Z22, Z22
+	VPADDD       Z22, Z26, Z21
+	VPSUBD       Z31, Z21, Z28
+	VPMINUD      Z28, Z21, Z21
+	VPADDD       Z24, Z26, Z20
+	VPSUBD       Z31, Z20, Z28
+	VPMINUD      Z28, Z20, Z20
+	VPADDD       Z25, Z27, Z22
+	VPSUBD       Z31, Z22, Z28
+	VPMINUD      Z28, Z22, Z22
+	VPADDD       Z4, Z0, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z8, Z24, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z12, Z24, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z16, Z24, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z20, Z24, Z24
+	VPSUBD       Z31, Z24, Z28
+	VPMINUD      Z28, Z24, Z24
+	VPADDD       Z5, Z1, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z9, Z25, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z13, Z25, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z17, Z25, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z21, Z25, Z25
+	VPSUBD       Z31, Z25, Z28
+	VPMINUD      Z28, Z25, Z25
+	VPADDD       Z6, Z2, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z10, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z14, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z18, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z22, Z26, Z26
+	VPSUBD       Z31, Z26, Z28
+	VPMINUD      Z28, Z26, Z26
+	VPADDD       Z7, Z3, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z11, Z27, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z15, Z27, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z19, Z27, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z23, Z27, Z27
+	VPSUBD       Z31, Z27, Z28
+	VPMINUD      Z28, Z27, Z27
+	VPADDD       Z24, Z0, Z0
+	VPSUBD       Z31, Z0, Z28
+	VPMINUD      Z28, Z0, Z0
+	VPADDD       Z25, Z1, Z1
+	VPSUBD       Z31, Z1, Z28
+	VPMINUD      Z28, Z1, Z1
+	VPADDD       Z26, Z2, Z2
+	VPSUBD       Z31, Z2, Z28
+	VPMINUD      Z28, Z2, Z2
+	VPADDD       Z27, Z3, Z3
+	VPSUBD       Z31, Z3, Z28
+	VPMINUD      Z28, Z3, Z3
+	VPADDD       Z24, Z4, Z4
+	VPSUBD       Z31, Z4, Z28
+	VPMINUD      Z28, Z4, Z4
+	VPADDD       Z25, Z5, Z5
+	VPSUBD       Z31, Z5, Z28
+	VPMINUD      Z28, Z5, Z5
+	VPADDD       Z26, Z6, Z6
+	VPSUBD       Z31, Z6, Z28
+	VPMINUD      Z28, Z6, Z6
+	VPADDD       Z27, Z7, Z7
+	VPSUBD       Z31, Z7, Z28
+	VPMINUD      Z28, Z7, Z7
+	VPADDD       Z24, Z8, Z8
+	VPSUBD       Z31, Z8, Z28
+	VPMINUD      Z28, Z8, Z8
+	VPADDD       Z25, Z9, Z9
+	VPSUBD       Z31, Z9, Z28
+	VPMINUD      Z28, Z9, Z9
+	VPADDD       Z26, Z10, Z10
+	VPSUBD       Z31, Z10, Z28
+	VPMINUD      Z28, Z10, Z10
+	VPADDD       Z27, Z11, Z11
+	VPSUBD       Z31, Z11, Z28
+	VPMINUD      Z28, Z11, Z11
+	VPADDD       Z24, Z12, Z12
+	VPSUBD       Z31, Z12, Z28
+	VPMINUD      Z28, Z12, Z12
+	VPADDD       Z25, Z13, Z13
+	VPSUBD       Z31, Z13, Z28
+	VPMINUD      Z28, Z13, Z13
+	VPADDD       Z26, Z14, Z14
+	VPSUBD       Z31, Z14, Z28
+	VPMINUD      Z28, Z14, Z14
+	VPADDD       Z27, Z15, Z15
+	VPSUBD       Z31, Z15, Z28
+	VPMINUD      Z28, Z15, Z15
+	VPADDD       Z24, Z16, Z16
+	VPSUBD       Z31, Z16, Z28
+	VPMINUD      Z28, Z16, Z16
+	VPADDD       Z25, Z17, Z17
+	VPSUBD       Z31, Z17, Z28
+	VPMINUD      Z28, Z17, Z17
+	VPADDD       Z26, Z18, Z18
+	VPSUBD       Z31, Z18, Z28
+	VPMINUD      Z28, Z18, Z18
+	VPADDD       Z27, Z19, Z19
+	VPSUBD       Z31, Z19, Z28
+	VPMINUD      Z28, Z19, Z19
+	VPADDD       Z24, Z20, Z20
+	VPSUBD       Z31, Z20, Z28
+	VPMINUD      Z28, Z20, Z20
+	VPADDD       Z25, Z21, Z21
+	VPSUBD       Z31, Z21, Z28
+	VPMINUD      Z28, Z21, Z21
+	VPADDD       Z26, Z22, Z22
+	VPSUBD       Z31, Z22, Z28
+	VPMINUD      Z28, Z22, Z22
+	VPADDD       Z27, Z23, Z23
+	VPSUBD       Z31, Z23, Z28
+	VPMINUD      Z28, Z23, Z23
+	ADDQ         $96, DX
+	DECQ         BX            // decrement n
+	JMP          loop_11
+
+done_12:
+	VMOVDQU32 Z0, 0(AX)
+	VMOVDQU32 Z1, 64(AX)
+	VMOVDQU32 Z2, 128(AX)
+	VMOVDQU32 Z3, 192(AX)
+	VMOVDQU32 Z4, 256(AX)
+	VMOVDQU32 Z5, 320(AX)
+	VMOVDQU32 Z6, 384(AX)
+	VMOVDQU32 Z7, 448(AX)
+	VMOVDQU32 Z8, 512(AX)
+	VMOVDQU32 Z9, 576(AX)
+	VMOVDQU32 Z10, 640(AX)
+	VMOVDQU32 Z11, 704(AX)
+	VMOVDQU32 Z12, 768(AX)
+	VMOVDQU32 Z13, 832(AX)
+	VMOVDQU32 Z14, 896(AX)
+	VMOVDQU32 Z15, 960(AX)
+	VMOVDQU32 Z16, 1024(AX)
+	VMOVDQU32 Z17, 1088(AX)
+	VMOVDQU32 Z18, 1152(AX)
+	VMOVDQU32 Z19, 1216(AX)
+	VMOVDQU32 Z20, 1280(AX)
+	VMOVDQU32 Z21, 1344(AX)
+	VMOVDQU32 Z22, 1408(AX)
+	VMOVDQU32 Z23, 1472(AX)
+	VZEROUPPER
+	RET
@@ -0,0 +1,88 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon2
+
+import (
+	"github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/consensys/gnark-crypto/field/babybear/merkletree"
+)
+
+// Sponge padding-free sponge of rate Width - DigestSize: the
+// input is absorbed by overwriting the first elements of the state, the state
+// being permuted after each chunk of rate elements, including the last one if
+// it is incomplete. The digest is the first DigestSize elements of the state.
+//
+// Since the input is not padded, inputs which differ by trailing zeros may
+// have the same digest: the sponge must be used on inputs of a fixed length,
+// such as the leaves of a Merkle tree.
+type Sponge struct {
+	p *Permutation
+}
+
+// NewSponge returns the sponge with the permutation of the given width.
+func NewSponge(width int) Sponge {
+	p := NewPermutation(width)
+	if p.params.Width <= DigestSize {
+		panic("poseidon2: the width must be larger than DigestSize")
+	}
+	return Sponge{p: p}
+}
+
+// Rate returns the number of elements absorbed by each permutation.
+func (s Sponge) Rate() int {
+	return s.p.params.Width - DigestSize
+}
+
+// Hash returns the digest of input.
+func (s Sponge) Hash(input []babybear.Element) merkletree.Digest {
+	state := make([]babybear.Element, s.p.params.Width)
+	s.absorb(state, input)
+
+	var res merkletree.Digest
+	copy(res[:], state)
+	return res
+}
+
+// BatchHash sets res[i] to the digest of inputs[i], with the permutations
+// applied by BatchPermutation. The inputs must have the same length.
+func (s Sponge) BatchHash(res []merkletree.Digest, inputs [][]babybear.Element) error {
+	if len(res) != len(inputs) {
+		return ErrInvalidNbDigests
+	}
+	if len(inputs) == 0 {
+		return nil
+	}
+	n := len(inputs[0])
+	for i := range inputs {
+		if len(inputs[i]) != n {
+			return ErrInvalidSizebuffer
+		}
+	}
+
+	width, rate := s.p.params.Width, s.Rate()
+	states := make([]babybear.Element, len(inputs)*width)
+	for start := 0; start < n; start += rate {
+		end := min(start+rate, n)
+		for i := range inputs {
+			copy(states[i*width:], inputs[i][start:end])
+		}
+		s.p.batchPermutation(states)
+	}
+	for i := range res {
+		copy(res[i][:], states[i*width:])
+	}
+	return nil
+}
+
+// absorb absorbs input in state
+func (s Sponge) absorb(state, input []babybear.Element) {
+	rate := s.Rate()
+	for len(input) > 0 {
+		n := copy(state[:rate], input)
+		input = input[n:]
+		s.p.permutation(state)
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package stark
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/consensys/gnark-crypto/field/babybear/extensions"
+)
+
+var ErrInvalidAIR = errors.New("invalid AIR")
+
+// AIR algebraic intermediate representation of a computation over babybear. An
+// execution trace of Width columns and n rows, n a power of 2, satisfies the
+// AIR if
+//   - the transition constraints vanish on all the pairs of consecutive rows
+//     (tᵢ, tᵢ₊₁), for i < n - 1,
+//   - the boundary constraints hold.
+type AIR struct {
+	// Width number of columns of the trace
+	Width int
+
+	// Transitions transition constraints
+	Transitions []*Expression
+
+	// Boundaries boundary constraints, typically the public inputs and outputs
+	Boundaries []Boundary
+}
+
+// Boundary boundary constraint, the value of a cell of the trace.
+type Boundary struct {
+	Column, Row int
+	Value       babybear.Element
+}
+
+// check returns an error wrapping ErrInvalidAIR if the AIR is not well formed
+// for traces of n rows.
+func (air *AIR) check(n int) error {
+	if air.Width < 1 {
+		return fmt.Errorf("%w: the width must be positive", ErrInvalidAIR)
+	}
+	if len(air.Transitions)+len(air.Boundaries) == 0 {
+		return fmt.Errorf("%w: no constraints", ErrInvalidAIR)
+	}
+	for i, c := range air.Transitions {
+		if err := c.check(air.Width); err != nil {
+			return fmt.Errorf("transition constraint %d: %w", i, err)
+		}
+	}
+	for i, b := range air.Boundaries {
+		if b.Column < 0 || b.Column >= air.Width || b.Row < 0 || b.Row >= n {
+			return fmt.Errorf("%w: boundary constraint %d is out of the trace", ErrInvalidAIR, i)
+		}
+	}
+	return nil
+}
+
+// compositionDegree returns the number D of segments of degree < n of the
+// composition polynomial, whose degree is < D·n.
+func (air *AIR) compositionDegree() int {
+	// C(t(X), t(gX))·(X - g⁻¹)/(Xⁿ - 1) has degree ≤ (d - 1)·n - d + 1
+	res := 1
+	for _, c := range air.Transitions {
+		res = max(res, c.Degree()-1)
+	}
+	return res
+}
+
+// nbConstraints returns the number of constraints of the AIR.
+func (air *AIR) nbConstraints() int {
+	return len(air.Transitions) + len(air.Boundaries)
+}
+
+// checkTrace returns an error wrapping ErrUnsatisfied if the trace, made of
+// Width columns of size n, does not satisfy the constraints.
+func (air *AIR) checkTrace(trace [][]babybear.Element) error {
+	n := len(trace[0])
+	cur, next := make([]babybear.Element, air.Width), make([]babybear.Element, air.Width)
+	for i := 0; i < n-1; i++ {
+		for j := range cur {
+			cur[j], next[j] = trace[j][i], trace[j][i+1]
+		}
+		for c, constraint := range air.Transitions {
+			if v := constraint.Evaluate(cur, next); !v.IsZero() {
+				return fmt.Errorf("%w: transition constraint %d at row %d", ErrUnsatisfied, c, i)
+			}
+		}
+	}
+	for c, b := range air.Boundaries {
+		if !trace[b.Column][b.Row].Equal(&b.Value) {
+			return fmt.Errorf("%w: boundary constraint %d", ErrUnsatisfied, c)
+		}
+	}
+	return nil
+}
+
+type operation uint8
+
+const (
+	opCur operation = iota
+	opNext
+	opConstant
+	opAdd
+	opSub
+	opMul
+	opPow
+)
+
+// Expression polynomial expression in the cells of the current and the next
+// rows of the trace.
+type Expression struct {
+	op       operation
+	column   int
+	constant babybear.Element
+	exponent int
+	operands []*Expression
+}
+
+// Cur returns the expression of the cell of the current row in given column.
+func Cur(column int) *Expression {
+	return &Expression{op: opCur, column: column}
+}
+
+// Next returns the expression of the cell of the next row in given column.
+func Next(column int) *Expression {
+	return &Expression{op: opNext, column: column}
+}
+
+// Constant returns the expression of the constant c.
+func Constant(c babybear.Element) *Expression {
+	return &Expression{op: opConstant, constant: c}
+}
+
+// Add returns the expression of the sum of the operands.
+func Add(operands ...*Expression) *Expression {
+	return &Expression{op: opAdd, operands: operands}
+}
+
+// Sub returns the expression a - b.
+func Sub(a, b *Expression) *Expression {
+	return &Expression{op: opSub, operands: []*Expression{a, b}}
+}
+
+// Mul returns the expression of the product of the operands.
+func Mul(operands ...*Expression) *Expression {
+	return &Expression{op: opMul, operands: operands}
+}
+
+// Pow returns the expression aᵉ.
+func Pow(a *Expression, e int) *Expression {
+	return &Expression{op: opPow, operands: []*Expression{a}, exponent: e}
+}
+
+// Degree returns the total degree of the expression in the cells.
+func (e *Expression) Degree() int {
+	switch e.op {
+	case opCur, opNext:
+		return 1
+	case opConstant:
+		return 0
+	case opMul:
+		res := 0
+		for _, o := range e.operands {
+			res += o.Degree()
+		}
+		return res
+	case opPow:
+		return e.exponent * e.operands[0].Degree()
+	default:
+		res := 0
+		for _, o := range e.operands {
+			res = max(res, o.Degree())
+		}
+		return res
+	}
+}
+
+// check returns an error wrapping ErrInvalidAIR if the expression is not well
+// formed for a trace of given width.
+func (e *Expression) check(width int) error {
+	switch e.op {
+	case opCur, opNext:
+		if e.column < 0 || e.column >= width {
+			return fmt.Errorf("%w: column %d is out of the trace", ErrInvalidAIR, e.column)
+		}
+		return nil
+	case opConstant:
+		return nil
+	case opPow:
+		if e.exponent < 0 {
+			return fmt.Errorf("%w: negative exponent", ErrInvalidAIR)
+		}
+	}
+	if len(e.operands) == 0 {
+		return fmt.Errorf("%w: no operands", ErrInvalidAIR)
+	}
+	for _, o := range e.operands {
+		if o == nil {
+			return fmt.Errorf("%w: nil operand", ErrInvalidAIR)
+		}
+		if err := o.check(width); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Evaluate returns the value of the expression on the rows cur and next.
+func (e *Expression) Evaluate(cur, next []babybear.Element) babybear.Element {
+	var res babybear.Element
+	switch e.op {
+	case opCur:
+		return cur[e.column]
+	case opNext:
+		return next[e.column]
+	case opConstant:
+		return e.constant
+	case opAdd:
+		for _, o := range e.operands {
+			v := o.Evaluate(cur, next)
+			res.Add(&res, &v)
+		}
+	case opSub:
+		a, b := e.operands[0].Evaluate(cur, next), e.operands[1].Evaluate(cur, next)
+		res.Sub(&a, &b)
+	case opMul:
+		res.SetOne()
+		for _, o := range e.operands {
+			v := o.Evaluate(cur, next)
+			res.Mul(&res, &v)
+		}
+	case opPow:
+		res.Exp(e.operands[0].Evaluate(cur, next), big.NewInt(int64(e.exponent)))
+	}
+	return res
+}
+
+// evaluateExtension returns the value of the expression on rows in the
+// extension.
+func (e *Expression) evaluateExtension(cur, next []extensions.E4) extensions.E4 {
+	var res extensions.E4
+	switch e.op {
+	case opCur:
+		return cur[e.column]
+	case opNext:
+		return next[e.column]
+	case opConstant:
+		return fromElement(&e.constant)
+	case opAdd:
+		for _, o := range e.operands {
+			v := o.evaluateExtension(cur, next)
+			res.Add(&res, &v)
+		}
+	case opSub:
+		a, b := e.operands[0].evaluateExtension(cur, next), e.operands[1].evaluateExtension(cur, next)
+		res.Sub(&a, &b)
+	case opMul:
+		res.SetOne()
+		for _, o := range e.operands {
+			v := o.evaluateExtension(cur, next)
+			res.Mul(&res, &v)
+		}
+	case opPow:
+		res.Exp(e.operands[0].evaluateExtension(cur, next), big.NewInt(int64(e.exponent)))
+	}
+	return res
+}
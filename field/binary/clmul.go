@@ -0,0 +1,46 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+// clmul64Generic returns the carry-less product of x and y, with a window of 4
+// bits of x.
+func clmul64Generic(x, y uint64) (lo, hi uint64) {
+	// products of y by the polynomials of degree < 4
+	var tLo, tHi [16]uint64
+	for i := 0; i < 4; i++ {
+		tLo[1<<i] = y << i
+		if i > 0 {
+			tHi[1<<i] = y >> (64 - i)
+		}
+	}
+	for i := 3; i < 16; i++ {
+		if i&(i-1) != 0 {
+			tLo[i] = tLo[i&(i-1)] ^ tLo[i&-i]
+			tHi[i] = tHi[i&(i-1)] ^ tHi[i&-i]
+		}
+	}
+
+	for i := 60; i >= 0; i -= 4 {
+		hi = hi<<4 | lo>>60
+		lo <<= 4
+		w := (x >> i) & 15
+		lo ^= tLo[w]
+		hi ^= tHi[w]
+	}
+	return
+}
+
+// clmul128Generic sets z to the carry-less product of x and y, with the
+// Karatsuba formula.
+func clmul128Generic(z *[4]uint64, x, y *[2]uint64) {
+	m0Lo, m0Hi := clmul64Generic(x[0], y[0])
+	m2Lo, m2Hi := clmul64Generic(x[1], y[1])
+	m1Lo, m1Hi := clmul64Generic(x[0]^x[1], y[0]^y[1])
+	m1Lo ^= m0Lo ^ m2Lo
+	m1Hi ^= m0Hi ^ m2Hi
+	z[0] = m0Lo
+	z[1] = m0Hi ^ m1Lo
+	z[2] = m2Lo ^ m1Hi
+	z[3] = m2Hi
+}
@@ -0,0 +1,33 @@
+//go:build !purego
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import "golang.org/x/sys/cpu"
+
+var supportPclmulqdq = cpu.X86.HasPCLMULQDQ
+
+func clmul64(x, y uint64) (lo, hi uint64) {
+	if !supportPclmulqdq {
+		return clmul64Generic(x, y)
+	}
+	return clmul64Pclmulqdq(x, y)
+}
+
+func clmul128(z *[4]uint64, x, y *[2]uint64) {
+	if !supportPclmulqdq {
+		clmul128Generic(z, x, y)
+		return
+	}
+	clmul128Pclmulqdq(z, x, y)
+}
+
+// clmul64Pclmulqdq returns the carry-less product of x and y with PCLMULQDQ
+func clmul64Pclmulqdq(x, y uint64) (lo, hi uint64)
+
+// clmul128Pclmulqdq sets z to the carry-less product of x and y with PCLMULQDQ
+//
+//go:noescape
+func clmul128Pclmulqdq(z *[4]uint64, x, y *[2]uint64)
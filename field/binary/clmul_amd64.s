@@ -0,0 +1,46 @@
+//go:build !purego
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+#include "textflag.h"
+
+// func clmul64Pclmulqdq(x, y uint64) (lo, hi uint64)
+TEXT ·clmul64Pclmulqdq(SB), NOSPLIT, $0-32
+	MOVQ      x+0(FP), X0
+	MOVQ      y+8(FP), X1
+	PCLMULQDQ $0x00, X1, X0
+	MOVQ      X0, lo+16(FP)
+	MOVHPS    X0, hi+24(FP)
+	RET
+
+// func clmul128Pclmulqdq(z *[4]uint64, x, y *[2]uint64)
+TEXT ·clmul128Pclmulqdq(SB), NOSPLIT, $0-24
+	MOVQ z+0(FP), DI
+	MOVQ x+8(FP), SI
+	MOVQ y+16(FP), DX
+	MOVOU (SI), X0
+	MOVOU (DX), X1
+
+	// X2 = x₀·y₀, X3 = x₁·y₁
+	MOVOU     X0, X2
+	PCLMULQDQ $0x00, X1, X2
+	MOVOU     X0, X3
+	PCLMULQDQ $0x11, X1, X3
+
+	// X4 = x₁·y₀ + x₀·y₁
+	MOVOU     X0, X4
+	PCLMULQDQ $0x01, X1, X4
+	PCLMULQDQ $0x10, X1, X0
+	PXOR      X0, X4
+
+	// add the middle term at position 64
+	MOVOU  X4, X5
+	PSLLDQ $8, X4
+	PSRLDQ $8, X5
+	PXOR   X4, X2
+	PXOR   X5, X3
+
+	MOVOU X2, (DI)
+	MOVOU X3, 16(DI)
+	RET
@@ -0,0 +1,14 @@
+//go:build purego || !amd64
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+func clmul64(x, y uint64) (lo, hi uint64) {
+	return clmul64Generic(x, y)
+}
+
+func clmul128(z *[4]uint64, x, y *[2]uint64) {
+	clmul128Generic(z, x, y)
+}
@@ -0,0 +1,83 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// clmul64Naive returns the carry-less product of x and y bit by bit
+func clmul64Naive(x, y uint64) (lo, hi uint64) {
+	for i := 0; i < 64; i++ {
+		if (x>>i)&1 == 1 {
+			lo ^= y << i
+			if i > 0 {
+				hi ^= y >> (64 - i)
+			}
+		}
+	}
+	return
+}
+
+func TestClmul(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties(testParameters())
+
+	properties.Property("clmul64 and clmul64Generic must match the naive carry-less product", prop.ForAll(
+		func(a GF128) bool {
+			lo, hi := clmul64Naive(a[0], a[1])
+			lo1, hi1 := clmul64(a[0], a[1])
+			lo2, hi2 := clmul64Generic(a[0], a[1])
+			return lo == lo1 && hi == hi1 && lo == lo2 && hi == hi2
+		},
+		genGF128(),
+	))
+
+	properties.Property("clmul128 and clmul128Generic must match the schoolbook product", prop.ForAll(
+		func(a, b GF128) bool {
+			var expected, z1, z2 [4]uint64
+			for i := 0; i < 2; i++ {
+				for j := 0; j < 2; j++ {
+					lo, hi := clmul64Naive(a[i], b[j])
+					expected[i+j] ^= lo
+					expected[i+j+1] ^= hi
+				}
+			}
+			clmul128(&z1, (*[2]uint64)(&a), (*[2]uint64)(&b))
+			clmul128Generic(&z2, (*[2]uint64)(&a), (*[2]uint64)(&b))
+			return expected == z1 && expected == z2
+		},
+		genGF128(), genGF128(),
+	))
+
+	properties.Property("reductions must match the tower multiplication", prop.ForAll(
+		func(a, b GF128) bool {
+			return mul64(a[0], b[0]) == mulTower64(a[0], b[0]) &&
+				square64(a[1]) == mulTower64(a[1], a[1]) &&
+				mul128((*[2]uint64)(&a), (*[2]uint64)(&b)) == mulTower128((*[2]uint64)(&a), (*[2]uint64)(&b))
+		},
+		genGF128(), genGF128(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func BenchmarkClmul128(b *testing.B) {
+	x, y := [2]uint64{0x0123456789abcdef, 0xfedcba9876543210}, [2]uint64{0xdeadbeef, 0xcafebabe}
+	var z [4]uint64
+	b.Run("generic", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			clmul128Generic(&z, &x, &y)
+		}
+	})
+	b.Run("dispatch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			clmul128(&z, &x, &y)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package binary contains field arithmetic operations for the binary tower
+// fields GF(2⁸) ⊂ GF(2¹⁶) ⊂ GF(2³²) ⊂ GF(2⁶⁴) ⊂ GF(2¹²⁸) of Fan and Paar, as
+// used in [Binius].
+//
+// The tower is defined by τ₀ = GF(2) and τₖ₊₁ = τₖ[Xₖ]/(Xₖ² + Xₖ₋₁·Xₖ + 1),
+// with X₋₁ = 1. Elements are represented in the tower basis, as words whose
+// low half is the coordinate on 1 and high half the coordinate on Xₖ: the
+// subfields embed by zero-extension, and the product by an element of a
+// subfield is computed coordinate-wise.
+//
+//	type GF8 uint8
+//	type GF16 uint16
+//	type GF32 uint32
+//	type GF64 uint64
+//	type GF128 [2]uint64
+//
+// Multiplications in GF8 use precomputed tables, and are lifted to GF16 and
+// GF32 with the Karatsuba formula of the tower. GF64 and GF128 are multiplied
+// with carry-less multiplications (PCLMULQDQ on amd64) in isomorphic fields in
+// the polynomial basis, after a change of basis.
+//
+// The package fft provides the additive FFT of [Lin, Chung and Han] for
+// Reed–Solomon encoding over GF128.
+//
+// # Usage
+//
+// Example API signature:
+//
+//	// Mul z = x·y
+//	func (z *GF128) Mul(x, y *GF128) *GF128
+//
+// and can be used like so:
+//
+//	var a, b GF128
+//	c := GF64(42)
+//	a.SetRandom()
+//	b.SetGF64(&c)
+//	a.Mul(&a, &b).Inverse(&a)
+//
+// # Warning
+//
+// There is no security guarantees such as constant time implementation or side-channel attack resistance.
+//
+// [Binius]: https://eprint.iacr.org/2023/1784
+// [Lin, Chung and Han]: https://arxiv.org/abs/1404.3458
+package binary
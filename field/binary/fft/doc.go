@@ -0,0 +1,19 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package fft provides the additive FFT of [Lin, Chung and Han] over the binary
+// field GF128, for Reed–Solomon encoding.
+//
+// The evaluation domains are affine subspaces s + V of GF128 of cardinality
+// 2ˡ, where V = span(β₀, …, βₗ₋₁) with βᵢ = 2ⁱ in the tower basis, so that the
+// i-th point of the domain is s + i. Polynomials of degree < 2ˡ are
+// represented by their coefficients in the novel polynomial basis
+//
+//	Xⱼ(x) = ∏ Ŵᵢ(x)ʲⁱ,  j = ∑ jᵢ·2ⁱ
+//
+// where Ŵᵢ is the vanishing polynomial of span(β₀, …, βᵢ₋₁) normalized so that
+// Ŵᵢ(βᵢ) = 1. The Ŵᵢ are GF(2)-linear, which gives a butterfly structure with
+// one twiddle factor per block instead of per pair, and no bit reversal.
+//
+// [Lin, Chung and Han]: https://arxiv.org/abs/1404.3458
+package fft
@@ -0,0 +1,164 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package fft
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	gf "github.com/consensys/gnark-crypto/field/binary"
+)
+
+// ErrDomainSize is returned when the cardinality of a domain is not a power of 2
+// smaller than 2⁶³
+var ErrDomainSize = errors.New("the cardinality of the domain must be a power of 2 at most 2⁶³")
+
+// Domain affine subspace s + V of GF128 with a power of 2 cardinality 2ˡ, where
+// V = span(β₀, …, βₗ₋₁) and βᵢ = 2ⁱ in the tower basis.
+//
+// The i-th point of the domain is s + i, reading i as an element of GF128 in
+// the tower basis: evaluations are given in this order.
+type Domain struct {
+	Cardinality uint64
+
+	// Shift s of the affine subspace
+	Shift gf.GF128
+
+	// the following slices are not serialized and are (re)computed through domain.preComputeTwiddles()
+
+	// twiddles factors of the round i of the FFT: Ŵᵢ(s + ∑ bₖ·βᵢ₊₁₊ₖ) for the
+	// blocks b = ∑ bₖ·2ᵏ < 2ˡ⁻ⁱ⁻¹
+	twiddles [][]gf.GF128
+}
+
+// NewDomain returns an affine subspace with a power of 2 cardinality
+// cardinality >= m
+// shift: when specified, it's the shift s of the affine subspace s + V, by
+// default 0.
+func NewDomain(m uint64, opts ...DomainOption) *Domain {
+	opt := domainOptions(opts...)
+	if m > 1<<63 {
+		panic(ErrDomainSize)
+	}
+	domain := &Domain{
+		Cardinality: ecc.NextPowerOfTwo(m),
+		Shift:       opt.shift,
+	}
+	domain.preComputeTwiddles()
+
+	return domain
+}
+
+// At returns the i-th point of the domain
+func (d *Domain) At(i uint64) gf.GF128 {
+	return gf.GF128{d.Shift[0] ^ i, d.Shift[1]}
+}
+
+// subspacePolynomials returns the values Ŵᵢ(x) for i < l, for each x of xs.
+func subspacePolynomials(l int, xs ...gf.GF128) [][]gf.GF128 {
+	// w[k] = Wᵢ(βₖ) for k ≥ i, and ws[t] = Wᵢ(xs[t]), starting from W₀(x) = x
+	w := make([]gf.GF128, l)
+	for k := range w {
+		w[k] = gf.GF128{1 << k, 0}
+	}
+	ws := make([]gf.GF128, len(xs))
+	copy(ws, xs)
+
+	res := make([][]gf.GF128, len(xs))
+	for t := range res {
+		res[t] = make([]gf.GF128, l)
+	}
+
+	var normInv, tmp gf.GF128
+	for i := 0; i < l; i++ {
+		// Ŵᵢ = Wᵢ / Wᵢ(βᵢ)
+		normInv.Inverse(&w[i])
+		for t := range ws {
+			res[t][i].Mul(&ws[t], &normInv)
+		}
+
+		// Wᵢ₊₁(x) = Wᵢ(x)·Wᵢ(x + βᵢ) = Wᵢ(x)·(Wᵢ(x) + Wᵢ(βᵢ))
+		for k := i + 1; k < l; k++ {
+			tmp.Add(&w[k], &w[i])
+			w[k].Mul(&w[k], &tmp)
+		}
+		for t := range ws {
+			tmp.Add(&ws[t], &w[i])
+			ws[t].Mul(&ws[t], &tmp)
+		}
+	}
+	return res
+}
+
+func (d *Domain) preComputeTwiddles() {
+	l := bits.TrailingZeros64(d.Cardinality)
+
+	// Ŵᵢ(s) and Ŵᵢ(βₖ), from which the twiddles follow by linearity
+	xs := make([]gf.GF128, l+1)
+	xs[0] = d.Shift
+	for k := 0; k < l; k++ {
+		xs[k+1] = gf.GF128{1 << k, 0}
+	}
+	values := subspacePolynomials(l, xs...)
+
+	d.twiddles = make([][]gf.GF128, l)
+	for i := range d.twiddles {
+		t := make([]gf.GF128, 1<<(l-i-1))
+		t[0] = values[0][i]
+		for b := 1; b < len(t); b++ {
+			k := bits.TrailingZeros(uint(b))
+			t[b].Add(&t[b&(b-1)], &values[i+k+2][i])
+		}
+		d.twiddles[i] = t
+	}
+}
+
+// WriteTo writes a binary representation of the domain (without the precomputed twiddle factors)
+// to the provided writer
+func (d *Domain) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+
+	if err := binary.Write(w, binary.BigEndian, d.Cardinality); err != nil {
+		return written, err
+	}
+	written += 8
+
+	buf := d.Shift.Bytes()
+	n, err := w.Write(buf[:])
+	written += int64(n)
+
+	return written, err
+}
+
+// ReadFrom attempts to decode a domain from Reader
+func (d *Domain) ReadFrom(r io.Reader) (int64, error) {
+	var read int64
+
+	var cardinality uint64
+	if err := binary.Read(r, binary.BigEndian, &cardinality); err != nil {
+		return read, err
+	}
+	read += 8
+
+	var buf [gf.SizeOfGF128]byte
+	n, err := io.ReadFull(r, buf[:])
+	read += int64(n)
+	if err != nil {
+		return read, err
+	}
+
+	if bits.OnesCount64(cardinality) != 1 {
+		return read, ErrDomainSize
+	}
+	d.Cardinality = cardinality
+	if err = d.Shift.SetBytesCanonical(buf[:]); err != nil {
+		return read, err
+	}
+	d.preComputeTwiddles()
+
+	return read, nil
+}
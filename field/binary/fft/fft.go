@@ -0,0 +1,124 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package fft
+
+import (
+	"math/bits"
+
+	gf "github.com/consensys/gnark-crypto/field/binary"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// below this number of butterflies per round, the FFT runs on a single go routine
+const minParallelButterflies = 1 << 11
+
+// FFT replaces the coefficients a, in the novel polynomial basis, of a
+// polynomial by its evaluations on the domain, in the order of At.
+// len(a) must be the cardinality of the domain.
+func (d *Domain) FFT(a []gf.GF128, opts ...Option) {
+	if uint64(len(a)) != d.Cardinality {
+		panic("fft.FFT: len(a) must be the cardinality of the domain")
+	}
+	opt := fftOptions(opts...)
+
+	for i := len(d.twiddles) - 1; i >= 0; i-- {
+		round(a, i, d.twiddles[i], opt.nbTasks, butterfly)
+	}
+}
+
+// FFTInverse replaces the evaluations a of a polynomial on the domain, in the
+// order of At, by its coefficients in the novel polynomial basis.
+// len(a) must be the cardinality of the domain.
+func (d *Domain) FFTInverse(a []gf.GF128, opts ...Option) {
+	if uint64(len(a)) != d.Cardinality {
+		panic("fft.FFTInverse: len(a) must be the cardinality of the domain")
+	}
+	opt := fftOptions(opts...)
+
+	for i := range d.twiddles {
+		round(a, i, d.twiddles[i], opt.nbTasks, butterflyInverse)
+	}
+}
+
+// Encode sets codeword to the Reed–Solomon encoding of message: the
+// evaluations on the domain of the polynomial of coefficients message in the
+// novel polynomial basis.
+// len(message) must be a power of 2 at most the cardinality of the domain, and
+// len(codeword) the cardinality of the domain.
+func (d *Domain) Encode(codeword, message []gf.GF128, opts ...Option) {
+	if uint64(len(codeword)) != d.Cardinality {
+		panic("fft.Encode: len(codeword) must be the cardinality of the domain")
+	}
+	if bits.OnesCount(uint(len(message))) != 1 || len(message) > len(codeword) {
+		panic("fft.Encode: len(message) must be a power of 2 at most the cardinality of the domain")
+	}
+	opt := fftOptions(opts...)
+
+	// the rounds on the zero coefficients of degree ≥ len(message) copy the
+	// message in each block
+	for i := 0; i < len(codeword); i += len(message) {
+		copy(codeword[i:], message)
+	}
+	for i := bits.TrailingZeros(uint(len(message))) - 1; i >= 0; i-- {
+		round(codeword, i, d.twiddles[i], opt.nbTasks, butterfly)
+	}
+}
+
+// Evaluate returns the value at x of the polynomial of coefficients a in the
+// novel polynomial basis of the domain.
+// len(a) must be the cardinality of the domain.
+func (d *Domain) Evaluate(a []gf.GF128, x *gf.GF128) gf.GF128 {
+	if uint64(len(a)) != d.Cardinality {
+		panic("fft.Evaluate: len(a) must be the cardinality of the domain")
+	}
+	w := subspacePolynomials(len(d.twiddles), *x)[0]
+
+	// Xⱼ(x) = Ŵᵢ(x)·Xⱼ₋₂ᵢ(x) where 2ⁱ is the most significant bit of j
+	basis := make(gf.Vector, len(a))
+	basis[0].SetOne()
+	for i := range w {
+		half := 1 << i
+		for j := 0; j < half; j++ {
+			basis[half+j].Mul(&basis[j], &w[i])
+		}
+	}
+	return basis.InnerProduct(a)
+}
+
+// butterfly sets (u, v) = (u + v·w, v + u + v·w)
+func butterfly(u, v, w *gf.GF128) {
+	var t gf.GF128
+	t.Mul(v, w)
+	u.Add(u, &t)
+	v.Add(v, u)
+}
+
+// butterflyInverse sets (u, v) = (u + (u + v)·w, u + v), the inverse of butterfly
+func butterflyInverse(u, v, w *gf.GF128) {
+	var t gf.GF128
+	v.Add(v, u)
+	t.Mul(v, w)
+	u.Add(u, &t)
+}
+
+// round applies the butterflies (a[p], a[p + 2ⁱ]) with twiddles[b] to the
+// consecutive blocks b of size 2ⁱ⁺¹ of a
+func round(a []gf.GF128, i int, twiddles []gf.GF128, maxTasks int, butterfly func(u, v, w *gf.GF128)) {
+	half := 1 << i
+	nbButterflies := len(a) / 2
+	parallel.Execute(nbButterflies, func(start, end int) {
+		for k := start; k < end; k++ {
+			b := k >> i
+			p := b<<(i+1) | k&(half-1)
+			butterfly(&a[p], &a[p+half], &twiddles[b])
+		}
+	}, nbTasks(nbButterflies, maxTasks))
+}
+
+func nbTasks(nbIterations, maxTasks int) int {
+	if nbIterations < minParallelButterflies {
+		return 1
+	}
+	return maxTasks
+}
@@ -0,0 +1,153 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package fft
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	gf "github.com/consensys/gnark-crypto/field/binary"
+	"github.com/stretchr/testify/require"
+)
+
+func randomVector(n int) gf.Vector {
+	res := make(gf.Vector, n)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+func TestFFT(t *testing.T) {
+	var shift gf.GF128
+	shift.SetRandom()
+	for _, logN := range []uint64{0, 1, 2, 3, 6, 11} {
+		n := uint64(1) << logN
+		for name, domain := range map[string]*Domain{
+			"subspace": NewDomain(n),
+			"shifted":  NewDomain(n, WithShift(shift)),
+		} {
+			t.Run(fmt.Sprintf("%s/n=%d", name, n), func(t *testing.T) {
+				assert := require.New(t)
+
+				coefficients := randomVector(int(n))
+				evaluations := make(gf.Vector, n)
+				copy(evaluations, coefficients)
+				domain.FFT(evaluations)
+				for i := uint64(0); i < n; i += 1 + n/16 {
+					p := domain.At(i)
+					assert.Equal(domain.Evaluate(coefficients, &p), evaluations[i], "point %d", i)
+				}
+
+				domain.FFTInverse(evaluations, WithNbTasks(2))
+				assert.Equal(coefficients, evaluations)
+			})
+		}
+	}
+}
+
+func TestFFTBasis(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 64
+	domain := NewDomain(n)
+
+	// X₂ᵢ = Ŵᵢ vanishes on span(β₀, …, βᵢ₋₁) = [0, 2ⁱ) and Ŵᵢ(βᵢ) = 1
+	for i := 0; 1<<i < n; i++ {
+		a := make(gf.Vector, n)
+		a[1<<i].SetOne()
+		domain.FFT(a)
+		for j := 0; j < 1<<i; j++ {
+			assert.True(a[j].IsZero())
+		}
+		assert.True(a[1<<i].IsOne())
+	}
+
+	// X₀ = 1
+	a := make(gf.Vector, n)
+	a[0].SetOne()
+	domain.FFT(a)
+	for j := range a {
+		assert.True(a[j].IsOne())
+	}
+}
+
+func TestEncode(t *testing.T) {
+	assert := require.New(t)
+
+	var shift gf.GF128
+	shift.SetRandom()
+	const n, blowup = 32, 8
+	small, large := NewDomain(n, WithShift(shift)), NewDomain(n*blowup, WithShift(shift))
+
+	message := randomVector(n)
+	codeword := make(gf.Vector, n*blowup)
+	large.Encode(codeword, message)
+
+	// the encoding is the FFT of the message padded with zeros
+	expected := make(gf.Vector, n*blowup)
+	copy(expected, message)
+	large.FFT(expected)
+	assert.Equal(expected, codeword)
+
+	// the small domain is the beginning of the large one
+	copy(expected, message)
+	small.FFT(expected[:n])
+	assert.Equal(expected[:n], codeword[:n])
+
+	// the codeword has degree < n
+	large.FFTInverse(codeword)
+	assert.Equal(message, codeword[:n])
+	for _, c := range codeword[n:] {
+		assert.True(c.IsZero())
+	}
+
+	assert.Panics(func() { large.Encode(codeword, message[:3]) })
+	assert.Panics(func() { small.Encode(codeword[:n], codeword) })
+}
+
+func TestDomainSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	var shift gf.GF128
+	shift.SetRandom()
+	domain := NewDomain(1<<8, WithShift(shift))
+
+	var buf bytes.Buffer
+	written, err := domain.WriteTo(&buf)
+	assert.NoError(err)
+	encoded := append([]byte{}, buf.Bytes()...)
+
+	var reconstructed Domain
+	read, err := reconstructed.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(domain, &reconstructed)
+
+	// cardinality not a power of 2
+	encoded[7] ^= 1
+	_, err = new(Domain).ReadFrom(bytes.NewReader(encoded))
+	assert.ErrorIs(err, ErrDomainSize)
+
+	assert.Equal(uint64(8), NewDomain(5).Cardinality)
+	assert.PanicsWithValue(ErrDomainSize, func() { NewDomain(1<<63 + 1) })
+}
+
+func BenchmarkFFT(b *testing.B) {
+	const n = 1 << 16
+	domain := NewDomain(n)
+	a := randomVector(n)
+
+	b.Run("FFT", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			domain.FFT(a)
+		}
+	})
+	b.Run("FFTInverse", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			domain.FFTInverse(a)
+		}
+	})
+}
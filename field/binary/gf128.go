@@ -0,0 +1,181 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// SizeOfGF128 is the number of bytes of a GF128 element
+const SizeOfGF128 = 16
+
+// GF128 is an element of GF(2¹²⁸) = τ₇ = GF64[X₆]/(X₆² + X₅·X₆ + 1) in the tower
+// basis, stored as two words from the least significant: a₀ + a₁·X₆ is {a₀, a₁}.
+type GF128 [2]uint64
+
+// SetZero z = 0
+func (z *GF128) SetZero() *GF128 {
+	*z = GF128{}
+	return z
+}
+
+// SetOne z = 1
+func (z *GF128) SetOne() *GF128 {
+	*z = GF128{1, 0}
+	return z
+}
+
+// Set z = x
+func (z *GF128) Set(x *GF128) *GF128 {
+	*z = *x
+	return z
+}
+
+// SetGF64 sets z to x ∈ GF64 ⊂ GF128
+func (z *GF128) SetGF64(x *GF64) *GF128 {
+	*z = GF128{uint64(*x), 0}
+	return z
+}
+
+// Equal returns z == x
+func (z *GF128) Equal(x *GF128) bool {
+	return *z == *x
+}
+
+// IsZero returns z == 0
+func (z *GF128) IsZero() bool {
+	return z[0]|z[1] == 0
+}
+
+// IsOne returns z == 1
+func (z *GF128) IsOne() bool {
+	return z[0] == 1 && z[1] == 0
+}
+
+// SetRandom sets z to a uniform random value.
+//
+// This might error only if reading from crypto/rand.Reader errors,
+// in which case, value of z is undefined.
+func (z *GF128) SetRandom() (*GF128, error) {
+	var b [SizeOfGF128]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		return nil, err
+	}
+	return z, z.SetBytesCanonical(b[:])
+}
+
+// Add z = x + y, a XOR in characteristic 2
+func (z *GF128) Add(x, y *GF128) *GF128 {
+	z[0] = x[0] ^ y[0]
+	z[1] = x[1] ^ y[1]
+	return z
+}
+
+// Sub z = x - y = x + y
+func (z *GF128) Sub(x, y *GF128) *GF128 {
+	return z.Add(x, y)
+}
+
+// Neg z = -x = x
+func (z *GF128) Neg(x *GF128) *GF128 {
+	*z = *x
+	return z
+}
+
+// Mul z = x·y
+func (z *GF128) Mul(x, y *GF128) *GF128 {
+	*z = mul128((*[2]uint64)(x), (*[2]uint64)(y))
+	return z
+}
+
+// MulByGF8 z = x·y with y ∈ GF8, coordinate-wise in the tower basis
+func (z *GF128) MulByGF8(x *GF128, y *GF8) *GF128 {
+	z[0] = mulBy8(x[0], uint8(*y))
+	z[1] = mulBy8(x[1], uint8(*y))
+	return z
+}
+
+// MulByGF64 z = x·y with y ∈ GF64, coordinate-wise in the tower basis
+func (z *GF128) MulByGF64(x *GF128, y *GF64) *GF128 {
+	z[0] = mul64(x[0], uint64(*y))
+	z[1] = mul64(x[1], uint64(*y))
+	return z
+}
+
+// Square z = x²
+func (z *GF128) Square(x *GF128) *GF128 {
+	*z = square128((*[2]uint64)(x))
+	return z
+}
+
+// Inverse z = x⁻¹, or 0 if x = 0
+func (z *GF128) Inverse(x *GF128) *GF128 {
+	*z = inv128((*[2]uint64)(x))
+	return z
+}
+
+// Div z = x/y
+func (z *GF128) Div(x, y *GF128) *GF128 {
+	var yInv GF128
+	yInv.Inverse(y)
+	return z.Mul(x, &yInv)
+}
+
+// Exp z = xᵏ
+func (z *GF128) Exp(x GF128, k *big.Int) *GF128 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = new(big.Int).Neg(k)
+	}
+
+	z.Set(&x)
+
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// String returns the hexadecimal representation of z in the tower basis
+func (z *GF128) String() string {
+	if z[1] == 0 {
+		return fmt.Sprintf("%#x", z[0])
+	}
+	return fmt.Sprintf("%#x%016x", z[1], z[0])
+}
+
+// Bytes returns the value of z as a big-endian byte array
+func (z *GF128) Bytes() (res [SizeOfGF128]byte) {
+	binary.BigEndian.PutUint64(res[:8], z[1])
+	binary.BigEndian.PutUint64(res[8:], z[0])
+	return
+}
+
+// SetBytesCanonical sets z to the big-endian value encoded in e.
+// If e is not a 16-byte slice, SetBytesCanonical returns an error.
+func (z *GF128) SetBytesCanonical(e []byte) error {
+	if len(e) != SizeOfGF128 {
+		return fmt.Errorf("%w: expected 16 bytes for GF128", ErrInvalidEncoding)
+	}
+	z[1] = binary.BigEndian.Uint64(e[:8])
+	z[0] = binary.BigEndian.Uint64(e[8:])
+	return nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// SizeOfGF16 is the number of bytes of a GF16 element
+const SizeOfGF16 = 2
+
+// GF16 is an element of GF(2¹⁶) = τ₄ = GF8[X₃]/(X₃² + X₂·X₃ + 1) in the tower basis.
+type GF16 uint16
+
+// SetZero z = 0
+func (z *GF16) SetZero() *GF16 {
+	*z = 0
+	return z
+}
+
+// SetOne z = 1
+func (z *GF16) SetOne() *GF16 {
+	*z = 1
+	return z
+}
+
+// Set z = x
+func (z *GF16) Set(x *GF16) *GF16 {
+	*z = *x
+	return z
+}
+
+// SetGF8 sets z to x ∈ GF8 ⊂ GF16
+func (z *GF16) SetGF8(x *GF8) *GF16 {
+	*z = GF16(*x)
+	return z
+}
+
+// Equal returns z == x
+func (z *GF16) Equal(x *GF16) bool {
+	return *z == *x
+}
+
+// IsZero returns z == 0
+func (z *GF16) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns z == 1
+func (z *GF16) IsOne() bool {
+	return *z == 1
+}
+
+// SetRandom sets z to a uniform random value.
+//
+// This might error only if reading from crypto/rand.Reader errors,
+// in which case, value of z is undefined.
+func (z *GF16) SetRandom() (*GF16, error) {
+	var b [SizeOfGF16]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		return nil, err
+	}
+	*z = GF16(binary.BigEndian.Uint16(b[:]))
+	return z, nil
+}
+
+// Add z = x + y, a XOR in characteristic 2
+func (z *GF16) Add(x, y *GF16) *GF16 {
+	*z = *x ^ *y
+	return z
+}
+
+// Sub z = x - y = x + y
+func (z *GF16) Sub(x, y *GF16) *GF16 {
+	*z = *x ^ *y
+	return z
+}
+
+// Neg z = -x = x
+func (z *GF16) Neg(x *GF16) *GF16 {
+	*z = *x
+	return z
+}
+
+// Mul z = x·y
+func (z *GF16) Mul(x, y *GF16) *GF16 {
+	*z = GF16(mul16(uint16(*x), uint16(*y)))
+	return z
+}
+
+// MulByGF8 z = x·y with y ∈ GF8, coordinate-wise in the tower basis
+func (z *GF16) MulByGF8(x *GF16, y *GF8) *GF16 {
+	*z = GF16(mulBy8(uint64(*x), uint8(*y)))
+	return z
+}
+
+// Square z = x²
+func (z *GF16) Square(x *GF16) *GF16 {
+	*z = GF16(mul16(uint16(*x), uint16(*x)))
+	return z
+}
+
+// Inverse z = x⁻¹, or 0 if x = 0
+func (z *GF16) Inverse(x *GF16) *GF16 {
+	*z = GF16(inv16(uint16(*x)))
+	return z
+}
+
+// Div z = x/y
+func (z *GF16) Div(x, y *GF16) *GF16 {
+	var yInv GF16
+	yInv.Inverse(y)
+	return z.Mul(x, &yInv)
+}
+
+// Exp z = xᵏ
+func (z *GF16) Exp(x GF16, k *big.Int) *GF16 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = new(big.Int).Neg(k)
+	}
+
+	z.Set(&x)
+
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// String returns the hexadecimal representation of z in the tower basis
+func (z *GF16) String() string {
+	return fmt.Sprintf("%#x", uint16(*z))
+}
+
+// Bytes returns the value of z as a big-endian byte array
+func (z *GF16) Bytes() (res [SizeOfGF16]byte) {
+	binary.BigEndian.PutUint16(res[:], uint16(*z))
+	return
+}
+
+// SetBytesCanonical sets z to the big-endian value encoded in e.
+// If e is not a 2-byte slice, SetBytesCanonical returns an error.
+func (z *GF16) SetBytesCanonical(e []byte) error {
+	if len(e) != SizeOfGF16 {
+		return fmt.Errorf("%w: expected 2 bytes for GF16", ErrInvalidEncoding)
+	}
+	*z = GF16(binary.BigEndian.Uint16(e))
+	return nil
+}
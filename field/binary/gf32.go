@@ -0,0 +1,168 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// SizeOfGF32 is the number of bytes of a GF32 element
+const SizeOfGF32 = 4
+
+// GF32 is an element of GF(2³²) = τ₅ = GF16[X₄]/(X₄² + X₃·X₄ + 1) in the tower basis.
+type GF32 uint32
+
+// SetZero z = 0
+func (z *GF32) SetZero() *GF32 {
+	*z = 0
+	return z
+}
+
+// SetOne z = 1
+func (z *GF32) SetOne() *GF32 {
+	*z = 1
+	return z
+}
+
+// Set z = x
+func (z *GF32) Set(x *GF32) *GF32 {
+	*z = *x
+	return z
+}
+
+// SetGF16 sets z to x ∈ GF16 ⊂ GF32
+func (z *GF32) SetGF16(x *GF16) *GF32 {
+	*z = GF32(*x)
+	return z
+}
+
+// Equal returns z == x
+func (z *GF32) Equal(x *GF32) bool {
+	return *z == *x
+}
+
+// IsZero returns z == 0
+func (z *GF32) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns z == 1
+func (z *GF32) IsOne() bool {
+	return *z == 1
+}
+
+// SetRandom sets z to a uniform random value.
+//
+// This might error only if reading from crypto/rand.Reader errors,
+// in which case, value of z is undefined.
+func (z *GF32) SetRandom() (*GF32, error) {
+	var b [SizeOfGF32]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		return nil, err
+	}
+	*z = GF32(binary.BigEndian.Uint32(b[:]))
+	return z, nil
+}
+
+// Add z = x + y, a XOR in characteristic 2
+func (z *GF32) Add(x, y *GF32) *GF32 {
+	*z = *x ^ *y
+	return z
+}
+
+// Sub z = x - y = x + y
+func (z *GF32) Sub(x, y *GF32) *GF32 {
+	*z = *x ^ *y
+	return z
+}
+
+// Neg z = -x = x
+func (z *GF32) Neg(x *GF32) *GF32 {
+	*z = *x
+	return z
+}
+
+// Mul z = x·y
+func (z *GF32) Mul(x, y *GF32) *GF32 {
+	*z = GF32(mul32(uint32(*x), uint32(*y)))
+	return z
+}
+
+// MulByGF8 z = x·y with y ∈ GF8, coordinate-wise in the tower basis
+func (z *GF32) MulByGF8(x *GF32, y *GF8) *GF32 {
+	*z = GF32(mulBy8(uint64(*x), uint8(*y)))
+	return z
+}
+
+// Square z = x²
+func (z *GF32) Square(x *GF32) *GF32 {
+	*z = GF32(mul32(uint32(*x), uint32(*x)))
+	return z
+}
+
+// Inverse z = x⁻¹, or 0 if x = 0
+func (z *GF32) Inverse(x *GF32) *GF32 {
+	*z = GF32(inv32(uint32(*x)))
+	return z
+}
+
+// Div z = x/y
+func (z *GF32) Div(x, y *GF32) *GF32 {
+	var yInv GF32
+	yInv.Inverse(y)
+	return z.Mul(x, &yInv)
+}
+
+// Exp z = xᵏ
+func (z *GF32) Exp(x GF32, k *big.Int) *GF32 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = new(big.Int).Neg(k)
+	}
+
+	z.Set(&x)
+
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// String returns the hexadecimal representation of z in the tower basis
+func (z *GF32) String() string {
+	return fmt.Sprintf("%#x", uint32(*z))
+}
+
+// Bytes returns the value of z as a big-endian byte array
+func (z *GF32) Bytes() (res [SizeOfGF32]byte) {
+	binary.BigEndian.PutUint32(res[:], uint32(*z))
+	return
+}
+
+// SetBytesCanonical sets z to the big-endian value encoded in e.
+// If e is not a 4-byte slice, SetBytesCanonical returns an error.
+func (z *GF32) SetBytesCanonical(e []byte) error {
+	if len(e) != SizeOfGF32 {
+		return fmt.Errorf("%w: expected 4 bytes for GF32", ErrInvalidEncoding)
+	}
+	*z = GF32(binary.BigEndian.Uint32(e))
+	return nil
+}
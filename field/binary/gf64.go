@@ -0,0 +1,168 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// SizeOfGF64 is the number of bytes of a GF64 element
+const SizeOfGF64 = 8
+
+// GF64 is an element of GF(2⁶⁴) = τ₆ = GF32[X₅]/(X₅² + X₄·X₅ + 1) in the tower basis.
+type GF64 uint64
+
+// SetZero z = 0
+func (z *GF64) SetZero() *GF64 {
+	*z = 0
+	return z
+}
+
+// SetOne z = 1
+func (z *GF64) SetOne() *GF64 {
+	*z = 1
+	return z
+}
+
+// Set z = x
+func (z *GF64) Set(x *GF64) *GF64 {
+	*z = *x
+	return z
+}
+
+// SetGF32 sets z to x ∈ GF32 ⊂ GF64
+func (z *GF64) SetGF32(x *GF32) *GF64 {
+	*z = GF64(*x)
+	return z
+}
+
+// Equal returns z == x
+func (z *GF64) Equal(x *GF64) bool {
+	return *z == *x
+}
+
+// IsZero returns z == 0
+func (z *GF64) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns z == 1
+func (z *GF64) IsOne() bool {
+	return *z == 1
+}
+
+// SetRandom sets z to a uniform random value.
+//
+// This might error only if reading from crypto/rand.Reader errors,
+// in which case, value of z is undefined.
+func (z *GF64) SetRandom() (*GF64, error) {
+	var b [SizeOfGF64]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		return nil, err
+	}
+	*z = GF64(binary.BigEndian.Uint64(b[:]))
+	return z, nil
+}
+
+// Add z = x + y, a XOR in characteristic 2
+func (z *GF64) Add(x, y *GF64) *GF64 {
+	*z = *x ^ *y
+	return z
+}
+
+// Sub z = x - y = x + y
+func (z *GF64) Sub(x, y *GF64) *GF64 {
+	*z = *x ^ *y
+	return z
+}
+
+// Neg z = -x = x
+func (z *GF64) Neg(x *GF64) *GF64 {
+	*z = *x
+	return z
+}
+
+// Mul z = x·y
+func (z *GF64) Mul(x, y *GF64) *GF64 {
+	*z = GF64(mul64(uint64(*x), uint64(*y)))
+	return z
+}
+
+// MulByGF8 z = x·y with y ∈ GF8, coordinate-wise in the tower basis
+func (z *GF64) MulByGF8(x *GF64, y *GF8) *GF64 {
+	*z = GF64(mulBy8(uint64(*x), uint8(*y)))
+	return z
+}
+
+// Square z = x²
+func (z *GF64) Square(x *GF64) *GF64 {
+	*z = GF64(square64(uint64(*x)))
+	return z
+}
+
+// Inverse z = x⁻¹, or 0 if x = 0
+func (z *GF64) Inverse(x *GF64) *GF64 {
+	*z = GF64(inv64(uint64(*x)))
+	return z
+}
+
+// Div z = x/y
+func (z *GF64) Div(x, y *GF64) *GF64 {
+	var yInv GF64
+	yInv.Inverse(y)
+	return z.Mul(x, &yInv)
+}
+
+// Exp z = xᵏ
+func (z *GF64) Exp(x GF64, k *big.Int) *GF64 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = new(big.Int).Neg(k)
+	}
+
+	z.Set(&x)
+
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// String returns the hexadecimal representation of z in the tower basis
+func (z *GF64) String() string {
+	return fmt.Sprintf("%#x", uint64(*z))
+}
+
+// Bytes returns the value of z as a big-endian byte array
+func (z *GF64) Bytes() (res [SizeOfGF64]byte) {
+	binary.BigEndian.PutUint64(res[:], uint64(*z))
+	return
+}
+
+// SetBytesCanonical sets z to the big-endian value encoded in e.
+// If e is not a 8-byte slice, SetBytesCanonical returns an error.
+func (z *GF64) SetBytesCanonical(e []byte) error {
+	if len(e) != SizeOfGF64 {
+		return fmt.Errorf("%w: expected 8 bytes for GF64", ErrInvalidEncoding)
+	}
+	*z = GF64(binary.BigEndian.Uint64(e))
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// SizeOfGF8 is the number of bytes of a GF8 element
+const SizeOfGF8 = 1
+
+// GF8 is an element of GF(2⁸) = τ₃, the third level of the tower, in the tower
+// basis. Its multiplication uses precomputed tables.
+type GF8 uint8
+
+// SetZero z = 0
+func (z *GF8) SetZero() *GF8 {
+	*z = 0
+	return z
+}
+
+// SetOne z = 1
+func (z *GF8) SetOne() *GF8 {
+	*z = 1
+	return z
+}
+
+// Set z = x
+func (z *GF8) Set(x *GF8) *GF8 {
+	*z = *x
+	return z
+}
+
+// Equal returns z == x
+func (z *GF8) Equal(x *GF8) bool {
+	return *z == *x
+}
+
+// IsZero returns z == 0
+func (z *GF8) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns z == 1
+func (z *GF8) IsOne() bool {
+	return *z == 1
+}
+
+// SetRandom sets z to a uniform random value.
+//
+// This might error only if reading from crypto/rand.Reader errors,
+// in which case, value of z is undefined.
+func (z *GF8) SetRandom() (*GF8, error) {
+	var b [SizeOfGF8]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		return nil, err
+	}
+	*z = GF8(b[0])
+	return z, nil
+}
+
+// Add z = x + y, a XOR in characteristic 2
+func (z *GF8) Add(x, y *GF8) *GF8 {
+	*z = *x ^ *y
+	return z
+}
+
+// Sub z = x - y = x + y
+func (z *GF8) Sub(x, y *GF8) *GF8 {
+	*z = *x ^ *y
+	return z
+}
+
+// Neg z = -x = x
+func (z *GF8) Neg(x *GF8) *GF8 {
+	*z = *x
+	return z
+}
+
+// Mul z = x·y
+func (z *GF8) Mul(x, y *GF8) *GF8 {
+	*z = GF8(mul8(uint8(*x), uint8(*y)))
+	return z
+}
+
+// Square z = x²
+func (z *GF8) Square(x *GF8) *GF8 {
+	*z = GF8(mul8(uint8(*x), uint8(*x)))
+	return z
+}
+
+// Inverse z = x⁻¹, or 0 if x = 0
+func (z *GF8) Inverse(x *GF8) *GF8 {
+	*z = GF8(inv8(uint8(*x)))
+	return z
+}
+
+// Div z = x/y
+func (z *GF8) Div(x, y *GF8) *GF8 {
+	var yInv GF8
+	yInv.Inverse(y)
+	return z.Mul(x, &yInv)
+}
+
+// Exp z = xᵏ
+func (z *GF8) Exp(x GF8, k *big.Int) *GF8 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = new(big.Int).Neg(k)
+	}
+
+	z.Set(&x)
+
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// String returns the hexadecimal representation of z in the tower basis
+func (z *GF8) String() string {
+	return fmt.Sprintf("%#x", uint8(*z))
+}
+
+// Bytes returns the value of z as a big-endian byte array
+func (z *GF8) Bytes() (res [SizeOfGF8]byte) {
+	res[0] = uint8(*z)
+	return
+}
+
+// SetBytesCanonical sets z to the big-endian value encoded in e.
+// If e is not a 1-byte slice, SetBytesCanonical returns an error.
+func (z *GF8) SetBytesCanonical(e []byte) error {
+	if len(e) != SizeOfGF8 {
+		return fmt.Errorf("%w: expected 1 byte for GF8", ErrInvalidEncoding)
+	}
+	*z = GF8(e[0])
+	return nil
+}
@@ -0,0 +1,336 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	nbFuzzShort = 200
+	nbFuzz      = 1000
+)
+
+func genGF128() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		g := GF128{genParams.NextUint64(), genParams.NextUint64()}
+		return gopter.NewGenResult(g, gopter.NoShrinker)
+	}
+}
+
+func testParameters() *gopter.TestParameters {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	return parameters
+}
+
+// towerLevels gives the multiplication and inversion of each level of the tower
+// on uint64, for the tests common to all the levels
+var towerLevels = []struct {
+	name    string
+	k       int
+	mul     func(x, y uint64) uint64
+	inverse func(x uint64) uint64
+}{
+	{"GF8", 3,
+		func(x, y uint64) uint64 { a, b := GF8(x), GF8(y); return uint64(*a.Mul(&a, &b)) },
+		func(x uint64) uint64 { a := GF8(x); return uint64(*a.Inverse(&a)) }},
+	{"GF16", 4,
+		func(x, y uint64) uint64 { a, b := GF16(x), GF16(y); return uint64(*a.Mul(&a, &b)) },
+		func(x uint64) uint64 { a := GF16(x); return uint64(*a.Inverse(&a)) }},
+	{"GF32", 5,
+		func(x, y uint64) uint64 { a, b := GF32(x), GF32(y); return uint64(*a.Mul(&a, &b)) },
+		func(x uint64) uint64 { a := GF32(x); return uint64(*a.Inverse(&a)) }},
+	{"GF64", 6,
+		func(x, y uint64) uint64 { a, b := GF64(x), GF64(y); return uint64(*a.Mul(&a, &b)) },
+		func(x uint64) uint64 { a := GF64(x); return uint64(*a.Inverse(&a)) }},
+}
+
+func TestTowerMul(t *testing.T) {
+	t.Parallel()
+
+	for _, level := range towerLevels {
+		mask := uint64(1)<<(1<<level.k) - 1
+		if level.k == 6 {
+			mask = ^uint64(0)
+		}
+		properties := gopter.NewProperties(testParameters())
+		properties.Property(level.name+": Mul must match the recursive definition of the tower", prop.ForAll(
+			func(x, y uint64) bool {
+				x, y = x&mask, y&mask
+				return level.mul(x, y) == mulTower(x, y, level.k)
+			},
+			gopter.Gen(func(p *gopter.GenParameters) *gopter.GenResult {
+				return gopter.NewGenResult(p.NextUint64(), gopter.NoShrinker)
+			}),
+			gopter.Gen(func(p *gopter.GenParameters) *gopter.GenResult {
+				return gopter.NewGenResult(p.NextUint64(), gopter.NoShrinker)
+			}),
+		))
+		properties.Property(level.name+": x·x⁻¹ == 1", prop.ForAll(
+			func(x uint64) bool {
+				x &= mask
+				if x == 0 {
+					return level.inverse(x) == 0
+				}
+				return level.mul(x, level.inverse(x)) == 1
+			},
+			gopter.Gen(func(p *gopter.GenParameters) *gopter.GenResult {
+				return gopter.NewGenResult(p.NextUint64(), gopter.NoShrinker)
+			}),
+		))
+		properties.TestingRun(t, gopter.ConsoleReporter(false))
+	}
+
+	// GF8 exhaustively, against the recursive definition on 8 bits
+	for x := 0; x < 256; x++ {
+		for y := 0; y < 256; y++ {
+			require.Equal(t, mulTower(uint64(x), uint64(y), 3), uint64(mul8(uint8(x), uint8(y))))
+		}
+	}
+}
+
+func TestGF128(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties(testParameters())
+
+	properties.Property("Mul must match the Karatsuba formula of the tower", prop.ForAll(
+		func(a, b GF128) bool {
+			var c GF128
+			c.Mul(&a, &b)
+			return c == GF128(mulTower128((*[2]uint64)(&a), (*[2]uint64)(&b)))
+		},
+		genGF128(), genGF128(),
+	))
+
+	properties.Property("Mul is distributive and associative", prop.ForAll(
+		func(a, b, c GF128) bool {
+			var s, l, r, t GF128
+			s.Add(&b, &c)
+			l.Mul(&a, &s)
+			r.Mul(&a, &b)
+			t.Mul(&a, &c)
+			r.Add(&r, &t)
+			if l != r {
+				return false
+			}
+			l.Mul(&a, &b).Mul(&l, &c)
+			r.Mul(&b, &c).Mul(&a, &r)
+			return l == r
+		},
+		genGF128(), genGF128(), genGF128(),
+	))
+
+	properties.Property("Square(x) == Mul(x, x) and x·x⁻¹ == 1", prop.ForAll(
+		func(a GF128) bool {
+			var s, m, i GF128
+			s.Square(&a)
+			m.Mul(&a, &a)
+			i.Inverse(&a).Mul(&i, &a)
+			return s == m && (a.IsZero() || i.IsOne())
+		},
+		genGF128(),
+	))
+
+	properties.Property("Div(x, y)·y == x", prop.ForAll(
+		func(a, b GF128) bool {
+			if b.IsZero() {
+				return true
+			}
+			var c GF128
+			c.Div(&a, &b).Mul(&c, &b)
+			return c == a
+		},
+		genGF128(), genGF128(),
+	))
+
+	properties.Property("x^(2¹²⁸ - 1) == 1", prop.ForAll(
+		func(a GF128) bool {
+			if a.IsZero() {
+				return true
+			}
+			var e big.Int
+			e.Lsh(big.NewInt(1), 128).Sub(&e, big.NewInt(1))
+			a.Exp(a, &e)
+			return a.IsOne()
+		},
+		genGF128(),
+	))
+
+	properties.Property("Exp with a negative exponent inverts", prop.ForAll(
+		func(a GF128) bool {
+			var b, c GF128
+			b.Exp(a, big.NewInt(-3))
+			c.Square(&a).Mul(&c, &a).Inverse(&c)
+			return b == c
+		},
+		genGF128(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestSubfields(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties(testParameters())
+
+	properties.Property("the embeddings of the subfields are multiplicative", prop.ForAll(
+		func(a, b GF128) bool {
+			x8, y8 := GF8(a[0]), GF8(b[0])
+			x16, y16 := GF16(a[0]), GF16(b[0])
+			x32, y32 := GF32(a[0]), GF32(b[0])
+			x64, y64 := GF64(a[0]), GF64(b[0])
+
+			var p8 GF8
+			var p16, l16, r16 GF16
+			var p32, l32, r32 GF32
+			var p64, l64, r64 GF64
+			var l128, r128 GF128
+
+			p8.Mul(&x8, &y8)
+			p16.Mul(&x16, &y16)
+			p32.Mul(&x32, &y32)
+			p64.Mul(&x64, &y64)
+
+			l16.SetGF8(&p8)
+			r16.SetGF8(&x8).Mul(&r16, new(GF16).SetGF8(&y8))
+			l32.SetGF16(&p16)
+			r32.SetGF16(&x16).Mul(&r32, new(GF32).SetGF16(&y16))
+			l64.SetGF32(&p32)
+			r64.SetGF32(&x32).Mul(&r64, new(GF64).SetGF32(&y32))
+			l128.SetGF64(&p64)
+			r128.SetGF64(&x64).Mul(&r128, new(GF128).SetGF64(&y64))
+
+			return l16 == r16 && l32 == r32 && l64 == r64 && l128 == r128
+		},
+		genGF128(), genGF128(),
+	))
+
+	properties.Property("MulByGF8 and MulByGF64 match Mul by the embedded element", prop.ForAll(
+		func(a, b GF128) bool {
+			y8 := GF8(b[0])
+			y64 := GF64(b[1])
+			var e, l, r GF128
+			e.SetGF64(new(GF64).SetGF32(new(GF32).SetGF16(new(GF16).SetGF8(&y8))))
+			l.MulByGF8(&a, &y8)
+			r.Mul(&a, &e)
+			if l != r {
+				return false
+			}
+			l.MulByGF64(&a, &y64)
+			r.Mul(&a, e.SetGF64(&y64))
+			if l != r {
+				return false
+			}
+
+			x32 := GF32(a[0])
+			var l32, r32 GF32
+			l32.MulByGF8(&x32, &y8)
+			r32.Mul(&x32, new(GF32).SetGF16(new(GF16).SetGF8(&y8)))
+			return l32 == r32
+		},
+		genGF128(), genGF128(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestIsomorphisms(t *testing.T) {
+	assert := require.New(t)
+
+	// the roots of x⁶⁴ + x⁴ + x³ + x + 1 and x¹²⁸ + x⁷ + x² + x + 1 in the tower
+	evaluate := func(x GF128, exponents ...int) GF128 {
+		var res GF128
+		for _, e := range exponents {
+			var p GF128
+			p.Exp(x, big.NewInt(int64(e)))
+			res.Add(&res, &p)
+		}
+		return res
+	}
+	r64 := GF64(root64)
+	var x64 GF128
+	x64.SetGF64(&r64)
+	assert.Equal(GF128{}, evaluate(x64, 64, 4, 3, 1, 0))
+	assert.Equal(GF128{}, evaluate(root128, 128, 7, 2, 1, 0))
+
+	// the changes of basis are inverse of each other
+	x := GF128{0x0123456789abcdef, 0xfedcba9876543210}
+	p := convert128(&toPoly128, (*[2]uint64)(&x))
+	assert.Equal([2]uint64(x), convert128(&toTower128, &p))
+	assert.Equal(x[0], convert64(&toTower64, convert64(&toPoly64, x[0])))
+}
+
+func TestBytes(t *testing.T) {
+	assert := require.New(t)
+
+	x := GF128{0x0123456789abcdef, 0xfedcba9876543210}
+	b := x.Bytes()
+	assert.Equal(byte(0xfe), b[0])
+	var y GF128
+	assert.NoError(y.SetBytesCanonical(b[:]))
+	assert.Equal(x, y)
+	assert.ErrorIs(y.SetBytesCanonical(b[:15]), ErrInvalidEncoding)
+	assert.Equal("0xfedcba98765432100123456789abcdef", x.String())
+
+	x16 := GF16(0xabcd)
+	b16 := x16.Bytes()
+	var y16 GF16
+	assert.NoError(y16.SetBytesCanonical(b16[:]))
+	assert.Equal(x16, y16)
+	assert.Equal("0xabcd", x16.String())
+
+	x8 := GF8(0x2a)
+	b8 := x8.Bytes()
+	var y8 GF8
+	assert.NoError(y8.SetBytesCanonical(b8[:]))
+	assert.Equal(x8, y8)
+	assert.ErrorIs(y8.SetBytesCanonical(nil), ErrInvalidEncoding)
+}
+
+func BenchmarkGF32Mul(b *testing.B) {
+	x, y := GF32(0x12345678), GF32(0x9abcdef0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkGF64Mul(b *testing.B) {
+	x, y := GF64(0x0123456789abcdef), GF64(0xfedcba9876543210)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkGF128Mul(b *testing.B) {
+	var x, y GF128
+	x.SetRandom()
+	y.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkGF128Inverse(b *testing.B) {
+	var x GF128
+	x.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import "math/bits"
+
+// GF64 and GF128 are multiplied in the isomorphic fields in the polynomial basis
+//
+//	GF(2)[x]/(x⁶⁴ + x⁴ + x³ + x + 1) and GF(2)[x]/(x¹²⁸ + x⁷ + x² + x + 1)
+//
+// where the product is a carry-less multiplication followed by a sparse
+// reduction. The isomorphisms send x to the following roots of these
+// polynomials in the tower; the changes of basis are GF(2)-linear and are
+// applied byte by byte with precomputed tables.
+var (
+	root64  = uint64(0x5b65d4f60d630d1a)
+	root128 = [2]uint64{0x83d8c2c50ebd742a, 0x8645417677574bad}
+)
+
+var (
+	// toPoly64[i][b] is the image in the polynomial basis of the byte b at
+	// position i of an element in the tower basis, and toTower64 the converse
+	toPoly64, toTower64 [8][256]uint64
+
+	// same for GF128, on 16 bytes
+	toPoly128, toTower128 [16][256][2]uint64
+)
+
+func initIsomorphisms() {
+	// the columns of the change of basis to the tower are the powers of the root
+	var columns64 [64][2]uint64
+	columns64[0][0] = 1
+	for j := 1; j < 64; j++ {
+		columns64[j][0] = mulTower64(columns64[j-1][0], root64)
+	}
+	toTower, toPoly := basisTables(columns64[:])
+	for i := range toTower64 {
+		for b := range toTower64[i] {
+			toTower64[i][b] = toTower[i][b][0]
+			toPoly64[i][b] = toPoly[i][b][0]
+		}
+	}
+
+	var columns128 [128][2]uint64
+	columns128[0][0] = 1
+	for j := 1; j < 128; j++ {
+		columns128[j] = mulTower128(&columns128[j-1], &root128)
+	}
+	toTower, toPoly = basisTables(columns128[:])
+	copy(toTower128[:], toTower)
+	copy(toPoly128[:], toPoly)
+}
+
+// basisTables returns the byte tables of the change of basis whose columns are
+// given, and of its inverse.
+func basisTables(columns [][2]uint64) (direct, inverse [][256][2]uint64) {
+	n := len(columns)
+
+	// Gauss-Jordan elimination on the pairs (M·eⱼ, eⱼ), until M·eⱼ = eⱼ
+	images := make([][2]uint64, n)
+	preimages := make([][2]uint64, n)
+	copy(images, columns)
+	for j := range preimages {
+		preimages[j][j/64] = 1 << (j % 64)
+	}
+	for i := 0; i < n; i++ {
+		pivot := i
+		for pivot < n && (images[pivot][i/64]>>(i%64))&1 == 0 {
+			pivot++
+		}
+		if pivot == n {
+			panic("binary: the change of basis is not invertible")
+		}
+		images[i], images[pivot] = images[pivot], images[i]
+		preimages[i], preimages[pivot] = preimages[pivot], preimages[i]
+		for j := 0; j < n; j++ {
+			if j != i && (images[j][i/64]>>(i%64))&1 == 1 {
+				images[j][0] ^= images[i][0]
+				images[j][1] ^= images[i][1]
+				preimages[j][0] ^= preimages[i][0]
+				preimages[j][1] ^= preimages[i][1]
+			}
+		}
+	}
+
+	return byteTables(columns), byteTables(preimages)
+}
+
+// byteTables returns the tables t[i][b] = ∑ bₖ·columns[8i+k] of a linear map
+func byteTables(columns [][2]uint64) [][256][2]uint64 {
+	t := make([][256][2]uint64, len(columns)/8)
+	for i := range t {
+		for b := 1; b < 256; b++ {
+			c := columns[8*i+bits.TrailingZeros(uint(b))]
+			t[i][b][0] = t[i][b&(b-1)][0] ^ c[0]
+			t[i][b][1] = t[i][b&(b-1)][1] ^ c[1]
+		}
+	}
+	return t
+}
+
+// convert64 applies the change of basis given by its byte tables to x
+func convert64(table *[8][256]uint64, x uint64) uint64 {
+	// unrolled, as the loop is twice slower
+	return table[0][uint8(x)] ^ table[1][uint8(x>>8)] ^
+		table[2][uint8(x>>16)] ^ table[3][uint8(x>>24)] ^
+		table[4][uint8(x>>32)] ^ table[5][uint8(x>>40)] ^
+		table[6][uint8(x>>48)] ^ table[7][uint8(x>>56)]
+}
+
+// convert128 applies the change of basis given by its byte tables to x
+func convert128(table *[16][256][2]uint64, x *[2]uint64) [2]uint64 {
+	// unrolled, as the loop is twice slower
+	x0, x1 := x[0], x[1]
+	t0, t1 := &table[0][uint8(x0)], &table[1][uint8(x0>>8)]
+	t2, t3 := &table[2][uint8(x0>>16)], &table[3][uint8(x0>>24)]
+	t4, t5 := &table[4][uint8(x0>>32)], &table[5][uint8(x0>>40)]
+	t6, t7 := &table[6][uint8(x0>>48)], &table[7][uint8(x0>>56)]
+	t8, t9 := &table[8][uint8(x1)], &table[9][uint8(x1>>8)]
+	t10, t11 := &table[10][uint8(x1>>16)], &table[11][uint8(x1>>24)]
+	t12, t13 := &table[12][uint8(x1>>32)], &table[13][uint8(x1>>40)]
+	t14, t15 := &table[14][uint8(x1>>48)], &table[15][uint8(x1>>56)]
+	return [2]uint64{
+		t0[0] ^ t1[0] ^ t2[0] ^ t3[0] ^ t4[0] ^ t5[0] ^ t6[0] ^ t7[0] ^
+			t8[0] ^ t9[0] ^ t10[0] ^ t11[0] ^ t12[0] ^ t13[0] ^ t14[0] ^ t15[0],
+		t0[1] ^ t1[1] ^ t2[1] ^ t3[1] ^ t4[1] ^ t5[1] ^ t6[1] ^ t7[1] ^
+			t8[1] ^ t9[1] ^ t10[1] ^ t11[1] ^ t12[1] ^ t13[1] ^ t14[1] ^ t15[1],
+	}
+}
+
+// reduce64 returns hi·x⁶⁴ + lo mod x⁶⁴ + x⁴ + x³ + x + 1
+func reduce64(lo, hi uint64) uint64 {
+	// x⁶⁴ = x⁴ + x³ + x + 1, and the bits shifted out are reduced once more
+	overflow := hi>>63 ^ hi>>61 ^ hi>>60
+	hi ^= overflow
+	return lo ^ hi ^ hi<<1 ^ hi<<3 ^ hi<<4
+}
+
+// reduce128 returns c mod x¹²⁸ + x⁷ + x² + x + 1, with c given by its 4 words
+// from the least significant
+func reduce128(c *[4]uint64) [2]uint64 {
+	// x¹²⁸ = x⁷ + x² + x + 1, and the bits shifted out are reduced once more
+	h0, h1 := c[2], c[3]
+	overflow := h1>>63 ^ h1>>62 ^ h1>>57
+	h0 ^= overflow
+	return [2]uint64{
+		c[0] ^ h0 ^ h0<<1 ^ h0<<2 ^ h0<<7,
+		c[1] ^ h1 ^ (h1<<1 | h0>>63) ^ (h1<<2 | h0>>62) ^ (h1<<7 | h0>>57),
+	}
+}
+
+func mul64(x, y uint64) uint64 {
+	lo, hi := clmul64(convert64(&toPoly64, x), convert64(&toPoly64, y))
+	return convert64(&toTower64, reduce64(lo, hi))
+}
+
+func square64(x uint64) uint64 {
+	a := convert64(&toPoly64, x)
+	lo, hi := clmul64(a, a)
+	return convert64(&toTower64, reduce64(lo, hi))
+}
+
+func mul128(x, y *[2]uint64) [2]uint64 {
+	a, b := convert128(&toPoly128, x), convert128(&toPoly128, y)
+	var c [4]uint64
+	clmul128(&c, &a, &b)
+	r := reduce128(&c)
+	return convert128(&toTower128, &r)
+}
+
+func square128(x *[2]uint64) [2]uint64 {
+	a := convert128(&toPoly128, x)
+	var c [4]uint64
+	clmul128(&c, &a, &a)
+	r := reduce128(&c)
+	return convert128(&toTower128, &r)
+}
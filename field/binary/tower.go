@@ -0,0 +1,184 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import "errors"
+
+// ErrInvalidEncoding is returned when decoding a field element of the wrong size
+var ErrInvalidEncoding = errors.New("invalid binary field element encoding")
+
+// The tower is defined by τ₀ = GF(2) and τₖ₊₁ = τₖ[Xₖ]/(Xₖ² + Xₖ₋₁·Xₖ + 1),
+// with X₋₁ = 1. An element a₀ + a₁·Xₖ of τₖ₊₁ is stored in a 2ᵏ⁺¹-bit word
+// whose 2ᵏ low bits are a₀ and whose 2ᵏ high bits are a₁.
+//
+// With m₀ = a₀b₀, m₂ = a₁b₁ and m₁ = (a₀ + a₁)(b₀ + b₁), the product is
+//
+//	(a₀ + a₁Xₖ)(b₀ + b₁Xₖ) = (m₀ + m₂) + (m₁ + m₀ + m₂·(1 + Xₖ₋₁))·Xₖ
+//
+// and the inverse is given by the norm N = a₀(a₀ + a₁Xₖ₋₁) + a₁² ∈ τₖ:
+//
+//	(a₀ + a₁Xₖ)⁻¹ = ((a₀ + a₁Xₖ₋₁) + a₁Xₖ)·N⁻¹
+
+var (
+	// mul8Table[x][y] = x·y in GF8
+	mul8Table [256][256]uint8
+
+	// inv8Table[x] = x⁻¹ in GF8, with 0⁻¹ = 0
+	inv8Table [256]uint8
+)
+
+func init() {
+	for x := 0; x < 256; x++ {
+		for y := x; y < 256; y++ {
+			p := uint8(mulTower(uint64(x), uint64(y), 3))
+			mul8Table[x][y] = p
+			mul8Table[y][x] = p
+			if p == 1 {
+				inv8Table[x] = uint8(y)
+				inv8Table[y] = uint8(x)
+			}
+		}
+	}
+	initIsomorphisms()
+}
+
+// mulTower returns x·y in τₖ, k ≤ 6, bit by bit following the recursive
+// definition of the tower. It is slow and only used to build the tables.
+func mulTower(x, y uint64, k int) uint64 {
+	if k == 0 {
+		return x & y & 1
+	}
+	h := 1 << (k - 1)
+	mask := uint64(1)<<h - 1
+	x0, x1 := x&mask, x>>h
+	y0, y1 := y&mask, y>>h
+	m0 := mulTower(x0, y0, k-1)
+	m1 := mulTower(x0^x1, y0^y1, k-1)
+	m2 := mulTower(x1, y1, k-1)
+	return (m0 ^ m2) | (m1^m0^m2^mulByGeneratorTower(m2, k-1))<<h
+}
+
+// mulByGeneratorTower returns x·Xₖ₋₁ in τₖ, k ≤ 6.
+func mulByGeneratorTower(x uint64, k int) uint64 {
+	if k == 0 {
+		return x
+	}
+	h := 1 << (k - 1)
+	mask := uint64(1)<<h - 1
+	x0, x1 := x&mask, x>>h
+	return x1 | (x0^mulByGeneratorTower(x1, k-1))<<h
+}
+
+// the generators X₂ ∈ GF8, X₃ ∈ GF16, X₄ ∈ GF32 and X₅ ∈ GF64, in the tower basis
+const (
+	generator8  = 1 << 4
+	generator16 = 1 << 8
+	generator32 = 1 << 16
+	generator64 = 1 << 32
+)
+
+func mul8(x, y uint8) uint8 {
+	return mul8Table[x][y]
+}
+
+func mul16(x, y uint16) uint16 {
+	x0, x1 := uint8(x), uint8(x>>8)
+	y0, y1 := uint8(y), uint8(y>>8)
+	m0 := mul8Table[x0][y0]
+	m1 := mul8Table[x0^x1][y0^y1]
+	m2 := mul8Table[x1][y1]
+	return uint16(m0^m2) | uint16(m1^m0^mul8Table[m2][1|generator8])<<8
+}
+
+func mul32(x, y uint32) uint32 {
+	x0, x1 := uint16(x), uint16(x>>16)
+	y0, y1 := uint16(y), uint16(y>>16)
+	m0 := mul16(x0, y0)
+	m1 := mul16(x0^x1, y0^y1)
+	m2 := mul16(x1, y1)
+	return uint32(m0^m2) | uint32(m1^m0^m2^mulByGenerator16(m2))<<16
+}
+
+// mulTower64 returns x·y in GF64 with the recursive Karatsuba formula.
+func mulTower64(x, y uint64) uint64 {
+	x0, x1 := uint32(x), uint32(x>>32)
+	y0, y1 := uint32(y), uint32(y>>32)
+	m0 := mul32(x0, y0)
+	m1 := mul32(x0^x1, y0^y1)
+	m2 := mul32(x1, y1)
+	return uint64(m0^m2) | uint64(m1^m0^m2^mulByGenerator32(m2))<<32
+}
+
+// mulTower128 returns x·y in GF128 with the recursive Karatsuba formula.
+func mulTower128(x, y *[2]uint64) [2]uint64 {
+	m0 := mulTower64(x[0], y[0])
+	m1 := mulTower64(x[0]^x[1], y[0]^y[1])
+	m2 := mulTower64(x[1], y[1])
+	return [2]uint64{m0 ^ m2, m1 ^ m0 ^ m2 ^ mulByGenerator64(m2)}
+}
+
+// mulByGenerator8 returns x·X₂
+func mulByGenerator8(x uint8) uint8 {
+	return mul8Table[x][generator8]
+}
+
+// mulByGenerator16 returns x·X₃
+func mulByGenerator16(x uint16) uint16 {
+	x0, x1 := uint8(x), uint8(x>>8)
+	return uint16(x1) | uint16(x0^mulByGenerator8(x1))<<8
+}
+
+// mulByGenerator32 returns x·X₄
+func mulByGenerator32(x uint32) uint32 {
+	x0, x1 := uint16(x), uint16(x>>16)
+	return uint32(x1) | uint32(x0^mulByGenerator16(x1))<<16
+}
+
+// mulByGenerator64 returns x·X₅
+func mulByGenerator64(x uint64) uint64 {
+	x0, x1 := uint32(x), uint32(x>>32)
+	return uint64(x1) | uint64(x0^mulByGenerator32(x1))<<32
+}
+
+func inv8(x uint8) uint8 {
+	return inv8Table[x]
+}
+
+func inv16(x uint16) uint16 {
+	x0, x1 := uint8(x), uint8(x>>8)
+	t := x0 ^ mulByGenerator8(x1)
+	nInv := inv8(mul8(x0, t) ^ mul8(x1, x1))
+	return uint16(mul8(t, nInv)) | uint16(mul8(x1, nInv))<<8
+}
+
+func inv32(x uint32) uint32 {
+	x0, x1 := uint16(x), uint16(x>>16)
+	t := x0 ^ mulByGenerator16(x1)
+	nInv := inv16(mul16(x0, t) ^ mul16(x1, x1))
+	return uint32(mul16(t, nInv)) | uint32(mul16(x1, nInv))<<16
+}
+
+func inv64(x uint64) uint64 {
+	x0, x1 := uint32(x), uint32(x>>32)
+	t := x0 ^ mulByGenerator32(x1)
+	nInv := inv32(mul32(x0, t) ^ mul32(x1, x1))
+	return uint64(mul32(t, nInv)) | uint64(mul32(x1, nInv))<<32
+}
+
+func inv128(x *[2]uint64) [2]uint64 {
+	t := x[0] ^ mulByGenerator64(x[1])
+	nInv := inv64(mul64(x[0], t) ^ square64(x[1]))
+	return [2]uint64{mul64(t, nInv), mul64(x[1], nInv)}
+}
+
+// mulBy8 returns the product of the packed 8-bit coordinates of x by y ∈ GF8
+// which, in the tower basis, is the product of x by y.
+func mulBy8(x uint64, y uint8) uint64 {
+	row := &mul8Table[y]
+	var res uint64
+	for i := 0; i < 64; i += 8 {
+		res |= uint64(row[uint8(x>>i)]) << i
+	}
+	return res
+}
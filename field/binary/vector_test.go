@@ -0,0 +1,107 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func randomVector(n int) Vector {
+	res := make(Vector, n)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+func TestVectorSort(t *testing.T) {
+	assert := require.New(t)
+
+	v := Vector{{3, 1}, {1, 0}, {2, 1}}
+	sort.Sort(v)
+	assert.Equal("[0x1,0x10000000000000002,0x10000000000000003]", v.String())
+}
+
+func TestVectorRoundTrip(t *testing.T) {
+	assert := require.New(t)
+
+	v1 := randomVector(100)
+	b, err := v1.MarshalBinary()
+	assert.NoError(err)
+
+	var v2 Vector
+	assert.NoError(v2.UnmarshalBinary(b))
+	assert.Equal(v1, v2)
+
+	// truncated encoding
+	assert.Error(v2.UnmarshalBinary(b[:len(b)-1]))
+}
+
+func TestVectorOps(t *testing.T) {
+	for _, n := range []int{0, 1, 3, 4, 5, 33} {
+		assert := require.New(t)
+
+		a, b := randomVector(n), randomVector(n)
+		var s GF128
+		s.SetRandom()
+		s8 := GF8(s[0])
+
+		res := make(Vector, n)
+		var sum, innerProduct GF128
+		for i := 0; i < n; i++ {
+			var tmp GF128
+			sum.Add(&sum, &a[i])
+			innerProduct.Add(&innerProduct, tmp.Mul(&a[i], &b[i]))
+		}
+		assert.Equal(sum, a.Sum())
+		assert.Equal(innerProduct, a.InnerProduct(b))
+
+		res.Add(a, b)
+		for i := range res {
+			var e GF128
+			assert.Equal(*e.Add(&a[i], &b[i]), res[i])
+		}
+		res.Sub(a, b)
+		for i := range res {
+			var e GF128
+			assert.Equal(*e.Sub(&a[i], &b[i]), res[i])
+		}
+		res.Mul(a, b)
+		for i := range res {
+			var e GF128
+			assert.Equal(*e.Mul(&a[i], &b[i]), res[i])
+		}
+		res.ScalarMul(a, &s)
+		for i := range res {
+			var e GF128
+			assert.Equal(*e.Mul(&a[i], &s), res[i])
+		}
+		res.ScalarMulByGF8(a, &s8)
+		for i := range res {
+			var e GF128
+			assert.Equal(*e.MulByGF8(&a[i], &s8), res[i])
+		}
+	}
+}
+
+func BenchmarkVectorInnerProduct(b *testing.B) {
+	const n = 1 << 14
+	a, c := randomVector(n), randomVector(n)
+	b.Run("lazy reduction", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = a.InnerProduct(c)
+		}
+	})
+	b.Run("element-wise", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var res, tmp GF128
+			for j := range a {
+				res.Add(&res, tmp.Mul(&a[j], &c[j]))
+			}
+		}
+	})
+}
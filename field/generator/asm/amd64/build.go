@@ -218,9 +218,12 @@ func GenerateCommonASM(w io.Writer, nbWords, nbBits int, hasVector bool) error {
 	f.WriteLn("")
 
 	if nbWords == 1 {
-		if nbBits == 31 {
+		switch nbBits {
+		case 31:
 			return GenerateF31ASM(f, hasVector)
-		} else {
+		case 64:
+			return GenerateGoldilocksASM(f, hasVector)
+		default:
 			panic("not implemented")
 		}
 	}
@@ -279,6 +282,9 @@ func ElementASMFileName(nbWords, nbBits int) string {
 	const nameW1 = "element_%db_amd64.s"
 	const nameWN = "element_%dw_amd64.s"
 	if nbWords == 1 {
+		if nbBits == 64 {
+			return fmt.Sprintf(nameW1, 64)
+		}
 		if nbBits >= 32 {
 			panic("not implemented")
 		}
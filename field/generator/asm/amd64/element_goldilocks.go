@@ -0,0 +1,826 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package amd64
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/consensys/bavard/amd64"
+)
+
+// The goldilocks modulus q = 2⁶⁴ - 2³² + 1 satisfies q⁻¹ = 1 + 2³² mod 2⁶⁴, so the
+// Montgomery reduction of a 128-bit product hi·2⁶⁴ + lo needs no multiplication:
+//
+//	m = lo·q⁻¹ = lo + lo·2³² mod 2⁶⁴
+//	m·q = m·2⁶⁴ - m·2³² + m, whose high word is m - (m >> 32) - [m < (m << 32) mod 2⁶⁴]
+//	(hi·2⁶⁴ + lo - m·q) / 2⁶⁴ = hi - hi(m·q), plus q if it is negative
+//
+// since the low word of m·q is lo. Moreover 2⁶⁴ = 2³² - 1 mod q, so that adding q
+// modulo 2⁶⁴ is subtracting 2³² - 1.
+
+// GenerateGoldilocksASM generates the scalar multiplication and butterfly, and
+// the AVX-512 and AVX2 vector operations for the goldilocks field.
+func GenerateGoldilocksASM(f *FFAmd64, hasVector bool) error {
+	f.generateMulGoldilocks()
+	f.generateButterflyGoldilocks()
+
+	if !hasVector {
+		return nil
+	}
+
+	for _, avx512 := range []bool{true, false} {
+		v := vecGoldilocks{f: f, avx512: avx512}
+		v.generateAddVec()
+		v.generateSubVec()
+		v.generateSumVec()
+		v.generateMulVec("mulVec")
+		v.generateMulVec("scalarMulVec")
+		v.generateInnerProdVec()
+	}
+
+	return nil
+}
+
+// GenerateFFTGoldilocksASM generates the AVX-512 FFT kernels for the goldilocks
+// field: radix-2 and radix-4 stages on blocks of 8 elements, and the last 4
+// stages on blocks of 16 elements held in registers.
+func GenerateFFTGoldilocksASM(w io.Writer) error {
+	f := NewFFAmd64(w, 1)
+	f.Comment("Code generated by gnark-crypto/generator. DO NOT EDIT.")
+
+	f.WriteLn("#include \"textflag.h\"")
+	f.WriteLn("#include \"funcdata.h\"")
+	f.WriteLn("#include \"go_asm.h\"")
+	f.WriteLn("")
+
+	v := vecGoldilocks{f: f, avx512: true}
+	for _, dif := range []bool{true, false} {
+		v.generateRadix2(dif)
+		v.generateRadix4(dif)
+		v.generateTail16(dif)
+	}
+	return nil
+}
+
+func (f *FFAmd64) generateMulGoldilocks() {
+	f.Comment("mul(res, x, y *Element) res = x * y (mod q) in Montgomery form")
+	const argSize = 3 * 8
+	registers := f.FnHeader("mul", 0, argSize, amd64.AX, amd64.DX)
+	defer f.AssertCleanStack(0, 0)
+
+	m := f.Pop(&registers)
+	u := f.Pop(&registers)
+	h := f.Pop(&registers)
+	ptr := f.Pop(&registers)
+
+	f.MOVQ("x+8(FP)", ptr)
+	f.MOVQ(ptr.At(0), amd64.AX)
+	f.MOVQ("y+16(FP)", ptr)
+	f.MULQ(ptr.At(0), "DX:AX = x * y")
+
+	f.reduceGoldilocks(amd64.AX, amd64.DX, m, u, h)
+
+	f.MOVQ("res+0(FP)", ptr)
+	f.MOVQ(amd64.DX, ptr.At(0))
+	f.RET()
+
+	f.Push(&registers, m, u, h, ptr)
+}
+
+// reduceGoldilocks sets hi to the Montgomery reduction of hi:lo
+func (f *FFAmd64) reduceGoldilocks(lo, hi, m, u, h amd64.Register) {
+	f.MOVQ(lo, m)
+	f.SHLQ("$32", m)
+	f.ADDQ(lo, m, "m = lo + lo << 32")
+	f.MOVQ(m, u)
+	f.SHLQ("$32", u, "u = m << 32")
+	f.MOVQ(m, h)
+	f.SHRQ("$32", h)
+	f.WriteLn(fmt.Sprintf("    NEGQ %s", h))
+	f.ADDQ(m, h, "h = m - (m >> 32)")
+	f.CMPQ(m, u)
+	f.SBBQ("$0", h, "h = high word of m * q")
+	f.SUBQ(h, hi, "hi = hi - h")
+	f.SBBQ(u, u)
+	f.WriteLn(fmt.Sprintf("    MOVL %s, %s // 2³² - 1 if hi < h, 0 otherwise", u, u))
+	f.SUBQ(u, hi, "adds q if hi < h")
+}
+
+func (f *FFAmd64) generateButterflyGoldilocks() {
+	f.Comment("Butterfly(a, b *Element) sets a = a + b; b = a - b")
+	const argSize = 2 * 8
+	registers := f.FnHeader("Butterfly", 0, argSize)
+	defer f.AssertCleanStack(0, 0)
+
+	addrA := f.Pop(&registers)
+	addrB := f.Pop(&registers)
+	a := f.Pop(&registers)
+	b := f.Pop(&registers)
+	s := f.Pop(&registers)
+	t := f.Pop(&registers)
+	e := f.Pop(&registers)
+
+	f.MOVQ("a+0(FP)", addrA)
+	f.MOVQ("b+8(FP)", addrB)
+	f.MOVQ(addrA.At(0), a)
+	f.MOVQ(addrB.At(0), b)
+	f.WriteLn(fmt.Sprintf("    MOVL $0xffffffff, %s // e = 2³² - 1 = 2⁶⁴ mod q", e))
+
+	// a + b: the carry is e, and the result is reduced once more
+	f.MOVQ(a, s)
+	f.ADDQ(b, s, "s = a + b")
+	f.SBBQ(t, t)
+	f.ANDQ(e, t)
+	f.ADDQ(t, s, "s = s + e if a + b overflows")
+	f.MOVQ(s, t)
+	f.ADDQ(e, t, "t = s - q")
+	f.CMOVQCS(t, s, "s = t if s >= q")
+
+	// a - b: the borrow is -e
+	f.SUBQ(b, a, "a = a - b")
+	f.SBBQ(t, t)
+	f.ANDQ(e, t)
+	f.SUBQ(t, a, "a = a + q if a < b")
+
+	f.MOVQ(s, addrA.At(0))
+	f.MOVQ(a, addrB.At(0))
+	f.RET()
+
+	f.Push(&registers, addrA, addrB, a, b, s, t, e)
+}
+
+// vecGoldilocks generates vector operations on 8 elements with AVX-512, or on
+// 2×4 elements with AVX2.
+//
+// AVX2 has no unsigned comparison of quadwords, so they are done on signed
+// values after flipping the sign bits.
+type vecGoldilocks struct {
+	f      *FFAmd64
+	avx512 bool
+}
+
+// name returns the name of the function, with a suffix for AVX2
+func (v vecGoldilocks) name(fn string) string {
+	if v.avx512 {
+		return fn
+	}
+	return fn + "AVX2"
+}
+
+// r returns the i-th vector register
+func (v vecGoldilocks) r(i int) amd64.Register {
+	if v.avx512 {
+		return amd64.Register(fmt.Sprintf("Z%d", i))
+	}
+	return amd64.Register(fmt.Sprintf("Y%d", i))
+}
+
+// op writes an instruction with the given operands, in Go assembly order
+func (v vecGoldilocks) op(instruction string, operands ...any) {
+	ops := make([]string, len(operands))
+	for i, o := range operands {
+		ops[i] = fmt.Sprint(o)
+	}
+	v.f.WriteLn(fmt.Sprintf("    %s %s", instruction, strings.Join(ops, ", ")))
+}
+
+func (v vecGoldilocks) suffix(instruction string) string {
+	if v.avx512 {
+		return instruction + "Q"
+	}
+	return instruction
+}
+
+func (v vecGoldilocks) load(addr string, dst amd64.Register) {
+	if v.avx512 {
+		v.op("VMOVDQU64", addr, dst)
+	} else {
+		v.op("VMOVDQU", addr, dst)
+	}
+}
+
+func (v vecGoldilocks) store(src amd64.Register, addr string) {
+	if v.avx512 {
+		v.op("VMOVDQU64", src, addr)
+	} else {
+		v.op("VMOVDQU", src, addr)
+	}
+}
+
+func (v vecGoldilocks) and(a, b, dst amd64.Register) { v.op(v.suffix("VPAND"), a, b, dst) }
+func (v vecGoldilocks) or(a, b, dst amd64.Register)  { v.op(v.suffix("VPOR"), a, b, dst) }
+func (v vecGoldilocks) xor(a, b, dst amd64.Register) { v.op(v.suffix("VPXOR"), a, b, dst) }
+
+// broadcast sets all the lanes of dst to the general purpose register src
+func (v vecGoldilocks) broadcast(src, dst amd64.Register) {
+	if v.avx512 {
+		v.op("VPBROADCASTQ", src, dst)
+		return
+	}
+	x := strings.Replace(string(dst), "Y", "X", 1)
+	v.op("MOVQ", src, x)
+	v.op("VPBROADCASTQ", x, dst)
+}
+
+// vecConstants are the registers holding constants
+type vecConstants struct {
+	q    amd64.Register // q in all lanes
+	lo32 amd64.Register // 2³² - 1 in all lanes
+	aux  amd64.Register // AVX-512: -1 in all lanes; AVX2: 2⁶³ in all lanes
+}
+
+// loadConstants loads the constants in the 3 last vector registers
+func (v vecGoldilocks) loadConstants(tmp amd64.Register) vecConstants {
+	n := 16
+	if v.avx512 {
+		n = 32
+	}
+	c := vecConstants{q: v.r(n - 1), lo32: v.r(n - 2), aux: v.r(n - 3)}
+	v.f.MOVQ("$0xffffffff00000001", tmp)
+	v.broadcast(tmp, c.q)
+	v.f.WriteLn(fmt.Sprintf("    MOVL $0xffffffff, %s", tmp))
+	v.broadcast(tmp, c.lo32)
+	if v.avx512 {
+		v.op("VPTERNLOGD", "$0xff", c.aux, c.aux, c.aux)
+	} else {
+		v.f.MOVQ("$0x8000000000000000", tmp)
+		v.broadcast(tmp, c.aux)
+	}
+	return c
+}
+
+// lessThan sets the mask to x < y; it is the k register mask with AVX-512,
+// and -1 or 0 in the lanes of mask with AVX2, which uses tmp
+func (v vecGoldilocks) lessThan(c vecConstants, x, y, mask, tmp amd64.Register) {
+	if v.avx512 {
+		v.op("VPCMPUQ", "$1", y, x, mask)
+		return
+	}
+	v.xor(c.aux, x, tmp)
+	v.xor(c.aux, y, mask)
+	v.op("VPCMPGTQ", tmp, mask, mask)
+}
+
+// addIf adds b to dst in the lanes where mask is set, which it may overwrite
+func (v vecGoldilocks) addIf(mask, b, dst amd64.Register) {
+	if v.avx512 {
+		v.op("VPADDQ", b, dst, mask, dst)
+		return
+	}
+	v.and(b, mask, mask)
+	v.op("VPADDQ", mask, dst, dst)
+}
+
+// add sets dst = a + b (mod q), using 3 temporary registers; dst may be a or b
+func (v vecGoldilocks) add(c vecConstants, a, b, dst amd64.Register, t [3]amd64.Register) {
+	// a + b = a - (q - b), plus q if a < q - b
+	v.op("VPSUBQ", b, c.q, t[0])
+	mask := t[1]
+	if v.avx512 {
+		mask = "K1"
+	}
+	v.lessThan(c, a, t[0], mask, t[2])
+	v.op("VPSUBQ", t[0], a, dst)
+	v.addIf(mask, c.q, dst)
+}
+
+// sub sets dst = a - b (mod q), using 2 temporary registers; dst may be a or b
+func (v vecGoldilocks) sub(c vecConstants, a, b, dst amd64.Register, t [3]amd64.Register) {
+	mask := t[0]
+	if v.avx512 {
+		mask = "K1"
+	}
+	v.lessThan(c, a, b, mask, t[1])
+	v.op("VPSUBQ", b, a, dst)
+	v.addIf(mask, c.q, dst)
+}
+
+// mul sets dst = a * b (mod q) in Montgomery form, using 5 temporary registers;
+// dst may be a or b
+func (v vecGoldilocks) mul(c vecConstants, a, b, dst amd64.Register, t [5]amd64.Register) {
+	ah, bh, ll, lh, hl := t[0], t[1], t[2], t[3], t[4]
+
+	// the 128-bit product from 32-bit ones; the partial sums don't overflow
+	v.op("VPSRLQ", "$32", a, ah)
+	v.op("VPSRLQ", "$32", b, bh)
+	v.op("VPMULUDQ", b, a, ll)
+	v.op("VPMULUDQ", bh, a, lh)
+	v.op("VPMULUDQ", b, ah, hl)
+	hh := ah
+	v.op("VPMULUDQ", bh, ah, hh)
+	v.op("VPSRLQ", "$32", ll, bh)
+	v.op("VPADDQ", bh, lh, lh)
+	v.and(c.lo32, lh, bh)
+	v.op("VPADDQ", bh, hl, hl)
+	v.op("VPSRLQ", "$32", lh, lh)
+	v.op("VPADDQ", lh, hh, hh)
+	v.op("VPSRLQ", "$32", hl, bh)
+	v.op("VPADDQ", bh, hh, hh)
+	v.op("VPSLLQ", "$32", hl, hl)
+	v.and(c.lo32, ll, ll)
+	lo := ll
+	v.or(hl, ll, lo)
+
+	// m = lo + lo << 32
+	m := lo
+	v.op("VPSLLQ", "$32", lo, bh)
+	v.op("VPADDQ", bh, lo, m)
+
+	// h = m - (m >> 32) - [m < m << 32]
+	u := bh
+	v.op("VPSLLQ", "$32", m, u)
+	mask := u
+	if v.avx512 {
+		mask = "K2"
+	}
+	v.lessThan(c, m, u, mask, hl)
+	v.op("VPSRLQ", "$32", m, lh)
+	h := m
+	v.op("VPSUBQ", lh, m, h)
+	if v.avx512 {
+		v.op("VPADDQ", c.aux, h, mask, h)
+	} else {
+		v.op("VPADDQ", mask, h, h)
+	}
+
+	// dst = hh - h, plus q if hh < h
+	mask = lh
+	if v.avx512 {
+		mask = "K2"
+	}
+	v.lessThan(c, hh, h, mask, hl)
+	v.op("VPSUBQ", h, hh, dst)
+	v.addIf(mask, c.q, dst)
+}
+
+// butterflyDIF sets x, y = x + y, (x - y) * w, using 6 temporary registers;
+// the product is skipped if w is empty
+func (v vecGoldilocks) butterflyDIF(c vecConstants, x, y, w amd64.Register, t [6]amd64.Register) {
+	v.sub(c, x, y, t[0], [3]amd64.Register{t[1], t[2], t[3]})
+	v.add(c, x, y, x, [3]amd64.Register{t[1], t[2], t[3]})
+	if w == "" {
+		v.op("VMOVDQA64", t[0], y)
+		return
+	}
+	v.mul(c, t[0], w, y, [5]amd64.Register{t[1], t[2], t[3], t[4], t[5]})
+}
+
+// butterflyDIT sets x, y = x + y * w, x - y * w, using 6 temporary registers
+func (v vecGoldilocks) butterflyDIT(c vecConstants, x, y, w amd64.Register, t [6]amd64.Register) {
+	v.mul(c, y, w, y, [5]amd64.Register{t[1], t[2], t[3], t[4], t[5]})
+	v.butterflyDIF(c, x, y, "", t)
+}
+
+// loop writes the loop on n iterations, with the given pointers incremented
+// by the given number of bytes after each iteration
+func (v vecGoldilocks) loop(n amd64.Register, body func(), increment int, pointers ...amd64.Register) {
+	f := v.f
+	loop := f.NewLabel("loop")
+	done := f.NewLabel("done")
+
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	body()
+
+	f.Comment("increment pointers to visit next element")
+	for _, p := range pointers {
+		f.ADDQ(fmt.Sprintf("$%d", increment), p)
+	}
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+}
+
+// ret clears the upper bits of the vector registers and returns
+func (v vecGoldilocks) ret() {
+	v.f.WriteLn("    VZEROUPPER")
+	v.f.RET()
+}
+
+// halves returns the number of vectors in a block of 8 elements
+func (v vecGoldilocks) halves() int {
+	if v.avx512 {
+		return 1
+	}
+	return 2
+}
+
+// temporaries returns the vector registers from the i-th one
+func (v vecGoldilocks) temporaries(i int) [5]amd64.Register {
+	return [5]amd64.Register{v.r(i), v.r(i + 1), v.r(i + 2), v.r(i + 3), v.r(i + 4)}
+}
+
+func (v vecGoldilocks) generateAddVec() {
+	v.generateBinaryVec("addVec", "res[0...n] = a[0...n] + b[0...n]", v.add)
+}
+
+func (v vecGoldilocks) generateSubVec() {
+	v.generateBinaryVec("subVec", "res[0...n] = a[0...n] - b[0...n]", v.sub)
+}
+
+func (v vecGoldilocks) generateBinaryVec(fn, doc string, op func(c vecConstants, a, b, dst amd64.Register, t [3]amd64.Register)) {
+	f := v.f
+	fn = v.name(fn)
+	f.Comment(fmt.Sprintf("%s(res, a, b *Element, n uint64) %s", fn, doc))
+	f.Comment("n is the number of blocks of 8 elements to process")
+
+	const argSize = 4 * 8
+	registers := f.FnHeader(fn, 0, argSize)
+	defer f.AssertCleanStack(0, 0)
+
+	addrRes := f.Pop(&registers)
+	addrA := f.Pop(&registers)
+	addrB := f.Pop(&registers)
+	n := f.Pop(&registers)
+	tmp := f.Pop(&registers)
+
+	c := v.loadConstants(tmp)
+
+	f.MOVQ("res+0(FP)", addrRes)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("b+16(FP)", addrB)
+	f.MOVQ("n+24(FP)", n)
+
+	a, b := v.r(0), v.r(1)
+	v.loop(n, func() {
+		for i := 0; i < v.halves(); i++ {
+			v.load(addrA.At(4*i), a)
+			v.load(addrB.At(4*i), b)
+			op(c, a, b, a, [3]amd64.Register{v.r(2), v.r(3), v.r(4)})
+			v.store(a, addrRes.At(4*i))
+		}
+	}, 64, addrRes, addrA, addrB)
+
+	v.ret()
+	f.Push(&registers, addrRes, addrA, addrB, n, tmp)
+}
+
+func (v vecGoldilocks) generateMulVec(fn string) {
+	f := v.f
+	scalar := fn == "scalarMulVec"
+	fn = v.name(fn)
+	if scalar {
+		f.Comment(fmt.Sprintf("%s(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b", fn))
+	} else {
+		f.Comment(fmt.Sprintf("%s(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]", fn))
+	}
+	f.Comment("n is the number of blocks of 8 elements to process")
+
+	const argSize = 4 * 8
+	registers := f.FnHeader(fn, 0, argSize)
+	defer f.AssertCleanStack(0, 0)
+
+	addrRes := f.Pop(&registers)
+	addrA := f.Pop(&registers)
+	addrB := f.Pop(&registers)
+	n := f.Pop(&registers)
+	tmp := f.Pop(&registers)
+
+	c := v.loadConstants(tmp)
+
+	f.MOVQ("res+0(FP)", addrRes)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("b+16(FP)", addrB)
+	f.MOVQ("n+24(FP)", n)
+
+	a, b := v.r(0), v.r(1)
+	pointers := []amd64.Register{addrRes, addrA, addrB}
+	if scalar {
+		b = v.r(12)
+		f.MOVQ(addrB.At(0), tmp)
+		v.broadcast(tmp, b)
+		pointers = pointers[:2]
+	}
+
+	v.loop(n, func() {
+		for i := 0; i < v.halves(); i++ {
+			v.load(addrA.At(4*i), a)
+			if !scalar {
+				v.load(addrB.At(4*i), b)
+			}
+			v.mul(c, a, b, a, v.temporaries(2))
+			v.store(a, addrRes.At(4*i))
+		}
+	}, 64, pointers...)
+
+	v.ret()
+	f.Push(&registers, addrRes, addrA, addrB, n, tmp)
+}
+
+func (v vecGoldilocks) generateSumVec() {
+	f := v.f
+	fn := v.name("sumVec")
+	f.Comment(fmt.Sprintf("%s(t, a *Element, n uint64) t[0...8] = partial sums of a[0...n]", fn))
+	f.Comment("n is the number of blocks of 8 elements to process")
+
+	const argSize = 3 * 8
+	registers := f.FnHeader(fn, 0, argSize)
+	defer f.AssertCleanStack(0, 0)
+
+	addrT := f.Pop(&registers)
+	addrA := f.Pop(&registers)
+	n := f.Pop(&registers)
+	tmp := f.Pop(&registers)
+
+	c := v.loadConstants(tmp)
+
+	f.MOVQ("t+0(FP)", addrT)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("n+16(FP)", n)
+
+	// one accumulator per half block
+	a := v.r(0)
+	acc := func(i int) amd64.Register { return v.r(7 + i) }
+	for i := 0; i < v.halves(); i++ {
+		v.xor(acc(i), acc(i), acc(i))
+	}
+
+	v.loop(n, func() {
+		for i := 0; i < v.halves(); i++ {
+			v.load(addrA.At(4*i), a)
+			v.add(c, acc(i), a, acc(i), [3]amd64.Register{v.r(1), v.r(2), v.r(3)})
+		}
+	}, 64, addrA)
+
+	for i := 0; i < v.halves(); i++ {
+		v.store(acc(i), addrT.At(4*i))
+	}
+	v.ret()
+	f.Push(&registers, addrT, addrA, n, tmp)
+}
+
+func (v vecGoldilocks) generateInnerProdVec() {
+	f := v.f
+	fn := v.name("innerProdVec")
+	f.Comment(fmt.Sprintf("%s(t, a, b *Element, n uint64) t[0...8] = partial sums of a[0...n] * b[0...n]", fn))
+	f.Comment("n is the number of blocks of 8 elements to process")
+
+	const argSize = 4 * 8
+	registers := f.FnHeader(fn, 0, argSize)
+	defer f.AssertCleanStack(0, 0)
+
+	addrT := f.Pop(&registers)
+	addrA := f.Pop(&registers)
+	addrB := f.Pop(&registers)
+	n := f.Pop(&registers)
+	tmp := f.Pop(&registers)
+
+	c := v.loadConstants(tmp)
+
+	f.MOVQ("t+0(FP)", addrT)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("b+16(FP)", addrB)
+	f.MOVQ("n+24(FP)", n)
+
+	// one accumulator per half block
+	a, b := v.r(0), v.r(1)
+	acc := func(i int) amd64.Register { return v.r(7 + i) }
+	for i := 0; i < v.halves(); i++ {
+		v.xor(acc(i), acc(i), acc(i))
+	}
+
+	v.loop(n, func() {
+		for i := 0; i < v.halves(); i++ {
+			v.load(addrA.At(4*i), a)
+			v.load(addrB.At(4*i), b)
+			v.mul(c, a, b, a, v.temporaries(2))
+			v.add(c, acc(i), a, acc(i), [3]amd64.Register{v.r(1), v.r(2), v.r(3)})
+		}
+	}, 64, addrA, addrB)
+
+	for i := 0; i < v.halves(); i++ {
+		v.store(acc(i), addrT.At(4*i))
+	}
+	v.ret()
+	f.Push(&registers, addrT, addrA, addrB, n, tmp)
+}
+
+// fftTemporaries are the temporary registers of the butterflies in the kernels
+func (v vecGoldilocks) fftTemporaries() [6]amd64.Register {
+	return [6]amd64.Register{v.r(16), v.r(17), v.r(18), v.r(19), v.r(20), v.r(21)}
+}
+
+func kernelName(dif bool, kernel string) string {
+	if dif {
+		return "dif" + kernel + "AVX512"
+	}
+	return "dit" + kernel + "AVX512"
+}
+
+// generateRadix2 generates a stage of the FFT, on a[i] and b[i] for i < 8n
+func (v vecGoldilocks) generateRadix2(dif bool) {
+	f := v.f
+	fn := kernelName(dif, "Radix2")
+	if dif {
+		f.Comment(fmt.Sprintf("%s(a, b, twiddles *Element, n uint64) sets a, b = a + b, (a - b) * twiddles", fn))
+	} else {
+		f.Comment(fmt.Sprintf("%s(a, b, twiddles *Element, n uint64) sets a, b = a + b * twiddles, a - b * twiddles", fn))
+	}
+	f.Comment("n is the number of blocks of 8 elements to process")
+
+	const argSize = 4 * 8
+	registers := f.FnHeader(fn, 0, argSize)
+	defer f.AssertCleanStack(0, 0)
+
+	addrA := f.Pop(&registers)
+	addrB := f.Pop(&registers)
+	addrW := f.Pop(&registers)
+	n := f.Pop(&registers)
+	tmp := f.Pop(&registers)
+
+	c := v.loadConstants(tmp)
+
+	f.MOVQ("a+0(FP)", addrA)
+	f.MOVQ("b+8(FP)", addrB)
+	f.MOVQ("twiddles+16(FP)", addrW)
+	f.MOVQ("n+24(FP)", n)
+
+	a, b, w := v.r(0), v.r(1), v.r(2)
+	v.loop(n, func() {
+		v.load(addrA.At(0), a)
+		v.load(addrB.At(0), b)
+		v.load(addrW.At(0), w)
+		if dif {
+			v.butterflyDIF(c, a, b, w, v.fftTemporaries())
+		} else {
+			v.butterflyDIT(c, a, b, w, v.fftTemporaries())
+		}
+		v.store(a, addrA.At(0))
+		v.store(b, addrB.At(0))
+	}, 64, addrA, addrB, addrW)
+
+	v.ret()
+	f.Push(&registers, addrA, addrB, addrW, n, tmp)
+}
+
+// generateRadix4 generates two consecutive stages of the FFT on n blocks of
+// 4m elements, with the twiddles of the stages on 4m and 2m elements.
+func (v vecGoldilocks) generateRadix4(dif bool) {
+	f := v.f
+	fn := kernelName(dif, "Radix4")
+	f.Comment(fmt.Sprintf("%s(a, twiddles0, twiddles1 *Element, m, n uint64) performs two stages of the FFT", fn))
+	f.Comment("on n blocks of 4m elements; m must be a multiple of 8")
+
+	const argSize = 5 * 8
+	registers := f.FnHeader(fn, 0, argSize)
+	defer f.AssertCleanStack(0, 0)
+
+	p0 := f.Pop(&registers)
+	p2 := f.Pop(&registers)
+	w0 := f.Pop(&registers)
+	w1 := f.Pop(&registers)
+	m := f.Pop(&registers)
+	n := f.Pop(&registers)
+	i := f.Pop(&registers)
+	tmp := f.Pop(&registers)
+
+	c := v.loadConstants(tmp)
+
+	f.MOVQ("a+0(FP)", p0)
+	f.MOVQ("m+24(FP)", m)
+	f.SHLQ("$3", m, "m in bytes")
+	f.MOVQ("n+32(FP)", n)
+
+	blockLoop := f.NewLabel("blockLoop")
+	done := f.NewLabel("done")
+
+	f.LABEL(blockLoop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	f.MOVQ("twiddles0+8(FP)", w0)
+	f.MOVQ("twiddles1+16(FP)", w1)
+	v.op("LEAQ", fmt.Sprintf("(%s)(%s*2)", p0, m), p2)
+	f.MOVQ(m, i)
+	f.SHRQ("$6", i, "number of blocks of 8 elements in a quarter")
+
+	a0, a1, a2, a3 := v.r(0), v.r(1), v.r(2), v.r(3)
+	w00, w01, w10 := v.r(4), v.r(5), v.r(6)
+	next := func(p amd64.Register) string { return fmt.Sprintf("(%s)(%s*1)", p, m) }
+	t := v.fftTemporaries()
+	v.loop(i, func() {
+		v.load(p0.At(0), a0)
+		v.load(next(p0), a1)
+		v.load(p2.At(0), a2)
+		v.load(next(p2), a3)
+		v.load(w0.At(0), w00)
+		v.load(next(w0), w01)
+		v.load(w1.At(0), w10)
+		if dif {
+			v.butterflyDIF(c, a0, a2, w00, t)
+			v.butterflyDIF(c, a1, a3, w01, t)
+			v.butterflyDIF(c, a0, a1, w10, t)
+			v.butterflyDIF(c, a2, a3, w10, t)
+		} else {
+			v.butterflyDIT(c, a0, a1, w10, t)
+			v.butterflyDIT(c, a2, a3, w10, t)
+			v.butterflyDIT(c, a0, a2, w00, t)
+			v.butterflyDIT(c, a1, a3, w01, t)
+		}
+		v.store(a0, p0.At(0))
+		v.store(a1, next(p0))
+		v.store(a2, p2.At(0))
+		v.store(a3, next(p2))
+	}, 64, p0, p2, w0, w1)
+
+	f.Comment("the next block starts after the last quarter of this one")
+	v.op("LEAQ", next(p2), p0)
+	f.DECQ(n, "decrement n")
+	f.JMP(blockLoop)
+
+	f.LABEL(done)
+	v.ret()
+	f.Push(&registers, p0, p2, w0, w1, m, n, i, tmp)
+}
+
+// generateTail16 generates the last 4 stages of the FFT on n blocks of 16
+// elements, with the twiddles of the stages on 16, 8 and 4 elements. The
+// elements are shuffled in registers so that the butterflies are on lanes.
+func (v vecGoldilocks) generateTail16(dif bool) {
+	f := v.f
+	fn := kernelName(dif, "Tail16")
+	f.Comment(fmt.Sprintf("%s(a, twiddles8, twiddles4, twiddles2 *Element, n uint64) performs the last 4 stages", fn))
+	f.Comment("of the FFT on n blocks of 16 elements")
+
+	const argSize = 5 * 8
+	registers := f.FnHeader(fn, 0, argSize)
+	defer f.AssertCleanStack(0, 0)
+
+	addrA := f.Pop(&registers)
+	n := f.Pop(&registers)
+	tmp := f.Pop(&registers)
+
+	c := v.loadConstants(tmp)
+
+	w8, w4, w2 := v.r(8), v.r(9), v.r(10)
+	f.MOVQ("twiddles8+8(FP)", tmp)
+	v.load(tmp.At(0), w8)
+	f.MOVQ("twiddles4+16(FP)", tmp)
+	v.op("VBROADCASTI64X4", tmp.At(0), w4)
+	f.MOVQ("twiddles2+24(FP)", tmp)
+	v.op("VBROADCASTI64X2", tmp.At(0), w2)
+	f.MOVQ("a+0(FP)", addrA)
+	f.MOVQ("n+32(FP)", n)
+
+	// x = [x0...x7], y = [x8...x15]; the shuffles are on 128-bit chunks of 2 elements
+	x, y := v.r(0), v.r(1)
+	u, w := v.r(2), v.r(3)
+	// halves sets u = [x0...x3, x8...x11], w = [x4...x7, x12...x15] and is an involution
+	halves := func(x, y, u, w amd64.Register) {
+		v.op("VSHUFI64X2", "$0x44", y, x, u)
+		v.op("VSHUFI64X2", "$0xee", y, x, w)
+	}
+	// quarters sets u = [x.0, x.2, y.0, y.2], w = [x.1, x.3, y.1, y.3]
+	// on chunks and is of order 3
+	quarters := func(x, y, u, w amd64.Register) {
+		v.op("VSHUFI64X2", "$0x88", y, x, u)
+		v.op("VSHUFI64X2", "$0xdd", y, x, w)
+	}
+	// pairs sets u, w to the even and odd elements of x, y, and is an involution
+	pairs := func(x, y, u, w amd64.Register) {
+		v.op("VPUNPCKLQDQ", y, x, u)
+		v.op("VPUNPCKHQDQ", y, x, w)
+	}
+	t := v.fftTemporaries()
+
+	v.loop(n, func() {
+		v.load(addrA.At(0), x)
+		v.load(addrA.At(8), y)
+		if dif {
+			v.butterflyDIF(c, x, y, w8, t)
+			halves(x, y, u, w)
+			v.butterflyDIF(c, u, w, w4, t)
+			quarters(u, w, x, y)
+			v.butterflyDIF(c, x, y, w2, t)
+			pairs(x, y, u, w)
+			v.butterflyDIF(c, u, w, "", t)
+			pairs(u, w, x, y)
+			quarters(x, y, u, w)
+			quarters(u, w, x, y)
+			halves(x, y, u, w)
+		} else {
+			halves(x, y, u, w)
+			quarters(u, w, x, y)
+			pairs(x, y, u, w)
+			v.butterflyDIF(c, u, w, "", t)
+			pairs(u, w, x, y)
+			v.butterflyDIT(c, x, y, w2, t)
+			quarters(x, y, u, w)
+			quarters(u, w, x, y)
+			v.butterflyDIT(c, x, y, w4, t)
+			halves(x, y, u, w)
+			v.butterflyDIT(c, u, w, w8, t)
+		}
+		v.store(u, addrA.At(0))
+		v.store(w, addrA.At(8))
+	}, 128, addrA)
+
+	v.ret()
+	f.Push(&registers, addrA, n, tmp)
+}
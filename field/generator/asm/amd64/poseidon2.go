@@ -0,0 +1,413 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package amd64
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/bavard/amd64"
+)
+
+// Poseidon2 describes the Poseidon2 permutations of a field, see
+// GeneratePoseidon2ASM.
+type Poseidon2 struct {
+	// Q is the modulus, either on 31 bits (F31 set) or the goldilocks one
+	Q   uint64
+	F31 bool
+
+	SBoxDegree int
+
+	// HLExternalMatrix selects the 4x4 block of the external matrix of the
+	// Poseidon2 paper, instead of circ(2, 3, 1, 1)
+	HLExternalMatrix bool
+
+	Instances []Poseidon2Instance
+}
+
+// Poseidon2Instance describes the permutation of a given width
+type Poseidon2Instance struct {
+	Width           int
+	NbFullRounds    int
+	NbPartialRounds int
+}
+
+// GeneratePoseidon2ASM generates, for each width t, the function
+//
+//	permutation<t>AVX512(states, roundKeys, diag *Element)
+//
+// that permutes 16 states (31-bit fields) or 8 states (goldilocks) at once.
+// The states are transposed: the i-th row of 64 bytes holds the i-th element
+// of each state, so that each state is in a lane of the vector registers and
+// the rounds need no shuffle. The round keys are given in the order they are
+// used, and diag is the diagonal of the internal matrix minus the identity.
+func GeneratePoseidon2ASM(w io.Writer, params Poseidon2) error {
+	f := NewFFAmd64(w, 1)
+	f.Comment("Code generated by gnark-crypto/generator. DO NOT EDIT.")
+
+	f.WriteLn("#include \"textflag.h\"")
+	f.WriteLn("#include \"funcdata.h\"")
+	f.WriteLn("#include \"go_asm.h\"")
+	f.WriteLn("")
+
+	for _, instance := range params.Instances {
+		if instance.Width%4 != 0 || instance.Width < 8 || instance.Width > 24 {
+			return fmt.Errorf("unsupported poseidon2 width %d", instance.Width)
+		}
+		var ops poseidon2Ops
+		if params.F31 {
+			ops = newPoseidon2F31(f, params.Q)
+		} else {
+			ops = poseidon2Goldilocks{v: vecGoldilocks{f: f, avx512: true}}
+		}
+		p := poseidon2ASM{f: f, ops: ops, params: params, instance: instance}
+		p.generate()
+	}
+	return nil
+}
+
+// poseidon2Ops are the vector operations on field elements used by the
+// permutations
+type poseidon2Ops interface {
+	// elementSize is the size of an element in bytes
+	elementSize() int
+
+	// nbConstants is the number of vector registers holding constants, at
+	// the end of the register file
+	nbConstants() int
+
+	// loadConstants loads the constants, using the general purpose register tmp
+	loadConstants(tmp amd64.Register)
+
+	load(addr string, dst amd64.Register)
+	store(src amd64.Register, addr string)
+
+	// broadcast sets all the lanes of dst to the element at addr
+	broadcast(addr string, dst amd64.Register)
+
+	// add sets dst = a + b, with dst possibly a or b
+	add(a, b, dst amd64.Register, t []amd64.Register)
+
+	// mul sets dst = a * b, with dst possibly a or b; b is a broadcast
+	// register if isBroadcast is set
+	mul(a, b, dst amd64.Register, isBroadcast bool, t []amd64.Register)
+}
+
+type poseidon2ASM struct {
+	f        *FFAmd64
+	ops      poseidon2Ops
+	params   Poseidon2
+	instance Poseidon2Instance
+}
+
+func (p *poseidon2ASM) generate() {
+	f := p.f
+	width := p.instance.Width
+	size := p.ops.elementSize()
+
+	fn := fmt.Sprintf("permutation%dAVX512", width)
+	f.Comment(fmt.Sprintf("%s(states, roundKeys, diag *Element) applies the poseidon2 permutation", fn))
+	f.Comment(fmt.Sprintf("of width %d on the transposed states", width))
+
+	const argSize = 3 * 8
+	registers := f.FnHeader(fn, 0, argSize)
+	defer f.AssertCleanStack(0, 0)
+
+	states := f.Pop(&registers)
+	roundKeys := f.Pop(&registers)
+	diag := f.Pop(&registers)
+	n := f.Pop(&registers)
+
+	p.ops.loadConstants(n)
+
+	f.MOVQ("states+0(FP)", states)
+	f.MOVQ("roundKeys+8(FP)", roundKeys)
+	f.MOVQ("diag+16(FP)", diag)
+
+	x := make([]amd64.Register, width)
+	for i := range x {
+		x[i] = amd64.Register(fmt.Sprintf("Z%d", i))
+		p.ops.load(states.At(i*8), x[i])
+	}
+	var t []amd64.Register
+	for i := width; i < 32-p.ops.nbConstants(); i++ {
+		t = append(t, amd64.Register(fmt.Sprintf("Z%d", i)))
+	}
+
+	f.Comment("the initial external matrix")
+	p.matMulExternal(x, t)
+
+	fullRounds := func() {
+		f.MOVQ(fmt.Sprintf("$%d", p.instance.NbFullRounds/2), n)
+		p.loop(n, func() {
+			for i := range x {
+				p.ops.broadcast(offset(roundKeys, i*size), t[0])
+				p.ops.add(x[i], t[0], x[i], t[1:])
+			}
+			for i := range x {
+				p.sBox(x[i], t)
+			}
+			p.matMulExternal(x, t)
+		}, width*size, roundKeys)
+	}
+
+	f.Comment("the first half of the full rounds")
+	fullRounds()
+
+	f.Comment("the partial rounds")
+	f.MOVQ(fmt.Sprintf("$%d", p.instance.NbPartialRounds), n)
+	p.loop(n, func() {
+		p.ops.broadcast(offset(roundKeys, 0), t[0])
+		p.ops.add(x[0], t[0], x[0], t[1:])
+		p.sBox(x[0], t)
+		p.matMulInternal(x, diag, t)
+	}, size, roundKeys)
+
+	f.Comment("the second half of the full rounds")
+	fullRounds()
+
+	for i := range x {
+		p.ops.store(x[i], states.At(i*8))
+	}
+
+	f.WriteLn("    VZEROUPPER")
+	f.RET()
+	f.Push(&registers, states, roundKeys, diag, n)
+}
+
+// loop writes the loop on n iterations, with the pointer incremented by the
+// given number of bytes after each iteration
+func (p *poseidon2ASM) loop(n amd64.Register, body func(), increment int, pointer amd64.Register) {
+	f := p.f
+	loop := f.NewLabel("loop")
+	done := f.NewLabel("done")
+
+	f.LABEL(loop)
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	body()
+
+	f.ADDQ(fmt.Sprintf("$%d", increment), pointer)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+}
+
+// offset returns the address of the byte at the given offset from r
+func offset(r amd64.Register, bytes int) string {
+	return fmt.Sprintf("%d(%s)", bytes, r)
+}
+
+// sBox sets x = x^d, using 2 temporary registers on top of the ones of mul
+func (p *poseidon2ASM) sBox(x amd64.Register, t []amd64.Register) {
+	x2, h := t[0], t[1]
+	p.ops.mul(x, x, x2, false, t[2:])
+	switch p.params.SBoxDegree {
+	case 3:
+		p.ops.mul(x2, x, x, false, t[2:])
+	case 7:
+		p.ops.mul(x2, x, h, false, t[2:])
+		p.ops.mul(x2, x2, x2, false, t[2:])
+		p.ops.mul(x2, h, x, false, t[2:])
+	default:
+		panic("unsupported s-box degree")
+	}
+}
+
+// double sets x = 2x
+func (p *poseidon2ASM) double(x amd64.Register, t []amd64.Register) {
+	p.ops.add(x, x, x, t)
+}
+
+// matMulM4 multiplies the 4 elements x by the 4x4 block of the external matrix,
+// using 2 or 4 temporary registers on top of the ones of add
+func (p *poseidon2ASM) matMulM4(x []amd64.Register, t []amd64.Register) {
+	if p.params.HLExternalMatrix {
+		// (5 7 1 3)
+		// (4 6 1 1)
+		// (1 3 5 7)
+		// (1 1 4 6)
+		// see https://eprint.iacr.org/2023/323.pdf appendix B for the addition chain
+		t0, t1, u := t[0], t[1], t[2:]
+		p.ops.add(x[0], x[1], t0, u)
+		p.ops.add(x[2], x[3], t1, u)
+		p.double(x[1], u)
+		p.ops.add(x[1], t1, x[1], u) // t2 = 2x1 + t1
+		p.double(x[3], u)
+		p.ops.add(x[3], t0, x[3], u) // t3 = 2x3 + t0
+		p.double(t1, u)
+		p.double(t1, u)
+		p.ops.add(t1, x[3], t1, u) // t4 = 4t1 + t3
+		p.double(t0, u)
+		p.double(t0, u)
+		p.ops.add(t0, x[1], t0, u) // t5 = 4t0 + t2
+		p.ops.add(x[3], t0, x[0], u)
+		p.ops.add(x[1], t1, x[2], u)
+		p.f.WriteLn(fmt.Sprintf("    VMOVDQA64 %s, %s", t0, x[1]))
+		p.f.WriteLn(fmt.Sprintf("    VMOVDQA64 %s, %s", t1, x[3]))
+		return
+	}
+
+	// (2 3 1 1)
+	// (1 2 3 1)
+	// (1 1 2 3)
+	// (3 1 1 2)
+	t01, t23, a, b, u := t[0], t[1], t[2], t[3], t[4:]
+	p.ops.add(x[0], x[1], t01, u)
+	p.ops.add(x[2], x[3], t23, u)
+	p.ops.add(t01, t23, a, u)
+	p.ops.add(a, x[3], b, u) // x0 + x1 + x2 + 2x3
+	p.ops.add(a, x[1], a, u) // x0 + 2x1 + x2 + x3
+	p.double(x[0], u)
+	p.ops.add(b, x[0], x[3], u)
+	p.double(x[2], u)
+	p.ops.add(a, x[2], x[1], u)
+	p.ops.add(a, t01, x[0], u)
+	p.ops.add(b, t23, x[2], u)
+}
+
+// matMulExternal multiplies x by the external matrix circ(2M4, M4, ..., M4)
+func (p *poseidon2ASM) matMulExternal(x []amd64.Register, t []amd64.Register) {
+	for i := 0; i < len(x); i += 4 {
+		p.matMulM4(x[i:i+4], t)
+	}
+	s, u := t[:4], t[4:]
+	for j := 0; j < 4; j++ {
+		p.ops.add(x[j], x[4+j], s[j], u)
+		for i := 8 + j; i < len(x); i += 4 {
+			p.ops.add(s[j], x[i], s[j], u)
+		}
+	}
+	for i := range x {
+		p.ops.add(x[i], s[i%4], x[i], u)
+	}
+}
+
+// matMulInternal multiplies x by the internal matrix 1 + diag
+func (p *poseidon2ASM) matMulInternal(x []amd64.Register, diag amd64.Register, t []amd64.Register) {
+	sum, d, u := t[0], t[1], t[2:]
+	p.ops.add(x[0], x[1], sum, u)
+	for i := 2; i < len(x); i++ {
+		p.ops.add(sum, x[i], sum, u)
+	}
+	size := p.ops.elementSize()
+	for i := range x {
+		p.ops.broadcast(offset(diag, i*size), d)
+		p.ops.mul(x[i], d, x[i], true, u)
+		p.ops.add(x[i], sum, x[i], u)
+	}
+}
+
+// poseidon2F31 are the operations on 16 elements of a 31-bit field in
+// Montgomery form, held in the 32-bit lanes
+type poseidon2F31 struct {
+	f     *FFAmd64
+	q, mu uint64
+}
+
+func newPoseidon2F31(f *FFAmd64, q uint64) poseidon2F31 {
+	// mu = q⁻¹ mod 2³²
+	mu := new(big.Int).ModInverse(new(big.Int).SetUint64(q), new(big.Int).Lsh(big.NewInt(1), 32))
+	return poseidon2F31{f: f, q: q, mu: mu.Uint64()}
+}
+
+const (
+	f31Q     = amd64.Register("Z31")
+	f31Mu    = amd64.Register("Z30")
+	f31Evens = "K3"
+)
+
+func (o poseidon2F31) op(instruction string, operands ...any) {
+	vecGoldilocks{f: o.f}.op(instruction, operands...)
+}
+
+func (o poseidon2F31) elementSize() int { return 4 }
+func (o poseidon2F31) nbConstants() int { return 2 }
+
+func (o poseidon2F31) loadConstants(tmp amd64.Register) {
+	o.f.MOVQ(fmt.Sprintf("$%#x", o.q), tmp)
+	o.op("VPBROADCASTD", tmp, f31Q)
+	o.f.MOVQ(fmt.Sprintf("$%#x", o.mu), tmp)
+	o.op("VPBROADCASTD", tmp, f31Mu)
+	o.f.MOVQ("$0x5555", tmp)
+	o.op("KMOVW", tmp, f31Evens)
+}
+
+func (o poseidon2F31) load(addr string, dst amd64.Register)  { o.op("VMOVDQU32", addr, dst) }
+func (o poseidon2F31) store(src amd64.Register, addr string) { o.op("VMOVDQU32", src, addr) }
+
+func (o poseidon2F31) broadcast(addr string, dst amd64.Register) {
+	o.op("VPBROADCASTD", addr, dst)
+}
+
+// add sets dst = min(a + b, a + b - q), using 1 temporary register
+func (o poseidon2F31) add(a, b, dst amd64.Register, t []amd64.Register) {
+	o.op("VPADDD", b, a, dst)
+	o.op("VPSUBD", f31Q, dst, t[0])
+	o.op("VPMINUD", t[0], dst, dst)
+}
+
+// mul sets dst = a * b in Montgomery form, using 4 temporary registers.
+//
+// The products of the even and odd lanes are computed separately on 64 bits;
+// with m = lo(P)·q⁻¹ mod 2³², the high word of P - m·q is hi(P) - hi(m·q),
+// which is in (-q, q) and is reduced with a minimum as in add.
+func (o poseidon2F31) mul(a, b, dst amd64.Register, isBroadcast bool, t []amd64.Register) {
+	aOdd, bOdd, pEven, mEven := t[0], t[1], t[2], t[3]
+	o.op("VMOVSHDUP", a, aOdd)
+	if isBroadcast {
+		bOdd = b
+	} else {
+		o.op("VMOVSHDUP", b, bOdd)
+	}
+	o.op("VPMULUDQ", b, a, pEven)
+	pOdd := aOdd
+	o.op("VPMULUDQ", bOdd, aOdd, pOdd)
+	o.op("VPMULUDQ", f31Mu, pEven, mEven)
+	o.op("VPMULUDQ", f31Q, mEven, mEven)
+	mOdd := t[1]
+	o.op("VPMULUDQ", f31Mu, pOdd, mOdd)
+	o.op("VPMULUDQ", f31Q, mOdd, mOdd)
+	o.op("VPSUBQ", mEven, pEven, pEven)
+	o.op("VPSUBQ", mOdd, pOdd, pOdd)
+	// the even lanes of the result are the high words of pEven
+	o.op("VMOVSHDUP", pEven, f31Evens, pOdd)
+	o.op("VPADDD", f31Q, pOdd, mOdd)
+	o.op("VPMINUD", mOdd, pOdd, dst)
+}
+
+// poseidon2Goldilocks are the operations on 8 goldilocks elements
+type poseidon2Goldilocks struct {
+	v vecGoldilocks
+	c vecConstants
+}
+
+func (o poseidon2Goldilocks) elementSize() int { return 8 }
+func (o poseidon2Goldilocks) nbConstants() int { return 3 }
+
+func (o poseidon2Goldilocks) loadConstants(tmp amd64.Register) {
+	o.v.loadConstants(tmp)
+}
+
+func (o poseidon2Goldilocks) constants() vecConstants {
+	return vecConstants{q: o.v.r(31), lo32: o.v.r(30), aux: o.v.r(29)}
+}
+
+func (o poseidon2Goldilocks) load(addr string, dst amd64.Register)  { o.v.load(addr, dst) }
+func (o poseidon2Goldilocks) store(src amd64.Register, addr string) { o.v.store(src, addr) }
+
+func (o poseidon2Goldilocks) broadcast(addr string, dst amd64.Register) {
+	o.v.op("VPBROADCASTQ", addr, dst)
+}
+
+func (o poseidon2Goldilocks) add(a, b, dst amd64.Register, t []amd64.Register) {
+	o.v.add(o.constants(), a, b, dst, [3]amd64.Register{t[0], t[1], t[2]})
+}
+
+func (o poseidon2Goldilocks) mul(a, b, dst amd64.Register, _ bool, t []amd64.Register) {
+	o.v.mul(o.constants(), a, b, dst, [5]amd64.Register{t[0], t[1], t[2], t[3], t[4]})
+}
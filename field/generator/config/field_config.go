@@ -65,6 +65,10 @@ type Field struct {
 	Word Word // 32 iff Q < 2^32, else 64
 	F31  bool // 31 bits field
 
+	// Goldilocks is set for q = 2⁶⁴ - 2³² + 1, whose special form enables a
+	// Montgomery reduction without multiplications
+	Goldilocks bool
+
 	// asm code generation
 	GenerateOpsAMD64       bool
 	GenerateOpsARM64       bool
@@ -107,6 +111,7 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	// we could do uint32 bit size for all fields with NbBits <= 31, but we keep it as is for now
 	// to avoid breaking changes
 	F.F31 = F.ModulusHex == "7f000001" || F.ModulusHex == "78000001" // F.NbBits <= 31
+	F.Goldilocks = F.ModulusHex == "ffffffff00000001"
 	F.NbWords = len(bModulus.Bits())
 	F.NbWordsLastIndex = F.NbWords - 1
 
@@ -291,14 +296,14 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	// note: to simplify output files generated, we generated ASM code only for
 	// moduli that meet the condition F.NoCarry
 	// asm code generation for moduli with more than 6 words can be optimized further
-	F.GenerateOpsAMD64 = F.F31 || (F.NoCarry && F.NbWords <= 12 && F.NbWords > 1)
+	F.GenerateOpsAMD64 = F.F31 || F.Goldilocks || (F.NoCarry && F.NbWords <= 12 && F.NbWords > 1)
 	if F.NbWords == 4 && F.GenerateOpsAMD64 && F.NbBits <= 225 {
 		// 4 words field with 225 bits or less have no vector ops
 		// for now since we generate both in same file we disable
 		// TODO @gbotrel
 		F.GenerateOpsAMD64 = false
 	}
-	F.GenerateVectorOpsAMD64 = F.F31 || (F.GenerateOpsAMD64 && F.NbWords == 4 && F.NbBits > 225)
+	F.GenerateVectorOpsAMD64 = F.F31 || F.Goldilocks || (F.GenerateOpsAMD64 && F.NbWords == 4 && F.NbBits > 225)
 	F.GenerateOpsARM64 = F.F31 || (F.GenerateOpsAMD64 && (F.NbWords%2 == 0))
 	F.GenerateVectorOpsARM64 = F.F31
 
@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -45,7 +46,7 @@ func GenerateFF(F *config.Field, outputDir string, options ...Option) error {
 
 	// generate fft
 	if cfg.HasFFT() {
-		if err := generateFFT(F, cfg.fftConfig, outputDir); err != nil {
+		if err := generateFFT(F, cfg.fftConfig, outputDir, cfg.HasAMD64()); err != nil {
 			return err
 		}
 	}
@@ -57,6 +58,47 @@ func GenerateFF(F *config.Field, outputDir string, options ...Option) error {
 		}
 	}
 
+	// generate Merkle tree
+	if cfg.HasMerkleTree() {
+		if err := generateMerkleTree(F, outputDir); err != nil {
+			return err
+		}
+	}
+
+	// generate Poseidon2
+	if cfg.HasPoseidon2() {
+		if err := generatePoseidon2(F, outputDir, cfg.HasAMD64()); err != nil {
+			return err
+		}
+	}
+
+	// generate extensions
+	if cfg.HasExtension() {
+		if err := generateExtensions(F, cfg.extension, outputDir); err != nil {
+			return err
+		}
+	}
+
+	// generate FRI
+	if cfg.HasFRI() {
+		if !cfg.HasFFT() || !cfg.HasExtension() {
+			return errors.New("FRI requires the FFT and the extension")
+		}
+		if err := generateFRI(F, cfg.extension, outputDir); err != nil {
+			return err
+		}
+	}
+
+	// generate STARK
+	if cfg.HasSTARK() {
+		if !cfg.HasFRI() {
+			return errors.New("STARK requires FRI")
+		}
+		if err := generateSTARK(F, cfg.extension, outputDir); err != nil {
+			return err
+		}
+	}
+
 	return runFormatters(outputDir)
 }
 
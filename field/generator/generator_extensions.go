@@ -0,0 +1,109 @@
+package generator
+
+import (
+	"errors"
+	"math/big"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+func generateExtensions(F *config.Field, ext *config.Extension, outputDir string) error {
+
+	fieldImportPath, err := getImportPath(outputDir)
+	if err != nil {
+		return err
+	}
+
+	outputDir = filepath.Join(outputDir, "extensions")
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(outputDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(outputDir, "e2.go"), Templates: []string{"e2.go.tmpl"}},
+		{File: filepath.Join(outputDir, "extensions_test.go"), Templates: []string{"extensions.test.go.tmpl"}},
+		{File: filepath.Join(outputDir, "vector.go"), Templates: []string{"vector.go.tmpl"}},
+		{File: filepath.Join(outputDir, "vector_test.go"), Templates: []string{"vector.test.go.tmpl"}},
+	}
+
+	type extensionsTemplateData struct {
+		FF               string
+		FieldPackagePath string
+		Package          string
+
+		// Degree of the largest extension, E2 or E4 = E2[v]/(v² - u)
+		Degree int
+
+		// RootOf u² = RootOf in E2
+		RootOf int64
+
+		// Ext largest extension, element of the Vector
+		Ext string
+
+		// FrobeniusE4 vᵖ = FrobeniusE4·v
+		FrobeniusE4 string
+
+		// E3 = Fp[w]/(w³ - CubicRootOf), generated alongside E2 when p ≡ 1 mod 3,
+		// with wᵖ = FrobeniusE3[0]·w and w²ᵖ = FrobeniusE3[1]·w²
+		HasE3       bool
+		CubicRootOf int64
+		FrobeniusE3 [2]string
+	}
+
+	data := &extensionsTemplateData{
+		FF:               F.PackageName,
+		FieldPackagePath: fieldImportPath,
+		Package:          "extensions",
+		Degree:           ext.Degree,
+		RootOf:           ext.RootOf,
+		Ext:              "E2",
+	}
+
+	p := F.ModulusBig
+	pMinusOne := new(big.Int).Sub(p, big.NewInt(1))
+	switch ext.Degree {
+	case 2:
+		// E3, with the smallest cubic non-residue
+		var r big.Int
+		if r.Mod(pMinusOne, big.NewInt(3)).Sign() == 0 {
+			e := new(big.Int).Div(pMinusOne, big.NewInt(3))
+			for c := int64(2); ; c++ {
+				zeta := new(big.Int).Exp(big.NewInt(c), e, p)
+				if zeta.Cmp(big.NewInt(1)) != 0 {
+					data.HasE3 = true
+					data.CubicRootOf = c
+					data.FrobeniusE3[0] = zeta.String()
+					data.FrobeniusE3[1] = zeta.Exp(zeta, big.NewInt(2), p).String()
+					break
+				}
+			}
+			entries = append(entries, bavard.Entry{File: filepath.Join(outputDir, "e3.go"), Templates: []string{"e3.go.tmpl"}})
+		}
+	case 4:
+		// vᵖ = v·RootOf^((p-1)/4)
+		var r big.Int
+		if r.Mod(pMinusOne, big.NewInt(4)).Sign() != 0 {
+			return errors.New("E4 requires p ≡ 1 mod 4")
+		}
+		e := new(big.Int).Div(pMinusOne, big.NewInt(4))
+		data.FrobeniusE4 = new(big.Int).Exp(big.NewInt(ext.RootOf), e, p).String()
+		data.Ext = "E4"
+		entries = append(entries, bavard.Entry{File: filepath.Join(outputDir, "e4.go"), Templates: []string{"e4.go.tmpl"}})
+	default:
+		return errors.New("unsupported extension degree")
+	}
+
+	bgen := bavard.NewBatchGenerator("Consensys Software Inc.", 2020, "consensys/gnark-crypto")
+
+	extensionsTemplatesRootDir, err := findTemplatesRootDir()
+	if err != nil {
+		return err
+	}
+	extensionsTemplatesRootDir = filepath.Join(extensionsTemplatesRootDir, "extensions")
+
+	if err := bgen.Generate(data, data.Package, extensionsTemplatesRootDir, entries...); err != nil {
+		return err
+	}
+
+	return runFormatters(outputDir)
+}
@@ -9,11 +9,12 @@ import (
 	"strings"
 
 	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/asm/amd64"
 	"github.com/consensys/gnark-crypto/field/generator/config"
 	eccconfig "github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
-func generateFFT(F *config.Field, fft *config.FFT, outputDir string) error {
+func generateFFT(F *config.Field, fft *config.FFT, outputDir string, withAMD64 bool) error {
 
 	if fft.GeneratorFullMultiplicativeGroup == 0 || fft.GeneratorMaxTwoAdicSubgroup == "" {
 		// try to populate ourselves
@@ -34,6 +35,7 @@ func generateFFT(F *config.Field, fft *config.FFT, outputDir string) error {
 		FieldPackagePath: fieldImportPath,
 		FF:               F.PackageName,
 		Package:          "fft",
+		KernelsAMD64:     withAMD64 && F.Goldilocks,
 	}
 	outputDir = filepath.Join(outputDir, "fft")
 
@@ -48,6 +50,14 @@ func generateFFT(F *config.Field, fft *config.FFT, outputDir string) error {
 		{File: filepath.Join(outputDir, "options.go"), Templates: []string{"options.go.tmpl"}},
 	}
 
+	if data.KernelsAMD64 {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(outputDir, "kernel_amd64.go"), Templates: []string{"kernel_amd64.go.tmpl"}, BuildTag: "!purego"},
+			bavard.Entry{File: filepath.Join(outputDir, "kernel_purego.go"), Templates: []string{"kernel_purego.go.tmpl"}, BuildTag: "purego || (!amd64)"},
+			bavard.Entry{File: filepath.Join(outputDir, "kernel_amd64_test.go"), Templates: []string{"tests/kernel_amd64.go.tmpl"}, BuildTag: "!purego"},
+		)
+	}
+
 	funcs := make(map[string]interface{})
 	funcs["bitReverse"] = bitReverse
 	funcs["reverseBits"] = func(x, n any) uint64 {
@@ -74,6 +84,12 @@ func generateFFT(F *config.Field, fft *config.FFT, outputDir string) error {
 		return err
 	}
 
+	if data.KernelsAMD64 {
+		if err := generateFFTKernelsAMD64(outputDir); err != nil {
+			return err
+		}
+	}
+
 	// put the generator in the parent dir (fr)
 	// TODO this should be in goff
 	entries = []bavard.Entry{
@@ -99,6 +115,30 @@ type fftTemplateData struct {
 
 	// FF the name of the package corresponding to the finite field
 	FF string
+
+	// KernelsAMD64 is set when the FFT kernels have an amd64 implementation
+	KernelsAMD64 bool
+}
+
+// generateFFTKernelsAMD64 generates the assembly of the FFT kernels in the fft package
+func generateFFTKernelsAMD64(outputDir string) error {
+	pathSrc := filepath.Join(outputDir, "kernel_amd64.s")
+	fmt.Println("generating", pathSrc)
+	f, err := os.Create(pathSrc)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprint(f, "//go:build !purego\n\n"); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := amd64.GenerateFFTGoldilocksASM(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	_ = f.Close()
+
+	return runASMFormatter(pathSrc)
 }
 
 func findTemplatesRootDir() (string, error) {
@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+func generateFRI(F *config.Field, ext *config.Extension, outputDir string) error {
+
+	fieldImportPath, err := getImportPath(outputDir)
+	if err != nil {
+		return err
+	}
+
+	outputDir = filepath.Join(outputDir, "fri")
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(outputDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(outputDir, "config.go"), Templates: []string{"config.go.tmpl"}},
+		{File: filepath.Join(outputDir, "fri.go"), Templates: []string{"fri.go.tmpl"}},
+		{File: filepath.Join(outputDir, "fri_test.go"), Templates: []string{"fri.test.go.tmpl"}},
+		{File: filepath.Join(outputDir, "merkle.go"), Templates: []string{"merkle.go.tmpl"}},
+	}
+
+	type friTemplateData struct {
+		FF               string
+		FieldPackagePath string
+		Package          string
+		NbBits           int
+
+		// Ext extension of the challenges and of the folded codewords, of degree
+		// ExtDegree
+		Ext       string
+		ExtDegree int
+	}
+
+	data := &friTemplateData{
+		FF:               F.PackageName,
+		FieldPackagePath: fieldImportPath,
+		Package:          "fri",
+		NbBits:           F.NbBits,
+		Ext:              "E2",
+		ExtDegree:        ext.Degree,
+	}
+	if ext.Degree == 4 {
+		data.Ext = "E4"
+	}
+
+	bgen := bavard.NewBatchGenerator("Consensys Software Inc.", 2020, "consensys/gnark-crypto")
+
+	friTemplatesRootDir, err := findTemplatesRootDir()
+	if err != nil {
+		return err
+	}
+	friTemplatesRootDir = filepath.Join(friTemplatesRootDir, "fri")
+
+	if err := bgen.Generate(data, data.Package, friTemplatesRootDir, entries...); err != nil {
+		return err
+	}
+
+	return runFormatters(outputDir)
+}
@@ -0,0 +1,63 @@
+package generator
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+func generateMerkleTree(F *config.Field, outputDir string) error {
+
+	fieldImportPath, err := getImportPath(outputDir)
+	if err != nil {
+		return err
+	}
+
+	outputDir = filepath.Join(outputDir, "merkletree")
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(outputDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(outputDir, "merkletree.go"), Templates: []string{"merkletree.go.tmpl"}},
+		{File: filepath.Join(outputDir, "merkletree_test.go"), Templates: []string{"merkletree.test.go.tmpl"}},
+		{File: filepath.Join(outputDir, "compression.go"), Templates: []string{"compression.go.tmpl"}},
+		{File: filepath.Join(outputDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+	}
+
+	type merkleTreeTemplateData struct {
+		FF               string
+		FieldPackagePath string
+		Package          string
+
+		// DigestSize number of field elements of a digest, so that a digest
+		// has at least 248 bits
+		DigestSize int
+
+		// HasHashes true if the field has the mimc and poseidon2 packages, that
+		// is, if it is the scalar field of a curve
+		HasHashes bool
+	}
+
+	data := &merkleTreeTemplateData{
+		FF:               F.PackageName,
+		FieldPackagePath: fieldImportPath,
+		Package:          "merkletree",
+		DigestSize:       (248 + F.NbBits - 1) / F.NbBits,
+		HasHashes:        strings.HasSuffix(fieldImportPath, "/fr"),
+	}
+
+	bgen := bavard.NewBatchGenerator("Consensys Software Inc.", 2020, "consensys/gnark-crypto")
+
+	merkleTreeTemplatesRootDir, err := findTemplatesRootDir()
+	if err != nil {
+		return err
+	}
+	merkleTreeTemplatesRootDir = filepath.Join(merkleTreeTemplatesRootDir, "merkletree")
+
+	if err := bgen.Generate(data, data.Package, merkleTreeTemplatesRootDir, entries...); err != nil {
+		return err
+	}
+
+	return runFormatters(outputDir)
+}
@@ -0,0 +1,356 @@
+package generator
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/asm/amd64"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+// poseidon2Config parameters of the Poseidon2 permutations of a field
+type poseidon2Config struct {
+	// SBoxDegree degree of the power map of the S-box
+	SBoxDegree int
+
+	// HLExternalMatrix is set when the 4x4 block of the external matrix is the
+	// one of the Poseidon2 paper, instead of circ(2, 3, 1, 1)
+	HLExternalMatrix bool
+
+	Instances []poseidon2Instance
+}
+
+// poseidon2Instance parameters of the permutation of a given width
+type poseidon2Instance struct {
+	Width           int
+	NbFullRounds    int
+	NbPartialRounds int
+
+	// Diag diagonal V of the internal matrix 1 + Diag(V)
+	Diag []*big.Int
+
+	// TestVector permutation of (0, 1, ..., Width-1), used as a regression
+	// vector by the generated tests
+	TestVector []string
+}
+
+// poseidon2TemplateInstance is a poseidon2Instance with the round keys and
+// the constants formatted for the templates
+type poseidon2TemplateInstance struct {
+	Width           int
+	NbFullRounds    int
+	NbPartialRounds int
+	Diag            []string
+	RoundKeys       []string
+	TestVector      []string
+}
+
+func generatePoseidon2(F *config.Field, outputDir string, withAMD64 bool) error {
+	cfg, ok := poseidon2Configs[F.ModulusHex]
+	if !ok {
+		return fmt.Errorf("no poseidon2 parameters for modulus %s", F.Modulus)
+	}
+
+	fieldImportPath, err := getImportPath(outputDir)
+	if err != nil {
+		return err
+	}
+
+	outputDir = filepath.Join(outputDir, "poseidon2")
+
+	type poseidon2TemplateData struct {
+		FF               string
+		FieldPackagePath string
+		Package          string
+
+		// F31 is set for the fields on 31 bits, and Goldilocks for q = 2⁶⁴ - 2³² + 1
+		F31, Goldilocks bool
+
+		SBoxDegree       int
+		HLExternalMatrix bool
+
+		// DigestSize number of field elements of a digest, so that a digest
+		// has at least 248 bits
+		DigestSize int
+
+		// Word type of the word of an element, uint32 or uint64
+		Word string
+
+		// Lanes number of states permuted at once with AVX-512
+		Lanes int
+
+		// HasAMD64 is set when the permutations have an AVX-512 implementation
+		HasAMD64 bool
+
+		Instances []poseidon2TemplateInstance
+	}
+
+	data := &poseidon2TemplateData{
+		FF:               F.PackageName,
+		FieldPackagePath: fieldImportPath,
+		Package:          "poseidon2",
+		F31:              F.F31,
+		Goldilocks:       F.Goldilocks,
+		SBoxDegree:       cfg.SBoxDegree,
+		HLExternalMatrix: cfg.HLExternalMatrix,
+		DigestSize:       (248 + F.NbBits - 1) / F.NbBits,
+		Word:             F.Word.TypeLower,
+		Lanes:            8,
+		HasAMD64:         withAMD64,
+	}
+	if F.F31 {
+		data.Lanes = 16
+	}
+
+	format := func(x *big.Int) string {
+		if F.F31 {
+			return fmt.Sprintf("0x%08x", x)
+		}
+		return fmt.Sprintf("0x%016x", x)
+	}
+	for _, instance := range cfg.Instances {
+		roundKeys := grainRoundConstants(F.ModulusBig, F.NbBits, instance.Width, instance.NbFullRounds, instance.NbPartialRounds)
+		t := poseidon2TemplateInstance{
+			Width:           instance.Width,
+			NbFullRounds:    instance.NbFullRounds,
+			NbPartialRounds: instance.NbPartialRounds,
+			TestVector:      instance.TestVector,
+		}
+		for _, d := range instance.Diag {
+			t.Diag = append(t.Diag, format(d))
+		}
+		for _, rk := range roundKeys {
+			t.RoundKeys = append(t.RoundKeys, format(rk))
+		}
+		data.Instances = append(data.Instances, t)
+	}
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(outputDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(outputDir, "poseidon2.go"), Templates: []string{"poseidon2.go.tmpl"}},
+		{File: filepath.Join(outputDir, "constants.go"), Templates: []string{"constants.go.tmpl"}},
+		{File: filepath.Join(outputDir, "sponge.go"), Templates: []string{"sponge.go.tmpl"}},
+		{File: filepath.Join(outputDir, "poseidon2_test.go"), Templates: []string{"poseidon2.test.go.tmpl"}},
+	}
+	if withAMD64 {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(outputDir, "poseidon2_amd64.go"), Templates: []string{"poseidon2_amd64.go.tmpl"}, BuildTag: "!purego"},
+			bavard.Entry{File: filepath.Join(outputDir, "poseidon2_purego.go"), Templates: []string{"poseidon2_purego.go.tmpl"}, BuildTag: "purego || !amd64"},
+		)
+	} else {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(outputDir, "poseidon2_purego.go"), Templates: []string{"poseidon2_purego.go.tmpl"}},
+		)
+	}
+
+	bgen := bavard.NewBatchGenerator("Consensys Software Inc.", 2020, "consensys/gnark-crypto")
+
+	poseidon2TemplatesRootDir, err := findTemplatesRootDir()
+	if err != nil {
+		return err
+	}
+	poseidon2TemplatesRootDir = filepath.Join(poseidon2TemplatesRootDir, "poseidon2")
+
+	if err := bgen.Generate(data, data.Package, poseidon2TemplatesRootDir, entries...); err != nil {
+		return err
+	}
+
+	if withAMD64 {
+		params := amd64.Poseidon2{
+			Q:                F.ModulusBig.Uint64(),
+			F31:              F.F31,
+			SBoxDegree:       cfg.SBoxDegree,
+			HLExternalMatrix: cfg.HLExternalMatrix,
+		}
+		for _, instance := range cfg.Instances {
+			params.Instances = append(params.Instances, amd64.Poseidon2Instance{
+				Width:           instance.Width,
+				NbFullRounds:    instance.NbFullRounds,
+				NbPartialRounds: instance.NbPartialRounds,
+			})
+		}
+		if err := generatePoseidon2AMD64(outputDir, params); err != nil {
+			return err
+		}
+	}
+
+	return runFormatters(outputDir)
+}
+
+// generatePoseidon2AMD64 generates the assembly of the permutations in the
+// poseidon2 package
+func generatePoseidon2AMD64(outputDir string, params amd64.Poseidon2) error {
+	pathSrc := filepath.Join(outputDir, "poseidon2_amd64.s")
+	fmt.Println("generating", pathSrc)
+	f, err := os.Create(pathSrc)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprint(f, "//go:build !purego\n\n"); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := amd64.GeneratePoseidon2ASM(f, params); err != nil {
+		_ = f.Close()
+		return err
+	}
+	_ = f.Close()
+
+	return runASMFormatter(pathSrc)
+}
+
+// grainRoundConstants returns the nbFullRounds·width + nbPartialRounds round
+// constants of Poseidon2, in the order they are used, drawn from the Grain
+// LFSR as in the reference implementation
+// https://github.com/HorizenLabs/poseidon2/blob/main/poseidon2_rust_params.sage.
+func grainRoundConstants(q *big.Int, nbBits, width, nbFullRounds, nbPartialRounds int) []*big.Int {
+	// the state is initialized with the parameters: field GF(p) (1 on 2 bits),
+	// S-box x^α (0 on 4 bits), the field size, the width and the numbers of
+	// rounds, followed by ones
+	var state []uint8
+	appendBits := func(x, n int) {
+		for i := n - 1; i >= 0; i-- {
+			state = append(state, uint8(x>>i)&1)
+		}
+	}
+	appendBits(1, 2)
+	appendBits(0, 4)
+	appendBits(nbBits, 12)
+	appendBits(width, 12)
+	appendBits(nbFullRounds, 10)
+	appendBits(nbPartialRounds, 10)
+	for i := 0; i < 30; i++ {
+		state = append(state, 1)
+	}
+
+	step := func() uint8 {
+		b := state[62] ^ state[51] ^ state[38] ^ state[23] ^ state[13] ^ state[0]
+		state = append(state[1:], b)
+		return b
+	}
+	for i := 0; i < 160; i++ {
+		step()
+	}
+	// the output bits are the second bits of the pairs whose first bit is 1
+	nextBit := func() uint8 {
+		for step() == 0 {
+			step()
+		}
+		return step()
+	}
+
+	res := make([]*big.Int, nbFullRounds*width+nbPartialRounds)
+	for i := range res {
+		res[i] = new(big.Int)
+		for {
+			res[i].SetUint64(0)
+			for j := 0; j < nbBits; j++ {
+				res[i].Lsh(res[i], 1)
+				res[i].SetBit(res[i], 0, uint(nextBit()))
+			}
+			if res[i].Cmp(q) < 0 {
+				break
+			}
+		}
+	}
+	return res
+}
+
+var poseidon2Configs map[string]poseidon2Config
+
+func init() {
+	poseidon2Configs = make(map[string]poseidon2Config)
+
+	// dyadic returns the vector of the elements n/2^k, for the pairs (n, k)
+	dyadic := func(q *big.Int, pairs ...int64) []*big.Int {
+		res := make([]*big.Int, 0, len(pairs)/2)
+		for i := 0; i < len(pairs); i += 2 {
+			d := new(big.Int).Lsh(big.NewInt(1), uint(pairs[i+1]))
+			d.ModInverse(d, q)
+			d.Mul(d, big.NewInt(pairs[i])).Mod(d, q)
+			res = append(res, d)
+		}
+		return res
+	}
+	hexes := func(values ...string) []*big.Int {
+		res := make([]*big.Int, len(values))
+		for i, v := range values {
+			res[i], _ = new(big.Int).SetString(v, 0)
+		}
+		return res
+	}
+
+	// the vectors V of the internal matrices of the 31-bit fields start with
+	// [-2, 1, 2, 1/2, 3, 4, -1/2, -3, -4]
+	small := []int64{-2, 0, 1, 0, 2, 0, 1, 1, 3, 0, 4, 0, -1, 1, -3, 0, -4, 0}
+
+	// koala bear
+	q, _ := new(big.Int).SetString("2130706433", 10)
+	poseidon2Configs["7f000001"] = poseidon2Config{
+		SBoxDegree: 3,
+		Instances: []poseidon2Instance{
+			{
+				Width: 16, NbFullRounds: 8, NbPartialRounds: 20,
+				// 1/2^8, 1/8, 1/2^24, -1/2^8, -1/8, -1/16, -1/2^24
+				Diag:       dyadic(q, append(small, 1, 8, 1, 3, 1, 24, -1, 8, -1, 3, -1, 4, -1, 24)...),
+				TestVector: []string{"0x4b134812", "0x278ba7f8", "0x76944b51", "0x1c672bb2", "0x31dfa6bb", "0x6b7e9d79", "0x4945e876", "0x78a321a8", "0x7a73bc0a", "0x5cc8abbc", "0x56beb8c0", "0x57b4c9a2", "0x193b9df3", "0x15194d64", "0x47ba9576", "0x5eeccb64"},
+			},
+			{
+				Width: 24, NbFullRounds: 8, NbPartialRounds: 23,
+				// 1/2^8, 1/4, 1/8, 1/16, 1/32, 1/64, 1/2^24,
+				// -1/2^8, -1/8, -1/16, -1/32, -1/64, -1/2^7, -1/2^9, -1/2^24
+				Diag:       dyadic(q, append(small, 1, 8, 1, 2, 1, 3, 1, 4, 1, 5, 1, 6, 1, 24, -1, 8, -1, 3, -1, 4, -1, 5, -1, 6, -1, 7, -1, 9, -1, 24)...),
+				TestVector: []string{"0x2b1fe9b9", "0x05318423", "0x22fdb71d", "0x4edd9967", "0x389e988d", "0x7ad0a4a9", "0x0dfd9b0e", "0x31c3ab07", "0x1248a877", "0x698f9feb", "0x139f736e", "0x311a77bd", "0x2da0b618", "0x564e7762", "0x24174999", "0x0ebecfc3", "0x48e690f4", "0x1523b3a6", "0x0dead494", "0x7baffbc5", "0x74e5677e", "0x12af618e", "0x65717eaa", "0x6c39c16a"},
+			},
+		},
+	}
+
+	// baby bear
+	q, _ = new(big.Int).SetString("2013265921", 10)
+	poseidon2Configs["78000001"] = poseidon2Config{
+		SBoxDegree: 7,
+		Instances: []poseidon2Instance{
+			{
+				Width: 16, NbFullRounds: 8, NbPartialRounds: 13,
+				// 1/2^8, 1/4, 1/8, 1/2^27, -1/2^8, -1/16, -1/2^27
+				Diag:       dyadic(q, append(small, 1, 8, 1, 2, 1, 3, 1, 27, -1, 8, -1, 4, -1, 27)...),
+				TestVector: []string{"0x71a73fe7", "0x6788eb7b", "0x74cf6669", "0x29be1dc4", "0x61a2ab2d", "0x3ce48354", "0x66eb36b9", "0x68f8abb0", "0x5c87d280", "0x18dfc853", "0x0231831d", "0x486e0e38", "0x24a6fbd7", "0x4d87d14f", "0x390f6a6d", "0x122bbc33"},
+			},
+			{
+				Width: 24, NbFullRounds: 8, NbPartialRounds: 21,
+				// 1/2^8, 1/4, 1/8, 1/16, 1/2^7, 1/2^9, 1/2^27,
+				// -1/2^8, -1/4, -1/8, -1/16, -1/2^7, -1/2^9, -1/2^26, -1/2^27
+				Diag:       dyadic(q, append(small, 1, 8, 1, 2, 1, 3, 1, 4, 1, 7, 1, 9, 1, 27, -1, 8, -1, 2, -1, 3, -1, 4, -1, 7, -1, 9, -1, 26, -1, 27)...),
+				TestVector: []string{"0x44ab1f90", "0x00490a50", "0x4dfcfa18", "0x0d1b7488", "0x102cd7b2", "0x27085e38", "0x31e58371", "0x02836e46", "0x02d8c34d", "0x3b10d37b", "0x607584c9", "0x2ba1b0d5", "0x5d8bcb50", "0x2c2fe0bb", "0x445502a0", "0x6766221d", "0x231ef249", "0x48da476e", "0x4a19b917", "0x283f7d24", "0x29821b6a", "0x5a988d59", "0x3cf2e565", "0x77f0e609"},
+			},
+		},
+	}
+
+	// goldilocks: the instances of the Poseidon2 paper
+	poseidon2Configs["ffffffff00000001"] = poseidon2Config{
+		SBoxDegree:       7,
+		HLExternalMatrix: true,
+		Instances: []poseidon2Instance{
+			{
+				Width: 8, NbFullRounds: 8, NbPartialRounds: 22,
+				Diag: hexes(
+					"0xa98811a1fed4e3a5", "0x1cc48b54f377e2a0", "0xe40cd4f6c5609a26", "0x11de79ebca97a4a3",
+					"0x9177c73d8b7e929c", "0x2a6fe8085797e791", "0x3de6e93329f8d5ad", "0x3f7af9125da962fe",
+				),
+				TestVector: []string{"0xc5fb1cfe0b4697bb", "0x4a4a32ff849af473", "0xd2fd266077f8efba", "0xf4ad9b74e833916d", "0xe6648eb0acc11463", "0x8d5529a930d75194", "0xe8c993aa10da6c90", "0xa73104a95b68031c"},
+			},
+			{
+				Width: 12, NbFullRounds: 8, NbPartialRounds: 22,
+				Diag: hexes(
+					"0xc3b6c08e23ba9300", "0xd84b5de94a324fb6", "0x0d0c371c5b35b84f", "0x7964f570e7188037",
+					"0x5daf18bbd996604b", "0x6743bc47b9595257", "0x5528b9362c59bb70", "0xac45e25b7127b68b",
+					"0xa2077d7dfbb606b5", "0xf3faac6faee378ae", "0x0c6388b51545e883", "0xd27dbb6944917b60",
+				),
+				TestVector: []string{"0x01eaef96bdf1c0c1", "0x1f0d2cc525b2540c", "0x6282c1dfe1e0358d", "0xe780d721f698e1e6", "0x280c0b6f753d833b", "0x1b942dd5023156ab", "0x43f0df3fcccb8398", "0xe8e8190585489025", "0x56bdbf72f77ada22", "0x7911c32bf9dcd705", "0xec467926508fbe67", "0x6a50450ddf85a6ed"},
+			},
+		},
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package generator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrainRoundConstants(t *testing.T) {
+	assert := require.New(t)
+
+	// first round constant of the Goldilocks instance of width 8 of the
+	// reference implementation of HorizenLabs
+	q, _ := new(big.Int).SetString("ffffffff00000001", 16)
+	rc := grainRoundConstants(q, 64, 8, 8, 22)
+	assert.Len(rc, 8*8+22)
+	assert.Equal("dd5743e7f2a5a5d9", rc[0].Text(16))
+}
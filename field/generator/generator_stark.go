@@ -0,0 +1,62 @@
+package generator
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+func generateSTARK(F *config.Field, ext *config.Extension, outputDir string) error {
+
+	fieldImportPath, err := getImportPath(outputDir)
+	if err != nil {
+		return err
+	}
+
+	outputDir = filepath.Join(outputDir, "stark")
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(outputDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(outputDir, "air.go"), Templates: []string{"air.go.tmpl"}},
+		{File: filepath.Join(outputDir, "prover.go"), Templates: []string{"prover.go.tmpl"}},
+		{File: filepath.Join(outputDir, "verifier.go"), Templates: []string{"verifier.go.tmpl"}},
+		{File: filepath.Join(outputDir, "utils.go"), Templates: []string{"utils.go.tmpl"}},
+		{File: filepath.Join(outputDir, "stark_test.go"), Templates: []string{"stark.test.go.tmpl"}},
+	}
+
+	type starkTemplateData struct {
+		FF               string
+		FieldPackagePath string
+		Package          string
+
+		// Ext extension of the challenges, of degree ExtDegree
+		Ext       string
+		ExtDegree int
+	}
+
+	data := &starkTemplateData{
+		FF:               F.PackageName,
+		FieldPackagePath: fieldImportPath,
+		Package:          "stark",
+		Ext:              "E2",
+		ExtDegree:        ext.Degree,
+	}
+	if ext.Degree == 4 {
+		data.Ext = "E4"
+	}
+
+	bgen := bavard.NewBatchGenerator("Consensys Software Inc.", 2020, "consensys/gnark-crypto")
+
+	starkTemplatesRootDir, err := findTemplatesRootDir()
+	if err != nil {
+		return err
+	}
+	starkTemplatesRootDir = filepath.Join(starkTemplatesRootDir, "stark")
+
+	if err := bgen.Generate(data, data.Package, starkTemplatesRootDir, entries...); err != nil {
+		return err
+	}
+
+	return runFormatters(outputDir)
+}
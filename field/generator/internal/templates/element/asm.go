@@ -14,13 +14,20 @@ const Avx = `
 import 	"golang.org/x/sys/cpu"
 
 var (
-	supportAvx512 = {{- if not .F31 }}supportAdx && {{- end}}cpu.X86.HasAVX512 && cpu.X86.HasAVX512DQ
+	supportAvx512 = {{- if not (or .F31 .Goldilocks) }}supportAdx && {{- end}}cpu.X86.HasAVX512 && cpu.X86.HasAVX512DQ
 	_ = supportAvx512
+	{{- if .Goldilocks}}
+	supportAvx2 = cpu.X86.HasAVX2
+	_ = supportAvx2
+	{{- end}}
 )
 `
 
 const NoAvx = `
 const supportAvx512 = false
+{{- if .Goldilocks}}
+const supportAvx2 = false
+{{- end}}
 `
 
 // AsmNoAdx ...
@@ -579,7 +579,13 @@ func (z *{{.ElementName}}) Select(c int, x0 *{{.ElementName}}, x1 *{{.ElementNam
 	return z
 }
 
-{{- if ne .NbWords 1}}
+{{- if .Goldilocks}}
+// _mulGeneric is textbook Montgomery multiplication (REDC)
+// it is used for testing purposes.
+func _mulGeneric(z,x,y *{{.ElementName}}) {
+	{{ template "mul_cios_one_limb" dict "all" . "V1" "x" "V2" "y" }}
+}
+{{- else if ne .NbWords 1}}
 // _mulGeneric is unoptimized textbook CIOS
 // it is a fallback solution on x86 when ADX instruction set is not available
 // and is used for testing purposes.
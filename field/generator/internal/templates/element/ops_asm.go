@@ -118,6 +118,54 @@ func fromMont(z *{{.ElementName}} ) {
 func reduce(res *{{.ElementName}})
 `
 
+// OpsAMD64Goldilocks is included with AMD64 builds of the goldilocks field
+const OpsAMD64Goldilocks = `
+//go:noescape
+func mul(res,x,y *{{.ElementName}})
+
+// Butterfly sets
+//  a = a + b (mod q)
+//  b = a - b (mod q)
+//go:noescape
+func Butterfly(a, b *{{.ElementName}})
+
+// Mul z = x * y (mod q)
+func (z *{{.ElementName}}) Mul(x, y *{{.ElementName}}) *{{.ElementName}} {
+	// The Montgomery reduction of x * y = hi·2⁶⁴ + lo uses q⁻¹ = 1 + 2³² mod 2⁶⁴:
+	// m = lo·q⁻¹ is computed with a shift, and so is the high word of m·q
+	// since q = 2⁶⁴ - 2³² + 1. See _mulGeneric for the textbook algorithm.
+	mul(z, x, y)
+	return z
+}
+
+// Square z = x * x (mod q)
+func (z *{{.ElementName}}) Square(x *{{.ElementName}}) *{{.ElementName}} {
+	// see Mul for doc.
+	mul(z, x, x)
+	return z
+}
+
+{{ $mulConsts := list 3 5 13 }}
+{{- range $i := $mulConsts }}
+
+// MulBy{{$i}} x *= {{$i}} (mod q)
+func MulBy{{$i}}(x *{{$.ElementName}}) {
+	var y {{$.ElementName}}
+	y.SetUint64({{$i}})
+	x.Mul(x, &y)
+}
+
+{{- end}}
+
+func fromMont(z *{{.ElementName}} ) {
+	_fromMontGeneric(z)
+}
+
+func reduce(z *{{.ElementName}})  {
+	_reduceGeneric(z)
+}
+`
+
 const IncludeASM = `
 
 // We include the hash to force the Go compiler to recompile: {{.Hash}}
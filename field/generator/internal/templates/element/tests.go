@@ -730,7 +730,7 @@ func Test{{toTitle .ElementName}}LexicographicallyLargest(t *testing.T) {
 
 {{template "testBinaryOp" dict "all" . "Op" "Add"}}
 {{template "testBinaryOp" dict "all" . "Op" "Sub"}}
-{{- if ne .NbWords 1}}
+{{- if or (ne .NbWords 1) .Goldilocks}}
 {{template "testBinaryOp" dict "all" . "Op" "Mul" "GenericOp" "_mulGeneric"}}
 {{- else}}
 {{template "testBinaryOp" dict "all" . "Op" "Mul"}}
@@ -363,3 +363,116 @@ func genVector(size int) gopter.Gen {
 }
 
 `
+
+// TestVectorAMD64Goldilocks tests the assembly vector operations of the
+// goldilocks field against the generic ones
+const TestVectorAMD64Goldilocks = `
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// vectorKernels are the assembly vector operations, on blocks of 8 elements
+type vectorKernels struct {
+	add, sub, mul, scalarMul func(res, a, b *{{.ElementName}}, n uint64)
+	sum                      func(t, a *{{.ElementName}}, n uint64)
+	innerProd                func(t, a, b *{{.ElementName}}, n uint64)
+}
+
+// genEdgeVectors returns vectors a, b such that (a[i], b[i]) go through all the
+// pairs of values around the carries of the reduction, padded with random
+// values to a multiple of the block size
+func genEdgeVectors() (a, b Vector) {
+	edges := []uint64{
+		0, 1, 2,
+		1<<32 - 1, 1 << 32, 1<<32 + 1,
+		1<<63 - 1, 1 << 63,
+		q - 1<<32, q - 2, q - 1,
+	}
+	for _, x := range edges {
+		for _, y := range edges {
+			a = append(a, {{.ElementName}}{x})
+			b = append(b, {{.ElementName}}{y})
+		}
+	}
+	for len(a)%blockSize != 0 || len(a) < 4*blockSize {
+		var x, y {{.ElementName}}
+		x.SetRandom()
+		y.SetRandom()
+		a = append(a, x)
+		b = append(b, y)
+	}
+	return
+}
+
+func TestVectorAssembly(t *testing.T) {
+	isas := []struct {
+		name      string
+		supported bool
+		kernels   vectorKernels
+	}{
+		{"AVX-512", supportAvx512, vectorKernels{addVec, subVec, mulVec, scalarMulVec, sumVec, innerProdVec}},
+		{"AVX2", supportAvx2, vectorKernels{addVecAVX2, subVecAVX2, mulVecAVX2, scalarMulVecAVX2, sumVecAVX2, innerProdVecAVX2}},
+	}
+
+	for _, isa := range isas {
+		t.Run(isa.name, func(t *testing.T) {
+			if !isa.supported {
+				t.Skip("instruction set not supported")
+			}
+			assert := require.New(t)
+			k := isa.kernels
+
+			a, b := genEdgeVectors()
+			n := len(a)
+			nbBlocks := uint64(n / blockSize)
+			expected, got := make(Vector, n), make(Vector, n)
+
+			addVecGeneric(expected, a, b)
+			k.add(&got[0], &a[0], &b[0], nbBlocks)
+			assert.Equal(expected, got, "add")
+
+			subVecGeneric(expected, a, b)
+			k.sub(&got[0], &a[0], &b[0], nbBlocks)
+			assert.Equal(expected, got, "sub")
+
+			for i := range a {
+				_mulGeneric(&expected[i], &a[i], &b[i])
+			}
+			k.mul(&got[0], &a[0], &b[0], nbBlocks)
+			assert.Equal(expected, got, "mul")
+
+			for _, s := range b[:12] {
+				for i := range a {
+					_mulGeneric(&expected[i], &a[i], &s)
+				}
+				k.scalarMul(&got[0], &a[0], &s, nbBlocks)
+				assert.Equal(expected, got, "scalarMul by %s", s.String())
+			}
+
+			var partial [blockSize]{{.ElementName}}
+			var sum, expectedSum {{.ElementName}}
+			k.sum(&partial[0], &a[0], nbBlocks)
+			for i := range partial {
+				sum.Add(&sum, &partial[i])
+			}
+			sumVecGeneric(&expectedSum, a)
+			assert.Equal(expectedSum, sum, "sum")
+
+			var innerProd, expectedInnerProd {{.ElementName}}
+			k.innerProd(&partial[0], &a[0], &b[0], nbBlocks)
+			for i := range partial {
+				innerProd.Add(&innerProd, &partial[i])
+			}
+			for i := range a {
+				var tmp {{.ElementName}}
+				_mulGeneric(&tmp, &a[i], &b[i])
+				expectedInnerProd.Add(&expectedInnerProd, &tmp)
+			}
+			assert.Equal(expectedInnerProd, innerProd, "innerProd")
+		})
+	}
+}
+`
@@ -10,12 +10,39 @@ type generatorConfig struct {
 	fftConfig *config.FFT
 	asmConfig *config.Assembly
 	withSIS   bool
+
+	withMerkleTree bool
+	withPoseidon2  bool
+
+	extension *config.Extension
+	withFRI   bool
+	withSTARK bool
 }
 
 func (cfg *generatorConfig) HasSIS() bool {
 	return cfg.withSIS
 }
 
+func (cfg *generatorConfig) HasMerkleTree() bool {
+	return cfg.withMerkleTree
+}
+
+func (cfg *generatorConfig) HasPoseidon2() bool {
+	return cfg.withPoseidon2
+}
+
+func (cfg *generatorConfig) HasExtension() bool {
+	return cfg.extension != nil
+}
+
+func (cfg *generatorConfig) HasFRI() bool {
+	return cfg.withFRI
+}
+
+func (cfg *generatorConfig) HasSTARK() bool {
+	return cfg.withSTARK
+}
+
 func (cfg *generatorConfig) HasFFT() bool {
 	return cfg.fftConfig != nil
 }
@@ -34,6 +61,43 @@ func WithSIS() Option {
 	}
 }
 
+func WithMerkleTree() Option {
+	return func(opt *generatorConfig) {
+		opt.withMerkleTree = true
+	}
+}
+
+// WithPoseidon2 generates the Poseidon2 permutations; only koalabear, babybear
+// and goldilocks are supported.
+func WithPoseidon2() Option {
+	return func(opt *generatorConfig) {
+		opt.withPoseidon2 = true
+	}
+}
+
+// WithExtension generates the extensions E2 = Fp[u]/(u² - ext.RootOf) and,
+// if ext.Degree is 4, E4 = E2[v]/(v² - u).
+func WithExtension(ext config.Extension) Option {
+	return func(opt *generatorConfig) {
+		opt.extension = &ext
+	}
+}
+
+// WithFRI generates FRI with challenges in the extension; it requires the FFT
+// and the extension.
+func WithFRI() Option {
+	return func(opt *generatorConfig) {
+		opt.withFRI = true
+	}
+}
+
+// WithSTARK generates a STARK prover and verifier; it requires FRI.
+func WithSTARK() Option {
+	return func(opt *generatorConfig) {
+		opt.withSTARK = true
+	}
+}
+
 func WithFFT(cfg *config.FFT) Option {
 	return func(opt *generatorConfig) {
 		opt.fftConfig = cfg
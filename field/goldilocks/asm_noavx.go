@@ -0,0 +1,11 @@
+//go:build noavx
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package goldilocks
+
+const supportAvx512 = false
+const supportAvx2 = false
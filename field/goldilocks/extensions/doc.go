@@ -0,0 +1,12 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package extensions provides extension fields of goldilocks.
+//
+// E2 = goldilocks[u]/(u² - 7) and E3 = goldilocks[w]/(w³ - 2).
+//
+// Vector is a slice of E2, with element-wise operations and mixed
+// operations with goldilocks.Vector.
+package extensions
@@ -0,0 +1,352 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// SizeOfE3 number of bytes of the encoding of an E3
+const SizeOfE3 = 3 * goldilocks.Bytes
+
+// E3 is a degree three finite field extension of goldilocks.Element, E3 = goldilocks[w]/(w³ - 2)
+type E3 struct {
+	A0, A1, A2 goldilocks.Element
+}
+
+var (
+	// cubicNonResidue w³ = 2, a cubic non-residue of goldilocks
+	cubicNonResidue = goldilocks.NewElement(2)
+
+	// frobeniusW wᵖ = ζ·w and w²ᵖ = ζ²·w², where ζ = 2^((p-1)/3)
+	frobeniusW = [2]goldilocks.Element{
+		goldilocks.NewElement(4294967295),
+		goldilocks.NewElement(18446744065119617025),
+	}
+
+	// p³ - 1 = 2ˢ·t with t odd, and the exponent (t - 1)/2, for the
+	// Tonelli-Shanks square root
+	sqrtE3S      int
+	sqrtE3T      big.Int
+	sqrtE3TMinus big.Int
+)
+
+func init() {
+	sqrtE3T.Exp(goldilocks.Modulus(), big.NewInt(3), nil)
+	sqrtE3T.Sub(&sqrtE3T, big.NewInt(1))
+	sqrtE3S = int(sqrtE3T.TrailingZeroBits())
+	sqrtE3T.Rsh(&sqrtE3T, uint(sqrtE3S))
+	sqrtE3TMinus.Rsh(&sqrtE3T, 1)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E3) Equal(x *E3) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1) && z.A2.Equal(&x.A2)
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *E3) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *E3) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E3) SetZero() *E3 {
+	z.A0.SetZero()
+	z.A1.SetZero()
+	z.A2.SetZero()
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E3) SetOne() *E3 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	z.A2.SetZero()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *E3) Set(x *E3) *E3 {
+	z.A0 = x.A0
+	z.A1 = x.A1
+	z.A2 = x.A2
+	return z
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E3) SetRandom() (*E3, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A2.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Add sets z = x + y and returns z
+func (z *E3) Add(x, y *E3) *E3 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	z.A2.Add(&x.A2, &y.A2)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *E3) Sub(x, y *E3) *E3 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	z.A2.Sub(&x.A2, &y.A2)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *E3) Double(x *E3) *E3 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	z.A2.Double(&x.A2)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *E3) Neg(x *E3) *E3 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	z.A2.Neg(&x.A2)
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *E3) Mul(x, y *E3) *E3 {
+	// Karatsuba, see https://eprint.iacr.org/2006/471.pdf section 4
+	var t0, t1, t2, c0, c1, c2, a, b goldilocks.Element
+	t0.Mul(&x.A0, &y.A0)
+	t1.Mul(&x.A1, &y.A1)
+	t2.Mul(&x.A2, &y.A2)
+
+	a.Add(&x.A1, &x.A2)
+	b.Add(&y.A1, &y.A2)
+	c0.Mul(&a, &b).Sub(&c0, &t1).Sub(&c0, &t2).Mul(&c0, &cubicNonResidue).Add(&c0, &t0)
+
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	c1.Mul(&a, &b).Sub(&c1, &t0).Sub(&c1, &t1)
+	a.Mul(&t2, &cubicNonResidue)
+	c1.Add(&c1, &a)
+
+	a.Add(&x.A0, &x.A2)
+	b.Add(&y.A0, &y.A2)
+	c2.Mul(&a, &b).Sub(&c2, &t0).Sub(&c2, &t2).Add(&c2, &t1)
+
+	z.A0, z.A1, z.A2 = c0, c1, c2
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *E3) Square(x *E3) *E3 {
+	// (a0 + a1·w + a2·w²)² = a0² + 22·a1·a2 + (2·a0·a1 + 2·a2²)·w + (a1² + 2·a0·a2)·w²
+	var c0, c1, c2, t goldilocks.Element
+	c0.Mul(&x.A1, &x.A2).Double(&c0).Mul(&c0, &cubicNonResidue)
+	t.Square(&x.A0)
+	c0.Add(&c0, &t)
+	c1.Square(&x.A2).Mul(&c1, &cubicNonResidue)
+	t.Mul(&x.A0, &x.A1).Double(&t)
+	c1.Add(&c1, &t)
+	c2.Mul(&x.A0, &x.A2).Double(&c2)
+	t.Square(&x.A1)
+	c2.Add(&c2, &t)
+
+	z.A0, z.A1, z.A2 = c0, c1, c2
+	return z
+}
+
+// MulByElement sets z = x·y, y in goldilocks, and returns z
+func (z *E3) MulByElement(x *E3, y *goldilocks.Element) *E3 {
+	z.A0.Mul(&x.A0, y)
+	z.A1.Mul(&x.A1, y)
+	z.A2.Mul(&x.A2, y)
+	return z
+}
+
+// MulByNonResidue sets z = x·w and returns z
+func (z *E3) MulByNonResidue(x *E3) *E3 {
+	a0, a1 := x.A0, x.A1
+	z.A0.Mul(&x.A2, &cubicNonResidue)
+	z.A1 = a0
+	z.A2 = a1
+	return z
+}
+
+// adjugate returns x̃ such that x·x̃ = N(x) is the norm of x, and N(x)
+func (x *E3) adjugate() (E3, goldilocks.Element) {
+	// see https://eprint.iacr.org/2010/354.pdf algorithm 17
+	var res E3
+	var t, n goldilocks.Element
+	res.A0.Square(&x.A0)
+	t.Mul(&x.A1, &x.A2).Mul(&t, &cubicNonResidue)
+	res.A0.Sub(&res.A0, &t)
+	res.A1.Square(&x.A2).Mul(&res.A1, &cubicNonResidue)
+	t.Mul(&x.A0, &x.A1)
+	res.A1.Sub(&res.A1, &t)
+	res.A2.Square(&x.A1)
+	t.Mul(&x.A0, &x.A2)
+	res.A2.Sub(&res.A2, &t)
+
+	n.Mul(&x.A2, &res.A1)
+	t.Mul(&x.A1, &res.A2)
+	n.Add(&n, &t).Mul(&n, &cubicNonResidue)
+	t.Mul(&x.A0, &res.A0)
+	n.Add(&n, &t)
+	return res, n
+}
+
+// Inverse sets z = 1/x, 0 if x = 0, and returns z
+func (z *E3) Inverse(x *E3) *E3 {
+	a, n := x.adjugate()
+	n.Inverse(&n)
+	return z.MulByElement(&a, &n)
+}
+
+// Frobenius sets z = xᵖ and returns z
+func (z *E3) Frobenius(x *E3) *E3 {
+	z.A0 = x.A0
+	z.A1.Mul(&x.A1, &frobeniusW[0])
+	z.A2.Mul(&x.A2, &frobeniusW[1])
+	return z
+}
+
+// Legendre returns 1 if x is a non-zero square, -1 if it is not a square and 0
+// if x = 0. The degree being odd, x is a square in E3 iff its norm is a square
+// in goldilocks.
+func (x *E3) Legendre() int {
+	_, n := x.adjugate()
+	return n.Legendre()
+}
+
+// Sqrt sets z = √x and returns z. If x is not a square, Sqrt leaves z
+// unchanged and returns nil.
+func (z *E3) Sqrt(x *E3) *E3 {
+	switch x.Legendre() {
+	case 0:
+		return z.SetZero()
+	case -1:
+		return nil
+	}
+
+	// Tonelli-Shanks, see modSqrtTonelliShanks in math/big/int.go. The
+	// quadratic non-residue 7 of goldilocks is not a square in E3 either.
+	var y, b, g, t E3
+	y.Exp(*x, &sqrtE3TMinus)
+	b.Square(&y).Mul(&b, x)
+	y.Mul(&y, x)
+	g.A0 = nonResidue
+	g.Exp(g, &sqrtE3T)
+	r := sqrtE3S
+	for !b.IsOne() {
+		m := 0
+		t = b
+		for !t.IsOne() {
+			t.Square(&t)
+			m++
+		}
+		t = g
+		for i := 0; i < r-m-1; i++ {
+			t.Square(&t)
+		}
+		g.Square(&t)
+		y.Mul(&y, &t)
+		b.Mul(&b, &g)
+		r = m
+	}
+	return z.Set(&y)
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *E3) Exp(x E3, k *big.Int) *E3 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		x.Inverse(&x)
+		e = new(big.Int).Neg(k)
+	}
+	z.SetOne()
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+	return z
+}
+
+// BatchInvertE3 returns a new slice with every element inverted, using the
+// Montgomery batch inversion trick. Zero elements are mapped to zero.
+func BatchInvertE3(a []E3) []E3 {
+	res := make([]E3, len(a))
+	if len(a) == 0 {
+		return res
+	}
+	var accumulator E3
+	accumulator.SetOne()
+	for i := range a {
+		if a[i].IsZero() {
+			continue
+		}
+		res[i] = accumulator
+		accumulator.Mul(&accumulator, &a[i])
+	}
+	accumulator.Inverse(&accumulator)
+	for i := len(a) - 1; i >= 0; i-- {
+		if a[i].IsZero() {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+	return res
+}
+
+// Bytes returns the big endian encoding of A0, A1 and A2
+func (z *E3) Bytes() (res [SizeOfE3]byte) {
+	for i, a := range []*goldilocks.Element{&z.A0, &z.A1, &z.A2} {
+		b := a.Bytes()
+		copy(res[i*goldilocks.Bytes:], b[:])
+	}
+	return
+}
+
+// SetBytesCanonical sets z from the encoding returned by Bytes. It returns an
+// error if the encoding has the wrong size or a coordinate is not canonical.
+func (z *E3) SetBytesCanonical(e []byte) error {
+	if len(e) != SizeOfE3 {
+		return errors.New("invalid E3 encoding size")
+	}
+	for i, a := range []*goldilocks.Element{&z.A0, &z.A1, &z.A2} {
+		if err := a.SetBytesCanonical(e[i*goldilocks.Bytes : (i+1)*goldilocks.Bytes]); err != nil {
+			return ErrCanonical
+		}
+	}
+	return nil
+}
+
+// String returns z as A0+A1*w+A2*w²
+func (z *E3) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*w+" + z.A2.String() + "*w²"
+}
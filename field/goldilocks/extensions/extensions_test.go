@@ -0,0 +1,279 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/stretchr/testify/require"
+)
+
+const nbTests = 100
+
+func randomE2() E2 {
+	var res E2
+	if _, err := res.SetRandom(); err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func TestE2Arithmetic(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < nbTests; i++ {
+		a, b, c := randomE2(), randomE2(), randomE2()
+		var l, r, tmp E2
+
+		// (a + b)·c = a·c + b·c
+		l.Add(&a, &b).Mul(&l, &c)
+		r.Mul(&a, &c)
+		tmp.Mul(&b, &c)
+		r.Add(&r, &tmp)
+		assert.True(l.Equal(&r))
+
+		// (a·b)·c = a·(b·c)
+		l.Mul(&a, &b).Mul(&l, &c)
+		r.Mul(&b, &c).Mul(&a, &r)
+		assert.True(l.Equal(&r))
+
+		l.Square(&a)
+		r.Mul(&a, &a)
+		assert.True(l.Equal(&r))
+
+		l.Double(&a)
+		r.Add(&a, &a)
+		assert.True(l.Equal(&r))
+
+		l.Sub(&a, &b).Add(&l, &b)
+		assert.True(l.Equal(&a))
+
+		l.Inverse(&a).Mul(&l, &a)
+		assert.True(l.IsOne())
+
+		// u² = 7
+		var u E2
+		u.A1.SetOne()
+		l.MulByNonResidue(&a)
+		r.Mul(&a, &u)
+		assert.True(l.Equal(&r))
+
+		var s goldilocks.Element
+		s.SetRandom()
+		l.MulByElement(&a, &s)
+		r.Mul(&a, &E2{A0: s})
+		assert.True(l.Equal(&r))
+
+		// a^(p²-1) = 1
+		q := new(big.Int).Mul(goldilocks.Modulus(), goldilocks.Modulus())
+		l.Exp(a, q.Sub(q, big.NewInt(1)))
+		assert.True(l.IsOne())
+	}
+
+	var zero E2
+	assert.True(zero.Inverse(&zero).IsZero())
+}
+
+func TestE2Frobenius(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < nbTests; i++ {
+		a := randomE2()
+		var l, r E2
+		l.Frobenius(&a)
+		r.Exp(a, goldilocks.Modulus())
+		assert.True(l.Equal(&r))
+	}
+}
+
+func TestE2Sqrt(t *testing.T) {
+	assert := require.New(t)
+
+	var u E2
+	u.A1.SetOne()
+	assert.Equal(-1, u.Legendre())
+	for i := 0; i < nbTests; i++ {
+		a := randomE2()
+		var s, r, tmp E2
+		s.Square(&a)
+		assert.Equal(1, s.Legendre())
+		assert.NotNil(r.Sqrt(&s))
+		tmp.Square(&r)
+		assert.True(tmp.Equal(&s))
+
+		// a·u is a square iff a is not
+		s.Mul(&a, &u)
+		if a.Legendre() == 1 {
+			assert.Nil(r.Sqrt(&s))
+		} else {
+			assert.NotNil(r.Sqrt(&s))
+		}
+
+		// elements of goldilocks, which are all squares in E2
+		s = E2{A0: a.A0}
+		assert.NotNil(r.Sqrt(&s))
+		tmp.Square(&r)
+		assert.True(tmp.Equal(&s))
+	}
+
+	var zero E2
+	assert.Equal(0, zero.Legendre())
+	assert.True(zero.Sqrt(&zero).IsZero())
+}
+
+func TestE2BatchInvert(t *testing.T) {
+	assert := require.New(t)
+
+	a := make([]E2, 20)
+	for i := range a {
+		a[i] = randomE2()
+	}
+	a[5].SetZero()
+	inv := BatchInvertE2(a)
+	for i := range a {
+		var e E2
+		e.Inverse(&a[i])
+		assert.True(e.Equal(&inv[i]))
+	}
+}
+
+func TestE2Bytes(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < nbTests; i++ {
+		a := randomE2()
+		b := a.Bytes()
+		var r E2
+		assert.NoError(r.SetBytesCanonical(b[:]))
+		assert.True(r.Equal(&a))
+	}
+	var r E2
+	assert.Error(r.SetBytesCanonical(make([]byte, SizeOfE2-1)))
+	b := make([]byte, SizeOfE2)
+	for i := range b {
+		b[i] = 0xff
+	}
+	assert.ErrorIs(r.SetBytesCanonical(b), ErrCanonical)
+}
+
+func randomE3() E3 {
+	var res E3
+	if _, err := res.SetRandom(); err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func TestE3Arithmetic(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < nbTests; i++ {
+		a, b, c := randomE3(), randomE3(), randomE3()
+		var l, r, tmp E3
+
+		// (a + b)·c = a·c + b·c
+		l.Add(&a, &b).Mul(&l, &c)
+		r.Mul(&a, &c)
+		tmp.Mul(&b, &c)
+		r.Add(&r, &tmp)
+		assert.True(l.Equal(&r))
+
+		// (a·b)·c = a·(b·c)
+		l.Mul(&a, &b).Mul(&l, &c)
+		r.Mul(&b, &c).Mul(&a, &r)
+		assert.True(l.Equal(&r))
+
+		l.Square(&a)
+		r.Mul(&a, &a)
+		assert.True(l.Equal(&r))
+
+		l.Double(&a)
+		r.Add(&a, &a)
+		assert.True(l.Equal(&r))
+
+		l.Sub(&a, &b).Neg(&l).Add(&l, &a)
+		assert.True(l.Equal(&b))
+
+		l.Inverse(&a).Mul(&l, &a)
+		assert.True(l.IsOne())
+
+		// w³ = 2
+		var w E3
+		w.A1.SetOne()
+		l.MulByNonResidue(&a)
+		r.Mul(&a, &w)
+		assert.True(l.Equal(&r))
+
+		var s goldilocks.Element
+		s.SetRandom()
+		l.MulByElement(&a, &s)
+		r.Mul(&a, &E3{A0: s})
+		assert.True(l.Equal(&r))
+
+		// a^(p³-1) = 1
+		q := new(big.Int).Exp(goldilocks.Modulus(), big.NewInt(3), nil)
+		l.Exp(a, q.Sub(q, big.NewInt(1)))
+		assert.True(l.IsOne())
+
+		// Frobenius
+		l.Frobenius(&a)
+		r.Exp(a, goldilocks.Modulus())
+		assert.True(l.Equal(&r))
+	}
+
+	var w, w3 E3
+	w.A1.SetOne()
+	w3.Exp(w, big.NewInt(3))
+	assert.True(w3.Equal(&E3{A0: goldilocks.NewElement(2)}))
+
+	var zero E3
+	assert.True(zero.Inverse(&zero).IsZero())
+}
+
+func TestE3Sqrt(t *testing.T) {
+	assert := require.New(t)
+
+	nr := E3{A0: goldilocks.NewElement(7)}
+	assert.Equal(-1, nr.Legendre())
+	for i := 0; i < nbTests; i++ {
+		a := randomE3()
+		var s, r, tmp E3
+		s.Square(&a)
+		assert.Equal(1, s.Legendre())
+		assert.NotNil(r.Sqrt(&s))
+		tmp.Square(&r)
+		assert.True(tmp.Equal(&s))
+
+		s.Mul(&s, &nr)
+		assert.Nil(r.Sqrt(&s))
+	}
+
+	var zero E3
+	assert.Equal(0, zero.Legendre())
+	assert.True(zero.Sqrt(&zero).IsZero())
+}
+
+func TestE3BatchInvertAndBytes(t *testing.T) {
+	assert := require.New(t)
+
+	a := make([]E3, 20)
+	for i := range a {
+		a[i] = randomE3()
+	}
+	a[5].SetZero()
+	inv := BatchInvertE3(a)
+	for i := range a {
+		var e E3
+		e.Inverse(&a[i])
+		assert.True(e.Equal(&inv[i]))
+
+		b := a[i].Bytes()
+		assert.NoError(e.SetBytesCanonical(b[:]))
+		assert.True(e.Equal(&a[i]))
+	}
+}
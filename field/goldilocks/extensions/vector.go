@@ -0,0 +1,226 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+	"unsafe"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// Vector represents a slice of E2.
+//
+// Add, Sub, ScalarMulByElement and MulByElement operate on the coordinates as
+// a goldilocks.Vector, and use the vectorized implementations of goldilocks where
+// they exist.
+//
+// It implements the following interfaces:
+//   - Stringer
+//   - io.WriterTo
+//   - io.ReaderFrom
+//   - encoding.BinaryMarshaler
+//   - encoding.BinaryUnmarshaler
+type Vector []E2
+
+// coordinates returns the coordinates of the elements of the vector, sharing
+// its memory.
+func (vector Vector) coordinates() goldilocks.Vector {
+	if len(vector) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*goldilocks.Element)(unsafe.Pointer(&vector[0])), 2*len(vector))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (vector *Vector) MarshalBinary() (data []byte, err error) {
+	var buf bytes.Buffer
+
+	if _, err = vector.WriteTo(&buf); err != nil {
+		return
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (vector *Vector) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	_, err := vector.ReadFrom(r)
+	return err
+}
+
+// WriteTo implements io.WriterTo and writes a vector of E2 encoded with Bytes.
+// Length of the vector is encoded as a uint32 on the first 4 bytes.
+func (vector *Vector) WriteTo(w io.Writer) (int64, error) {
+	// encode slice length
+	if err := binary.Write(w, binary.BigEndian, uint32(len(*vector))); err != nil {
+		return 0, err
+	}
+
+	n := int64(4)
+
+	for i := 0; i < len(*vector); i++ {
+		buf := (*vector)[i].Bytes()
+		m, err := w.Write(buf[:])
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// ReadFrom implements io.ReaderFrom and reads a vector of E2 encoded with Bytes.
+// Length of the vector must be encoded as a uint32 on the first 4 bytes.
+func (vector *Vector) ReadFrom(r io.Reader) (int64, error) {
+
+	var buf [SizeOfE2]byte
+	if read, err := io.ReadFull(r, buf[:4]); err != nil {
+		return int64(read), err
+	}
+	sliceLen := binary.BigEndian.Uint32(buf[:4])
+
+	n := int64(4)
+	(*vector) = make(Vector, sliceLen)
+
+	for i := 0; i < int(sliceLen); i++ {
+		read, err := io.ReadFull(r, buf[:])
+		n += int64(read)
+		if err != nil {
+			return n, err
+		}
+		if err := (*vector)[i].SetBytesCanonical(buf[:]); err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+// String implements fmt.Stringer interface
+func (vector Vector) String() string {
+	var sbb strings.Builder
+	sbb.WriteByte('[')
+	for i := 0; i < len(vector); i++ {
+		sbb.WriteString(vector[i].String())
+		if i != len(vector)-1 {
+			sbb.WriteByte(',')
+		}
+	}
+	sbb.WriteByte(']')
+	return sbb.String()
+}
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	res := vector.coordinates()
+	res.Add(a.coordinates(), b.coordinates())
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	res := vector.coordinates()
+	res.Sub(a.coordinates(), b.coordinates())
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *E2) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Mul(&a[i], b)
+	}
+}
+
+// ScalarMulByElement multiplies a vector by a scalar of goldilocks element-wise and
+// stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMulByElement(a Vector, b *goldilocks.Element) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMulByElement: vectors don't have the same length")
+	}
+	res := vector.coordinates()
+	res.ScalarMul(a.coordinates(), b)
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		(*vector)[i].Mul(&a[i], &b[i])
+	}
+}
+
+// MulByElement multiplies a vector by a vector of goldilocks element-wise and
+// stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) MulByElement(a Vector, b goldilocks.Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.MulByElement: vectors don't have the same length")
+	}
+	// each element of b is repeated for the 2 coordinates
+	expanded := make(goldilocks.Vector, 2*len(b))
+	for i := range b {
+		for c := 0; c < 2; c++ {
+			expanded[2*i+c] = b[i]
+		}
+	}
+	res := vector.coordinates()
+	res.Mul(a.coordinates(), expanded)
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res E2) {
+	for i := 0; i < len(*vector); i++ {
+		res.Add(&res, &(*vector)[i])
+	}
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res E2) {
+	if len(*vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var tmp E2
+	for i := 0; i < len(other); i++ {
+		tmp.Mul(&(*vector)[i], &other[i])
+		res.Add(&res, &tmp)
+	}
+	return
+}
+
+// InnerProductByElement computes the inner product of the vector with a vector
+// of goldilocks.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProductByElement(other goldilocks.Vector) (res E2) {
+	if len(*vector) != len(other) {
+		panic("vector.InnerProductByElement: vectors don't have the same length")
+	}
+	var tmp E2
+	for i := 0; i < len(other); i++ {
+		tmp.MulByElement(&(*vector)[i], &other[i])
+		res.Add(&res, &tmp)
+	}
+	return
+}
@@ -251,7 +251,7 @@ func difFFT(a []goldilocks.Element, w goldilocks.Element, twiddles [][]goldilock
 
 }
 
-func innerDIFWithTwiddles(a []goldilocks.Element, twiddles []goldilocks.Element, start, end, m int) {
+func innerDIFWithTwiddlesGeneric(a []goldilocks.Element, twiddles []goldilocks.Element, start, end, m int) {
 	if start == 0 {
 		goldilocks.Butterfly(&a[0], &a[m])
 		start++
@@ -332,7 +332,7 @@ func ditFFT(a []goldilocks.Element, w goldilocks.Element, twiddles [][]goldilock
 	}
 }
 
-func innerDITWithTwiddles(a []goldilocks.Element, twiddles []goldilocks.Element, start, end, m int) {
+func innerDITWithTwiddlesGeneric(a []goldilocks.Element, twiddles []goldilocks.Element, start, end, m int) {
 	if start == 0 {
 		goldilocks.Butterfly(&a[0], &a[m])
 		start++
@@ -355,7 +355,7 @@ func innerDITWithoutTwiddles(a []goldilocks.Element, at, w goldilocks.Element, s
 	}
 }
 
-func kerDIFNP_256(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+func kerDIFNP_256Generic(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
 	// code unrolled & generated by internal/generator/fft/template/fft.go.tmpl
 
 	innerDIFWithTwiddles(a[:256], twiddles[stage+0], 0, 128, 128)
@@ -382,7 +382,7 @@ func kerDIFNP_256(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage
 	}
 }
 
-func kerDITNP_256(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+func kerDITNP_256Generic(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
 	// code unrolled & generated by internal/generator/fft/template/fft.go.tmpl
 
 	for offset := 0; offset < 256; offset += 2 {
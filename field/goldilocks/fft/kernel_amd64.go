@@ -0,0 +1,110 @@
+//go:build !purego
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"golang.org/x/sys/cpu"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+var supportAvx512 = cpu.X86.HasAVX512 && cpu.X86.HasAVX512DQ
+
+// difRadix2AVX512 sets a, b = a + b, (a - b) * twiddles on n blocks of 8 elements
+//
+//go:noescape
+func difRadix2AVX512(a, b, twiddles *goldilocks.Element, n uint64)
+
+// ditRadix2AVX512 sets a, b = a + b * twiddles, a - b * twiddles on n blocks of 8 elements
+//
+//go:noescape
+func ditRadix2AVX512(a, b, twiddles *goldilocks.Element, n uint64)
+
+// difRadix4AVX512 performs two stages of the DIF FFT on n blocks of 4m elements,
+// with the twiddles of the stages of size 4m and 2m; m must be a multiple of 8
+//
+//go:noescape
+func difRadix4AVX512(a, twiddles0, twiddles1 *goldilocks.Element, m, n uint64)
+
+// ditRadix4AVX512 performs two stages of the DIT FFT on n blocks of 4m elements,
+// with the twiddles of the stages of size 2m and 4m; m must be a multiple of 8
+//
+//go:noescape
+func ditRadix4AVX512(a, twiddles0, twiddles1 *goldilocks.Element, m, n uint64)
+
+// difTail16AVX512 performs the last 4 stages of the DIF FFT on n blocks of 16 elements
+//
+//go:noescape
+func difTail16AVX512(a, twiddles8, twiddles4, twiddles2 *goldilocks.Element, n uint64)
+
+// ditTail16AVX512 performs the first 4 stages of the DIT FFT on n blocks of 16 elements
+//
+//go:noescape
+func ditTail16AVX512(a, twiddles8, twiddles4, twiddles2 *goldilocks.Element, n uint64)
+
+func innerDIFWithTwiddles(a []goldilocks.Element, twiddles []goldilocks.Element, start, end, m int) {
+	if !supportAvx512 || m < 8 {
+		innerDIFWithTwiddlesGeneric(a, twiddles, start, end, m)
+		return
+	}
+	// the assembly processes blocks of 8 butterflies, the rest is done in Go
+	s, e := (start+7)&^7, end&^7
+	if s >= e {
+		innerDIFWithTwiddlesGeneric(a, twiddles, start, end, m)
+		return
+	}
+	if start < s {
+		innerDIFWithTwiddlesGeneric(a, twiddles, start, s, m)
+	}
+	difRadix2AVX512(&a[s], &a[s+m], &twiddles[s], uint64((e-s)/8))
+	if e < end {
+		innerDIFWithTwiddlesGeneric(a, twiddles, e, end, m)
+	}
+}
+
+func innerDITWithTwiddles(a []goldilocks.Element, twiddles []goldilocks.Element, start, end, m int) {
+	if !supportAvx512 || m < 8 {
+		innerDITWithTwiddlesGeneric(a, twiddles, start, end, m)
+		return
+	}
+	// the assembly processes blocks of 8 butterflies, the rest is done in Go
+	s, e := (start+7)&^7, end&^7
+	if s >= e {
+		innerDITWithTwiddlesGeneric(a, twiddles, start, end, m)
+		return
+	}
+	if start < s {
+		innerDITWithTwiddlesGeneric(a, twiddles, start, s, m)
+	}
+	ditRadix2AVX512(&a[s], &a[s+m], &twiddles[s], uint64((e-s)/8))
+	if e < end {
+		innerDITWithTwiddlesGeneric(a, twiddles, e, end, m)
+	}
+}
+
+func kerDIFNP_256(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+	if !supportAvx512 {
+		kerDIFNP_256Generic(a, twiddles, stage)
+		return
+	}
+	_ = a[255]
+	difRadix4AVX512(&a[0], &twiddles[stage][0], &twiddles[stage+1][0], 64, 1)
+	difRadix4AVX512(&a[0], &twiddles[stage+2][0], &twiddles[stage+3][0], 16, 4)
+	difTail16AVX512(&a[0], &twiddles[stage+4][0], &twiddles[stage+5][0], &twiddles[stage+6][0], 16)
+}
+
+func kerDITNP_256(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+	if !supportAvx512 {
+		kerDITNP_256Generic(a, twiddles, stage)
+		return
+	}
+	_ = a[255]
+	ditTail16AVX512(&a[0], &twiddles[stage+4][0], &twiddles[stage+5][0], &twiddles[stage+6][0], 16)
+	ditRadix4AVX512(&a[0], &twiddles[stage+2][0], &twiddles[stage+3][0], 16, 4)
+	ditRadix4AVX512(&a[0], &twiddles[stage][0], &twiddles[stage+1][0], 64, 1)
+}
@@ -0,0 +1,846 @@
+//go:build !purego
+
+// Code generated by gnark-crypto/generator. DO NOT EDIT.
+#include "textflag.h"
+#include "funcdata.h"
+#include "go_asm.h"
+
+// difRadix2AVX512(a, b, twiddles *Element, n uint64) sets a, b = a + b, (a - b) * twiddles
+// n is the number of blocks of 8 elements to process
+TEXT ·difRadix2AVX512(SB), NOSPLIT, $0-32
+	MOVQ         $0xffffffff00000001, SI
+	VPBROADCASTQ SI, Z31
+	MOVL         $0xffffffff, SI
+	VPBROADCASTQ SI, Z30
+	VPTERNLOGD   $0xff, Z29, Z29, Z29
+	MOVQ         a+0(FP), AX
+	MOVQ         b+8(FP), DX
+	MOVQ         twiddles+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+loop_1:
+	TESTQ     BX, BX
+	JEQ       done_2            // n == 0, we are done
+	VMOVDQU64 0(AX), Z0
+	VMOVDQU64 0(DX), Z1
+	VMOVDQU64 0(CX), Z2
+	VPCMPUQ   $1, Z1, Z0, K1
+	VPSUBQ    Z1, Z0, Z16
+	VPADDQ    Z31, Z16, K1, Z16
+	VPSUBQ    Z1, Z31, Z17
+	VPCMPUQ   $1, Z17, Z0, K1
+	VPSUBQ    Z17, Z0, Z0
+	VPADDQ    Z31, Z0, K1, Z0
+	VPSRLQ    $32, Z16, Z17
+	VPSRLQ    $32, Z2, Z18
+	VPMULUDQ  Z2, Z16, Z19
+	VPMULUDQ  Z18, Z16, Z20
+	VPMULUDQ  Z2, Z17, Z21
+	VPMULUDQ  Z18, Z17, Z17
+	VPSRLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z20, Z20
+	VPANDQ    Z30, Z20, Z18
+	VPADDQ    Z18, Z21, Z21
+	VPSRLQ    $32, Z20, Z20
+	VPADDQ    Z20, Z17, Z17
+	VPSRLQ    $32, Z21, Z18
+	VPADDQ    Z18, Z17, Z17
+	VPSLLQ    $32, Z21, Z21
+	VPANDQ    Z30, Z19, Z19
+	VPORQ     Z21, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPCMPUQ   $1, Z18, Z19, K2
+	VPSRLQ    $32, Z19, Z20
+	VPSUBQ    Z20, Z19, Z19
+	VPADDQ    Z29, Z19, K2, Z19
+	VPCMPUQ   $1, Z19, Z17, K2
+	VPSUBQ    Z19, Z17, Z1
+	VPADDQ    Z31, Z1, K2, Z1
+	VMOVDQU64 Z0, 0(AX)
+	VMOVDQU64 Z1, 0(DX)
+
+	// increment pointers to visit next element
+	ADDQ $64, AX
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX      // decrement n
+	JMP  loop_1
+
+done_2:
+	VZEROUPPER
+	RET
+
+// difRadix4AVX512(a, twiddles0, twiddles1 *Element, m, n uint64) performs two stages of the FFT
+// on n blocks of 4m elements; m must be a multiple of 8
+TEXT ·difRadix4AVX512(SB), NOSPLIT, $0-40
+	MOVQ         $0xffffffff00000001, R9
+	VPBROADCASTQ R9, Z31
+	MOVL         $0xffffffff, R9
+	VPBROADCASTQ R9, Z30
+	VPTERNLOGD   $0xff, Z29, Z29, Z29
+	MOVQ         a+0(FP), AX
+	MOVQ         m+24(FP), SI
+	SHLQ         $3, SI                  // m in bytes
+	MOVQ         n+32(FP), DI
+
+blockLoop_3:
+	TESTQ DI, DI
+	JEQ   done_4               // n == 0, we are done
+	MOVQ  twiddles0+8(FP), CX
+	MOVQ  twiddles1+16(FP), BX
+	LEAQ  (AX)(SI*2), DX
+	MOVQ  SI, R8
+	SHRQ  $6, R8               // number of blocks of 8 elements in a quarter
+
+loop_5:
+	TESTQ     R8, R8
+	JEQ       done_6            // n == 0, we are done
+	VMOVDQU64 0(AX), Z0
+	VMOVDQU64 (AX)(SI*1), Z1
+	VMOVDQU64 0(DX), Z2
+	VMOVDQU64 (DX)(SI*1), Z3
+	VMOVDQU64 0(CX), Z4
+	VMOVDQU64 (CX)(SI*1), Z5
+	VMOVDQU64 0(BX), Z6
+	VPCMPUQ   $1, Z2, Z0, K1
+	VPSUBQ    Z2, Z0, Z16
+	VPADDQ    Z31, Z16, K1, Z16
+	VPSUBQ    Z2, Z31, Z17
+	VPCMPUQ   $1, Z17, Z0, K1
+	VPSUBQ    Z17, Z0, Z0
+	VPADDQ    Z31, Z0, K1, Z0
+	VPSRLQ    $32, Z16, Z17
+	VPSRLQ    $32, Z4, Z18
+	VPMULUDQ  Z4, Z16, Z19
+	VPMULUDQ  Z18, Z16, Z20
+	VPMULUDQ  Z4, Z17, Z21
+	VPMULUDQ  Z18, Z17, Z17
+	VPSRLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z20, Z20
+	VPANDQ    Z30, Z20, Z18
+	VPADDQ    Z18, Z21, Z21
+	VPSRLQ    $32, Z20, Z20
+	VPADDQ    Z20, Z17, Z17
+	VPSRLQ    $32, Z21, Z18
+	VPADDQ    Z18, Z17, Z17
+	VPSLLQ    $32, Z21, Z21
+	VPANDQ    Z30, Z19, Z19
+	VPORQ     Z21, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPCMPUQ   $1, Z18, Z19, K2
+	VPSRLQ    $32, Z19, Z20
+	VPSUBQ    Z20, Z19, Z19
+	VPADDQ    Z29, Z19, K2, Z19
+	VPCMPUQ   $1, Z19, Z17, K2
+	VPSUBQ    Z19, Z17, Z2
+	VPADDQ    Z31, Z2, K2, Z2
+	VPCMPUQ   $1, Z3, Z1, K1
+	VPSUBQ    Z3, Z1, Z16
+	VPADDQ    Z31, Z16, K1, Z16
+	VPSUBQ    Z3, Z31, Z17
+	VPCMPUQ   $1, Z17, Z1, K1
+	VPSUBQ    Z17, Z1, Z1
+	VPADDQ    Z31, Z1, K1, Z1
+	VPSRLQ    $32, Z16, Z17
+	VPSRLQ    $32, Z5, Z18
+	VPMULUDQ  Z5, Z16, Z19
+	VPMULUDQ  Z18, Z16, Z20
+	VPMULUDQ  Z5, Z17, Z21
+	VPMULUDQ  Z18, Z17, Z17
+	VPSRLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z20, Z20
+	VPANDQ    Z30, Z20, Z18
+	VPADDQ    Z18, Z21, Z21
+	VPSRLQ    $32, Z20, Z20
+	VPADDQ    Z20, Z17, Z17
+	VPSRLQ    $32, Z21, Z18
+	VPADDQ    Z18, Z17, Z17
+	VPSLLQ    $32, Z21, Z21
+	VPANDQ    Z30, Z19, Z19
+	VPORQ     Z21, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPCMPUQ   $1, Z18, Z19, K2
+	VPSRLQ    $32, Z19, Z20
+	VPSUBQ    Z20, Z19, Z19
+	VPADDQ    Z29, Z19, K2, Z19
+	VPCMPUQ   $1, Z19, Z17, K2
+	VPSUBQ    Z19, Z17, Z3
+	VPADDQ    Z31, Z3, K2, Z3
+	VPCMPUQ   $1, Z1, Z0, K1
+	VPSUBQ    Z1, Z0, Z16
+	VPADDQ    Z31, Z16, K1, Z16
+	VPSUBQ    Z1, Z31, Z17
+	VPCMPUQ   $1, Z17, Z0, K1
+	VPSUBQ    Z17, Z0, Z0
+	VPADDQ    Z31, Z0, K1, Z0
+	VPSRLQ    $32, Z16, Z17
+	VPSRLQ    $32, Z6, Z18
+	VPMULUDQ  Z6, Z16, Z19
+	VPMULUDQ  Z18, Z16, Z20
+	VPMULUDQ  Z6, Z17, Z21
+	VPMULUDQ  Z18, Z17, Z17
+	VPSRLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z20, Z20
+	VPANDQ    Z30, Z20, Z18
+	VPADDQ    Z18, Z21, Z21
+	VPSRLQ    $32, Z20, Z20
+	VPADDQ    Z20, Z17, Z17
+	VPSRLQ    $32, Z21, Z18
+	VPADDQ    Z18, Z17, Z17
+	VPSLLQ    $32, Z21, Z21
+	VPANDQ    Z30, Z19, Z19
+	VPORQ     Z21, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPCMPUQ   $1, Z18, Z19, K2
+	VPSRLQ    $32, Z19, Z20
+	VPSUBQ    Z20, Z19, Z19
+	VPADDQ    Z29, Z19, K2, Z19
+	VPCMPUQ   $1, Z19, Z17, K2
+	VPSUBQ    Z19, Z17, Z1
+	VPADDQ    Z31, Z1, K2, Z1
+	VPCMPUQ   $1, Z3, Z2, K1
+	VPSUBQ    Z3, Z2, Z16
+	VPADDQ    Z31, Z16, K1, Z16
+	VPSUBQ    Z3, Z31, Z17
+	VPCMPUQ   $1, Z17, Z2, K1
+	VPSUBQ    Z17, Z2, Z2
+	VPADDQ    Z31, Z2, K1, Z2
+	VPSRLQ    $32, Z16, Z17
+	VPSRLQ    $32, Z6, Z18
+	VPMULUDQ  Z6, Z16, Z19
+	VPMULUDQ  Z18, Z16, Z20
+	VPMULUDQ  Z6, Z17, Z21
+	VPMULUDQ  Z18, Z17, Z17
+	VPSRLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z20, Z20
+	VPANDQ    Z30, Z20, Z18
+	VPADDQ    Z18, Z21, Z21
+	VPSRLQ    $32, Z20, Z20
+	VPADDQ    Z20, Z17, Z17
+	VPSRLQ    $32, Z21, Z18
+	VPADDQ    Z18, Z17, Z17
+	VPSLLQ    $32, Z21, Z21
+	VPANDQ    Z30, Z19, Z19
+	VPORQ     Z21, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPCMPUQ   $1, Z18, Z19, K2
+	VPSRLQ    $32, Z19, Z20
+	VPSUBQ    Z20, Z19, Z19
+	VPADDQ    Z29, Z19, K2, Z19
+	VPCMPUQ   $1, Z19, Z17, K2
+	VPSUBQ    Z19, Z17, Z3
+	VPADDQ    Z31, Z3, K2, Z3
+	VMOVDQU64 Z0, 0(AX)
+	VMOVDQU64 Z1, (AX)(SI*1)
+	VMOVDQU64 Z2, 0(DX)
+	VMOVDQU64 Z3, (DX)(SI*1)
+
+	// increment pointers to visit next element
+	ADDQ $64, AX
+	ADDQ $64, DX
+	ADDQ $64, CX
+	ADDQ $64, BX
+	DECQ R8      // decrement n
+	JMP  loop_5
+
+done_6:
+	// the next block starts after the last quarter of this one
+	LEAQ (DX)(SI*1), AX
+	DECQ DI             // decrement n
+	JMP  blockLoop_3
+
+done_4:
+	VZEROUPPER
+	RET
+
+// difTail16AVX512(a, twiddles8, twiddles4, twiddles2 *Element, n uint64) performs the last 4 stages
+// of the FFT on n blocks of 16 elements
+TEXT ·difTail16AVX512(SB), NOSPLIT, $0-40
+	MOVQ            $0xffffffff00000001, CX
+	VPBROADCASTQ    CX, Z31
+	MOVL            $0xffffffff, CX
+	VPBROADCASTQ    CX, Z30
+	VPTERNLOGD      $0xff, Z29, Z29, Z29
+	MOVQ            twiddles8+8(FP), CX
+	VMOVDQU64       0(CX), Z8
+	MOVQ            twiddles4+16(FP), CX
+	VBROADCASTI64X4 0(CX), Z9
+	MOVQ            twiddles2+24(FP), CX
+	VBROADCASTI64X2 0(CX), Z10
+	MOVQ            a+0(FP), AX
+	MOVQ            n+32(FP), DX
+
+loop_7:
+	TESTQ       DX, DX
+	JEQ         done_8            // n == 0, we are done
+	VMOVDQU64   0(AX), Z0
+	VMOVDQU64   64(AX), Z1
+	VPCMPUQ     $1, Z1, Z0, K1
+	VPSUBQ      Z1, Z0, Z16
+	VPADDQ      Z31, Z16, K1, Z16
+	VPSUBQ      Z1, Z31, Z17
+	VPCMPUQ     $1, Z17, Z0, K1
+	VPSUBQ      Z17, Z0, Z0
+	VPADDQ      Z31, Z0, K1, Z0
+	VPSRLQ      $32, Z16, Z17
+	VPSRLQ      $32, Z8, Z18
+	VPMULUDQ    Z8, Z16, Z19
+	VPMULUDQ    Z18, Z16, Z20
+	VPMULUDQ    Z8, Z17, Z21
+	VPMULUDQ    Z18, Z17, Z17
+	VPSRLQ      $32, Z19, Z18
+	VPADDQ      Z18, Z20, Z20
+	VPANDQ      Z30, Z20, Z18
+	VPADDQ      Z18, Z21, Z21
+	VPSRLQ      $32, Z20, Z20
+	VPADDQ      Z20, Z17, Z17
+	VPSRLQ      $32, Z21, Z18
+	VPADDQ      Z18, Z17, Z17
+	VPSLLQ      $32, Z21, Z21
+	VPANDQ      Z30, Z19, Z19
+	VPORQ       Z21, Z19, Z19
+	VPSLLQ      $32, Z19, Z18
+	VPADDQ      Z18, Z19, Z19
+	VPSLLQ      $32, Z19, Z18
+	VPCMPUQ     $1, Z18, Z19, K2
+	VPSRLQ      $32, Z19, Z20
+	VPSUBQ      Z20, Z19, Z19
+	VPADDQ      Z29, Z19, K2, Z19
+	VPCMPUQ     $1, Z19, Z17, K2
+	VPSUBQ      Z19, Z17, Z1
+	VPADDQ      Z31, Z1, K2, Z1
+	VSHUFI64X2  $0x44, Z1, Z0, Z2
+	VSHUFI64X2  $0xee, Z1, Z0, Z3
+	VPCMPUQ     $1, Z3, Z2, K1
+	VPSUBQ      Z3, Z2, Z16
+	VPADDQ      Z31, Z16, K1, Z16
+	VPSUBQ      Z3, Z31, Z17
+	VPCMPUQ     $1, Z17, Z2, K1
+	VPSUBQ      Z17, Z2, Z2
+	VPADDQ      Z31, Z2, K1, Z2
+	VPSRLQ      $32, Z16, Z17
+	VPSRLQ      $32, Z9, Z18
+	VPMULUDQ    Z9, Z16, Z19
+	VPMULUDQ    Z18, Z16, Z20
+	VPMULUDQ    Z9, Z17, Z21
+	VPMULUDQ    Z18, Z17, Z17
+	VPSRLQ      $32, Z19, Z18
+	VPADDQ      Z18, Z20, Z20
+	VPANDQ      Z30, Z20, Z18
+	VPADDQ      Z18, Z21, Z21
+	VPSRLQ      $32, Z20, Z20
+	VPADDQ      Z20, Z17, Z17
+	VPSRLQ      $32, Z21, Z18
+	VPADDQ      Z18, Z17, Z17
+	VPSLLQ      $32, Z21, Z21
+	VPANDQ      Z30, Z19, Z19
+	VPORQ       Z21, Z19, Z19
+	VPSLLQ      $32, Z19, Z18
+	VPADDQ      Z18, Z19, Z19
+	VPSLLQ      $32, Z19, Z18
+	VPCMPUQ     $1, Z18, Z19, K2
+	VPSRLQ      $32, Z19, Z20
+	VPSUBQ      Z20, Z19, Z19
+	VPADDQ      Z29, Z19, K2, Z19
+	VPCMPUQ     $1, Z19, Z17, K2
+	VPSUBQ      Z19, Z17, Z3
+	VPADDQ      Z31, Z3, K2, Z3
+	VSHUFI64X2  $0x88, Z3, Z2, Z0
+	VSHUFI64X2  $0xdd, Z3, Z2, Z1
+	VPCMPUQ     $1, Z1, Z0, K1
+	VPSUBQ      Z1, Z0, Z16
+	VPADDQ      Z31, Z16, K1, Z16
+	VPSUBQ      Z1, Z31, Z17
+	VPCMPUQ     $1, Z17, Z0, K1
+	VPSUBQ      Z17, Z0, Z0
+	VPADDQ      Z31, Z0, K1, Z0
+	VPSRLQ      $32, Z16, Z17
+	VPSRLQ      $32, Z10, Z18
+	VPMULUDQ    Z10, Z16, Z19
+	VPMULUDQ    Z18, Z16, Z20
+	VPMULUDQ    Z10, Z17, Z21
+	VPMULUDQ    Z18, Z17, Z17
+	VPSRLQ      $32, Z19, Z18
+	VPADDQ      Z18, Z20, Z20
+	VPANDQ      Z30, Z20, Z18
+	VPADDQ      Z18, Z21, Z21
+	VPSRLQ      $32, Z20, Z20
+	VPADDQ      Z20, Z17, Z17
+	VPSRLQ      $32, Z21, Z18
+	VPADDQ      Z18, Z17, Z17
+	VPSLLQ      $32, Z21, Z21
+	VPANDQ      Z30, Z19, Z19
+	VPORQ       Z21, Z19, Z19
+	VPSLLQ      $32, Z19, Z18
+	VPADDQ      Z18, Z19, Z19
+	VPSLLQ      $32, Z19, Z18
+	VPCMPUQ     $1, Z18, Z19, K2
+	VPSRLQ      $32, Z19, Z20
+	VPSUBQ      Z20, Z19, Z19
+	VPADDQ      Z29, Z19, K2, Z19
+	VPCMPUQ     $1, Z19, Z17, K2
+	VPSUBQ      Z19, Z17, Z1
+	VPADDQ      Z31, Z1, K2, Z1
+	VPUNPCKLQDQ Z1, Z0, Z2
+	VPUNPCKHQDQ Z1, Z0, Z3
+	VPCMPUQ     $1, Z3, Z2, K1
+	VPSUBQ      Z3, Z2, Z16
+	VPADDQ      Z31, Z16, K1, Z16
+	VPSUBQ      Z3, Z31, Z17
+	VPCMPUQ     $1, Z17, Z2, K1
+	VPSUBQ      Z17, Z2, Z2
+	VPADDQ      Z31, Z2, K1, Z2
+	VMOVDQA64   Z16, Z3
+	VPUNPCKLQDQ Z3, Z2, Z0
+	VPUNPCKHQDQ Z3, Z2, Z1
+	VSHUFI64X2  $0x88, Z1, Z0, Z2
+	VSHUFI64X2  $0xdd, Z1, Z0, Z3
+	VSHUFI64X2  $0x88, Z3, Z2, Z0
+	VSHUFI64X2  $0xdd, Z3, Z2, Z1
+	VSHUFI64X2  $0x44, Z1, Z0, Z2
+	VSHUFI64X2  $0xee, Z1, Z0, Z3
+	VMOVDQU64   Z2, 0(AX)
+	VMOVDQU64   Z3, 64(AX)
+
+	// increment pointers to visit next element
+	ADDQ $128, AX
+	DECQ DX       // decrement n
+	JMP  loop_7
+
+done_8:
+	VZEROUPPER
+	RET
+
+// ditRadix2AVX512(a, b, twiddles *Element, n uint64) sets a, b = a + b * twiddles, a - b * twiddles
+// n is the number of blocks of 8 elements to process
+TEXT ·ditRadix2AVX512(SB), NOSPLIT, $0-32
+	MOVQ         $0xffffffff00000001, SI
+	VPBROADCASTQ SI, Z31
+	MOVL         $0xffffffff, SI
+	VPBROADCASTQ SI, Z30
+	VPTERNLOGD   $0xff, Z29, Z29, Z29
+	MOVQ         a+0(FP), AX
+	MOVQ         b+8(FP), DX
+	MOVQ         twiddles+16(FP), CX
+	MOVQ         n+24(FP), BX
+
+loop_9:
+	TESTQ     BX, BX
+	JEQ       done_10           // n == 0, we are done
+	VMOVDQU64 0(AX), Z0
+	VMOVDQU64 0(DX), Z1
+	VMOVDQU64 0(CX), Z2
+	VPSRLQ    $32, Z1, Z17
+	VPSRLQ    $32, Z2, Z18
+	VPMULUDQ  Z2, Z1, Z19
+	VPMULUDQ  Z18, Z1, Z20
+	VPMULUDQ  Z2, Z17, Z21
+	VPMULUDQ  Z18, Z17, Z17
+	VPSRLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z20, Z20
+	VPANDQ    Z30, Z20, Z18
+	VPADDQ    Z18, Z21, Z21
+	VPSRLQ    $32, Z20, Z20
+	VPADDQ    Z20, Z17, Z17
+	VPSRLQ    $32, Z21, Z18
+	VPADDQ    Z18, Z17, Z17
+	VPSLLQ    $32, Z21, Z21
+	VPANDQ    Z30, Z19, Z19
+	VPORQ     Z21, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPCMPUQ   $1, Z18, Z19, K2
+	VPSRLQ    $32, Z19, Z20
+	VPSUBQ    Z20, Z19, Z19
+	VPADDQ    Z29, Z19, K2, Z19
+	VPCMPUQ   $1, Z19, Z17, K2
+	VPSUBQ    Z19, Z17, Z1
+	VPADDQ    Z31, Z1, K2, Z1
+	VPCMPUQ   $1, Z1, Z0, K1
+	VPSUBQ    Z1, Z0, Z16
+	VPADDQ    Z31, Z16, K1, Z16
+	VPSUBQ    Z1, Z31, Z17
+	VPCMPUQ   $1, Z17, Z0, K1
+	VPSUBQ    Z17, Z0, Z0
+	VPADDQ    Z31, Z0, K1, Z0
+	VMOVDQA64 Z16, Z1
+	VMOVDQU64 Z0, 0(AX)
+	VMOVDQU64 Z1, 0(DX)
+
+	// increment pointers to visit next element
+	ADDQ $64, AX
+	ADDQ $64, DX
+	ADDQ $64, CX
+	DECQ BX      // decrement n
+	JMP  loop_9
+
+done_10:
+	VZEROUPPER
+	RET
+
+// ditRadix4AVX512(a, twiddles0, twiddles1 *Element, m, n uint64) performs two stages of the FFT
+// on n blocks of 4m elements; m must be a multiple of 8
+TEXT ·ditRadix4AVX512(SB), NOSPLIT, $0-40
+	MOVQ         $0xffffffff00000001, R9
+	VPBROADCASTQ R9, Z31
+	MOVL         $0xffffffff, R9
+	VPBROADCASTQ R9, Z30
+	VPTERNLOGD   $0xff, Z29, Z29, Z29
+	MOVQ         a+0(FP), AX
+	MOVQ         m+24(FP), SI
+	SHLQ         $3, SI                  // m in bytes
+	MOVQ         n+32(FP), DI
+
+blockLoop_11:
+	TESTQ DI, DI
+	JEQ   done_12              // n == 0, we are done
+	MOVQ  twiddles0+8(FP), CX
+	MOVQ  twiddles1+16(FP), BX
+	LEAQ  (AX)(SI*2), DX
+	MOVQ  SI, R8
+	SHRQ  $6, R8               // number of blocks of 8 elements in a quarter
+
+loop_13:
+	TESTQ     R8, R8
+	JEQ       done_14           // n == 0, we are done
+	VMOVDQU64 0(AX), Z0
+	VMOVDQU64 (AX)(SI*1), Z1
+	VMOVDQU64 0(DX), Z2
+	VMOVDQU64 (DX)(SI*1), Z3
+	VMOVDQU64 0(CX), Z4
+	VMOVDQU64 (CX)(SI*1), Z5
+	VMOVDQU64 0(BX), Z6
+	VPSRLQ    $32, Z1, Z17
+	VPSRLQ    $32, Z6, Z18
+	VPMULUDQ  Z6, Z1, Z19
+	VPMULUDQ  Z18, Z1, Z20
+	VPMULUDQ  Z6, Z17, Z21
+	VPMULUDQ  Z18, Z17, Z17
+	VPSRLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z20, Z20
+	VPANDQ    Z30, Z20, Z18
+	VPADDQ    Z18, Z21, Z21
+	VPSRLQ    $32, Z20, Z20
+	VPADDQ    Z20, Z17, Z17
+	VPSRLQ    $32, Z21, Z18
+	VPADDQ    Z18, Z17, Z17
+	VPSLLQ    $32, Z21, Z21
+	VPANDQ    Z30, Z19, Z19
+	VPORQ     Z21, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPCMPUQ   $1, Z18, Z19, K2
+	VPSRLQ    $32, Z19, Z20
+	VPSUBQ    Z20, Z19, Z19
+	VPADDQ    Z29, Z19, K2, Z19
+	VPCMPUQ   $1, Z19, Z17, K2
+	VPSUBQ    Z19, Z17, Z1
+	VPADDQ    Z31, Z1, K2, Z1
+	VPCMPUQ   $1, Z1, Z0, K1
+	VPSUBQ    Z1, Z0, Z16
+	VPADDQ    Z31, Z16, K1, Z16
+	VPSUBQ    Z1, Z31, Z17
+	VPCMPUQ   $1, Z17, Z0, K1
+	VPSUBQ    Z17, Z0, Z0
+	VPADDQ    Z31, Z0, K1, Z0
+	VMOVDQA64 Z16, Z1
+	VPSRLQ    $32, Z3, Z17
+	VPSRLQ    $32, Z6, Z18
+	VPMULUDQ  Z6, Z3, Z19
+	VPMULUDQ  Z18, Z3, Z20
+	VPMULUDQ  Z6, Z17, Z21
+	VPMULUDQ  Z18, Z17, Z17
+	VPSRLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z20, Z20
+	VPANDQ    Z30, Z20, Z18
+	VPADDQ    Z18, Z21, Z21
+	VPSRLQ    $32, Z20, Z20
+	VPADDQ    Z20, Z17, Z17
+	VPSRLQ    $32, Z21, Z18
+	VPADDQ    Z18, Z17, Z17
+	VPSLLQ    $32, Z21, Z21
+	VPANDQ    Z30, Z19, Z19
+	VPORQ     Z21, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPCMPUQ   $1, Z18, Z19, K2
+	VPSRLQ    $32, Z19, Z20
+	VPSUBQ    Z20, Z19, Z19
+	VPADDQ    Z29, Z19, K2, Z19
+	VPCMPUQ   $1, Z19, Z17, K2
+	VPSUBQ    Z19, Z17, Z3
+	VPADDQ    Z31, Z3, K2, Z3
+	VPCMPUQ   $1, Z3, Z2, K1
+	VPSUBQ    Z3, Z2, Z16
+	VPADDQ    Z31, Z16, K1, Z16
+	VPSUBQ    Z3, Z31, Z17
+	VPCMPUQ   $1, Z17, Z2, K1
+	VPSUBQ    Z17, Z2, Z2
+	VPADDQ    Z31, Z2, K1, Z2
+	VMOVDQA64 Z16, Z3
+	VPSRLQ    $32, Z2, Z17
+	VPSRLQ    $32, Z4, Z18
+	VPMULUDQ  Z4, Z2, Z19
+	VPMULUDQ  Z18, Z2, Z20
+	VPMULUDQ  Z4, Z17, Z21
+	VPMULUDQ  Z18, Z17, Z17
+	VPSRLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z20, Z20
+	VPANDQ    Z30, Z20, Z18
+	VPADDQ    Z18, Z21, Z21
+	VPSRLQ    $32, Z20, Z20
+	VPADDQ    Z20, Z17, Z17
+	VPSRLQ    $32, Z21, Z18
+	VPADDQ    Z18, Z17, Z17
+	VPSLLQ    $32, Z21, Z21
+	VPANDQ    Z30, Z19, Z19
+	VPORQ     Z21, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPCMPUQ   $1, Z18, Z19, K2
+	VPSRLQ    $32, Z19, Z20
+	VPSUBQ    Z20, Z19, Z19
+	VPADDQ    Z29, Z19, K2, Z19
+	VPCMPUQ   $1, Z19, Z17, K2
+	VPSUBQ    Z19, Z17, Z2
+	VPADDQ    Z31, Z2, K2, Z2
+	VPCMPUQ   $1, Z2, Z0, K1
+	VPSUBQ    Z2, Z0, Z16
+	VPADDQ    Z31, Z16, K1, Z16
+	VPSUBQ    Z2, Z31, Z17
+	VPCMPUQ   $1, Z17, Z0, K1
+	VPSUBQ    Z17, Z0, Z0
+	VPADDQ    Z31, Z0, K1, Z0
+	VMOVDQA64 Z16, Z2
+	VPSRLQ    $32, Z3, Z17
+	VPSRLQ    $32, Z5, Z18
+	VPMULUDQ  Z5, Z3, Z19
+	VPMULUDQ  Z18, Z3, Z20
+	VPMULUDQ  Z5, Z17, Z21
+	VPMULUDQ  Z18, Z17, Z17
+	VPSRLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z20, Z20
+	VPANDQ    Z30, Z20, Z18
+	VPADDQ    Z18, Z21, Z21
+	VPSRLQ    $32, Z20, Z20
+	VPADDQ    Z20, Z17, Z17
+	VPSRLQ    $32, Z21, Z18
+	VPADDQ    Z18, Z17, Z17
+	VPSLLQ    $32, Z21, Z21
+	VPANDQ    Z30, Z19, Z19
+	VPORQ     Z21, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPADDQ    Z18, Z19, Z19
+	VPSLLQ    $32, Z19, Z18
+	VPCMPUQ   $1, Z18, Z19, K2
+	VPSRLQ    $32, Z19, Z20
+	VPSUBQ    Z20, Z19, Z19
+	VPADDQ    Z29, Z19, K2, Z19
+	VPCMPUQ   $1, Z19, Z17, K2
+	VPSUBQ    Z19, Z17, Z3
+	VPADDQ    Z31, Z3, K2, Z3
+	VPCMPUQ   $1, Z3, Z1, K1
+	VPSUBQ    Z3, Z1, Z16
+	VPADDQ    Z31, Z16, K1, Z16
+	VPSUBQ    Z3, Z31, Z17
+	VPCMPUQ   $1, Z17, Z1, K1
+	VPSUBQ    Z17, Z1, Z1
+	VPADDQ    Z31, Z1, K1, Z1
+	VMOVDQA64 Z16, Z3
+	VMOVDQU64 Z0, 0(AX)
+	VMOVDQU64 Z1, (AX)(SI*1)
+	VMOVDQU64 Z2, 0(DX)
+	VMOVDQU64 Z3, (DX)(SI*1)
+
+	// increment pointers to visit next element
+	ADDQ $64, AX
+	ADDQ $64, DX
+	ADDQ $64, CX
+	ADDQ $64, BX
+	DECQ R8      // decrement n
+	JMP  loop_13
+
+done_14:
+	// the next block starts after the last quarter of this one
+	LEAQ (DX)(SI*1), AX
+	DECQ DI             // decrement n
+	JMP  blockLoop_11
+
+done_12:
+	VZEROUPPER
+	RET
+
+// ditTail16AVX512(a, twiddles8, twiddles4, twiddles2 *Element, n uint64) performs the last 4 stages
+// of the FFT on n blocks of 16 elements
+TEXT ·ditTail16AVX512(SB), NOSPLIT, $0-40
+	MOVQ            $0xffffffff00000001, CX
+	VPBROADCASTQ    CX, Z31
+	MOVL            $0xffffffff, CX
+	VPBROADCASTQ    CX, Z30
+	VPTERNLOGD      $0xff, Z29, Z29, Z29
+	MOVQ            twiddles8+8(FP), CX
+	VMOVDQU64       0(CX), Z8
+	MOVQ            twiddles4+16(FP), CX
+	VBROADCASTI64X4 0(CX), Z9
+	MOVQ            twiddles2+24(FP), CX
+	VBROADCASTI64X2 0(CX), Z10
+	MOVQ            a+0(FP), AX
+	MOVQ            n+32(FP), DX
+
+loop_15:
+	TESTQ       DX, DX
+	JEQ         done_16           // n == 0, we are done
+	VMOVDQU64   0(AX), Z0
+	VMOVDQU64   64(AX), Z1
+	VSHUFI64X2  $0x44, Z1, Z0, Z2
+	VSHUFI64X2  $0xee, Z1, Z0, Z3
+	VSHUFI64X2  $0x88, Z3, Z2, Z0
+	VSHUFI64X2  $0xdd, Z3, Z2, Z1
+	VPUNPCKLQDQ Z1, Z0, Z2
+	VPUNPCKHQDQ Z1, Z0, Z3
+	VPCMPUQ     $1, Z3, Z2, K1
+	VPSUBQ      Z3, Z2, Z16
+	VPADDQ      Z31, Z16, K1, Z16
+	VPSUBQ      Z3, Z31, Z17
+	VPCMPUQ     $1, Z17, Z2, K1
+	VPSUBQ      Z17, Z2, Z2
+	VPADDQ      Z31, Z2, K1, Z2
+	VMOVDQA64   Z16, Z3
+	VPUNPCKLQDQ Z3, Z2, Z0
+	VPUNPCKHQDQ Z3, Z2, Z1
+	VPSRLQ      $32, Z1, Z17
+	VPSRLQ      $32, Z10, Z18
+	VPMULUDQ    Z10, Z1, Z19
+	VPMULUDQ    Z18, Z1, Z20
+	VPMULUDQ    Z10, Z17, Z21
+	VPMULUDQ    Z18, Z17, Z17
+	VPSRLQ      $32, Z19, Z18
+	VPADDQ      Z18, Z20, Z20
+	VPANDQ      Z30, Z20, Z18
+	VPADDQ      Z18, Z21, Z21
+	VPSRLQ      $32, Z20, Z20
+	VPADDQ      Z20, Z17, Z17
+	VPSRLQ      $32, Z21, Z18
+	VPADDQ      Z18, Z17, Z17
+	VPSLLQ      $32, Z21, Z21
+	VPANDQ      Z30, Z19, Z19
+	VPORQ       Z21, Z19, Z19
+	VPSLLQ      $32, Z19, Z18
+	VPADDQ      Z18, Z19, Z19
+	VPSLLQ      $32, Z19, Z18
+	VPCMPUQ     $1, Z18, Z19, K2
+	VPSRLQ      $32, Z19, Z20
+	VPSUBQ      Z20, Z19, Z19
+	VPADDQ      Z29, Z19, K2, Z19
+	VPCMPUQ     $1, Z19, Z17, K2
+	VPSUBQ      Z19, Z17, Z1
+	VPADDQ      Z31, Z1, K2, Z1
+	VPCMPUQ     $1, Z1, Z0, K1
+	VPSUBQ      Z1, Z0, Z16
+	VPADDQ      Z31, Z16, K1, Z16
+	VPSUBQ      Z1, Z31, Z17
+	VPCMPUQ     $1, Z17, Z0, K1
+	VPSUBQ      Z17, Z0, Z0
+	VPADDQ      Z31, Z0, K1, Z0
+	VMOVDQA64   Z16, Z1
+	VSHUFI64X2  $0x88, Z1, Z0, Z2
+	VSHUFI64X2  $0xdd, Z1, Z0, Z3
+	VSHUFI64X2  $0x88, Z3, Z2, Z0
+	VSHUFI64X2  $0xdd, Z3, Z2, Z1
+	VPSRLQ      $32, Z1, Z17
+	VPSRLQ      $32, Z9, Z18
+	VPMULUDQ    Z9, Z1, Z19
+	VPMULUDQ    Z18, Z1, Z20
+	VPMULUDQ    Z9, Z17, Z21
+	VPMULUDQ    Z18, Z17, Z17
+	VPSRLQ      $32, Z19, Z18
+	VPADDQ      Z18, Z20, Z20
+	VPANDQ      Z30, Z20, Z18
+	VPADDQ      Z18, Z21, Z21
+	VPSRLQ      $32, Z20, Z20
+	VPADDQ      Z20, Z17, Z17
+	VPSRLQ      $32, Z21, Z18
+	VPADDQ      Z18, Z17, Z17
+	VPSLLQ      $32, Z21, Z21
+	VPANDQ      Z30, Z19, Z19
+	VPORQ       Z21, Z19, Z19
+	VPSLLQ      $32, Z19, Z18
+	VPADDQ      Z18, Z19, Z19
+	VPSLLQ      $32, Z19, Z18
+	VPCMPUQ     $1, Z18, Z19, K2
+	VPSRLQ      $32, Z19, Z20
+	VPSUBQ      Z20, Z19, Z19
+	VPADDQ      Z29, Z19, K2, Z19
+	VPCMPUQ     $1, Z19, Z17, K2
+	VPSUBQ      Z19, Z17, Z1
+	VPADDQ      Z31, Z1, K2, Z1
+	VPCMPUQ     $1, Z1, Z0, K1
+	VPSUBQ      Z1, Z0, Z16
+	VPADDQ      Z31, Z16, K1, Z16
+	VPSUBQ      Z1, Z31, Z17
+	VPCMPUQ     $1, Z17, Z0, K1
+	VPSUBQ      Z17, Z0, Z0
+	VPADDQ      Z31, Z0, K1, Z0
+	VMOVDQA64   Z16, Z1
+	VSHUFI64X2  $0x44, Z1, Z0, Z2
+	VSHUFI64X2  $0xee, Z1, Z0, Z3
+	VPSRLQ      $32, Z3, Z17
+	VPSRLQ      $32, Z8, Z18
+	VPMULUDQ    Z8, Z3, Z19
+	VPMULUDQ    Z18, Z3, Z20
+	VPMULUDQ    Z8, Z17, Z21
+	VPMULUDQ    Z18, Z17, Z17
+	VPSRLQ      $32, Z19, Z18
+	VPADDQ      Z18, Z20, Z20
+	VPANDQ      Z30, Z20, Z18
+	VPADDQ      Z18, Z21, Z21
+	VPSRLQ      $32, Z20, Z20
+	VPADDQ      Z20, Z17, Z17
+	VPSRLQ      $32, Z21, Z18
+	VPADDQ      Z18, Z17, Z17
+	VPSLLQ      $32, Z21, Z21
+	VPANDQ      Z30, Z19, Z19
+	VPORQ       Z21, Z19, Z19
+	VPSLLQ      $32, Z19, Z18
+	VPADDQ      Z18, Z19, Z19
+	VPSLLQ      $32, Z19, Z18
+	VPCMPUQ     $1, Z18, Z19, K2
+	VPSRLQ      $32, Z19, Z20
+	VPSUBQ      Z20, Z19, Z19
+	VPADDQ      Z29, Z19, K2, Z19
+	VPCMPUQ     $1, Z19, Z17, K2
+	VPSUBQ      Z19, Z17, Z3
+	VPADDQ      Z31, Z3, K2, Z3
+	VPCMPUQ     $1, Z3, Z2, K1
+	VPSUBQ      Z3, Z2, Z16
+	VPADDQ      Z31, Z16, K1, Z16
+	VPSUBQ      Z3, Z31, Z17
+	VPCMPUQ     $1, Z17, Z2, K1
+	VPSUBQ      Z17, Z2, Z2
+	VPADDQ      Z31, Z2, K1, Z2
+	VMOVDQA64   Z16, Z3
+	VMOVDQU64   Z2, 0(AX)
+	VMOVDQU64   Z3, 64(AX)
+
+	// increment pointers to visit next element
+	ADDQ $128, AX
+	DECQ DX       // decrement n
+	JMP  loop_15
+
+done_16:
+	VZEROUPPER
+	RET
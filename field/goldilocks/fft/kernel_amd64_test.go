@@ -0,0 +1,87 @@
+//go:build !purego
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+func TestKernelsAMD64(t *testing.T) {
+	if !supportAvx512 {
+		t.Skip("AVX-512 not supported")
+	}
+
+	const size = 1 << 10
+	domain := NewDomain(size)
+	twiddles, err := domain.Twiddles()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	random := func(n int) []goldilocks.Element {
+		a := make([]goldilocks.Element, n)
+		for i := range a {
+			a[i].SetRandom()
+		}
+		return a
+	}
+	check := func(name string, got, expected []goldilocks.Element) {
+		t.Helper()
+		for i := range got {
+			if !got[i].Equal(&expected[i]) {
+				t.Fatalf("%s: mismatch at index %d", name, i)
+			}
+		}
+	}
+
+	t.Run("radix2", func(t *testing.T) {
+		for stage := 0; stage < len(twiddles); stage++ {
+			m := size >> (stage + 1)
+			starts := []int{0, 1, 3, 8, m / 2}
+			ends := []int{m, m, m - 5, m - 8, m}
+			for j := range starts {
+				start, end := starts[j], ends[j]
+				if start > end {
+					continue
+				}
+				a := random(2 * m)
+				expected := make([]goldilocks.Element, len(a))
+
+				copy(expected, a)
+				innerDIFWithTwiddlesGeneric(expected, twiddles[stage], start, end, m)
+				innerDIFWithTwiddles(a, twiddles[stage], start, end, m)
+				check("dif", a, expected)
+
+				copy(expected, a)
+				innerDITWithTwiddlesGeneric(expected, twiddles[stage], start, end, m)
+				innerDITWithTwiddles(a, twiddles[stage], start, end, m)
+				check("dit", a, expected)
+			}
+		}
+	})
+
+	t.Run("kernel256", func(t *testing.T) {
+		// the kernels are called on the last 8 stages
+		for _, stage := range []int{0, len(twiddles) - 8} {
+			a := random(256)
+			expected := make([]goldilocks.Element, len(a))
+
+			copy(expected, a)
+			kerDIFNP_256Generic(expected, twiddles, stage)
+			kerDIFNP_256(a, twiddles, stage)
+			check("dif", a, expected)
+
+			copy(expected, a)
+			kerDITNP_256Generic(expected, twiddles, stage)
+			kerDITNP_256(a, twiddles, stage)
+			check("dit", a, expected)
+		}
+	})
+}
@@ -0,0 +1,758 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+	"math/bits"
+
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/consensys/gnark-crypto/field/goldilocks/extensions"
+	"github.com/consensys/gnark-crypto/field/goldilocks/fft"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrPolynomialSize       = errors.New("a polynomial is larger than the size of the FRI")
+	ErrNoColumns            = errors.New("at least one column must be committed")
+	ErrColumnSize           = errors.New("the columns must have the size of the domain")
+	ErrProofShape           = errors.New("the proof does not match the configuration")
+	ErrGrinding             = errors.New("invalid proof of work")
+	ErrMerklePath           = errors.New("merkle path proof is wrong")
+	ErrProximityTestFolding = errors.New("one round of interaction failed")
+)
+
+// extensionDegree degree of the extension E2 of goldilocks
+const extensionDegree = 2
+
+// FRI proof of proximity of the columns of a commitment, evaluations of
+// polynomials over goldilocks on a domain D₀ of size N = B·size, to polynomials of
+// degree < size. The folding factor k, the blowup factor B, the number of
+// queries, the degree of the final polynomial and the grinding bits are given
+// by a Config.
+//
+// The columns cᵢ are combined into h₀ = ∑ᵢ γⁱcᵢ, γ being a challenge in E2.
+// The codewords hᵣ are given by their evaluations on the domains Dᵣ generated
+// by gᵣ = g^{kʳ}. At round r, hᵣ(X) = ∑ⱼ Xʲhᵣ,ⱼ(Xᵏ) is folded into
+// hᵣ₊₁ = ∑ⱼ αᵣʲhᵣ,ⱼ, whose evaluations on Dᵣ₊₁ only depend on the
+// evaluations of hᵣ on the cosets gᵣⁱ⟨ω⟩, ω being a primitive k-th root of
+// unity. The leaves of the Merkle trees are these cosets, so that the leaves of
+// the commitment to the columns are made of k rows.
+//
+// With the option WithCoset, D₀ is replaced by the coset s·D₀, s generating
+// the multiplicative group, and Dᵣ by s^{kʳ}·Dᵣ.
+type FRI struct {
+	config Config
+	h      hash.Hash
+
+	// shift s of the domains, 1 if the domains are not cosets
+	shift, shiftInv goldilocks.Element
+	coset           bool
+
+	// degreeBounds degree bounds n₀ = size, …, n_R of the folded polynomials
+	degreeBounds []uint64
+
+	// domain of size N of the columns
+	domain *fft.Domain
+
+	// omegaInv powers of ω⁻¹, where ω = g^{N/k}
+	omegaInv []goldilocks.Element
+	kInv     goldilocks.Element
+}
+
+// Commitment Merkle commitment to columns of size N. The leaf i of the tree is
+// made of the rows i + t·N/k, for t < k.
+type Commitment struct {
+	Root      []byte
+	NbColumns int
+
+	columns [][]goldilocks.Element
+	tree    *merkleTree
+}
+
+// Proof proof of proximity of FRI.
+type Proof struct {
+	// Roots Merkle roots of the folded codewords h₁, …, h_{R-1}
+	Roots [][]byte
+
+	// FinalPolynomial coefficients of the final polynomial h_R, in canonical
+	// basis
+	FinalPolynomial []extensions.E2
+
+	// Nonce proof of work
+	Nonce uint64
+
+	// Rows for each query, the opening of the commitment to the columns
+	Rows []RowOpening
+
+	// Queries for each query, the openings of the codewords h₁, …, h_{R-1}
+	Queries [][]Opening
+}
+
+// RowOpening opening of a leaf of a commitment to columns: the k rows on a
+// coset of ⟨ω⟩, row after row, with their Merkle path.
+type RowOpening struct {
+	Values []goldilocks.Element
+	Path   [][]byte
+}
+
+// Opening opening of a leaf of a folded codeword: the k values of the
+// codeword on a coset of ⟨ω⟩, with their Merkle path.
+type Opening struct {
+	Values []extensions.E2
+	Path   [][]byte
+}
+
+// Option option of NewFRI.
+type Option func(*FRI)
+
+// WithCoset evaluates the polynomials on a coset s·D₀ of the subgroup D₀ of
+// size N, which is needed by protocols dividing by the vanishing polynomial of
+// a subgroup of D₀.
+func WithCoset() Option {
+	return func(f *FRI) {
+		f.coset = true
+	}
+}
+
+// NewFRI returns the FRI with given configuration for polynomials of degree <
+// size, a power of 2. The hash function is used for the Merkle trees and Fiat
+// Shamir, and its digests must have at least 8·extensionDegree bytes.
+func NewFRI(size uint64, h hash.Hash, config Config, opts ...Option) (*FRI, error) {
+	degreeBounds, err := config.degreeBounds(size)
+	if err != nil {
+		return nil, err
+	}
+	if h.Size() < 8*extensionDegree {
+		return nil, fmt.Errorf("%w: the digests are too small to derive challenges in the extension", ErrInvalidConfig)
+	}
+	f := FRI{
+		config:       config,
+		h:            h,
+		degreeBounds: degreeBounds,
+		domain:       fft.NewDomain(size * uint64(config.BlowupFactor)),
+	}
+	for _, opt := range opts {
+		opt(&f)
+	}
+	f.shift.SetOne()
+	if f.coset {
+		f.shift = f.domain.FrMultiplicativeGen
+	}
+	f.shiftInv.Inverse(&f.shift)
+	k := config.FoldingFactor
+	var omegaInv goldilocks.Element
+	omegaInv.Exp(f.domain.GeneratorInv, new(big.Int).SetUint64(f.domain.Cardinality/uint64(k)))
+	f.omegaInv = make([]goldilocks.Element, k)
+	f.omegaInv[0].SetOne()
+	for i := 1; i < k; i++ {
+		f.omegaInv[i].Mul(&f.omegaInv[i-1], &omegaInv)
+	}
+	f.kInv.SetUint64(uint64(k)).Inverse(&f.kInv)
+	return &f, nil
+}
+
+// Config returns the configuration of f.
+func (f *FRI) Config() Config {
+	return f.config
+}
+
+// NbRounds returns the number R of folding rounds.
+func (f *FRI) NbRounds() int {
+	return len(f.degreeBounds) - 1
+}
+
+// Domain returns the domain D₀ of size N of the columns, shifted by Shift.
+func (f *FRI) Domain() *fft.Domain {
+	return f.domain
+}
+
+// Shift returns the shift s of the domain of the columns, 1 if the domain is
+// not a coset.
+func (f *FRI) Shift() goldilocks.Element {
+	return f.shift
+}
+
+// LeafPoints returns the points s·gⁱ⁺ᵗᴺᐟᵏ of the rows of the leaf i, for t < k.
+func (f *FRI) LeafPoints(i int) []goldilocks.Element {
+	k := f.config.FoldingFactor
+	res := make([]goldilocks.Element, k)
+	res[0].Exp(f.domain.Generator, big.NewInt(int64(i))).Mul(&res[0], &f.shift)
+	var omega goldilocks.Element
+	omega.Inverse(&f.omegaInv[1])
+	for t := 1; t < k; t++ {
+		res[t].Mul(&res[t-1], &omega)
+	}
+	return res
+}
+
+// Commit returns the commitment to the evaluations on the domain of f of the
+// polynomials, given in canonical basis, of degree < size.
+func (f *FRI) Commit(polynomials [][]goldilocks.Element) (*Commitment, error) {
+	if len(polynomials) == 0 {
+		return nil, ErrNoColumns
+	}
+	for _, p := range polynomials {
+		if uint64(len(p)) > f.degreeBounds[0] {
+			return nil, ErrPolynomialSize
+		}
+	}
+	var opts []fft.Option
+	if f.coset {
+		opts = append(opts, fft.OnCoset())
+	}
+	columns := make([][]goldilocks.Element, len(polynomials))
+	parallel.Execute(len(polynomials), func(start, end int) {
+		for i := start; i < end; i++ {
+			columns[i] = make([]goldilocks.Element, f.domain.Cardinality)
+			copy(columns[i], polynomials[i])
+			f.domain.FFT(columns[i], fft.DIF, opts...)
+			fft.BitReverse(columns[i])
+		}
+	}, 1)
+	return f.commitColumns(columns), nil
+}
+
+// CommitEvaluations returns the commitment to columns given by their
+// evaluations on the domain of f, in natural order.
+func (f *FRI) CommitEvaluations(columns [][]goldilocks.Element) (*Commitment, error) {
+	if len(columns) == 0 {
+		return nil, ErrNoColumns
+	}
+	for _, c := range columns {
+		if uint64(len(c)) != f.domain.Cardinality {
+			return nil, ErrColumnSize
+		}
+	}
+	return f.commitColumns(columns), nil
+}
+
+// commitColumns returns the commitment to columns of size N.
+func (f *FRI) commitColumns(columns [][]goldilocks.Element) *Commitment {
+	k := f.config.FoldingFactor
+	m := int(f.domain.Cardinality) / k
+	tree := newMerkleTree(f.h, m, func(l int, buf []byte) []byte {
+		for t := 0; t < k; t++ {
+			for _, c := range columns {
+				b := c[l+t*m].Bytes()
+				buf = append(buf, b[:]...)
+			}
+		}
+		return buf
+	})
+	return &Commitment{
+		Root:      tree.root(),
+		NbColumns: len(columns),
+		columns:   columns,
+		tree:      tree,
+	}
+}
+
+// Columns returns the evaluations of the committed polynomials on the domain,
+// in natural order.
+func (c *Commitment) Columns() [][]goldilocks.Element {
+	return c.columns
+}
+
+// Open returns the opening of the leaf i of the commitment, made of the rows
+// i + t·N/k for t < k.
+func (c *Commitment) Open(i int) RowOpening {
+	m := len(c.tree.levels[0])
+	k := len(c.columns[0]) / m
+	values := make([]goldilocks.Element, 0, k*len(c.columns))
+	for t := 0; t < k; t++ {
+		for _, column := range c.columns {
+			values = append(values, column[i+t*m])
+		}
+	}
+	return RowOpening{Values: values, Path: c.tree.path(i)}
+}
+
+// Prove returns a proof of proximity of the committed columns.
+func (f *FRI) Prove(c *Commitment) (Proof, error) {
+	proof, _, err := f.ProveWithSeed(c, nil)
+	return proof, err
+}
+
+// ProveWithSeed returns a proof of proximity of the committed columns, whose
+// challenges depend on seed, typically the state of the transcript of a
+// protocol using FRI. It also returns the leaves of the commitment queried by
+// the verifier, at which the protocol may open its own commitments.
+func (f *FRI) ProveWithSeed(c *Commitment, seed []byte) (Proof, []int, error) {
+	var proof Proof
+	fs := f.transcript()
+	gamma, err := f.bindCommitment(fs, seed, c.Root, c.NbColumns)
+	if err != nil {
+		return proof, nil, err
+	}
+
+	// h₀ = ∑ᵢ γⁱcᵢ
+	gammas := powers(gamma, len(c.columns))
+	h := make([]extensions.E2, f.domain.Cardinality)
+	parallel.Execute(len(h), func(start, end int) {
+		var t extensions.E2
+		for j := start; j < end; j++ {
+			for i, column := range c.columns {
+				t.MulByElement(&gammas[i], &column[j])
+				h[j].Add(&h[j], &t)
+			}
+		}
+	})
+
+	// commit phase
+	k := f.config.FoldingFactor
+	codewords := make([][]extensions.E2, f.NbRounds())
+	trees := make([]*merkleTree, f.NbRounds())
+	gInv, sInv := f.domain.GeneratorInv, f.shiftInv
+	for r := range codewords {
+		var root []byte
+		if r > 0 {
+			codewords[r] = h
+			trees[r] = commitCodeword(f.h, h, k)
+			root = trees[r].root()
+			proof.Roots = append(proof.Roots, root)
+		}
+		alpha, err := challenge(fs, alphaID(r), root)
+		if err != nil {
+			return proof, nil, err
+		}
+		h = f.foldCodeword(h, sInv, gInv, alpha)
+		gInv.Exp(gInv, big.NewInt(int64(k)))
+		sInv.Exp(sInv, big.NewInt(int64(k)))
+	}
+	var sFinal goldilocks.Element
+	sFinal.Inverse(&sInv)
+	proof.FinalPolynomial = interpolate(h, sFinal)[:f.degreeBounds[f.NbRounds()]]
+
+	// proof of work and query phase
+	powSeed, err := challengeBytes(fs, grindingID, marshalExtensions(proof.FinalPolynomial))
+	if err != nil {
+		return proof, nil, err
+	}
+	proof.Nonce = grind(f.h, powSeed, f.config.GrindingBits)
+	positions, err := f.queryPositions(fs, proof.Nonce)
+	if err != nil {
+		return proof, nil, err
+	}
+	proof.Rows = make([]RowOpening, len(positions))
+	proof.Queries = make([][]Opening, len(positions))
+	for q, pos := range positions {
+		proof.Queries[q] = make([]Opening, f.NbRounds()-1)
+		size := int(f.domain.Cardinality)
+		for r := 0; r < f.NbRounds(); r++ {
+			m := size / k
+			l := pos % m
+			if r == 0 {
+				proof.Rows[q] = c.Open(l)
+			} else {
+				values := make([]extensions.E2, k)
+				for t := range values {
+					values[t] = codewords[r][l+t*m]
+				}
+				proof.Queries[q][r-1] = Opening{Values: values, Path: trees[r].path(l)}
+			}
+			pos, size = l, m
+		}
+	}
+	return proof, positions, nil
+}
+
+// Verify verifies a proof of proximity of the nbColumns columns committed in
+// root.
+func (f *FRI) Verify(root []byte, nbColumns int, proof *Proof) error {
+	_, err := f.VerifyWithSeed(root, nbColumns, nil, proof)
+	return err
+}
+
+// VerifyWithSeed verifies a proof of proximity of the nbColumns columns
+// committed in root, computed by ProveWithSeed with the same seed, and returns
+// the queried leaves of the commitment.
+func (f *FRI) VerifyWithSeed(root []byte, nbColumns int, seed []byte, proof *Proof) ([]int, error) {
+	if nbColumns < 1 {
+		return nil, ErrNoColumns
+	}
+	if err := f.checkShape(nbColumns, proof); err != nil {
+		return nil, err
+	}
+
+	fs := f.transcript()
+	gamma, err := f.bindCommitment(fs, seed, root, nbColumns)
+	if err != nil {
+		return nil, err
+	}
+	gammas := powers(gamma, nbColumns)
+	alphas := make([]extensions.E2, f.NbRounds())
+	for r := range alphas {
+		var root []byte
+		if r > 0 {
+			root = proof.Roots[r-1]
+		}
+		if alphas[r], err = challenge(fs, alphaID(r), root); err != nil {
+			return nil, err
+		}
+	}
+	powSeed, err := challengeBytes(fs, grindingID, marshalExtensions(proof.FinalPolynomial))
+	if err != nil {
+		return nil, err
+	}
+	if !checkProofOfWork(f.h, powSeed, proof.Nonce, f.config.GrindingBits) {
+		return nil, ErrGrinding
+	}
+	positions, err := f.queryPositions(fs, proof.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	// generators of the domains, and of the final domain
+	k := f.config.FoldingFactor
+	gInvs := make([]goldilocks.Element, f.NbRounds())
+	sInvs := make([]goldilocks.Element, f.NbRounds())
+	gInvs[0], sInvs[0] = f.domain.GeneratorInv, f.shiftInv
+	for r := 1; r < len(gInvs); r++ {
+		gInvs[r].Exp(gInvs[r-1], big.NewInt(int64(k)))
+		sInvs[r].Exp(sInvs[r-1], big.NewInt(int64(k)))
+	}
+	kR := new(big.Int).Exp(big.NewInt(int64(k)), big.NewInt(int64(f.NbRounds())), nil)
+	var gFinal, sFinal goldilocks.Element
+	gFinal.Exp(f.domain.Generator, kR)
+	sFinal.Exp(f.shift, kR)
+
+	var xInv, x goldilocks.Element
+	var t extensions.E2
+	values := make([]extensions.E2, k)
+	for q, pos := range positions {
+		var folded extensions.E2
+		size := int(f.domain.Cardinality)
+		for r := 0; r < f.NbRounds(); r++ {
+			m := size / k
+			l := pos % m
+			if r == 0 {
+				o := &proof.Rows[q]
+				if err := verifyMerklePath(f.h, root, l, marshalElements(o.Values), o.Path); err != nil {
+					return nil, err
+				}
+				for j := range values {
+					values[j].SetZero()
+					for i := range gammas {
+						t.MulByElement(&gammas[i], &o.Values[j*nbColumns+i])
+						values[j].Add(&values[j], &t)
+					}
+				}
+			} else {
+				o := &proof.Queries[q][r-1]
+				if err := verifyMerklePath(f.h, proof.Roots[r-1], l, marshalExtensions(o.Values), o.Path); err != nil {
+					return nil, err
+				}
+				if !o.Values[pos/m].Equal(&folded) {
+					return nil, ErrProximityTestFolding
+				}
+				copy(values, o.Values)
+			}
+			xInv.Exp(gInvs[r], big.NewInt(int64(l))).Mul(&xInv, &sInvs[r])
+			folded = f.fold(values, xInv, alphas[r])
+			pos, size = l, m
+		}
+		x.Exp(gFinal, big.NewInt(int64(pos))).Mul(&x, &sFinal)
+		if e := evaluate(proof.FinalPolynomial, x); !e.Equal(&folded) {
+			return nil, ErrProximityTestFolding
+		}
+	}
+	return positions, nil
+}
+
+// VerifyRowOpening verifies the opening of the leaf i of the commitment to
+// nbColumns columns of given root.
+func (f *FRI) VerifyRowOpening(root []byte, nbColumns, i int, o *RowOpening) error {
+	nbLeaves := f.domain.Cardinality / uint64(f.config.FoldingFactor)
+	if len(o.Values) != f.config.FoldingFactor*nbColumns || len(o.Path) != bits.TrailingZeros64(nbLeaves) || uint64(i) >= nbLeaves {
+		return ErrProofShape
+	}
+	return verifyMerklePath(f.h, root, i, marshalElements(o.Values), o.Path)
+}
+
+// checkShape checks that the proof has the sizes given by the configuration.
+func (f *FRI) checkShape(nbColumns int, proof *Proof) error {
+	if len(proof.Roots) != f.NbRounds()-1 ||
+		uint64(len(proof.FinalPolynomial)) != f.degreeBounds[f.NbRounds()] ||
+		len(proof.Rows) != f.config.NbQueries ||
+		len(proof.Queries) != f.config.NbQueries {
+		return ErrProofShape
+	}
+	k := uint64(f.config.FoldingFactor)
+	for q := range proof.Queries {
+		nbLeaves := f.domain.Cardinality / k
+		if len(proof.Rows[q].Values) != int(k)*nbColumns || len(proof.Rows[q].Path) != bits.TrailingZeros64(nbLeaves) {
+			return ErrProofShape
+		}
+		if len(proof.Queries[q]) != f.NbRounds()-1 {
+			return ErrProofShape
+		}
+		for _, o := range proof.Queries[q] {
+			nbLeaves /= k
+			if len(o.Values) != int(k) || len(o.Path) != bits.TrailingZeros64(nbLeaves) {
+				return ErrProofShape
+			}
+		}
+	}
+	return nil
+}
+
+// fold returns g(α), where g is the polynomial of degree < k such that
+// g(xωᵗ) = vₜ. If vₜ = h(xωᵗ) with h(X) = ∑ⱼ Xʲhⱼ(Xᵏ), then g(α) is the value
+// at xᵏ of the folded polynomial ∑ⱼ αʲhⱼ.
+func (f *FRI) fold(values []extensions.E2, xInv goldilocks.Element, alpha extensions.E2) extensions.E2 {
+	// g(xu) = ∑ⱼ cⱼuʲ where cⱼ = 1/k ∑ₜ vₜω⁻ᵗʲ, so that g(α) = ∑ⱼ cⱼ(α/x)ʲ
+	k := len(values)
+	var beta, res, c, t extensions.E2
+	beta.MulByElement(&alpha, &xInv)
+	for j := k - 1; j >= 0; j-- {
+		c.SetZero()
+		for i := range values {
+			t.MulByElement(&values[i], &f.omegaInv[(i*j)%k])
+			c.Add(&c, &t)
+		}
+		res.Mul(&res, &beta).Add(&res, &c)
+	}
+	return *res.MulByElement(&res, &f.kInv)
+}
+
+// foldCodeword returns the evaluations of the folded polynomial on the domain
+// sᵏ·⟨gᵏ⟩, from the evaluations of the polynomial on the domain s·⟨g⟩.
+func (f *FRI) foldCodeword(codeword []extensions.E2, sInv, gInv goldilocks.Element, alpha extensions.E2) []extensions.E2 {
+	k := f.config.FoldingFactor
+	m := len(codeword) / k
+	res := make([]extensions.E2, m)
+	parallel.Execute(m, func(start, end int) {
+		var xInv goldilocks.Element
+		xInv.Exp(gInv, big.NewInt(int64(start))).Mul(&xInv, &sInv)
+		values := make([]extensions.E2, k)
+		for l := start; l < end; l++ {
+			for t := range values {
+				values[t] = codeword[l+t*m]
+			}
+			res[l] = f.fold(values, xInv, alpha)
+			xInv.Mul(&xInv, &gInv)
+		}
+	})
+	return res
+}
+
+// commitCodeword returns the Merkle tree of the codeword, whose i-th leaf is
+// made of the values at positions i + t·N/k, for t < k.
+func commitCodeword(h hash.Hash, codeword []extensions.E2, k int) *merkleTree {
+	m := len(codeword) / k
+	return newMerkleTree(h, m, func(i int, buf []byte) []byte {
+		for t := 0; t < k; t++ {
+			buf = appendExtension(buf, &codeword[i+t*m])
+		}
+		return buf
+	})
+}
+
+// interpolate returns the coefficients in canonical basis of the polynomial
+// whose evaluations on the coset shift·D of the domain D of size len(values)
+// are given.
+func interpolate(values []extensions.E2, shift goldilocks.Element) []extensions.E2 {
+	domain := fft.NewDomain(uint64(len(values)), fft.WithShift(shift))
+	res := make([]extensions.E2, len(values))
+	coordinate := make([]goldilocks.Element, len(values))
+	for c := 0; c < extensionDegree; c++ {
+		for i := range values {
+			coordinate[i] = *coordinates(&values[i])[c]
+		}
+		domain.FFTInverse(coordinate, fft.DIF, fft.OnCoset())
+		fft.BitReverse(coordinate)
+		for i := range res {
+			*coordinates(&res[i])[c] = coordinate[i]
+		}
+	}
+	return res
+}
+
+// evaluate returns p(x), p being given in canonical basis.
+func evaluate(p []extensions.E2, x goldilocks.Element) extensions.E2 {
+	var res extensions.E2
+	for i := len(p) - 1; i >= 0; i-- {
+		res.MulByElement(&res, &x).Add(&res, &p[i])
+	}
+	return res
+}
+
+// powers returns 1, γ, …, γⁿ⁻¹.
+func powers(gamma extensions.E2, n int) []extensions.E2 {
+	res := make([]extensions.E2, n)
+	if n == 0 {
+		return res
+	}
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &gamma)
+	}
+	return res
+}
+
+// coordinates returns pointers to the coordinates of e over goldilocks.
+func coordinates(e *extensions.E2) [extensionDegree]*goldilocks.Element {
+	return [extensionDegree]*goldilocks.Element{&e.A0, &e.A1}
+}
+
+// appendExtension appends the encodings of the coordinates of e to buf.
+func appendExtension(buf []byte, e *extensions.E2) []byte {
+	for _, c := range coordinates(e) {
+		b := c.Bytes()
+		buf = append(buf, b[:]...)
+	}
+	return buf
+}
+
+// marshalExtensions returns the concatenation of the encodings of v.
+func marshalExtensions(v []extensions.E2) []byte {
+	res := make([]byte, 0, len(v)*extensionDegree*goldilocks.Bytes)
+	for i := range v {
+		res = appendExtension(res, &v[i])
+	}
+	return res
+}
+
+// marshalElements returns the concatenation of the encodings of v.
+func marshalElements(v []goldilocks.Element) []byte {
+	res := make([]byte, 0, len(v)*goldilocks.Bytes)
+	for i := range v {
+		b := v[i].Bytes()
+		res = append(res, b[:]...)
+	}
+	return res
+}
+
+// bindCommitment binds the seed, if any, and the commitment to nbColumns
+// columns, and returns the challenge γ combining the columns.
+func (f *FRI) bindCommitment(fs *fiatshamir.Transcript, seed, root []byte, nbColumns int) (extensions.E2, error) {
+	if seed != nil {
+		if err := fs.Bind(gammaID, seed); err != nil {
+			return extensions.E2{}, err
+		}
+	}
+	data := make([]byte, len(root), len(root)+8)
+	copy(data, root)
+	return challenge(fs, gammaID, binary.BigEndian.AppendUint64(data, uint64(nbColumns)))
+}
+
+const (
+	gammaID    = "gamma"
+	grindingID = "grinding"
+)
+
+func alphaID(round int) string {
+	return fmt.Sprintf("alpha%d", round)
+}
+
+func queryID(query int) string {
+	return fmt.Sprintf("query%d", query)
+}
+
+// transcript returns the Fiat Shamir transcript of f: the challenge γ
+// combining the columns, the challenges αᵣ folding the rounds, the seed of the
+// proof of work, and the queries.
+func (f *FRI) transcript() *fiatshamir.Transcript {
+	ids := make([]string, 0, f.NbRounds()+2+f.config.NbQueries)
+	ids = append(ids, gammaID)
+	for r := 0; r < f.NbRounds(); r++ {
+		ids = append(ids, alphaID(r))
+	}
+	ids = append(ids, grindingID)
+	for q := 0; q < f.config.NbQueries; q++ {
+		ids = append(ids, queryID(q))
+	}
+	return fiatshamir.NewTranscript(f.h, ids...)
+}
+
+// challenge binds data, if any, to the challenge id and returns its value in
+// E2, each coordinate being derived from 8 bytes of the challenge.
+func challenge(fs *fiatshamir.Transcript, id string, data []byte) (extensions.E2, error) {
+	var res extensions.E2
+	b, err := challengeBytes(fs, id, data)
+	if err != nil {
+		return res, err
+	}
+	for i, c := range coordinates(&res) {
+		c.SetUint64(binary.BigEndian.Uint64(b[8*i:]))
+	}
+	return res, nil
+}
+
+// challengeBytes binds data, if any, to the challenge id and returns its
+// value.
+func challengeBytes(fs *fiatshamir.Transcript, id string, data []byte) ([]byte, error) {
+	if data != nil {
+		if err := fs.Bind(id, data); err != nil {
+			return nil, err
+		}
+	}
+	return fs.ComputeChallenge(id)
+}
+
+// queryPositions binds the nonce and returns the leaves of the commitment to
+// the columns queried by the verifier.
+func (f *FRI) queryPositions(fs *fiatshamir.Transcript, nonce uint64) ([]int, error) {
+	var bNonce [8]byte
+	binary.BigEndian.PutUint64(bNonce[:], nonce)
+	if err := fs.Bind(queryID(0), bNonce[:]); err != nil {
+		return nil, err
+	}
+	// the number of leaves is a power of 2, so that masking the challenges
+	// gives uniform positions
+	mask := f.domain.Cardinality/uint64(f.config.FoldingFactor) - 1
+	res := make([]int, f.config.NbQueries)
+	for q := range res {
+		b, err := fs.ComputeChallenge(queryID(q))
+		if err != nil {
+			return nil, err
+		}
+		res[q] = int(binary.BigEndian.Uint64(b[len(b)-8:]) & mask)
+	}
+	return res, nil
+}
+
+// grind returns the smallest nonce such that H(seed ‖ nonce) ends with nbBits
+// zero bits.
+func grind(h hash.Hash, seed []byte, nbBits int) uint64 {
+	var nonce uint64
+	for !checkProofOfWork(h, seed, nonce, nbBits) {
+		nonce++
+	}
+	return nonce
+}
+
+// checkProofOfWork returns true if H(seed ‖ nonce) ends with nbBits zero bits.
+func checkProofOfWork(h hash.Hash, seed []byte, nonce uint64, nbBits int) bool {
+	if nbBits == 0 {
+		return true
+	}
+	var bNonce [8]byte
+	binary.BigEndian.PutUint64(bNonce[:], nonce)
+	h.Reset()
+	if _, err := h.Write(seed); err != nil {
+		panic(err)
+	}
+	if _, err := h.Write(bNonce[:]); err != nil {
+		panic(err)
+	}
+	digest := h.Sum(nil)
+	zeros := 0
+	for i := len(digest) - 1; i >= 0 && zeros < nbBits; i-- {
+		if digest[i] != 0 {
+			zeros += bits.TrailingZeros8(digest[i])
+			break
+		}
+		zeros += 8
+	}
+	return zeros >= nbBits
+}
@@ -0,0 +1,233 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/consensys/gnark-crypto/field/goldilocks/extensions"
+	"github.com/stretchr/testify/require"
+)
+
+func randomPolynomial(size int) []goldilocks.Element {
+	res := make([]goldilocks.Element, size)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+func randomPolynomials(nbPolynomials, size int) [][]goldilocks.Element {
+	res := make([][]goldilocks.Element, nbPolynomials)
+	for i := range res {
+		res[i] = randomPolynomial(size)
+	}
+	return res
+}
+
+func TestFRI(t *testing.T) {
+	const size = 256
+	for _, k := range []int{2, 4, 8, 16} {
+		for _, blowup := range []int{2, 4} {
+			for _, finalDegree := range []int{0, 3, 20} {
+				config := Config{
+					FoldingFactor: k,
+					BlowupFactor:  blowup,
+					NbQueries:     8,
+					FinalDegree:   finalDegree,
+					GrindingBits:  4,
+				}
+				t.Run(fmt.Sprintf("k=%d/blowup=%d/final=%d", k, blowup, finalDegree), func(t *testing.T) {
+					assert := require.New(t)
+
+					f, err := NewFRI(size, sha256.New(), config)
+					assert.NoError(err)
+					assert.LessOrEqual(f.degreeBounds[f.NbRounds()], uint64(finalDegree+1))
+
+					// many columns, some of them of smaller degree
+					polynomials := randomPolynomials(10, size)
+					polynomials[3] = polynomials[3][:size/3]
+					c, err := f.Commit(polynomials)
+					assert.NoError(err)
+					proof, err := f.Prove(c)
+					assert.NoError(err)
+					assert.NoError(f.Verify(c.Root, c.NbColumns, &proof))
+
+					// a single column
+					c, err = f.Commit(polynomials[:1])
+					assert.NoError(err)
+					proof, err = f.Prove(c)
+					assert.NoError(err)
+					assert.NoError(f.Verify(c.Root, 1, &proof))
+				})
+			}
+		}
+	}
+}
+
+func TestFRISoundness(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 256
+	config := Config{FoldingFactor: 4, BlowupFactor: 4, NbQueries: 16, FinalDegree: 3}
+	f, err := NewFRI(size, sha256.New(), config)
+	assert.NoError(err)
+	polynomials := randomPolynomials(5, size)
+	c, err := f.Commit(polynomials)
+	assert.NoError(err)
+	proof, err := f.Prove(c)
+	assert.NoError(err)
+	assert.NoError(f.Verify(c.Root, c.NbColumns, &proof))
+
+	tamper := func(f func(proof *Proof)) *Proof {
+		tampered := proof
+		tampered.Roots = append([][]byte{}, proof.Roots...)
+		tampered.FinalPolynomial = append([]extensions.E2{}, proof.FinalPolynomial...)
+		tampered.Rows = make([]RowOpening, len(proof.Rows))
+		tampered.Queries = make([][]Opening, len(proof.Queries))
+		for q := range proof.Queries {
+			tampered.Rows[q] = RowOpening{Values: append([]goldilocks.Element{}, proof.Rows[q].Values...), Path: proof.Rows[q].Path}
+			tampered.Queries[q] = make([]Opening, len(proof.Queries[q]))
+			for r, o := range proof.Queries[q] {
+				tampered.Queries[q][r] = Opening{Values: append([]extensions.E2{}, o.Values...), Path: o.Path}
+			}
+		}
+		f(&tampered)
+		return &tampered
+	}
+
+	assert.ErrorIs(f.Verify(c.Root, c.NbColumns, tamper(func(proof *Proof) { proof.Rows[2].Values[7].SetRandom() })), ErrMerklePath)
+	assert.ErrorIs(f.Verify(c.Root, c.NbColumns, tamper(func(proof *Proof) { proof.Queries[3][1].Values[2].SetRandom() })), ErrMerklePath)
+	assert.ErrorIs(f.Verify(c.Root, c.NbColumns, tamper(func(proof *Proof) { proof.Roots[0] = proof.Roots[1] })), ErrMerklePath)
+	assert.Error(f.Verify(c.Root, c.NbColumns, tamper(func(proof *Proof) { proof.FinalPolynomial[1].SetRandom() })))
+	assert.ErrorIs(f.Verify(c.Root, c.NbColumns, tamper(func(proof *Proof) { proof.Queries = proof.Queries[1:] })), ErrProofShape)
+	assert.ErrorIs(f.Verify(c.Root, c.NbColumns-1, &proof), ErrProofShape)
+	assert.ErrorIs(f.Verify(c.Root, 0, &proof), ErrNoColumns)
+
+	// a column far from the code
+	columns := append([][]goldilocks.Element{}, c.columns...)
+	columns[2] = randomPolynomial(int(f.domain.Cardinality))
+	proof, err = f.Prove(f.commitColumns(columns))
+	assert.NoError(err)
+	assert.ErrorIs(f.Verify(f.commitColumns(columns).Root, c.NbColumns, &proof), ErrProximityTestFolding)
+
+	_, err = f.Commit(randomPolynomials(2, size+1))
+	assert.ErrorIs(err, ErrPolynomialSize)
+	_, err = f.Commit(nil)
+	assert.ErrorIs(err, ErrNoColumns)
+
+	// the nonce is the smallest one with enough zero bits
+	config.GrindingBits = 8
+	f, err = NewFRI(size, sha256.New(), config)
+	assert.NoError(err)
+	c, err = f.Commit(polynomials)
+	assert.NoError(err)
+	proof, err = f.Prove(c)
+	assert.NoError(err)
+	assert.NoError(f.Verify(c.Root, c.NbColumns, &proof))
+	assert.ErrorIs(f.Verify(c.Root, c.NbColumns, tamper(func(proof *Proof) { proof.Nonce-- })), ErrGrinding)
+}
+
+func TestFRICoset(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 128
+	f, err := NewFRI(size, sha256.New(), Config{FoldingFactor: 4, BlowupFactor: 4, NbQueries: 8, FinalDegree: 3}, WithCoset())
+	assert.NoError(err)
+	shift := f.Shift()
+	assert.False(shift.IsOne())
+	polynomials := randomPolynomials(3, size)
+	c, err := f.Commit(polynomials)
+	assert.NoError(err)
+
+	// the columns are the evaluations on the coset
+	points := f.LeafPoints(5)
+	m := int(f.Domain().Cardinality) / f.Config().FoldingFactor
+	for t, x := range points {
+		var e goldilocks.Element
+		for i := len(polynomials[1]) - 1; i >= 0; i-- {
+			e.Mul(&e, &x).Add(&e, &polynomials[1][i])
+		}
+		assert.True(e.Equal(&c.Columns()[1][5+t*m]))
+	}
+	c2, err := f.CommitEvaluations(c.Columns())
+	assert.NoError(err)
+	assert.Equal(c.Root, c2.Root)
+	_, err = f.CommitEvaluations([][]goldilocks.Element{polynomials[0]})
+	assert.ErrorIs(err, ErrColumnSize)
+
+	seed := []byte("seed")
+	proof, positions, err := f.ProveWithSeed(c, seed)
+	assert.NoError(err)
+	verified, err := f.VerifyWithSeed(c.Root, c.NbColumns, seed, &proof)
+	assert.NoError(err)
+	assert.Equal(positions, verified)
+	assert.Error(f.Verify(c.Root, c.NbColumns, &proof))
+
+	// openings at the queried leaves
+	for q, l := range positions {
+		o := c.Open(l)
+		assert.Equal(proof.Rows[q].Values, o.Values)
+		assert.NoError(f.VerifyRowOpening(c.Root, c.NbColumns, l, &o))
+		assert.ErrorIs(f.VerifyRowOpening(c.Root, c.NbColumns, l^1, &o), ErrMerklePath)
+	}
+}
+
+func TestConfig(t *testing.T) {
+	assert := require.New(t)
+
+	config := DefaultConfig()
+	assert.NoError(config.Check())
+	assert.InDelta(100, config.ConjecturedSecurity(1<<20), 1)
+	assert.Greater(config.ConjecturedSecurity(1<<20), config.ProvableSecurity(1<<20))
+
+	for _, invalid := range []Config{
+		{FoldingFactor: 3, BlowupFactor: 2, NbQueries: 1},
+		{FoldingFactor: 2, BlowupFactor: 3, NbQueries: 1},
+		{FoldingFactor: 2, BlowupFactor: 2, NbQueries: 0},
+		{FoldingFactor: 2, BlowupFactor: 2, NbQueries: 1, FinalDegree: -1},
+		{FoldingFactor: 2, BlowupFactor: 2, NbQueries: 1, GrindingBits: 40},
+	} {
+		assert.ErrorIs(invalid.Check(), ErrInvalidConfig)
+	}
+	_, err := NewFRI(100, sha256.New(), config)
+	assert.ErrorIs(err, ErrInvalidConfig)
+}
+
+func BenchmarkFRI(b *testing.B) {
+	const size = 1 << 14
+	polynomials := randomPolynomials(16, size)
+	f, err := NewFRI(size, sha256.New(), DefaultConfig())
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("commit", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = f.Commit(polynomials)
+		}
+	})
+	c, err := f.Commit(polynomials)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("prove", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = f.Prove(c)
+		}
+	})
+	proof, err := f.Prove(c)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("verify", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = f.Verify(c.Root, c.NbColumns, &proof)
+		}
+	})
+}
@@ -0,0 +1,6858 @@
+//go:build !purego
+
+// Code generated by gnark-crypto/generator. DO NOT EDIT.
+#include "textflag.h"
+#include "funcdata.h"
+#include "go_asm.h"
+
+// permutation8AVX512(states, roundKeys, diag *Element) applies the poseidon2 permutation
+// of width 8 on the transposed states
+TEXT ·permutation8AVX512(SB), NOSPLIT, $0-24
+	MOVQ         $0xffffffff00000001, BX
+	VPBROADCASTQ BX, Z31
+	MOVL         $0xffffffff, BX
+	VPBROADCASTQ BX, Z30
+	VPTERNLOGD   $0xff, Z29, Z29, Z29
+	MOVQ         states+0(FP), AX
+	MOVQ         roundKeys+8(FP), DX
+	MOVQ         diag+16(FP), CX
+	VMOVDQU64    0(AX), Z0
+	VMOVDQU64    64(AX), Z1
+	VMOVDQU64    128(AX), Z2
+	VMOVDQU64    192(AX), Z3
+	VMOVDQU64    256(AX), Z4
+	VMOVDQU64    320(AX), Z5
+	VMOVDQU64    384(AX), Z6
+	VMOVDQU64    448(AX), Z7
+
+	// the initial external matrix
+	VPSUBQ    Z1, Z31, Z10
+	VPCMPUQ   $1, Z10, Z0, K1
+	VPSUBQ    Z10, Z0, Z8
+	VPADDQ    Z31, Z8, K1, Z8
+	VPSUBQ    Z3, Z31, Z10
+	VPCMPUQ   $1, Z10, Z2, K1
+	VPSUBQ    Z10, Z2, Z9
+	VPADDQ    Z31, Z9, K1, Z9
+	VPSUBQ    Z1, Z31, Z10
+	VPCMPUQ   $1, Z10, Z1, K1
+	VPSUBQ    Z10, Z1, Z1
+	VPADDQ    Z31, Z1, K1, Z1
+	VPSUBQ    Z9, Z31, Z10
+	VPCMPUQ   $1, Z10, Z1, K1
+	VPSUBQ    Z10, Z1, Z1
+	VPADDQ    Z31, Z1, K1, Z1
+	VPSUBQ    Z3, Z31, Z10
+	VPCMPUQ   $1, Z10, Z3, K1
+	VPSUBQ    Z10, Z3, Z3
+	VPADDQ    Z31, Z3, K1, Z3
+	VPSUBQ    Z8, Z31, Z10
+	VPCMPUQ   $1, Z10, Z3, K1
+	VPSUBQ    Z10, Z3, Z3
+	VPADDQ    Z31, Z3, K1, Z3
+	VPSUBQ    Z9, Z31, Z10
+	VPCMPUQ   $1, Z10, Z9, K1
+	VPSUBQ    Z10, Z9, Z9
+	VPADDQ    Z31, Z9, K1, Z9
+	VPSUBQ    Z9, Z31, Z10
+	VPCMPUQ   $1, Z10, Z9, K1
+	VPSUBQ    Z10, Z9, Z9
+	VPADDQ    Z31, Z9, K1, Z9
+	VPSUBQ    Z3, Z31, Z10
+	VPCMPUQ   $1, Z10, Z9, K1
+	VPSUBQ    Z10, Z9, Z9
+	VPADDQ    Z31, Z9, K1, Z9
+	VPSUBQ    Z8, Z31, Z10
+	VPCMPUQ   $1, Z10, Z8, K1
+	VPSUBQ    Z10, Z8, Z8
+	VPADDQ    Z31, Z8, K1, Z8
+	VPSUBQ    Z8, Z31, Z10
+	VPCMPUQ   $1, Z10, Z8, K1
+	VPSUBQ    Z10, Z8, Z8
+	VPADDQ    Z31, Z8, K1, Z8
+	VPSUBQ    Z1, Z31, Z10
+	VPCMPUQ   $1, Z10, Z8, K1
+	VPSUBQ    Z10, Z8, Z8
+	VPADDQ    Z31, Z8, K1, Z8
+	VPSUBQ    Z8, Z31, Z10
+	VPCMPUQ   $1, Z10, Z3, K1
+	VPSUBQ    Z10, Z3, Z0
+	VPADDQ    Z31, Z0, K1, Z0
+	VPSUBQ    Z9, Z31, Z10
+	VPCMPUQ   $1, Z10, Z1, K1
+	VPSUBQ    Z10, Z1, Z2
+	VPADDQ    Z31, Z2, K1, Z2
+	VMOVDQA64 Z8, Z1
+	VMOVDQA64 Z9, Z3
+	VPSUBQ    Z5, Z31, Z10
+	VPCMPUQ   $1, Z10, Z4, K1
+	VPSUBQ    Z10, Z4, Z8
+	VPADDQ    Z31, Z8, K1, Z8
+	VPSUBQ    Z7, Z31, Z10
+	VPCMPUQ   $1, Z10, Z6, K1
+	VPSUBQ    Z10, Z6, Z9
+	VPADDQ    Z31, Z9, K1, Z9
+	VPSUBQ    Z5, Z31, Z10
+	VPCMPUQ   $1, Z10, Z5, K1
+	VPSUBQ    Z10, Z5, Z5
+	VPADDQ    Z31, Z5, K1, Z5
+	VPSUBQ    Z9, Z31, Z10
+	VPCMPUQ   $1, Z10, Z5, K1
+	VPSUBQ    Z10, Z5, Z5
+	VPADDQ    Z31, Z5, K1, Z5
+	VPSUBQ    Z7, Z31, Z10
+	VPCMPUQ   $1, Z10, Z7, K1
+	VPSUBQ    Z10, Z7, Z7
+	VPADDQ    Z31, Z7, K1, Z7
+	VPSUBQ    Z8, Z31, Z10
+	VPCMPUQ   $1, Z10, Z7, K1
+	VPSUBQ    Z10, Z7, Z7
+	VPADDQ    Z31, Z7, K1, Z7
+	VPSUBQ    Z9, Z31, Z10
+	VPCMPUQ   $1, Z10, Z9, K1
+	VPSUBQ    Z10, Z9, Z9
+	VPADDQ    Z31, Z9, K1, Z9
+	VPSUBQ    Z9, Z31, Z10
+	VPCMPUQ   $1, Z10, Z9, K1
+	VPSUBQ    Z10, Z9, Z9
+	VPADDQ    Z31, Z9, K1, Z9
+	VPSUBQ    Z7, Z31, Z10
+	VPCMPUQ   $1, Z10, Z9, K1
+	VPSUBQ    Z10, Z9, Z9
+	VPADDQ    Z31, Z9, K1, Z9
+	VPSUBQ    Z8, Z31, Z10
+	VPCMPUQ   $1, Z10, Z8, K1
+	VPSUBQ    Z10, Z8, Z8
+	VPADDQ    Z31, Z8, K1, Z8
+	VPSUBQ    Z8, Z31, Z10
+	VPCMPUQ   $1, Z10, Z8, K1
+	VPSUBQ    Z10, Z8, Z8
+	VPADDQ    Z31, Z8, K1, Z8
+	VPSUBQ    Z5, Z31, Z10
+	VPCMPUQ   $1, Z10, Z8, K1
+	VPSUBQ    Z10, Z8, Z8
+	VPADDQ    Z31, Z8, K1, Z8
+	VPSUBQ    Z8, Z31, Z10
+	VPCMPUQ   $1, Z10, Z7, K1
+	VPSUBQ    Z10, Z7, Z4
+	VPADDQ    Z31, Z4, K1, Z4
+	VPSUBQ    Z9, Z31, Z10
+	VPCMPUQ   $1, Z10, Z5, K1
+	VPSUBQ    Z10, Z5, Z6
+	VPADDQ    Z31, Z6, K1, Z6
+	VMOVDQA64 Z8, Z5
+	VMOVDQA64 Z9, Z7
+	VPSUBQ    Z4, Z31, Z12
+	VPCMPUQ   $1, Z12, Z0, K1
+	VPSUBQ    Z12, Z0, Z8
+	VPADDQ    Z31, Z8, K1, Z8
+	VPSUBQ    Z5, Z31, Z12
+	VPCMPUQ   $1, Z12, Z1, K1
+	VPSUBQ    Z12, Z1, Z9
+	VPADDQ    Z31, Z9, K1, Z9
+	VPSUBQ    Z6, Z31, Z12
+	VPCMPUQ   $1, Z12, Z2, K1
+	VPSUBQ    Z12, Z2, Z10
+	VPADDQ    Z31, Z10, K1, Z10
+	VPSUBQ    Z7, Z31, Z12
+	VPCMPUQ   $1, Z12, Z3, K1
+	VPSUBQ    Z12, Z3, Z11
+	VPADDQ    Z31, Z11, K1, Z11
+	VPSUBQ    Z8, Z31, Z12
+	VPCMPUQ   $1, Z12, Z0, K1
+	VPSUBQ    Z12, Z0, Z0
+	VPADDQ    Z31, Z0, K1, Z0
+	VPSUBQ    Z9, Z31, Z12
+	VPCMPUQ   $1, Z12, Z1, K1
+	VPSUBQ    Z12, Z1, Z1
+	VPADDQ    Z31, Z1, K1, Z1
+	VPSUBQ    Z10, Z31, Z12
+	VPCMPUQ   $1, Z12, Z2, K1
+	VPSUBQ    Z12, Z2, Z2
+	VPADDQ    Z31, Z2, K1, Z2
+	VPSUBQ    Z11, Z31, Z12
+	VPCMPUQ   $1, Z12, Z3, K1
+	VPSUBQ    Z12, Z3, Z3
+	VPADDQ    Z31, Z3, K1, Z3
+	VPSUBQ    Z8, Z31, Z12
+	VPCMPUQ   $1, Z12, Z4, K1
+	VPSUBQ    Z12, Z4, Z4
+	VPADDQ    Z31, Z4, K1, Z4
+	VPSUBQ    Z9, Z31, Z12
+	VPCMPUQ   $1, Z12, Z5, K1
+	VPSUBQ    Z12, Z5, Z5
+	VPADDQ    Z31, Z5, K1, Z5
+	VPSUBQ    Z10, Z31, Z12
+	VPCMPUQ   $1, Z12, Z6, K1
+	VPSUBQ    Z12, Z6, Z6
+	VPADDQ    Z31, Z6, K1, Z6
+	VPSUBQ    Z11, Z31, Z12
+	VPCMPUQ   $1, Z12, Z7, K1
+	VPSUBQ    Z12, Z7, Z7
+	VPADDQ    Z31, Z7, K1, Z7
+
+	// the first half of the full rounds
+	MOVQ $4, BX
+
+loop_1:
+	TESTQ        BX, BX
+	JEQ          done_2            // n == 0, we are done
+	VPBROADCASTQ 0(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z0, K1
+	VPSUBQ       Z9, Z0, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPBROADCASTQ 8(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z1, K1
+	VPSUBQ       Z9, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPBROADCASTQ 16(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z2, K1
+	VPSUBQ       Z9, Z2, Z2
+	VPADDQ       Z31, Z2, K1, Z2
+	VPBROADCASTQ 24(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z3, K1
+	VPSUBQ       Z9, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPBROADCASTQ 32(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z4, K1
+	VPSUBQ       Z9, Z4, Z4
+	VPADDQ       Z31, Z4, K1, Z4
+	VPBROADCASTQ 40(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z5, K1
+	VPSUBQ       Z9, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPBROADCASTQ 48(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z6, K1
+	VPSUBQ       Z9, Z6, Z6
+	VPADDQ       Z31, Z6, K1, Z6
+	VPBROADCASTQ 56(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z7, K1
+	VPSUBQ       Z9, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPSRLQ       $32, Z0, Z10
+	VPSRLQ       $32, Z0, Z11
+	VPMULUDQ     Z0, Z0, Z12
+	VPMULUDQ     Z11, Z0, Z13
+	VPMULUDQ     Z0, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z0, Z11
+	VPMULUDQ     Z0, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z0, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z0
+	VPADDQ       Z31, Z0, K2, Z0
+	VPSRLQ       $32, Z1, Z10
+	VPSRLQ       $32, Z1, Z11
+	VPMULUDQ     Z1, Z1, Z12
+	VPMULUDQ     Z11, Z1, Z13
+	VPMULUDQ     Z1, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z1, Z11
+	VPMULUDQ     Z1, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z1, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z1
+	VPADDQ       Z31, Z1, K2, Z1
+	VPSRLQ       $32, Z2, Z10
+	VPSRLQ       $32, Z2, Z11
+	VPMULUDQ     Z2, Z2, Z12
+	VPMULUDQ     Z11, Z2, Z13
+	VPMULUDQ     Z2, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z2, Z11
+	VPMULUDQ     Z2, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z2, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z2
+	VPADDQ       Z31, Z2, K2, Z2
+	VPSRLQ       $32, Z3, Z10
+	VPSRLQ       $32, Z3, Z11
+	VPMULUDQ     Z3, Z3, Z12
+	VPMULUDQ     Z11, Z3, Z13
+	VPMULUDQ     Z3, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z3, Z11
+	VPMULUDQ     Z3, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z3, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z3
+	VPADDQ       Z31, Z3, K2, Z3
+	VPSRLQ       $32, Z4, Z10
+	VPSRLQ       $32, Z4, Z11
+	VPMULUDQ     Z4, Z4, Z12
+	VPMULUDQ     Z11, Z4, Z13
+	VPMULUDQ     Z4, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z4, Z11
+	VPMULUDQ     Z4, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z4, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z4
+	VPADDQ       Z31, Z4, K2, Z4
+	VPSRLQ       $32, Z5, Z10
+	VPSRLQ       $32, Z5, Z11
+	VPMULUDQ     Z5, Z5, Z12
+	VPMULUDQ     Z11, Z5, Z13
+	VPMULUDQ     Z5, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z5, Z11
+	VPMULUDQ     Z5, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z5, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z5
+	VPADDQ       Z31, Z5, K2, Z5
+	VPSRLQ       $32, Z6, Z10
+	VPSRLQ       $32, Z6, Z11
+	VPMULUDQ     Z6, Z6, Z12
+	VPMULUDQ     Z11, Z6, Z13
+	VPMULUDQ     Z6, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z6, Z11
+	VPMULUDQ     Z6, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z6, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z6
+	VPADDQ       Z31, Z6, K2, Z6
+	VPSRLQ       $32, Z7, Z10
+	VPSRLQ       $32, Z7, Z11
+	VPMULUDQ     Z7, Z7, Z12
+	VPMULUDQ     Z11, Z7, Z13
+	VPMULUDQ     Z7, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z7, Z11
+	VPMULUDQ     Z7, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z7, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z7
+	VPADDQ       Z31, Z7, K2, Z7
+	VPSUBQ       Z1, Z31, Z10
+	VPCMPUQ      $1, Z10, Z0, K1
+	VPSUBQ       Z10, Z0, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z3, Z31, Z10
+	VPCMPUQ      $1, Z10, Z2, K1
+	VPSUBQ       Z10, Z2, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z1, Z31, Z10
+	VPCMPUQ      $1, Z10, Z1, K1
+	VPSUBQ       Z10, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z1, K1
+	VPSUBQ       Z10, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPSUBQ       Z3, Z31, Z10
+	VPCMPUQ      $1, Z10, Z3, K1
+	VPSUBQ       Z10, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z3, K1
+	VPSUBQ       Z10, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z9, K1
+	VPSUBQ       Z10, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z9, K1
+	VPSUBQ       Z10, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z3, Z31, Z10
+	VPCMPUQ      $1, Z10, Z9, K1
+	VPSUBQ       Z10, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z1, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z3, K1
+	VPSUBQ       Z10, Z3, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z1, K1
+	VPSUBQ       Z10, Z1, Z2
+	VPADDQ       Z31, Z2, K1, Z2
+	VMOVDQA64    Z8, Z1
+	VMOVDQA64    Z9, Z3
+	VPSUBQ       Z5, Z31, Z10
+	VPCMPUQ      $1, Z10, Z4, K1
+	VPSUBQ       Z10, Z4, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z7, Z31, Z10
+	VPCMPUQ      $1, Z10, Z6, K1
+	VPSUBQ       Z10, Z6, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z5, Z31, Z10
+	VPCMPUQ      $1, Z10, Z5, K1
+	VPSUBQ       Z10, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z5, K1
+	VPSUBQ       Z10, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPSUBQ       Z7, Z31, Z10
+	VPCMPUQ      $1, Z10, Z7, K1
+	VPSUBQ       Z10, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z7, K1
+	VPSUBQ       Z10, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z9, K1
+	VPSUBQ       Z10, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z9, K1
+	VPSUBQ       Z10, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z7, Z31, Z10
+	VPCMPUQ      $1, Z10, Z9, K1
+	VPSUBQ       Z10, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z5, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z7, K1
+	VPSUBQ       Z10, Z7, Z4
+	VPADDQ       Z31, Z4, K1, Z4
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z5, K1
+	VPSUBQ       Z10, Z5, Z6
+	VPADDQ       Z31, Z6, K1, Z6
+	VMOVDQA64    Z8, Z5
+	VMOVDQA64    Z9, Z7
+	VPSUBQ       Z4, Z31, Z12
+	VPCMPUQ      $1, Z12, Z0, K1
+	VPSUBQ       Z12, Z0, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z5, Z31, Z12
+	VPCMPUQ      $1, Z12, Z1, K1
+	VPSUBQ       Z12, Z1, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z6, Z31, Z12
+	VPCMPUQ      $1, Z12, Z2, K1
+	VPSUBQ       Z12, Z2, Z10
+	VPADDQ       Z31, Z10, K1, Z10
+	VPSUBQ       Z7, Z31, Z12
+	VPCMPUQ      $1, Z12, Z3, K1
+	VPSUBQ       Z12, Z3, Z11
+	VPADDQ       Z31, Z11, K1, Z11
+	VPSUBQ       Z8, Z31, Z12
+	VPCMPUQ      $1, Z12, Z0, K1
+	VPSUBQ       Z12, Z0, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPSUBQ       Z9, Z31, Z12
+	VPCMPUQ      $1, Z12, Z1, K1
+	VPSUBQ       Z12, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPSUBQ       Z10, Z31, Z12
+	VPCMPUQ      $1, Z12, Z2, K1
+	VPSUBQ       Z12, Z2, Z2
+	VPADDQ       Z31, Z2, K1, Z2
+	VPSUBQ       Z11, Z31, Z12
+	VPCMPUQ      $1, Z12, Z3, K1
+	VPSUBQ       Z12, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPSUBQ       Z8, Z31, Z12
+	VPCMPUQ      $1, Z12, Z4, K1
+	VPSUBQ       Z12, Z4, Z4
+	VPADDQ       Z31, Z4, K1, Z4
+	VPSUBQ       Z9, Z31, Z12
+	VPCMPUQ      $1, Z12, Z5, K1
+	VPSUBQ       Z12, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPSUBQ       Z10, Z31, Z12
+	VPCMPUQ      $1, Z12, Z6, K1
+	VPSUBQ       Z12, Z6, Z6
+	VPADDQ       Z31, Z6, K1, Z6
+	VPSUBQ       Z11, Z31, Z12
+	VPCMPUQ      $1, Z12, Z7, K1
+	VPSUBQ       Z12, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	ADDQ         $64, DX
+	DECQ         BX                // decrement n
+	JMP          loop_1
+
+done_2:
+	// the partial rounds
+	MOVQ $22, BX
+
+loop_3:
+	TESTQ        BX, BX
+	JEQ          done_4            // n == 0, we are done
+	VPBROADCASTQ 0(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z0, K1
+	VPSUBQ       Z9, Z0, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPSRLQ       $32, Z0, Z10
+	VPSRLQ       $32, Z0, Z11
+	VPMULUDQ     Z0, Z0, Z12
+	VPMULUDQ     Z11, Z0, Z13
+	VPMULUDQ     Z0, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z0, Z11
+	VPMULUDQ     Z0, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z0, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z0
+	VPADDQ       Z31, Z0, K2, Z0
+	VPSUBQ       Z1, Z31, Z10
+	VPCMPUQ      $1, Z10, Z0, K1
+	VPSUBQ       Z10, Z0, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z2, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z3, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z4, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z5, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z6, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z7, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPBROADCASTQ 0(CX), Z9
+	VPSRLQ       $32, Z0, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z0, Z12
+	VPMULUDQ     Z11, Z0, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z0
+	VPADDQ       Z31, Z0, K2, Z0
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z0, K1
+	VPSUBQ       Z10, Z0, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPBROADCASTQ 8(CX), Z9
+	VPSRLQ       $32, Z1, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z1, Z12
+	VPMULUDQ     Z11, Z1, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z1
+	VPADDQ       Z31, Z1, K2, Z1
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z1, K1
+	VPSUBQ       Z10, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPBROADCASTQ 16(CX), Z9
+	VPSRLQ       $32, Z2, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z2, Z12
+	VPMULUDQ     Z11, Z2, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z2
+	VPADDQ       Z31, Z2, K2, Z2
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z2, K1
+	VPSUBQ       Z10, Z2, Z2
+	VPADDQ       Z31, Z2, K1, Z2
+	VPBROADCASTQ 24(CX), Z9
+	VPSRLQ       $32, Z3, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z3, Z12
+	VPMULUDQ     Z11, Z3, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z3
+	VPADDQ       Z31, Z3, K2, Z3
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z3, K1
+	VPSUBQ       Z10, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPBROADCASTQ 32(CX), Z9
+	VPSRLQ       $32, Z4, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z4, Z12
+	VPMULUDQ     Z11, Z4, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z4
+	VPADDQ       Z31, Z4, K2, Z4
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z4, K1
+	VPSUBQ       Z10, Z4, Z4
+	VPADDQ       Z31, Z4, K1, Z4
+	VPBROADCASTQ 40(CX), Z9
+	VPSRLQ       $32, Z5, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z5, Z12
+	VPMULUDQ     Z11, Z5, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z5
+	VPADDQ       Z31, Z5, K2, Z5
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z5, K1
+	VPSUBQ       Z10, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPBROADCASTQ 48(CX), Z9
+	VPSRLQ       $32, Z6, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z6, Z12
+	VPMULUDQ     Z11, Z6, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z6
+	VPADDQ       Z31, Z6, K2, Z6
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z6, K1
+	VPSUBQ       Z10, Z6, Z6
+	VPADDQ       Z31, Z6, K1, Z6
+	VPBROADCASTQ 56(CX), Z9
+	VPSRLQ       $32, Z7, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z7, Z12
+	VPMULUDQ     Z11, Z7, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z7
+	VPADDQ       Z31, Z7, K2, Z7
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z7, K1
+	VPSUBQ       Z10, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	ADDQ         $8, DX
+	DECQ         BX                // decrement n
+	JMP          loop_3
+
+done_4:
+	// the second half of the full rounds
+	MOVQ $4, BX
+
+loop_5:
+	TESTQ        BX, BX
+	JEQ          done_6            // n == 0, we are done
+	VPBROADCASTQ 0(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z0, K1
+	VPSUBQ       Z9, Z0, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPBROADCASTQ 8(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z1, K1
+	VPSUBQ       Z9, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPBROADCASTQ 16(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z2, K1
+	VPSUBQ       Z9, Z2, Z2
+	VPADDQ       Z31, Z2, K1, Z2
+	VPBROADCASTQ 24(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z3, K1
+	VPSUBQ       Z9, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPBROADCASTQ 32(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z4, K1
+	VPSUBQ       Z9, Z4, Z4
+	VPADDQ       Z31, Z4, K1, Z4
+	VPBROADCASTQ 40(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z5, K1
+	VPSUBQ       Z9, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPBROADCASTQ 48(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z6, K1
+	VPSUBQ       Z9, Z6, Z6
+	VPADDQ       Z31, Z6, K1, Z6
+	VPBROADCASTQ 56(DX), Z8
+	VPSUBQ       Z8, Z31, Z9
+	VPCMPUQ      $1, Z9, Z7, K1
+	VPSUBQ       Z9, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPSRLQ       $32, Z0, Z10
+	VPSRLQ       $32, Z0, Z11
+	VPMULUDQ     Z0, Z0, Z12
+	VPMULUDQ     Z11, Z0, Z13
+	VPMULUDQ     Z0, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z0, Z11
+	VPMULUDQ     Z0, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z0, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z0
+	VPADDQ       Z31, Z0, K2, Z0
+	VPSRLQ       $32, Z1, Z10
+	VPSRLQ       $32, Z1, Z11
+	VPMULUDQ     Z1, Z1, Z12
+	VPMULUDQ     Z11, Z1, Z13
+	VPMULUDQ     Z1, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z1, Z11
+	VPMULUDQ     Z1, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z1, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z1
+	VPADDQ       Z31, Z1, K2, Z1
+	VPSRLQ       $32, Z2, Z10
+	VPSRLQ       $32, Z2, Z11
+	VPMULUDQ     Z2, Z2, Z12
+	VPMULUDQ     Z11, Z2, Z13
+	VPMULUDQ     Z2, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z2, Z11
+	VPMULUDQ     Z2, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z2, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z2
+	VPADDQ       Z31, Z2, K2, Z2
+	VPSRLQ       $32, Z3, Z10
+	VPSRLQ       $32, Z3, Z11
+	VPMULUDQ     Z3, Z3, Z12
+	VPMULUDQ     Z11, Z3, Z13
+	VPMULUDQ     Z3, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z3, Z11
+	VPMULUDQ     Z3, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z3, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z3
+	VPADDQ       Z31, Z3, K2, Z3
+	VPSRLQ       $32, Z4, Z10
+	VPSRLQ       $32, Z4, Z11
+	VPMULUDQ     Z4, Z4, Z12
+	VPMULUDQ     Z11, Z4, Z13
+	VPMULUDQ     Z4, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z4, Z11
+	VPMULUDQ     Z4, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z4, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z4
+	VPADDQ       Z31, Z4, K2, Z4
+	VPSRLQ       $32, Z5, Z10
+	VPSRLQ       $32, Z5, Z11
+	VPMULUDQ     Z5, Z5, Z12
+	VPMULUDQ     Z11, Z5, Z13
+	VPMULUDQ     Z5, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z5, Z11
+	VPMULUDQ     Z5, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z5, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z5
+	VPADDQ       Z31, Z5, K2, Z5
+	VPSRLQ       $32, Z6, Z10
+	VPSRLQ       $32, Z6, Z11
+	VPMULUDQ     Z6, Z6, Z12
+	VPMULUDQ     Z11, Z6, Z13
+	VPMULUDQ     Z6, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z6, Z11
+	VPMULUDQ     Z6, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z6, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z6
+	VPADDQ       Z31, Z6, K2, Z6
+	VPSRLQ       $32, Z7, Z10
+	VPSRLQ       $32, Z7, Z11
+	VPMULUDQ     Z7, Z7, Z12
+	VPMULUDQ     Z11, Z7, Z13
+	VPMULUDQ     Z7, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z7, Z11
+	VPMULUDQ     Z7, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z7, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z8, Z11
+	VPMULUDQ     Z8, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z8, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z8, Z10
+	VPSRLQ       $32, Z9, Z11
+	VPMULUDQ     Z9, Z8, Z12
+	VPMULUDQ     Z11, Z8, Z13
+	VPMULUDQ     Z9, Z10, Z14
+	VPMULUDQ     Z11, Z10, Z10
+	VPSRLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z13, Z13
+	VPANDQ       Z30, Z13, Z11
+	VPADDQ       Z11, Z14, Z14
+	VPSRLQ       $32, Z13, Z13
+	VPADDQ       Z13, Z10, Z10
+	VPSRLQ       $32, Z14, Z11
+	VPADDQ       Z11, Z10, Z10
+	VPSLLQ       $32, Z14, Z14
+	VPANDQ       Z30, Z12, Z12
+	VPORQ        Z14, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPADDQ       Z11, Z12, Z12
+	VPSLLQ       $32, Z12, Z11
+	VPCMPUQ      $1, Z11, Z12, K2
+	VPSRLQ       $32, Z12, Z13
+	VPSUBQ       Z13, Z12, Z12
+	VPADDQ       Z29, Z12, K2, Z12
+	VPCMPUQ      $1, Z12, Z10, K2
+	VPSUBQ       Z12, Z10, Z7
+	VPADDQ       Z31, Z7, K2, Z7
+	VPSUBQ       Z1, Z31, Z10
+	VPCMPUQ      $1, Z10, Z0, K1
+	VPSUBQ       Z10, Z0, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z3, Z31, Z10
+	VPCMPUQ      $1, Z10, Z2, K1
+	VPSUBQ       Z10, Z2, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z1, Z31, Z10
+	VPCMPUQ      $1, Z10, Z1, K1
+	VPSUBQ       Z10, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z1, K1
+	VPSUBQ       Z10, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPSUBQ       Z3, Z31, Z10
+	VPCMPUQ      $1, Z10, Z3, K1
+	VPSUBQ       Z10, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z3, K1
+	VPSUBQ       Z10, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z9, K1
+	VPSUBQ       Z10, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z9, K1
+	VPSUBQ       Z10, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z3, Z31, Z10
+	VPCMPUQ      $1, Z10, Z9, K1
+	VPSUBQ       Z10, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z1, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z3, K1
+	VPSUBQ       Z10, Z3, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z1, K1
+	VPSUBQ       Z10, Z1, Z2
+	VPADDQ       Z31, Z2, K1, Z2
+	VMOVDQA64    Z8, Z1
+	VMOVDQA64    Z9, Z3
+	VPSUBQ       Z5, Z31, Z10
+	VPCMPUQ      $1, Z10, Z4, K1
+	VPSUBQ       Z10, Z4, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z7, Z31, Z10
+	VPCMPUQ      $1, Z10, Z6, K1
+	VPSUBQ       Z10, Z6, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z5, Z31, Z10
+	VPCMPUQ      $1, Z10, Z5, K1
+	VPSUBQ       Z10, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z5, K1
+	VPSUBQ       Z10, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPSUBQ       Z7, Z31, Z10
+	VPCMPUQ      $1, Z10, Z7, K1
+	VPSUBQ       Z10, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z7, K1
+	VPSUBQ       Z10, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z9, K1
+	VPSUBQ       Z10, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z9, K1
+	VPSUBQ       Z10, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z7, Z31, Z10
+	VPCMPUQ      $1, Z10, Z9, K1
+	VPSUBQ       Z10, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z5, Z31, Z10
+	VPCMPUQ      $1, Z10, Z8, K1
+	VPSUBQ       Z10, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z8, Z31, Z10
+	VPCMPUQ      $1, Z10, Z7, K1
+	VPSUBQ       Z10, Z7, Z4
+	VPADDQ       Z31, Z4, K1, Z4
+	VPSUBQ       Z9, Z31, Z10
+	VPCMPUQ      $1, Z10, Z5, K1
+	VPSUBQ       Z10, Z5, Z6
+	VPADDQ       Z31, Z6, K1, Z6
+	VMOVDQA64    Z8, Z5
+	VMOVDQA64    Z9, Z7
+	VPSUBQ       Z4, Z31, Z12
+	VPCMPUQ      $1, Z12, Z0, K1
+	VPSUBQ       Z12, Z0, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z5, Z31, Z12
+	VPCMPUQ      $1, Z12, Z1, K1
+	VPSUBQ       Z12, Z1, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z6, Z31, Z12
+	VPCMPUQ      $1, Z12, Z2, K1
+	VPSUBQ       Z12, Z2, Z10
+	VPADDQ       Z31, Z10, K1, Z10
+	VPSUBQ       Z7, Z31, Z12
+	VPCMPUQ      $1, Z12, Z3, K1
+	VPSUBQ       Z12, Z3, Z11
+	VPADDQ       Z31, Z11, K1, Z11
+	VPSUBQ       Z8, Z31, Z12
+	VPCMPUQ      $1, Z12, Z0, K1
+	VPSUBQ       Z12, Z0, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPSUBQ       Z9, Z31, Z12
+	VPCMPUQ      $1, Z12, Z1, K1
+	VPSUBQ       Z12, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPSUBQ       Z10, Z31, Z12
+	VPCMPUQ      $1, Z12, Z2, K1
+	VPSUBQ       Z12, Z2, Z2
+	VPADDQ       Z31, Z2, K1, Z2
+	VPSUBQ       Z11, Z31, Z12
+	VPCMPUQ      $1, Z12, Z3, K1
+	VPSUBQ       Z12, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPSUBQ       Z8, Z31, Z12
+	VPCMPUQ      $1, Z12, Z4, K1
+	VPSUBQ       Z12, Z4, Z4
+	VPADDQ       Z31, Z4, K1, Z4
+	VPSUBQ       Z9, Z31, Z12
+	VPCMPUQ      $1, Z12, Z5, K1
+	VPSUBQ       Z12, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPSUBQ       Z10, Z31, Z12
+	VPCMPUQ      $1, Z12, Z6, K1
+	VPSUBQ       Z12, Z6, Z6
+	VPADDQ       Z31, Z6, K1, Z6
+	VPSUBQ       Z11, Z31, Z12
+	VPCMPUQ      $1, Z12, Z7, K1
+	VPSUBQ       Z12, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	ADDQ         $64, DX
+	DECQ         BX                // decrement n
+	JMP          loop_5
+
+done_6:
+	VMOVDQU64 Z0, 0(AX)
+	VMOVDQU64 Z1, 64(AX)
+	VMOVDQU64 Z2, 128(AX)
+	VMOVDQU64 Z3, 192(AX)
+	VMOVDQU64 Z4, 256(AX)
+	VMOVDQU64 Z5, 320(AX)
+	VMOVDQU64 Z6, 384(AX)
+	VMOVDQU64 Z7, 448(AX)
+	VZEROUPPER
+	RET
+
+// permutation12AVX512(states, roundKeys, diag *Element) applies the poseidon2 permutation
+// of width 12 on the transposed states
+TEXT ·permutation12AVX512(SB), NOSPLIT, $0-24
+	MOVQ         $0xffffffff00000001, BX
+	VPBROADCASTQ BX, Z31
+	MOVL         $0xffffffff, BX
+	VPBROADCASTQ BX, Z30
+	VPTERNLOGD   $0xff, Z29, Z29, Z29
+	MOVQ         states+0(FP), AX
+	MOVQ         roundKeys+8(FP), DX
+	MOVQ         diag+16(FP), CX
+	VMOVDQU64    0(AX), Z0
+	VMOVDQU64    64(AX), Z1
+	VMOVDQU64    128(AX), Z2
+	VMOVDQU64    192(AX), Z3
+	VMOVDQU64    256(AX), Z4
+	VMOVDQU64    320(AX), Z5
+	VMOVDQU64    384(AX), Z6
+	VMOVDQU64    448(AX), Z7
+	VMOVDQU64    512(AX), Z8
+	VMOVDQU64    576(AX), Z9
+	VMOVDQU64    640(AX), Z10
+	VMOVDQU64    704(AX), Z11
+
+	// the initial external matrix
+	VPSUBQ    Z1, Z31, Z14
+	VPCMPUQ   $1, Z14, Z0, K1
+	VPSUBQ    Z14, Z0, Z12
+	VPADDQ    Z31, Z12, K1, Z12
+	VPSUBQ    Z3, Z31, Z14
+	VPCMPUQ   $1, Z14, Z2, K1
+	VPSUBQ    Z14, Z2, Z13
+	VPADDQ    Z31, Z13, K1, Z13
+	VPSUBQ    Z1, Z31, Z14
+	VPCMPUQ   $1, Z14, Z1, K1
+	VPSUBQ    Z14, Z1, Z1
+	VPADDQ    Z31, Z1, K1, Z1
+	VPSUBQ    Z13, Z31, Z14
+	VPCMPUQ   $1, Z14, Z1, K1
+	VPSUBQ    Z14, Z1, Z1
+	VPADDQ    Z31, Z1, K1, Z1
+	VPSUBQ    Z3, Z31, Z14
+	VPCMPUQ   $1, Z14, Z3, K1
+	VPSUBQ    Z14, Z3, Z3
+	VPADDQ    Z31, Z3, K1, Z3
+	VPSUBQ    Z12, Z31, Z14
+	VPCMPUQ   $1, Z14, Z3, K1
+	VPSUBQ    Z14, Z3, Z3
+	VPADDQ    Z31, Z3, K1, Z3
+	VPSUBQ    Z13, Z31, Z14
+	VPCMPUQ   $1, Z14, Z13, K1
+	VPSUBQ    Z14, Z13, Z13
+	VPADDQ    Z31, Z13, K1, Z13
+	VPSUBQ    Z13, Z31, Z14
+	VPCMPUQ   $1, Z14, Z13, K1
+	VPSUBQ    Z14, Z13, Z13
+	VPADDQ    Z31, Z13, K1, Z13
+	VPSUBQ    Z3, Z31, Z14
+	VPCMPUQ   $1, Z14, Z13, K1
+	VPSUBQ    Z14, Z13, Z13
+	VPADDQ    Z31, Z13, K1, Z13
+	VPSUBQ    Z12, Z31, Z14
+	VPCMPUQ   $1, Z14, Z12, K1
+	VPSUBQ    Z14, Z12, Z12
+	VPADDQ    Z31, Z12, K1, Z12
+	VPSUBQ    Z12, Z31, Z14
+	VPCMPUQ   $1, Z14, Z12, K1
+	VPSUBQ    Z14, Z12, Z12
+	VPADDQ    Z31, Z12, K1, Z12
+	VPSUBQ    Z1, Z31, Z14
+	VPCMPUQ   $1, Z14, Z12, K1
+	VPSUBQ    Z14, Z12, Z12
+	VPADDQ    Z31, Z12, K1, Z12
+	VPSUBQ    Z12, Z31, Z14
+	VPCMPUQ   $1, Z14, Z3, K1
+	VPSUBQ    Z14, Z3, Z0
+	VPADDQ    Z31, Z0, K1, Z0
+	VPSUBQ    Z13, Z31, Z14
+	VPCMPUQ   $1, Z14, Z1, K1
+	VPSUBQ    Z14, Z1, Z2
+	VPADDQ    Z31, Z2, K1, Z2
+	VMOVDQA64 Z12, Z1
+	VMOVDQA64 Z13, Z3
+	VPSUBQ    Z5, Z31, Z14
+	VPCMPUQ   $1, Z14, Z4, K1
+	VPSUBQ    Z14, Z4, Z12
+	VPADDQ    Z31, Z12, K1, Z12
+	VPSUBQ    Z7, Z31, Z14
+	VPCMPUQ   $1, Z14, Z6, K1
+	VPSUBQ    Z14, Z6, Z13
+	VPADDQ    Z31, Z13, K1, Z13
+	VPSUBQ    Z5, Z31, Z14
+	VPCMPUQ   $1, Z14, Z5, K1
+	VPSUBQ    Z14, Z5, Z5
+	VPADDQ    Z31, Z5, K1, Z5
+	VPSUBQ    Z13, Z31, Z14
+	VPCMPUQ   $1, Z14, Z5, K1
+	VPSUBQ    Z14, Z5, Z5
+	VPADDQ    Z31, Z5, K1, Z5
+	VPSUBQ    Z7, Z31, Z14
+	VPCMPUQ   $1, Z14, Z7, K1
+	VPSUBQ    Z14, Z7, Z7
+	VPADDQ    Z31, Z7, K1, Z7
+	VPSUBQ    Z12, Z31, Z14
+	VPCMPUQ   $1, Z14, Z7, K1
+	VPSUBQ    Z14, Z7, Z7
+	VPADDQ    Z31, Z7, K1, Z7
+	VPSUBQ    Z13, Z31, Z14
+	VPCMPUQ   $1, Z14, Z13, K1
+	VPSUBQ    Z14, Z13, Z13
+	VPADDQ    Z31, Z13, K1, Z13
+	VPSUBQ    Z13, Z31, Z14
+	VPCMPUQ   $1, Z14, Z13, K1
+	VPSUBQ    Z14, Z13, Z13
+	VPADDQ    Z31, Z13, K1, Z13
+	VPSUBQ    Z7, Z31, Z14
+	VPCMPUQ   $1, Z14, Z13, K1
+	VPSUBQ    Z14, Z13, Z13
+	VPADDQ    Z31, Z13, K1, Z13
+	VPSUBQ    Z12, Z31, Z14
+	VPCMPUQ   $1, Z14, Z12, K1
+	VPSUBQ    Z14, Z12, Z12
+	VPADDQ    Z31, Z12, K1, Z12
+	VPSUBQ    Z12, Z31, Z14
+	VPCMPUQ   $1, Z14, Z12, K1
+	VPSUBQ    Z14, Z12, Z12
+	VPADDQ    Z31, Z12, K1, Z12
+	VPSUBQ    Z5, Z31, Z14
+	VPCMPUQ   $1, Z14, Z12, K1
+	VPSUBQ    Z14, Z12, Z12
+	VPADDQ    Z31, Z12, K1, Z12
+	VPSUBQ    Z12, Z31, Z14
+	VPCMPUQ   $1, Z14, Z7, K1
+	VPSUBQ    Z14, Z7, Z4
+	VPADDQ    Z31, Z4, K1, Z4
+	VPSUBQ    Z13, Z31, Z14
+	VPCMPUQ   $1, Z14, Z5, K1
+	VPSUBQ    Z14, Z5, Z6
+	VPADDQ    Z31, Z6, K1, Z6
+	VMOVDQA64 Z12, Z5
+	VMOVDQA64 Z13, Z7
+	VPSUBQ    Z9, Z31, Z14
+	VPCMPUQ   $1, Z14, Z8, K1
+	VPSUBQ    Z14, Z8, Z12
+	VPADDQ    Z31, Z12, K1, Z12
+	VPSUBQ    Z11, Z31, Z14
+	VPCMPUQ   $1, Z14, Z10, K1
+	VPSUBQ    Z14, Z10, Z13
+	VPADDQ    Z31, Z13, K1, Z13
+	VPSUBQ    Z9, Z31, Z14
+	VPCMPUQ   $1, Z14, Z9, K1
+	VPSUBQ    Z14, Z9, Z9
+	VPADDQ    Z31, Z9, K1, Z9
+	VPSUBQ    Z13, Z31, Z14
+	VPCMPUQ   $1, Z14, Z9, K1
+	VPSUBQ    Z14, Z9, Z9
+	VPADDQ    Z31, Z9, K1, Z9
+	VPSUBQ    Z11, Z31, Z14
+	VPCMPUQ   $1, Z14, Z11, K1
+	VPSUBQ    Z14, Z11, Z11
+	VPADDQ    Z31, Z11, K1, Z11
+	VPSUBQ    Z12, Z31, Z14
+	VPCMPUQ   $1, Z14, Z11, K1
+	VPSUBQ    Z14, Z11, Z11
+	VPADDQ    Z31, Z11, K1, Z11
+	VPSUBQ    Z13, Z31, Z14
+	VPCMPUQ   $1, Z14, Z13, K1
+	VPSUBQ    Z14, Z13, Z13
+	VPADDQ    Z31, Z13, K1, Z13
+	VPSUBQ    Z13, Z31, Z14
+	VPCMPUQ   $1, Z14, Z13, K1
+	VPSUBQ    Z14, Z13, Z13
+	VPADDQ    Z31, Z13, K1, Z13
+	VPSUBQ    Z11, Z31, Z14
+	VPCMPUQ   $1, Z14, Z13, K1
+	VPSUBQ    Z14, Z13, Z13
+	VPADDQ    Z31, Z13, K1, Z13
+	VPSUBQ    Z12, Z31, Z14
+	VPCMPUQ   $1, Z14, Z12, K1
+	VPSUBQ    Z14, Z12, Z12
+	VPADDQ    Z31, Z12, K1, Z12
+	VPSUBQ    Z12, Z31, Z14
+	VPCMPUQ   $1, Z14, Z12, K1
+	VPSUBQ    Z14, Z12, Z12
+	VPADDQ    Z31, Z12, K1, Z12
+	VPSUBQ    Z9, Z31, Z14
+	VPCMPUQ   $1, Z14, Z12, K1
+	VPSUBQ    Z14, Z12, Z12
+	VPADDQ    Z31, Z12, K1, Z12
+	VPSUBQ    Z12, Z31, Z14
+	VPCMPUQ   $1, Z14, Z11, K1
+	VPSUBQ    Z14, Z11, Z8
+	VPADDQ    Z31, Z8, K1, Z8
+	VPSUBQ    Z13, Z31, Z14
+	VPCMPUQ   $1, Z14, Z9, K1
+	VPSUBQ    Z14, Z9, Z10
+	VPADDQ    Z31, Z10, K1, Z10
+	VMOVDQA64 Z12, Z9
+	VMOVDQA64 Z13, Z11
+	VPSUBQ    Z4, Z31, Z16
+	VPCMPUQ   $1, Z16, Z0, K1
+	VPSUBQ    Z16, Z0, Z12
+	VPADDQ    Z31, Z12, K1, Z12
+	VPSUBQ    Z8, Z31, Z16
+	VPCMPUQ   $1, Z16, Z12, K1
+	VPSUBQ    Z16, Z12, Z12
+	VPADDQ    Z31, Z12, K1, Z12
+	VPSUBQ    Z5, Z31, Z16
+	VPCMPUQ   $1, Z16, Z1, K1
+	VPSUBQ    Z16, Z1, Z13
+	VPADDQ    Z31, Z13, K1, Z13
+	VPSUBQ    Z9, Z31, Z16
+	VPCMPUQ   $1, Z16, Z13, K1
+	VPSUBQ    Z16, Z13, Z13
+	VPADDQ    Z31, Z13, K1, Z13
+	VPSUBQ    Z6, Z31, Z16
+	VPCMPUQ   $1, Z16, Z2, K1
+	VPSUBQ    Z16, Z2, Z14
+	VPADDQ    Z31, Z14, K1, Z14
+	VPSUBQ    Z10, Z31, Z16
+	VPCMPUQ   $1, Z16, Z14, K1
+	VPSUBQ    Z16, Z14, Z14
+	VPADDQ    Z31, Z14, K1, Z14
+	VPSUBQ    Z7, Z31, Z16
+	VPCMPUQ   $1, Z16, Z3, K1
+	VPSUBQ    Z16, Z3, Z15
+	VPADDQ    Z31, Z15, K1, Z15
+	VPSUBQ    Z11, Z31, Z16
+	VPCMPUQ   $1, Z16, Z15, K1
+	VPSUBQ    Z16, Z15, Z15
+	VPADDQ    Z31, Z15, K1, Z15
+	VPSUBQ    Z12, Z31, Z16
+	VPCMPUQ   $1, Z16, Z0, K1
+	VPSUBQ    Z16, Z0, Z0
+	VPADDQ    Z31, Z0, K1, Z0
+	VPSUBQ    Z13, Z31, Z16
+	VPCMPUQ   $1, Z16, Z1, K1
+	VPSUBQ    Z16, Z1, Z1
+	VPADDQ    Z31, Z1, K1, Z1
+	VPSUBQ    Z14, Z31, Z16
+	VPCMPUQ   $1, Z16, Z2, K1
+	VPSUBQ    Z16, Z2, Z2
+	VPADDQ    Z31, Z2, K1, Z2
+	VPSUBQ    Z15, Z31, Z16
+	VPCMPUQ   $1, Z16, Z3, K1
+	VPSUBQ    Z16, Z3, Z3
+	VPADDQ    Z31, Z3, K1, Z3
+	VPSUBQ    Z12, Z31, Z16
+	VPCMPUQ   $1, Z16, Z4, K1
+	VPSUBQ    Z16, Z4, Z4
+	VPADDQ    Z31, Z4, K1, Z4
+	VPSUBQ    Z13, Z31, Z16
+	VPCMPUQ   $1, Z16, Z5, K1
+	VPSUBQ    Z16, Z5, Z5
+	VPADDQ    Z31, Z5, K1, Z5
+	VPSUBQ    Z14, Z31, Z16
+	VPCMPUQ   $1, Z16, Z6, K1
+	VPSUBQ    Z16, Z6, Z6
+	VPADDQ    Z31, Z6, K1, Z6
+	VPSUBQ    Z15, Z31, Z16
+	VPCMPUQ   $1, Z16, Z7, K1
+	VPSUBQ    Z16, Z7, Z7
+	VPADDQ    Z31, Z7, K1, Z7
+	VPSUBQ    Z12, Z31, Z16
+	VPCMPUQ   $1, Z16, Z8, K1
+	VPSUBQ    Z16, Z8, Z8
+	VPADDQ    Z31, Z8, K1, Z8
+	VPSUBQ    Z13, Z31, Z16
+	VPCMPUQ   $1, Z16, Z9, K1
+	VPSUBQ    Z16, Z9, Z9
+	VPADDQ    Z31, Z9, K1, Z9
+	VPSUBQ    Z14, Z31, Z16
+	VPCMPUQ   $1, Z16, Z10, K1
+	VPSUBQ    Z16, Z10, Z10
+	VPADDQ    Z31, Z10, K1, Z10
+	VPSUBQ    Z15, Z31, Z16
+	VPCMPUQ   $1, Z16, Z11, K1
+	VPSUBQ    Z16, Z11, Z11
+	VPADDQ    Z31, Z11, K1, Z11
+
+	// the first half of the full rounds
+	MOVQ $4, BX
+
+loop_7:
+	TESTQ        BX, BX
+	JEQ          done_8            // n == 0, we are done
+	VPBROADCASTQ 0(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z0, K1
+	VPSUBQ       Z13, Z0, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPBROADCASTQ 8(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z1, K1
+	VPSUBQ       Z13, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPBROADCASTQ 16(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z2, K1
+	VPSUBQ       Z13, Z2, Z2
+	VPADDQ       Z31, Z2, K1, Z2
+	VPBROADCASTQ 24(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z3, K1
+	VPSUBQ       Z13, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPBROADCASTQ 32(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z4, K1
+	VPSUBQ       Z13, Z4, Z4
+	VPADDQ       Z31, Z4, K1, Z4
+	VPBROADCASTQ 40(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z5, K1
+	VPSUBQ       Z13, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPBROADCASTQ 48(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z6, K1
+	VPSUBQ       Z13, Z6, Z6
+	VPADDQ       Z31, Z6, K1, Z6
+	VPBROADCASTQ 56(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z7, K1
+	VPSUBQ       Z13, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPBROADCASTQ 64(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z8, K1
+	VPSUBQ       Z13, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPBROADCASTQ 72(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z9, K1
+	VPSUBQ       Z13, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPBROADCASTQ 80(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z10, K1
+	VPSUBQ       Z13, Z10, Z10
+	VPADDQ       Z31, Z10, K1, Z10
+	VPBROADCASTQ 88(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z11, K1
+	VPSUBQ       Z13, Z11, Z11
+	VPADDQ       Z31, Z11, K1, Z11
+	VPSRLQ       $32, Z0, Z14
+	VPSRLQ       $32, Z0, Z15
+	VPMULUDQ     Z0, Z0, Z16
+	VPMULUDQ     Z15, Z0, Z17
+	VPMULUDQ     Z0, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z0, Z15
+	VPMULUDQ     Z0, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z0, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z0
+	VPADDQ       Z31, Z0, K2, Z0
+	VPSRLQ       $32, Z1, Z14
+	VPSRLQ       $32, Z1, Z15
+	VPMULUDQ     Z1, Z1, Z16
+	VPMULUDQ     Z15, Z1, Z17
+	VPMULUDQ     Z1, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z1, Z15
+	VPMULUDQ     Z1, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z1, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z1
+	VPADDQ       Z31, Z1, K2, Z1
+	VPSRLQ       $32, Z2, Z14
+	VPSRLQ       $32, Z2, Z15
+	VPMULUDQ     Z2, Z2, Z16
+	VPMULUDQ     Z15, Z2, Z17
+	VPMULUDQ     Z2, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z2, Z15
+	VPMULUDQ     Z2, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z2, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z2
+	VPADDQ       Z31, Z2, K2, Z2
+	VPSRLQ       $32, Z3, Z14
+	VPSRLQ       $32, Z3, Z15
+	VPMULUDQ     Z3, Z3, Z16
+	VPMULUDQ     Z15, Z3, Z17
+	VPMULUDQ     Z3, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z3, Z15
+	VPMULUDQ     Z3, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z3, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z3
+	VPADDQ       Z31, Z3, K2, Z3
+	VPSRLQ       $32, Z4, Z14
+	VPSRLQ       $32, Z4, Z15
+	VPMULUDQ     Z4, Z4, Z16
+	VPMULUDQ     Z15, Z4, Z17
+	VPMULUDQ     Z4, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z4, Z15
+	VPMULUDQ     Z4, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z4, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z4
+	VPADDQ       Z31, Z4, K2, Z4
+	VPSRLQ       $32, Z5, Z14
+	VPSRLQ       $32, Z5, Z15
+	VPMULUDQ     Z5, Z5, Z16
+	VPMULUDQ     Z15, Z5, Z17
+	VPMULUDQ     Z5, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z5, Z15
+	VPMULUDQ     Z5, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z5, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z5
+	VPADDQ       Z31, Z5, K2, Z5
+	VPSRLQ       $32, Z6, Z14
+	VPSRLQ       $32, Z6, Z15
+	VPMULUDQ     Z6, Z6, Z16
+	VPMULUDQ     Z15, Z6, Z17
+	VPMULUDQ     Z6, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z6, Z15
+	VPMULUDQ     Z6, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z6, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z6
+	VPADDQ       Z31, Z6, K2, Z6
+	VPSRLQ       $32, Z7, Z14
+	VPSRLQ       $32, Z7, Z15
+	VPMULUDQ     Z7, Z7, Z16
+	VPMULUDQ     Z15, Z7, Z17
+	VPMULUDQ     Z7, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z7, Z15
+	VPMULUDQ     Z7, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z7, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z7
+	VPADDQ       Z31, Z7, K2, Z7
+	VPSRLQ       $32, Z8, Z14
+	VPSRLQ       $32, Z8, Z15
+	VPMULUDQ     Z8, Z8, Z16
+	VPMULUDQ     Z15, Z8, Z17
+	VPMULUDQ     Z8, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z8, Z15
+	VPMULUDQ     Z8, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z8, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z9, Z14
+	VPSRLQ       $32, Z9, Z15
+	VPMULUDQ     Z9, Z9, Z16
+	VPMULUDQ     Z15, Z9, Z17
+	VPMULUDQ     Z9, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z9, Z15
+	VPMULUDQ     Z9, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z9, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z10, Z14
+	VPSRLQ       $32, Z10, Z15
+	VPMULUDQ     Z10, Z10, Z16
+	VPMULUDQ     Z15, Z10, Z17
+	VPMULUDQ     Z10, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z10, Z15
+	VPMULUDQ     Z10, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z10, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z10
+	VPADDQ       Z31, Z10, K2, Z10
+	VPSRLQ       $32, Z11, Z14
+	VPSRLQ       $32, Z11, Z15
+	VPMULUDQ     Z11, Z11, Z16
+	VPMULUDQ     Z15, Z11, Z17
+	VPMULUDQ     Z11, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z11, Z15
+	VPMULUDQ     Z11, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z11, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z11
+	VPADDQ       Z31, Z11, K2, Z11
+	VPSUBQ       Z1, Z31, Z14
+	VPCMPUQ      $1, Z14, Z0, K1
+	VPSUBQ       Z14, Z0, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z3, Z31, Z14
+	VPCMPUQ      $1, Z14, Z2, K1
+	VPSUBQ       Z14, Z2, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z1, Z31, Z14
+	VPCMPUQ      $1, Z14, Z1, K1
+	VPSUBQ       Z14, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z1, K1
+	VPSUBQ       Z14, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPSUBQ       Z3, Z31, Z14
+	VPCMPUQ      $1, Z14, Z3, K1
+	VPSUBQ       Z14, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z3, K1
+	VPSUBQ       Z14, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z3, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z1, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z3, K1
+	VPSUBQ       Z14, Z3, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z1, K1
+	VPSUBQ       Z14, Z1, Z2
+	VPADDQ       Z31, Z2, K1, Z2
+	VMOVDQA64    Z12, Z1
+	VMOVDQA64    Z13, Z3
+	VPSUBQ       Z5, Z31, Z14
+	VPCMPUQ      $1, Z14, Z4, K1
+	VPSUBQ       Z14, Z4, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z7, Z31, Z14
+	VPCMPUQ      $1, Z14, Z6, K1
+	VPSUBQ       Z14, Z6, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z5, Z31, Z14
+	VPCMPUQ      $1, Z14, Z5, K1
+	VPSUBQ       Z14, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z5, K1
+	VPSUBQ       Z14, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPSUBQ       Z7, Z31, Z14
+	VPCMPUQ      $1, Z14, Z7, K1
+	VPSUBQ       Z14, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z7, K1
+	VPSUBQ       Z14, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z7, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z5, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z7, K1
+	VPSUBQ       Z14, Z7, Z4
+	VPADDQ       Z31, Z4, K1, Z4
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z5, K1
+	VPSUBQ       Z14, Z5, Z6
+	VPADDQ       Z31, Z6, K1, Z6
+	VMOVDQA64    Z12, Z5
+	VMOVDQA64    Z13, Z7
+	VPSUBQ       Z9, Z31, Z14
+	VPCMPUQ      $1, Z14, Z8, K1
+	VPSUBQ       Z14, Z8, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z11, Z31, Z14
+	VPCMPUQ      $1, Z14, Z10, K1
+	VPSUBQ       Z14, Z10, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z9, Z31, Z14
+	VPCMPUQ      $1, Z14, Z9, K1
+	VPSUBQ       Z14, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z9, K1
+	VPSUBQ       Z14, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z11, Z31, Z14
+	VPCMPUQ      $1, Z14, Z11, K1
+	VPSUBQ       Z14, Z11, Z11
+	VPADDQ       Z31, Z11, K1, Z11
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z11, K1
+	VPSUBQ       Z14, Z11, Z11
+	VPADDQ       Z31, Z11, K1, Z11
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z11, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z9, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z11, K1
+	VPSUBQ       Z14, Z11, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z9, K1
+	VPSUBQ       Z14, Z9, Z10
+	VPADDQ       Z31, Z10, K1, Z10
+	VMOVDQA64    Z12, Z9
+	VMOVDQA64    Z13, Z11
+	VPSUBQ       Z4, Z31, Z16
+	VPCMPUQ      $1, Z16, Z0, K1
+	VPSUBQ       Z16, Z0, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z8, Z31, Z16
+	VPCMPUQ      $1, Z16, Z12, K1
+	VPSUBQ       Z16, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z5, Z31, Z16
+	VPCMPUQ      $1, Z16, Z1, K1
+	VPSUBQ       Z16, Z1, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z9, Z31, Z16
+	VPCMPUQ      $1, Z16, Z13, K1
+	VPSUBQ       Z16, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z6, Z31, Z16
+	VPCMPUQ      $1, Z16, Z2, K1
+	VPSUBQ       Z16, Z2, Z14
+	VPADDQ       Z31, Z14, K1, Z14
+	VPSUBQ       Z10, Z31, Z16
+	VPCMPUQ      $1, Z16, Z14, K1
+	VPSUBQ       Z16, Z14, Z14
+	VPADDQ       Z31, Z14, K1, Z14
+	VPSUBQ       Z7, Z31, Z16
+	VPCMPUQ      $1, Z16, Z3, K1
+	VPSUBQ       Z16, Z3, Z15
+	VPADDQ       Z31, Z15, K1, Z15
+	VPSUBQ       Z11, Z31, Z16
+	VPCMPUQ      $1, Z16, Z15, K1
+	VPSUBQ       Z16, Z15, Z15
+	VPADDQ       Z31, Z15, K1, Z15
+	VPSUBQ       Z12, Z31, Z16
+	VPCMPUQ      $1, Z16, Z0, K1
+	VPSUBQ       Z16, Z0, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPSUBQ       Z13, Z31, Z16
+	VPCMPUQ      $1, Z16, Z1, K1
+	VPSUBQ       Z16, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPSUBQ       Z14, Z31, Z16
+	VPCMPUQ      $1, Z16, Z2, K1
+	VPSUBQ       Z16, Z2, Z2
+	VPADDQ       Z31, Z2, K1, Z2
+	VPSUBQ       Z15, Z31, Z16
+	VPCMPUQ      $1, Z16, Z3, K1
+	VPSUBQ       Z16, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPSUBQ       Z12, Z31, Z16
+	VPCMPUQ      $1, Z16, Z4, K1
+	VPSUBQ       Z16, Z4, Z4
+	VPADDQ       Z31, Z4, K1, Z4
+	VPSUBQ       Z13, Z31, Z16
+	VPCMPUQ      $1, Z16, Z5, K1
+	VPSUBQ       Z16, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPSUBQ       Z14, Z31, Z16
+	VPCMPUQ      $1, Z16, Z6, K1
+	VPSUBQ       Z16, Z6, Z6
+	VPADDQ       Z31, Z6, K1, Z6
+	VPSUBQ       Z15, Z31, Z16
+	VPCMPUQ      $1, Z16, Z7, K1
+	VPSUBQ       Z16, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPSUBQ       Z12, Z31, Z16
+	VPCMPUQ      $1, Z16, Z8, K1
+	VPSUBQ       Z16, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z13, Z31, Z16
+	VPCMPUQ      $1, Z16, Z9, K1
+	VPSUBQ       Z16, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z14, Z31, Z16
+	VPCMPUQ      $1, Z16, Z10, K1
+	VPSUBQ       Z16, Z10, Z10
+	VPADDQ       Z31, Z10, K1, Z10
+	VPSUBQ       Z15, Z31, Z16
+	VPCMPUQ      $1, Z16, Z11, K1
+	VPSUBQ       Z16, Z11, Z11
+	VPADDQ       Z31, Z11, K1, Z11
+	ADDQ         $96, DX
+	DECQ         BX                // decrement n
+	JMP          loop_7
+
+done_8:
+	// the partial rounds
+	MOVQ $22, BX
+
+loop_9:
+	TESTQ        BX, BX
+	JEQ          done_10           // n == 0, we are done
+	VPBROADCASTQ 0(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z0, K1
+	VPSUBQ       Z13, Z0, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPSRLQ       $32, Z0, Z14
+	VPSRLQ       $32, Z0, Z15
+	VPMULUDQ     Z0, Z0, Z16
+	VPMULUDQ     Z15, Z0, Z17
+	VPMULUDQ     Z0, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z0, Z15
+	VPMULUDQ     Z0, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z0, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z0
+	VPADDQ       Z31, Z0, K2, Z0
+	VPSUBQ       Z1, Z31, Z14
+	VPCMPUQ      $1, Z14, Z0, K1
+	VPSUBQ       Z14, Z0, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z2, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z3, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z4, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z5, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z6, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z7, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z8, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z9, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z10, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z11, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPBROADCASTQ 0(CX), Z13
+	VPSRLQ       $32, Z0, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z0, Z16
+	VPMULUDQ     Z15, Z0, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z0
+	VPADDQ       Z31, Z0, K2, Z0
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z0, K1
+	VPSUBQ       Z14, Z0, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPBROADCASTQ 8(CX), Z13
+	VPSRLQ       $32, Z1, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z1, Z16
+	VPMULUDQ     Z15, Z1, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z1
+	VPADDQ       Z31, Z1, K2, Z1
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z1, K1
+	VPSUBQ       Z14, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPBROADCASTQ 16(CX), Z13
+	VPSRLQ       $32, Z2, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z2, Z16
+	VPMULUDQ     Z15, Z2, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z2
+	VPADDQ       Z31, Z2, K2, Z2
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z2, K1
+	VPSUBQ       Z14, Z2, Z2
+	VPADDQ       Z31, Z2, K1, Z2
+	VPBROADCASTQ 24(CX), Z13
+	VPSRLQ       $32, Z3, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z3, Z16
+	VPMULUDQ     Z15, Z3, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z3
+	VPADDQ       Z31, Z3, K2, Z3
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z3, K1
+	VPSUBQ       Z14, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPBROADCASTQ 32(CX), Z13
+	VPSRLQ       $32, Z4, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z4, Z16
+	VPMULUDQ     Z15, Z4, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z4
+	VPADDQ       Z31, Z4, K2, Z4
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z4, K1
+	VPSUBQ       Z14, Z4, Z4
+	VPADDQ       Z31, Z4, K1, Z4
+	VPBROADCASTQ 40(CX), Z13
+	VPSRLQ       $32, Z5, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z5, Z16
+	VPMULUDQ     Z15, Z5, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z5
+	VPADDQ       Z31, Z5, K2, Z5
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z5, K1
+	VPSUBQ       Z14, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPBROADCASTQ 48(CX), Z13
+	VPSRLQ       $32, Z6, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z6, Z16
+	VPMULUDQ     Z15, Z6, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z6
+	VPADDQ       Z31, Z6, K2, Z6
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z6, K1
+	VPSUBQ       Z14, Z6, Z6
+	VPADDQ       Z31, Z6, K1, Z6
+	VPBROADCASTQ 56(CX), Z13
+	VPSRLQ       $32, Z7, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z7, Z16
+	VPMULUDQ     Z15, Z7, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z7
+	VPADDQ       Z31, Z7, K2, Z7
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z7, K1
+	VPSUBQ       Z14, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPBROADCASTQ 64(CX), Z13
+	VPSRLQ       $32, Z8, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z8, Z16
+	VPMULUDQ     Z15, Z8, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z8, K1
+	VPSUBQ       Z14, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPBROADCASTQ 72(CX), Z13
+	VPSRLQ       $32, Z9, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z9, Z16
+	VPMULUDQ     Z15, Z9, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z9, K1
+	VPSUBQ       Z14, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPBROADCASTQ 80(CX), Z13
+	VPSRLQ       $32, Z10, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z10, Z16
+	VPMULUDQ     Z15, Z10, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z10
+	VPADDQ       Z31, Z10, K2, Z10
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z10, K1
+	VPSUBQ       Z14, Z10, Z10
+	VPADDQ       Z31, Z10, K1, Z10
+	VPBROADCASTQ 88(CX), Z13
+	VPSRLQ       $32, Z11, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z11, Z16
+	VPMULUDQ     Z15, Z11, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z11
+	VPADDQ       Z31, Z11, K2, Z11
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z11, K1
+	VPSUBQ       Z14, Z11, Z11
+	VPADDQ       Z31, Z11, K1, Z11
+	ADDQ         $8, DX
+	DECQ         BX                // decrement n
+	JMP          loop_9
+
+done_10:
+	// the second half of the full rounds
+	MOVQ $4, BX
+
+loop_11:
+	TESTQ        BX, BX
+	JEQ          done_12           // n == 0, we are done
+	VPBROADCASTQ 0(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z0, K1
+	VPSUBQ       Z13, Z0, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPBROADCASTQ 8(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z1, K1
+	VPSUBQ       Z13, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPBROADCASTQ 16(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z2, K1
+	VPSUBQ       Z13, Z2, Z2
+	VPADDQ       Z31, Z2, K1, Z2
+	VPBROADCASTQ 24(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z3, K1
+	VPSUBQ       Z13, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPBROADCASTQ 32(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z4, K1
+	VPSUBQ       Z13, Z4, Z4
+	VPADDQ       Z31, Z4, K1, Z4
+	VPBROADCASTQ 40(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z5, K1
+	VPSUBQ       Z13, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPBROADCASTQ 48(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z6, K1
+	VPSUBQ       Z13, Z6, Z6
+	VPADDQ       Z31, Z6, K1, Z6
+	VPBROADCASTQ 56(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z7, K1
+	VPSUBQ       Z13, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPBROADCASTQ 64(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z8, K1
+	VPSUBQ       Z13, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPBROADCASTQ 72(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z9, K1
+	VPSUBQ       Z13, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPBROADCASTQ 80(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z10, K1
+	VPSUBQ       Z13, Z10, Z10
+	VPADDQ       Z31, Z10, K1, Z10
+	VPBROADCASTQ 88(DX), Z12
+	VPSUBQ       Z12, Z31, Z13
+	VPCMPUQ      $1, Z13, Z11, K1
+	VPSUBQ       Z13, Z11, Z11
+	VPADDQ       Z31, Z11, K1, Z11
+	VPSRLQ       $32, Z0, Z14
+	VPSRLQ       $32, Z0, Z15
+	VPMULUDQ     Z0, Z0, Z16
+	VPMULUDQ     Z15, Z0, Z17
+	VPMULUDQ     Z0, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z0, Z15
+	VPMULUDQ     Z0, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z0, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z0
+	VPADDQ       Z31, Z0, K2, Z0
+	VPSRLQ       $32, Z1, Z14
+	VPSRLQ       $32, Z1, Z15
+	VPMULUDQ     Z1, Z1, Z16
+	VPMULUDQ     Z15, Z1, Z17
+	VPMULUDQ     Z1, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z1, Z15
+	VPMULUDQ     Z1, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z1, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z1
+	VPADDQ       Z31, Z1, K2, Z1
+	VPSRLQ       $32, Z2, Z14
+	VPSRLQ       $32, Z2, Z15
+	VPMULUDQ     Z2, Z2, Z16
+	VPMULUDQ     Z15, Z2, Z17
+	VPMULUDQ     Z2, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z2, Z15
+	VPMULUDQ     Z2, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z2, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z2
+	VPADDQ       Z31, Z2, K2, Z2
+	VPSRLQ       $32, Z3, Z14
+	VPSRLQ       $32, Z3, Z15
+	VPMULUDQ     Z3, Z3, Z16
+	VPMULUDQ     Z15, Z3, Z17
+	VPMULUDQ     Z3, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z3, Z15
+	VPMULUDQ     Z3, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z3, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z3
+	VPADDQ       Z31, Z3, K2, Z3
+	VPSRLQ       $32, Z4, Z14
+	VPSRLQ       $32, Z4, Z15
+	VPMULUDQ     Z4, Z4, Z16
+	VPMULUDQ     Z15, Z4, Z17
+	VPMULUDQ     Z4, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z4, Z15
+	VPMULUDQ     Z4, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z4, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z4
+	VPADDQ       Z31, Z4, K2, Z4
+	VPSRLQ       $32, Z5, Z14
+	VPSRLQ       $32, Z5, Z15
+	VPMULUDQ     Z5, Z5, Z16
+	VPMULUDQ     Z15, Z5, Z17
+	VPMULUDQ     Z5, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z5, Z15
+	VPMULUDQ     Z5, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z5, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z5
+	VPADDQ       Z31, Z5, K2, Z5
+	VPSRLQ       $32, Z6, Z14
+	VPSRLQ       $32, Z6, Z15
+	VPMULUDQ     Z6, Z6, Z16
+	VPMULUDQ     Z15, Z6, Z17
+	VPMULUDQ     Z6, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z6, Z15
+	VPMULUDQ     Z6, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z6, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z6
+	VPADDQ       Z31, Z6, K2, Z6
+	VPSRLQ       $32, Z7, Z14
+	VPSRLQ       $32, Z7, Z15
+	VPMULUDQ     Z7, Z7, Z16
+	VPMULUDQ     Z15, Z7, Z17
+	VPMULUDQ     Z7, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z7, Z15
+	VPMULUDQ     Z7, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z7, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z7
+	VPADDQ       Z31, Z7, K2, Z7
+	VPSRLQ       $32, Z8, Z14
+	VPSRLQ       $32, Z8, Z15
+	VPMULUDQ     Z8, Z8, Z16
+	VPMULUDQ     Z15, Z8, Z17
+	VPMULUDQ     Z8, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z8, Z15
+	VPMULUDQ     Z8, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z8, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z8
+	VPADDQ       Z31, Z8, K2, Z8
+	VPSRLQ       $32, Z9, Z14
+	VPSRLQ       $32, Z9, Z15
+	VPMULUDQ     Z9, Z9, Z16
+	VPMULUDQ     Z15, Z9, Z17
+	VPMULUDQ     Z9, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z9, Z15
+	VPMULUDQ     Z9, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z9, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z9
+	VPADDQ       Z31, Z9, K2, Z9
+	VPSRLQ       $32, Z10, Z14
+	VPSRLQ       $32, Z10, Z15
+	VPMULUDQ     Z10, Z10, Z16
+	VPMULUDQ     Z15, Z10, Z17
+	VPMULUDQ     Z10, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z10, Z15
+	VPMULUDQ     Z10, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z10, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z10
+	VPADDQ       Z31, Z10, K2, Z10
+	VPSRLQ       $32, Z11, Z14
+	VPSRLQ       $32, Z11, Z15
+	VPMULUDQ     Z11, Z11, Z16
+	VPMULUDQ     Z15, Z11, Z17
+	VPMULUDQ     Z11, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z11, Z15
+	VPMULUDQ     Z11, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z11, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z13
+	VPADDQ       Z31, Z13, K2, Z13
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z12, Z15
+	VPMULUDQ     Z12, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z12, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z12
+	VPADDQ       Z31, Z12, K2, Z12
+	VPSRLQ       $32, Z12, Z14
+	VPSRLQ       $32, Z13, Z15
+	VPMULUDQ     Z13, Z12, Z16
+	VPMULUDQ     Z15, Z12, Z17
+	VPMULUDQ     Z13, Z14, Z18
+	VPMULUDQ     Z15, Z14, Z14
+	VPSRLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z17, Z17
+	VPANDQ       Z30, Z17, Z15
+	VPADDQ       Z15, Z18, Z18
+	VPSRLQ       $32, Z17, Z17
+	VPADDQ       Z17, Z14, Z14
+	VPSRLQ       $32, Z18, Z15
+	VPADDQ       Z15, Z14, Z14
+	VPSLLQ       $32, Z18, Z18
+	VPANDQ       Z30, Z16, Z16
+	VPORQ        Z18, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPADDQ       Z15, Z16, Z16
+	VPSLLQ       $32, Z16, Z15
+	VPCMPUQ      $1, Z15, Z16, K2
+	VPSRLQ       $32, Z16, Z17
+	VPSUBQ       Z17, Z16, Z16
+	VPADDQ       Z29, Z16, K2, Z16
+	VPCMPUQ      $1, Z16, Z14, K2
+	VPSUBQ       Z16, Z14, Z11
+	VPADDQ       Z31, Z11, K2, Z11
+	VPSUBQ       Z1, Z31, Z14
+	VPCMPUQ      $1, Z14, Z0, K1
+	VPSUBQ       Z14, Z0, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z3, Z31, Z14
+	VPCMPUQ      $1, Z14, Z2, K1
+	VPSUBQ       Z14, Z2, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z1, Z31, Z14
+	VPCMPUQ      $1, Z14, Z1, K1
+	VPSUBQ       Z14, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z1, K1
+	VPSUBQ       Z14, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPSUBQ       Z3, Z31, Z14
+	VPCMPUQ      $1, Z14, Z3, K1
+	VPSUBQ       Z14, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z3, K1
+	VPSUBQ       Z14, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z3, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z1, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z3, K1
+	VPSUBQ       Z14, Z3, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z1, K1
+	VPSUBQ       Z14, Z1, Z2
+	VPADDQ       Z31, Z2, K1, Z2
+	VMOVDQA64    Z12, Z1
+	VMOVDQA64    Z13, Z3
+	VPSUBQ       Z5, Z31, Z14
+	VPCMPUQ      $1, Z14, Z4, K1
+	VPSUBQ       Z14, Z4, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z7, Z31, Z14
+	VPCMPUQ      $1, Z14, Z6, K1
+	VPSUBQ       Z14, Z6, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z5, Z31, Z14
+	VPCMPUQ      $1, Z14, Z5, K1
+	VPSUBQ       Z14, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z5, K1
+	VPSUBQ       Z14, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPSUBQ       Z7, Z31, Z14
+	VPCMPUQ      $1, Z14, Z7, K1
+	VPSUBQ       Z14, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z7, K1
+	VPSUBQ       Z14, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z7, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z5, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z7, K1
+	VPSUBQ       Z14, Z7, Z4
+	VPADDQ       Z31, Z4, K1, Z4
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z5, K1
+	VPSUBQ       Z14, Z5, Z6
+	VPADDQ       Z31, Z6, K1, Z6
+	VMOVDQA64    Z12, Z5
+	VMOVDQA64    Z13, Z7
+	VPSUBQ       Z9, Z31, Z14
+	VPCMPUQ      $1, Z14, Z8, K1
+	VPSUBQ       Z14, Z8, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z11, Z31, Z14
+	VPCMPUQ      $1, Z14, Z10, K1
+	VPSUBQ       Z14, Z10, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z9, Z31, Z14
+	VPCMPUQ      $1, Z14, Z9, K1
+	VPSUBQ       Z14, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z9, K1
+	VPSUBQ       Z14, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z11, Z31, Z14
+	VPCMPUQ      $1, Z14, Z11, K1
+	VPSUBQ       Z14, Z11, Z11
+	VPADDQ       Z31, Z11, K1, Z11
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z11, K1
+	VPSUBQ       Z14, Z11, Z11
+	VPADDQ       Z31, Z11, K1, Z11
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z11, Z31, Z14
+	VPCMPUQ      $1, Z14, Z13, K1
+	VPSUBQ       Z14, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z9, Z31, Z14
+	VPCMPUQ      $1, Z14, Z12, K1
+	VPSUBQ       Z14, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z12, Z31, Z14
+	VPCMPUQ      $1, Z14, Z11, K1
+	VPSUBQ       Z14, Z11, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z13, Z31, Z14
+	VPCMPUQ      $1, Z14, Z9, K1
+	VPSUBQ       Z14, Z9, Z10
+	VPADDQ       Z31, Z10, K1, Z10
+	VMOVDQA64    Z12, Z9
+	VMOVDQA64    Z13, Z11
+	VPSUBQ       Z4, Z31, Z16
+	VPCMPUQ      $1, Z16, Z0, K1
+	VPSUBQ       Z16, Z0, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z8, Z31, Z16
+	VPCMPUQ      $1, Z16, Z12, K1
+	VPSUBQ       Z16, Z12, Z12
+	VPADDQ       Z31, Z12, K1, Z12
+	VPSUBQ       Z5, Z31, Z16
+	VPCMPUQ      $1, Z16, Z1, K1
+	VPSUBQ       Z16, Z1, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z9, Z31, Z16
+	VPCMPUQ      $1, Z16, Z13, K1
+	VPSUBQ       Z16, Z13, Z13
+	VPADDQ       Z31, Z13, K1, Z13
+	VPSUBQ       Z6, Z31, Z16
+	VPCMPUQ      $1, Z16, Z2, K1
+	VPSUBQ       Z16, Z2, Z14
+	VPADDQ       Z31, Z14, K1, Z14
+	VPSUBQ       Z10, Z31, Z16
+	VPCMPUQ      $1, Z16, Z14, K1
+	VPSUBQ       Z16, Z14, Z14
+	VPADDQ       Z31, Z14, K1, Z14
+	VPSUBQ       Z7, Z31, Z16
+	VPCMPUQ      $1, Z16, Z3, K1
+	VPSUBQ       Z16, Z3, Z15
+	VPADDQ       Z31, Z15, K1, Z15
+	VPSUBQ       Z11, Z31, Z16
+	VPCMPUQ      $1, Z16, Z15, K1
+	VPSUBQ       Z16, Z15, Z15
+	VPADDQ       Z31, Z15, K1, Z15
+	VPSUBQ       Z12, Z31, Z16
+	VPCMPUQ      $1, Z16, Z0, K1
+	VPSUBQ       Z16, Z0, Z0
+	VPADDQ       Z31, Z0, K1, Z0
+	VPSUBQ       Z13, Z31, Z16
+	VPCMPUQ      $1, Z16, Z1, K1
+	VPSUBQ       Z16, Z1, Z1
+	VPADDQ       Z31, Z1, K1, Z1
+	VPSUBQ       Z14, Z31, Z16
+	VPCMPUQ      $1, Z16, Z2, K1
+	VPSUBQ       Z16, Z2, Z2
+	VPADDQ       Z31, Z2, K1, Z2
+	VPSUBQ       Z15, Z31, Z16
+	VPCMPUQ      $1, Z16, Z3, K1
+	VPSUBQ       Z16, Z3, Z3
+	VPADDQ       Z31, Z3, K1, Z3
+	VPSUBQ       Z12, Z31, Z16
+	VPCMPUQ      $1, Z16, Z4, K1
+	VPSUBQ       Z16, Z4, Z4
+	VPADDQ       Z31, Z4, K1, Z4
+	VPSUBQ       Z13, Z31, Z16
+	VPCMPUQ      $1, Z16, Z5, K1
+	VPSUBQ       Z16, Z5, Z5
+	VPADDQ       Z31, Z5, K1, Z5
+	VPSUBQ       Z14, Z31, Z16
+	VPCMPUQ      $1, Z16, Z6, K1
+	VPSUBQ       Z16, Z6, Z6
+	VPADDQ       Z31, Z6, K1, Z6
+	VPSUBQ       Z15, Z31, Z16
+	VPCMPUQ      $1, Z16, Z7, K1
+	VPSUBQ       Z16, Z7, Z7
+	VPADDQ       Z31, Z7, K1, Z7
+	VPSUBQ       Z12, Z31, Z16
+	VPCMPUQ      $1, Z16, Z8, K1
+	VPSUBQ       Z16, Z8, Z8
+	VPADDQ       Z31, Z8, K1, Z8
+	VPSUBQ       Z13, Z31, Z16
+	VPCMPUQ      $1, Z16, Z9, K1
+	VPSUBQ       Z16, Z9, Z9
+	VPADDQ       Z31, Z9, K1, Z9
+	VPSUBQ       Z14, Z31, Z16
+	VPCMPUQ      $1, Z16, Z10, K1
+	VPSUBQ       Z16, Z10, Z10
+	VPADDQ       Z31, Z10, K1, Z10
+	VPSUBQ       Z15, Z31, Z16
+	VPCMPUQ      $1, Z16, Z11, K1
+	VPSUBQ       Z16, Z11, Z11
+	VPADDQ       Z31, Z11, K1, Z11
+	ADDQ         $96, DX
+	DECQ         BX                // decrement n
+	JMP          loop_11
+
+done_12:
+	VMOVDQU64 Z0, 0(AX)
+	VMOVDQU64 Z1, 64(AX)
+	VMOVDQU64 Z2, 128(AX)
+	VMOVDQU64 Z3, 192(AX)
+	VMOVDQU64 Z4, 256(AX)
+	VMOVDQU64 Z5, 320(AX)
+	VMOVDQU64 Z6, 384(AX)
+	VMOVDQU64 Z7, 448(AX)
+	VMOVDQU64 Z8, 512(AX)
+	VMOVDQU64 Z9, 576(AX)
+	VMOVDQU64 Z10, 640(AX)
+	VMOVDQU64 Z11, 704(AX)
+	VZEROUPPER
+	RET
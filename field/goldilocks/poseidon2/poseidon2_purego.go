@@ -0,0 +1,16 @@
+//go:build purego || !amd64
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon2
+
+import (
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+func (p *Permutation) batchPermutation(states []goldilocks.Element) {
+	p.batchPermutationGeneric(states)
+}
@@ -0,0 +1,20 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package stark provides a STARK prover and verifier over goldilocks.
+//
+// A computation is described by an AIR: the columns of its execution trace, the
+// transition constraints between consecutive rows, polynomial expressions in
+// the cells of the two rows, and the boundary constraints fixing some cells.
+//
+// The prover interpolates the columns of the trace on the subgroup H of size
+// n, commits to their low-degree extension on a coset of a subgroup of size
+// B·n, and combines the quotients of the constraints by their vanishing
+// polynomials into the composition polynomial, committed as polynomials of
+// degree < n. The constraints are checked at a random point z out of the
+// domain (DEEP-ALI), and the evaluations at z and g·z are proven with FRI on
+// the DEEP composition polynomial. The challenges are in the extension E2
+// of goldilocks.
+package stark
@@ -0,0 +1,161 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package stark
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/consensys/gnark-crypto/field/goldilocks/extensions"
+	"github.com/consensys/gnark-crypto/field/goldilocks/fft"
+	"github.com/consensys/gnark-crypto/field/goldilocks/fri"
+)
+
+var (
+	ErrProofShape             = errors.New("the proof does not match the AIR")
+	ErrOutOfDomainEvaluations = errors.New("the out-of-domain evaluations do not satisfy the constraints")
+	ErrDEEPComposition        = errors.New("the DEEP composition polynomial does not match the openings")
+)
+
+// Verify verifies a proof that a trace of n rows satisfies the AIR, with the
+// hash function and the configuration of FRI used by the prover.
+func Verify(air *AIR, n int, proof *Proof, h hash.Hash, config fri.Config) error {
+	if n < 2 || bits.OnesCount(uint(n)) != 1 {
+		return ErrTraceShape
+	}
+	if err := air.check(n); err != nil {
+		return err
+	}
+	D := air.compositionDegree()
+	if D > config.BlowupFactor {
+		return ErrBlowupFactor
+	}
+	if len(proof.TraceEvaluations) != air.Width ||
+		len(proof.NextTraceEvaluations) != air.Width ||
+		len(proof.CompositionEvaluations) != D ||
+		len(proof.TraceOpenings) != config.NbQueries ||
+		len(proof.CompositionOpenings) != config.NbQueries {
+		return ErrProofShape
+	}
+	f, err := newFRI(n, h, config)
+	if err != nil {
+		return err
+	}
+
+	fs := newTranscript(h)
+	alpha, err := challenge(fs, alphaID, air.publicBytes(n, proof.TraceRoot))
+	if err != nil {
+		return err
+	}
+	z, err := challenge(fs, zID, proof.CompositionRoot)
+	if err != nil {
+		return err
+	}
+	beta, err := challenge(fs, betaID, marshalExtensions(proof.TraceEvaluations, proof.NextTraceEvaluations, proof.CompositionEvaluations))
+	if err != nil {
+		return err
+	}
+
+	// the constraints hold at z
+	g, err := fft.Generator(uint64(n))
+	if err != nil {
+		return err
+	}
+	if !air.checkOutOfDomain(n, g, &z, alpha, proof) {
+		return ErrOutOfDomainEvaluations
+	}
+
+	// FRI on the DEEP composition polynomial
+	if err := fs.Bind(friID, proof.DEEPRoot); err != nil {
+		return err
+	}
+	seed, err := fs.ComputeChallenge(friID)
+	if err != nil {
+		return err
+	}
+	positions, err := f.VerifyWithSeed(proof.DEEPRoot, extensionDegree, seed, &proof.FRI)
+	if err != nil {
+		return err
+	}
+
+	// the DEEP composition polynomial matches the trace and the composition
+	// polynomial at the queried points
+	var gz extensions.E2
+	gz.MulByElement(&z, &g)
+	betas := powers(beta, 2*air.Width+D)
+	k := config.FoldingFactor
+	for q, l := range positions {
+		traceOpening, compositionOpening := &proof.TraceOpenings[q], &proof.CompositionOpenings[q]
+		if err := f.VerifyRowOpening(proof.TraceRoot, air.Width, l, traceOpening); err != nil {
+			return err
+		}
+		if err := f.VerifyRowOpening(proof.CompositionRoot, D*extensionDegree, l, compositionOpening); err != nil {
+			return err
+		}
+		points := f.LeafPoints(l)
+		den := make([]extensions.E2, 2*k)
+		for t := range points {
+			e := fromElement(&points[t])
+			den[2*t].Sub(&e, &z)
+			den[2*t+1].Sub(&e, &gz)
+		}
+		den = extensions.BatchInvertE2(den)
+		for t := range points {
+			v := deepValue(betas,
+				traceOpening.Values[t*air.Width:(t+1)*air.Width],
+				compositionOpening.Values[t*D*extensionDegree:(t+1)*D*extensionDegree],
+				proof, &den[2*t], &den[2*t+1])
+			for c, coordinate := range coordinates(&v) {
+				if !coordinate.Equal(&proof.FRI.Rows[q].Values[t*extensionDegree+c]) {
+					return ErrDEEPComposition
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkOutOfDomain returns true if the composition polynomial at z, given by
+// its segments, is the combination of the quotients of the constraints at z.
+func (air *AIR) checkOutOfDomain(n int, g goldilocks.Element, z *extensions.E2, alpha extensions.E2, proof *Proof) bool {
+	alphas := powers(alpha, air.nbConstraints())
+
+	// (z - g⁻¹)/(zⁿ - 1)
+	var zn, tmp, v, lhs extensions.E2
+	zn.Exp(*z, big.NewInt(int64(n)))
+	one := goldilocks.One()
+	subElement(&tmp, &zn, &one).Inverse(&tmp)
+	var gInv goldilocks.Element
+	gInv.Inverse(&g)
+	subElement(&v, z, &gInv)
+	tmp.Mul(&tmp, &v)
+	for c, constraint := range air.Transitions {
+		v = constraint.evaluateExtension(proof.TraceEvaluations, proof.NextTraceEvaluations)
+		v.Mul(&v, &tmp).Mul(&v, &alphas[c])
+		lhs.Add(&lhs, &v)
+	}
+	for c, b := range air.Boundaries {
+		var gr goldilocks.Element
+		gr.Exp(g, big.NewInt(int64(b.Row)))
+		subElement(&tmp, z, &gr).Inverse(&tmp)
+		subElement(&v, &proof.TraceEvaluations[b.Column], &b.Value)
+		v.Mul(&v, &tmp).Mul(&v, &alphas[len(air.Transitions)+c])
+		lhs.Add(&lhs, &v)
+	}
+
+	// H(z) = ∑ₛ zˢⁿ·Hₛ(z)
+	var rhs, zsn extensions.E2
+	zsn.SetOne()
+	for s := range proof.CompositionEvaluations {
+		v.Mul(&proof.CompositionEvaluations[s], &zsn)
+		rhs.Add(&rhs, &v)
+		zsn.Mul(&zsn, &zn)
+	}
+	return lhs.Equal(&rhs)
+}
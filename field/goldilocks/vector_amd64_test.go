@@ -0,0 +1,116 @@
+//go:build !purego
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package goldilocks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// vectorKernels are the assembly vector operations, on blocks of 8 elements
+type vectorKernels struct {
+	add, sub, mul, scalarMul func(res, a, b *Element, n uint64)
+	sum                      func(t, a *Element, n uint64)
+	innerProd                func(t, a, b *Element, n uint64)
+}
+
+// genEdgeVectors returns vectors a, b such that (a[i], b[i]) go through all the
+// pairs of values around the carries of the reduction, padded with random
+// values to a multiple of the block size
+func genEdgeVectors() (a, b Vector) {
+	edges := []uint64{
+		0, 1, 2,
+		1<<32 - 1, 1 << 32, 1<<32 + 1,
+		1<<63 - 1, 1 << 63,
+		q - 1<<32, q - 2, q - 1,
+	}
+	for _, x := range edges {
+		for _, y := range edges {
+			a = append(a, Element{x})
+			b = append(b, Element{y})
+		}
+	}
+	for len(a)%blockSize != 0 || len(a) < 4*blockSize {
+		var x, y Element
+		x.SetRandom()
+		y.SetRandom()
+		a = append(a, x)
+		b = append(b, y)
+	}
+	return
+}
+
+func TestVectorAssembly(t *testing.T) {
+	isas := []struct {
+		name      string
+		supported bool
+		kernels   vectorKernels
+	}{
+		{"AVX-512", supportAvx512, vectorKernels{addVec, subVec, mulVec, scalarMulVec, sumVec, innerProdVec}},
+		{"AVX2", supportAvx2, vectorKernels{addVecAVX2, subVecAVX2, mulVecAVX2, scalarMulVecAVX2, sumVecAVX2, innerProdVecAVX2}},
+	}
+
+	for _, isa := range isas {
+		t.Run(isa.name, func(t *testing.T) {
+			if !isa.supported {
+				t.Skip("instruction set not supported")
+			}
+			assert := require.New(t)
+			k := isa.kernels
+
+			a, b := genEdgeVectors()
+			n := len(a)
+			nbBlocks := uint64(n / blockSize)
+			expected, got := make(Vector, n), make(Vector, n)
+
+			addVecGeneric(expected, a, b)
+			k.add(&got[0], &a[0], &b[0], nbBlocks)
+			assert.Equal(expected, got, "add")
+
+			subVecGeneric(expected, a, b)
+			k.sub(&got[0], &a[0], &b[0], nbBlocks)
+			assert.Equal(expected, got, "sub")
+
+			for i := range a {
+				_mulGeneric(&expected[i], &a[i], &b[i])
+			}
+			k.mul(&got[0], &a[0], &b[0], nbBlocks)
+			assert.Equal(expected, got, "mul")
+
+			for _, s := range b[:12] {
+				for i := range a {
+					_mulGeneric(&expected[i], &a[i], &s)
+				}
+				k.scalarMul(&got[0], &a[0], &s, nbBlocks)
+				assert.Equal(expected, got, "scalarMul by %s", s.String())
+			}
+
+			var partial [blockSize]Element
+			var sum, expectedSum Element
+			k.sum(&partial[0], &a[0], nbBlocks)
+			for i := range partial {
+				sum.Add(&sum, &partial[i])
+			}
+			sumVecGeneric(&expectedSum, a)
+			assert.Equal(expectedSum, sum, "sum")
+
+			var innerProd, expectedInnerProd Element
+			k.innerProd(&partial[0], &a[0], &b[0], nbBlocks)
+			for i := range partial {
+				innerProd.Add(&innerProd, &partial[i])
+			}
+			for i := range a {
+				var tmp Element
+				_mulGeneric(&tmp, &a[i], &b[i])
+				expectedInnerProd.Add(&expectedInnerProd, &tmp)
+			}
+			assert.Equal(expectedInnerProd, innerProd, "innerProd")
+		})
+	}
+}
@@ -15,12 +15,16 @@ func main() {
 	type field struct {
 		name    string
 		modulus string
+
+		// extension degree and u² of the extension E2 = Fp[u]/(u² - rootOf)
+		extensionDegree uint8
+		rootOf          int64
 	}
 
 	fields := []field{
-		{"goldilocks", "0xFFFFFFFF00000001"},
-		{"koalabear", "0x7f000001"}, // 2^31 - 2^24 + 1 ==> the cube map (x -> x^3) is an automorphism of the multiplicative group
-		{"babybear", "0x78000001"},  // 2^31 - 2^27 + 1 ==> 2-adicity 27
+		{"goldilocks", "0xFFFFFFFF00000001", 2, 7},
+		{"koalabear", "0x7f000001", 4, 3}, // 2^31 - 2^24 + 1 ==> the cube map (x -> x^3) is an automorphism of the multiplicative group
+		{"babybear", "0x78000001", 4, 11}, // 2^31 - 2^27 + 1 ==> 2-adicity 27
 	}
 
 	// generate assembly
@@ -35,6 +39,11 @@ func main() {
 			generator.WithASM(&config.Assembly{BuildDir: asmDirIncludePath, IncludeDir: asmDirIncludePath}),
 			generator.WithFFT(&config.FFT{}), // TODO @gbotrel
 			generator.WithSIS(),
+			generator.WithMerkleTree(),
+			generator.WithPoseidon2(),
+			generator.WithExtension(config.NewTower(fc, f.extensionDegree, f.rootOf)),
+			generator.WithFRI(),
+			generator.WithSTARK(),
 		); err != nil {
 			panic(err)
 		}
@@ -0,0 +1,13 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package extensions provides extension fields of koalabear.
+//
+// E2 = koalabear[u]/(u² - 3) and E4 = E2[v]/(v² - u), so that E4 is
+// isomorphic to koalabear[X]/(X⁴ - 3).
+//
+// Vector is a slice of E4, with element-wise operations and mixed
+// operations with koalabear.Vector.
+package extensions
@@ -0,0 +1,285 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/koalabear"
+)
+
+// SizeOfE4 number of bytes of the encoding of an E4
+const SizeOfE4 = 2 * SizeOfE2
+
+// E4 is a degree two finite field extension of E2, E4 = E2[v]/(v² - u)
+type E4 struct {
+	B0, B1 E2
+}
+
+// frobeniusV vᵖ = 3^((p-1)/4)·v
+var frobeniusV = koalabear.NewElement(2113994754)
+
+// Equal returns true if z equals x, false otherwise
+func (z *E4) Equal(x *E4) bool {
+	return z.B0.Equal(&x.B0) && z.B1.Equal(&x.B1)
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *E4) IsZero() bool {
+	return z.B0.IsZero() && z.B1.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *E4) IsOne() bool {
+	return z.B0.IsOne() && z.B1.IsZero()
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E4) SetZero() *E4 {
+	z.B0.SetZero()
+	z.B1.SetZero()
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E4) SetOne() *E4 {
+	z.B0.SetOne()
+	z.B1.SetZero()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *E4) Set(x *E4) *E4 {
+	z.B0 = x.B0
+	z.B1 = x.B1
+	return z
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E4) SetRandom() (*E4, error) {
+	if _, err := z.B0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.B1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Add sets z = x + y and returns z
+func (z *E4) Add(x, y *E4) *E4 {
+	z.B0.Add(&x.B0, &y.B0)
+	z.B1.Add(&x.B1, &y.B1)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *E4) Sub(x, y *E4) *E4 {
+	z.B0.Sub(&x.B0, &y.B0)
+	z.B1.Sub(&x.B1, &y.B1)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *E4) Double(x *E4) *E4 {
+	z.B0.Double(&x.B0)
+	z.B1.Double(&x.B1)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *E4) Neg(x *E4) *E4 {
+	z.B0.Neg(&x.B0)
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// Conjugate sets z = B0 - B1·v and returns z
+func (z *E4) Conjugate(x *E4) *E4 {
+	z.B0 = x.B0
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *E4) Mul(x, y *E4) *E4 {
+	var a, b, c E2
+	a.Add(&x.B0, &x.B1)
+	b.Add(&y.B0, &y.B1)
+	a.Mul(&a, &b)
+	b.Mul(&x.B0, &y.B0)
+	c.Mul(&x.B1, &y.B1)
+	z.B1.Sub(&a, &b).Sub(&z.B1, &c)
+	c.MulByNonResidue(&c)
+	z.B0.Add(&b, &c)
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *E4) Square(x *E4) *E4 {
+	// (b0 + b1·v)² = b0² + u·b1² + 2·b0·b1·v
+	var a, b E2
+	a.Square(&x.B0)
+	b.Square(&x.B1).MulByNonResidue(&b)
+	z.B1.Mul(&x.B0, &x.B1).Double(&z.B1)
+	z.B0.Add(&a, &b)
+	return z
+}
+
+// MulByElement sets z = x·y, y in koalabear, and returns z
+func (z *E4) MulByElement(x *E4, y *koalabear.Element) *E4 {
+	z.B0.MulByElement(&x.B0, y)
+	z.B1.MulByElement(&x.B1, y)
+	return z
+}
+
+// MulByE2 sets z = x·y, y in E2, and returns z
+func (z *E4) MulByE2(x *E4, y *E2) *E4 {
+	z.B0.Mul(&x.B0, y)
+	z.B1.Mul(&x.B1, y)
+	return z
+}
+
+// norm returns the norm x·x̄ = B0² - u·B1² of x, in E2
+func (x *E4) norm() E2 {
+	var a, b E2
+	a.Square(&x.B0)
+	b.Square(&x.B1).MulByNonResidue(&b)
+	return *a.Sub(&a, &b)
+}
+
+// Inverse sets z = 1/x, 0 if x = 0, and returns z
+func (z *E4) Inverse(x *E4) *E4 {
+	// 1/x = x̄/(x·x̄)
+	n := x.norm()
+	n.Inverse(&n)
+	z.Conjugate(x)
+	return z.MulByE2(z, &n)
+}
+
+// Frobenius sets z = xᵖ and returns z
+func (z *E4) Frobenius(x *E4) *E4 {
+	z.B0.Conjugate(&x.B0)
+	z.B1.Conjugate(&x.B1).MulByElement(&z.B1, &frobeniusV)
+	return z
+}
+
+// Legendre returns 1 if x is a non-zero square, -1 if it is not a square and 0
+// if x = 0. x is a square in E4 iff its norm is a square in E2.
+func (x *E4) Legendre() int {
+	n := x.norm()
+	return n.Legendre()
+}
+
+// Sqrt sets z = √x and returns z. If x is not a square, Sqrt leaves z
+// unchanged and returns nil.
+func (z *E4) Sqrt(x *E4) *E4 {
+	var c, d E2
+	if x.B1.IsZero() {
+		if c.Sqrt(&x.B0) != nil {
+			z.B0 = c
+			z.B1.SetZero()
+			return z
+		}
+		// B0/u is a square and √B0 = √(B0/u)·v
+		d.A1.SetOne()
+		d.Inverse(&d).Mul(&d, &x.B0)
+		d.Sqrt(&d)
+		z.B0.SetZero()
+		z.B1 = d
+		return z
+	}
+
+	// same as E2.Sqrt, u being a non-square of E2
+	n := x.norm()
+	if n.Sqrt(&n) == nil {
+		return nil
+	}
+	c.Add(&x.B0, &n).Halve()
+	if c.Legendre() != 1 {
+		c.Sub(&x.B0, &n).Halve()
+	}
+	c.Sqrt(&c)
+	d.Double(&c).Inverse(&d).Mul(&d, &x.B1)
+	z.B0 = c
+	z.B1 = d
+	return z
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *E4) Exp(x E4, k *big.Int) *E4 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		x.Inverse(&x)
+		e = new(big.Int).Neg(k)
+	}
+	z.SetOne()
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+	return z
+}
+
+// BatchInvertE4 returns a new slice with every element inverted, using the
+// Montgomery batch inversion trick. Zero elements are mapped to zero.
+func BatchInvertE4(a []E4) []E4 {
+	res := make([]E4, len(a))
+	if len(a) == 0 {
+		return res
+	}
+	var accumulator E4
+	accumulator.SetOne()
+	for i := range a {
+		if a[i].IsZero() {
+			continue
+		}
+		res[i] = accumulator
+		accumulator.Mul(&accumulator, &a[i])
+	}
+	accumulator.Inverse(&accumulator)
+	for i := len(a) - 1; i >= 0; i-- {
+		if a[i].IsZero() {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+	return res
+}
+
+// Bytes returns the encoding of B0 followed by the one of B1
+func (z *E4) Bytes() (res [SizeOfE4]byte) {
+	b := z.B0.Bytes()
+	copy(res[:], b[:])
+	b = z.B1.Bytes()
+	copy(res[SizeOfE2:], b[:])
+	return
+}
+
+// SetBytesCanonical sets z from the encoding returned by Bytes. It returns an
+// error if the encoding has the wrong size or a coordinate is not canonical.
+func (z *E4) SetBytesCanonical(e []byte) error {
+	if len(e) != SizeOfE4 {
+		return errors.New("invalid E4 encoding size")
+	}
+	if err := z.B0.SetBytesCanonical(e[:SizeOfE2]); err != nil {
+		return err
+	}
+	return z.B1.SetBytesCanonical(e[SizeOfE2:])
+}
+
+// String returns z as (B0)+(B1)*v
+func (z *E4) String() string {
+	return "(" + z.B0.String() + ")+(" + z.B1.String() + ")*v"
+}
@@ -0,0 +1,332 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/koalabear"
+	"github.com/stretchr/testify/require"
+)
+
+const nbTests = 100
+
+func randomE2() E2 {
+	var res E2
+	if _, err := res.SetRandom(); err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func TestE2Arithmetic(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < nbTests; i++ {
+		a, b, c := randomE2(), randomE2(), randomE2()
+		var l, r, tmp E2
+
+		// (a + b)·c = a·c + b·c
+		l.Add(&a, &b).Mul(&l, &c)
+		r.Mul(&a, &c)
+		tmp.Mul(&b, &c)
+		r.Add(&r, &tmp)
+		assert.True(l.Equal(&r))
+
+		// (a·b)·c = a·(b·c)
+		l.Mul(&a, &b).Mul(&l, &c)
+		r.Mul(&b, &c).Mul(&a, &r)
+		assert.True(l.Equal(&r))
+
+		l.Square(&a)
+		r.Mul(&a, &a)
+		assert.True(l.Equal(&r))
+
+		l.Double(&a)
+		r.Add(&a, &a)
+		assert.True(l.Equal(&r))
+
+		l.Sub(&a, &b).Add(&l, &b)
+		assert.True(l.Equal(&a))
+
+		l.Inverse(&a).Mul(&l, &a)
+		assert.True(l.IsOne())
+
+		// u² = 3
+		var u E2
+		u.A1.SetOne()
+		l.MulByNonResidue(&a)
+		r.Mul(&a, &u)
+		assert.True(l.Equal(&r))
+
+		var s koalabear.Element
+		s.SetRandom()
+		l.MulByElement(&a, &s)
+		r.Mul(&a, &E2{A0: s})
+		assert.True(l.Equal(&r))
+
+		// a^(p²-1) = 1
+		q := new(big.Int).Mul(koalabear.Modulus(), koalabear.Modulus())
+		l.Exp(a, q.Sub(q, big.NewInt(1)))
+		assert.True(l.IsOne())
+	}
+
+	var zero E2
+	assert.True(zero.Inverse(&zero).IsZero())
+}
+
+func TestE2Frobenius(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < nbTests; i++ {
+		a := randomE2()
+		var l, r E2
+		l.Frobenius(&a)
+		r.Exp(a, koalabear.Modulus())
+		assert.True(l.Equal(&r))
+	}
+}
+
+func TestE2Sqrt(t *testing.T) {
+	assert := require.New(t)
+
+	var u E2
+	u.A1.SetOne()
+	assert.Equal(-1, u.Legendre())
+	for i := 0; i < nbTests; i++ {
+		a := randomE2()
+		var s, r, tmp E2
+		s.Square(&a)
+		assert.Equal(1, s.Legendre())
+		assert.NotNil(r.Sqrt(&s))
+		tmp.Square(&r)
+		assert.True(tmp.Equal(&s))
+
+		// a·u is a square iff a is not
+		s.Mul(&a, &u)
+		if a.Legendre() == 1 {
+			assert.Nil(r.Sqrt(&s))
+		} else {
+			assert.NotNil(r.Sqrt(&s))
+		}
+
+		// elements of koalabear, which are all squares in E2
+		s = E2{A0: a.A0}
+		assert.NotNil(r.Sqrt(&s))
+		tmp.Square(&r)
+		assert.True(tmp.Equal(&s))
+	}
+
+	var zero E2
+	assert.Equal(0, zero.Legendre())
+	assert.True(zero.Sqrt(&zero).IsZero())
+}
+
+func TestE2BatchInvert(t *testing.T) {
+	assert := require.New(t)
+
+	a := make([]E2, 20)
+	for i := range a {
+		a[i] = randomE2()
+	}
+	a[5].SetZero()
+	inv := BatchInvertE2(a)
+	for i := range a {
+		var e E2
+		e.Inverse(&a[i])
+		assert.True(e.Equal(&inv[i]))
+	}
+}
+
+func TestE2Bytes(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < nbTests; i++ {
+		a := randomE2()
+		b := a.Bytes()
+		var r E2
+		assert.NoError(r.SetBytesCanonical(b[:]))
+		assert.True(r.Equal(&a))
+	}
+	var r E2
+	assert.Error(r.SetBytesCanonical(make([]byte, SizeOfE2-1)))
+	b := make([]byte, SizeOfE2)
+	for i := range b {
+		b[i] = 0xff
+	}
+	assert.ErrorIs(r.SetBytesCanonical(b), ErrCanonical)
+}
+
+func randomE4() E4 {
+	var res E4
+	if _, err := res.SetRandom(); err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func TestE4Arithmetic(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < nbTests; i++ {
+		a, b, c := randomE4(), randomE4(), randomE4()
+		var l, r, tmp E4
+
+		// (a + b)·c = a·c + b·c
+		l.Add(&a, &b).Mul(&l, &c)
+		r.Mul(&a, &c)
+		tmp.Mul(&b, &c)
+		r.Add(&r, &tmp)
+		assert.True(l.Equal(&r))
+
+		// (a·b)·c = a·(b·c)
+		l.Mul(&a, &b).Mul(&l, &c)
+		r.Mul(&b, &c).Mul(&a, &r)
+		assert.True(l.Equal(&r))
+
+		l.Square(&a)
+		r.Mul(&a, &a)
+		assert.True(l.Equal(&r))
+
+		l.Sub(&a, &b).Add(&l, &b)
+		assert.True(l.Equal(&a))
+
+		l.Inverse(&a).Mul(&l, &a)
+		assert.True(l.IsOne())
+
+		var s koalabear.Element
+		s.SetRandom()
+		l.MulByElement(&a, &s)
+		var e E4
+		e.B0.A0 = s
+		r.Mul(&a, &e)
+		assert.True(l.Equal(&r))
+
+		y := randomE2()
+		l.MulByE2(&a, &y)
+		r.Mul(&a, &E4{B0: y})
+		assert.True(l.Equal(&r))
+
+		// a^(p⁴-1) = 1
+		q := new(big.Int).Exp(koalabear.Modulus(), big.NewInt(4), nil)
+		l.Exp(a, q.Sub(q, big.NewInt(1)))
+		assert.True(l.IsOne())
+	}
+
+	// v⁴ = 3
+	var v, v4 E4
+	v.B1.SetOne()
+	v4.Exp(v, big.NewInt(4))
+	assert.True(v4.Equal(&E4{B0: E2{A0: koalabear.NewElement(3)}}))
+}
+
+func TestE4Frobenius(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < nbTests; i++ {
+		a := randomE4()
+		var l, r E4
+		l.Frobenius(&a)
+		r.Exp(a, koalabear.Modulus())
+		assert.True(l.Equal(&r))
+
+		// Frobenius⁴ = id
+		l.Frobenius(&l).Frobenius(&l).Frobenius(&l)
+		assert.True(l.Equal(&a))
+	}
+}
+
+func TestE4Sqrt(t *testing.T) {
+	assert := require.New(t)
+
+	var v E4
+	v.B1.SetOne()
+	assert.Equal(-1, v.Legendre())
+	for i := 0; i < nbTests; i++ {
+		a := randomE4()
+		var s, r, tmp E4
+		s.Square(&a)
+		assert.Equal(1, s.Legendre())
+		assert.NotNil(r.Sqrt(&s))
+		tmp.Square(&r)
+		assert.True(tmp.Equal(&s))
+
+		// a·v is a square iff a is not
+		s.Mul(&a, &v)
+		if a.Legendre() == 1 {
+			assert.Nil(r.Sqrt(&s))
+		} else {
+			assert.NotNil(r.Sqrt(&s))
+		}
+
+		// elements of E2, which are all squares in E4
+		s = E4{B0: a.B0}
+		assert.NotNil(r.Sqrt(&s))
+		tmp.Square(&r)
+		assert.True(tmp.Equal(&s))
+	}
+
+	var zero E4
+	assert.Equal(0, zero.Legendre())
+	assert.True(zero.Sqrt(&zero).IsZero())
+}
+
+func TestE4BatchInvert(t *testing.T) {
+	assert := require.New(t)
+
+	a := make([]E4, 20)
+	for i := range a {
+		a[i] = randomE4()
+	}
+	a[5].SetZero()
+	inv := BatchInvertE4(a)
+	for i := range a {
+		var e E4
+		e.Inverse(&a[i])
+		assert.True(e.Equal(&inv[i]))
+	}
+}
+
+func TestE4Bytes(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < nbTests; i++ {
+		a := randomE4()
+		b := a.Bytes()
+		var r E4
+		assert.NoError(r.SetBytesCanonical(b[:]))
+		assert.True(r.Equal(&a))
+	}
+	var r E4
+	assert.Error(r.SetBytesCanonical(make([]byte, SizeOfE4+1)))
+}
+
+func BenchmarkE4Mul(b *testing.B) {
+	x, y := randomE4(), randomE4()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkE4Inverse(b *testing.B) {
+	x := randomE4()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
+
+func BenchmarkE4Sqrt(b *testing.B) {
+	x := randomE4()
+	x.Square(&x)
+	var r E4
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Sqrt(&x)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/koalabear"
+	"github.com/stretchr/testify/require"
+)
+
+func randomVector(n int) Vector {
+	res := make(Vector, n)
+	for i := range res {
+		if _, err := res[i].SetRandom(); err != nil {
+			panic(err)
+		}
+	}
+	return res
+}
+
+func TestVectorOps(t *testing.T) {
+	// sizes around the block sizes of the vectorized implementations of koalabear
+	for _, n := range []int{0, 1, 3, 8, 17, 64, 129} {
+		assert := require.New(t)
+
+		a, b := randomVector(n), randomVector(n)
+		e := make(koalabear.Vector, n)
+		for i := range e {
+			e[i].SetRandom()
+		}
+		var s E4
+		s.SetRandom()
+		var se koalabear.Element
+		se.SetRandom()
+
+		var sum, innerProduct, innerProductByElement E4
+		add, sub, mul, mulByElement := make(Vector, n), make(Vector, n), make(Vector, n), make(Vector, n)
+		scalarMul, scalarMulByElement := make(Vector, n), make(Vector, n)
+		for i := 0; i < n; i++ {
+			var tmp E4
+			add[i].Add(&a[i], &b[i])
+			sub[i].Sub(&a[i], &b[i])
+			mul[i].Mul(&a[i], &b[i])
+			mulByElement[i].MulByElement(&a[i], &e[i])
+			scalarMul[i].Mul(&a[i], &s)
+			scalarMulByElement[i].MulByElement(&a[i], &se)
+			sum.Add(&sum, &a[i])
+			innerProduct.Add(&innerProduct, tmp.Mul(&a[i], &b[i]))
+			innerProductByElement.Add(&innerProductByElement, tmp.MulByElement(&a[i], &e[i]))
+		}
+
+		res := make(Vector, n)
+		res.Add(a, b)
+		assert.Equal(add, res)
+		res.Sub(a, b)
+		assert.Equal(sub, res)
+		res.Mul(a, b)
+		assert.Equal(mul, res)
+		res.MulByElement(a, e)
+		assert.Equal(mulByElement, res)
+		res.ScalarMul(a, &s)
+		assert.Equal(scalarMul, res)
+		res.ScalarMulByElement(a, &se)
+		assert.Equal(scalarMulByElement, res)
+		assert.Equal(sum, a.Sum())
+		assert.Equal(innerProduct, a.InnerProduct(b))
+		assert.Equal(innerProductByElement, a.InnerProductByElement(e))
+
+		// in place
+		res = append(Vector{}, a...)
+		res.Add(res, b)
+		assert.Equal(add, res)
+
+		if n > 0 {
+			assert.Panics(func() { res.Add(a, b[:n-1]) }, "vector.Add: vectors don't have the same length")
+		}
+	}
+}
+
+func TestVectorMarshal(t *testing.T) {
+	assert := require.New(t)
+
+	a := randomVector(33)
+	b, err := a.MarshalBinary()
+	assert.NoError(err)
+	var r Vector
+	assert.NoError(r.UnmarshalBinary(b))
+	assert.Equal(a, r)
+
+	// non-canonical coordinate
+	for i := 4; i < 4+koalabear.Bytes; i++ {
+		b[i] = 0xff
+	}
+	assert.ErrorIs(r.UnmarshalBinary(b), ErrCanonical)
+
+	// truncated
+	a = randomVector(3)
+	b, err = a.MarshalBinary()
+	assert.NoError(err)
+	assert.Error(r.UnmarshalBinary(b[:len(b)-1]))
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	const n = 1 << 16
+	v, w := randomVector(n), randomVector(n)
+	e := make(koalabear.Vector, n)
+	for i := range e {
+		e[i].SetRandom()
+	}
+	res := make(Vector, n)
+	var s koalabear.Element
+	s.SetRandom()
+
+	b.Run("Add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Add(v, w)
+		}
+	})
+	b.Run("ScalarMulByElement", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.ScalarMulByElement(v, &s)
+		}
+	})
+	b.Run("Mul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.Mul(v, w)
+		}
+	})
+	b.Run("MulByElement", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			res.MulByElement(v, e)
+		}
+	})
+	b.Run("InnerProduct", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = v.InnerProduct(w)
+		}
+	})
+}
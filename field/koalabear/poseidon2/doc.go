@@ -0,0 +1,31 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package poseidon2 implements the Poseidon2 permutations over koalabear with
+// widths 16 and 24, following the construction used by Plonky3.
+//
+// The outputs are not checked against test vectors of Plonky3: the tests
+// only pin regression vectors computed by this package, so the digests and
+// the Merkle roots must not be assumed to match the ones of Plonky3.
+//
+// The permutation is x ↦ Rₙ∘...∘R₁(E·x), where E is the external matrix
+// circ(2M₄, M₄, ..., M₄). The full rounds add a round key to each element,
+// apply the S-box x ↦ x^3 to each element and multiply by E. The
+// partial rounds add a round key to the first element, apply the S-box to the
+// first element only, and multiply by the internal matrix 1 + Diag(V).
+//
+// The round keys are drawn from the Grain LFSR as in the reference
+// implementation https://github.com/HorizenLabs/poseidon2, and the diagonals
+// V are chosen so that the products by V are cheap.
+//
+// Several states can be permuted at once with BatchPermutation, which uses
+// AVX-512 when available to permute 16 states in parallel.
+//
+// On top of the permutation, the package provides the 2-to-1 compression
+// (l, r) ↦ P(l ‖ r ‖ 0)[:DigestSize] for Merkle trees, and a padding-free
+// sponge to hash their leaves.
+//
+// See https://eprint.iacr.org/2023/323.pdf for the description of Poseidon2.
+package poseidon2
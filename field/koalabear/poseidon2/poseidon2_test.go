@@ -0,0 +1,310 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/koalabear"
+	"github.com/consensys/gnark-crypto/field/koalabear/merkletree"
+	"github.com/stretchr/testify/require"
+)
+
+var widths = []int{16, 24}
+
+func randomElements(n int) []koalabear.Element {
+	res := make([]koalabear.Element, n)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+func TestPermutationVectors(t *testing.T) {
+	// regression vectors: permutations of (0, 1, ..., width-1) by the
+	// parameters of poseidon2Configs in field/generator. They are not the test
+	// vectors published by Plonky3.
+	vectors := map[int][]uint64{
+		16: {
+			0x4b134812,
+			0x278ba7f8,
+			0x76944b51,
+			0x1c672bb2,
+			0x31dfa6bb,
+			0x6b7e9d79,
+			0x4945e876,
+			0x78a321a8,
+			0x7a73bc0a,
+			0x5cc8abbc,
+			0x56beb8c0,
+			0x57b4c9a2,
+			0x193b9df3,
+			0x15194d64,
+			0x47ba9576,
+			0x5eeccb64,
+		},
+		24: {
+			0x2b1fe9b9,
+			0x05318423,
+			0x22fdb71d,
+			0x4edd9967,
+			0x389e988d,
+			0x7ad0a4a9,
+			0x0dfd9b0e,
+			0x31c3ab07,
+			0x1248a877,
+			0x698f9feb,
+			0x139f736e,
+			0x311a77bd,
+			0x2da0b618,
+			0x564e7762,
+			0x24174999,
+			0x0ebecfc3,
+			0x48e690f4,
+			0x1523b3a6,
+			0x0dead494,
+			0x7baffbc5,
+			0x74e5677e,
+			0x12af618e,
+			0x65717eaa,
+			0x6c39c16a,
+		},
+	}
+
+	for _, width := range widths {
+		t.Run(fmt.Sprintf("width=%d", width), func(t *testing.T) {
+			assert := require.New(t)
+
+			input := make([]koalabear.Element, width)
+			for i := range input {
+				input[i].SetUint64(uint64(i))
+			}
+			assert.NoError(NewPermutation(width).Permutation(input))
+
+			for i := range input {
+				assert.Equal(vectors[width][i], input[i].Uint64(), "element %d", i)
+			}
+		})
+	}
+}
+
+func TestMatrices(t *testing.T) {
+	// the products by the matrices are compared with the products by the
+	// dense matrices
+	m4 := [4][4]uint64{
+		{2, 3, 1, 1},
+		{1, 2, 3, 1},
+		{1, 1, 2, 3},
+		{3, 1, 1, 2},
+	}
+	mul := func(m [][]koalabear.Element, x []koalabear.Element) []koalabear.Element {
+		res := make([]koalabear.Element, len(x))
+		for i := range m {
+			for j := range m[i] {
+				var tmp koalabear.Element
+				tmp.Mul(&m[i][j], &x[j])
+				res[i].Add(&res[i], &tmp)
+			}
+		}
+		return res
+	}
+
+	for _, width := range widths {
+		t.Run(fmt.Sprintf("width=%d", width), func(t *testing.T) {
+			assert := require.New(t)
+			p := NewPermutation(width)
+
+			external := make([][]koalabear.Element, width)
+			internal := make([][]koalabear.Element, width)
+			for i := range external {
+				external[i] = make([]koalabear.Element, width)
+				internal[i] = make([]koalabear.Element, width)
+				for j := range external[i] {
+					external[i][j].SetUint64(m4[i%4][j%4])
+					if i/4 == j/4 {
+						external[i][j].Double(&external[i][j])
+					}
+					internal[i][j].SetOne()
+				}
+				internal[i][i].Add(&internal[i][i], &p.params.DiagInternalMatrix[i])
+			}
+
+			x := randomElements(width)
+			expected := mul(external, x)
+			p.matMulExternalInPlace(x)
+			assert.Equal(expected, x, "external matrix")
+
+			expected = mul(internal, x)
+			p.matMulInternalInPlace(x)
+			assert.Equal(expected, x, "internal matrix")
+		})
+	}
+}
+
+func TestBatchPermutation(t *testing.T) {
+	for _, width := range widths {
+		t.Run(fmt.Sprintf("width=%d", width), func(t *testing.T) {
+			assert := require.New(t)
+			p := NewPermutation(width)
+
+			for _, n := range []int{0, 1, 7, 16, 37} {
+				states := randomElements(n * width)
+				expected := make([]koalabear.Element, len(states))
+				copy(expected, states)
+				for i := 0; i < n; i++ {
+					assert.NoError(p.Permutation(expected[i*width : (i+1)*width]))
+				}
+
+				assert.NoError(p.BatchPermutation(states))
+				assert.Equal(expected, states, "%d states", n)
+			}
+
+			assert.ErrorIs(p.BatchPermutation(make([]koalabear.Element, width+1)), ErrInvalidSizebuffer)
+			assert.ErrorIs(p.Permutation(make([]koalabear.Element, width-1)), ErrInvalidSizebuffer)
+		})
+	}
+}
+
+func TestCompression(t *testing.T) {
+	for _, width := range widths {
+		t.Run(fmt.Sprintf("width=%d", width), func(t *testing.T) {
+			assert := require.New(t)
+			c := NewCompression(width)
+
+			const n = 21
+			left := make([]merkletree.Digest, n)
+			right := make([]merkletree.Digest, n)
+			for i := 0; i < n; i++ {
+				copy(left[i][:], randomElements(DigestSize))
+				copy(right[i][:], randomElements(DigestSize))
+			}
+
+			// compression of the first digests, by hand
+			state := make([]koalabear.Element, width)
+			copy(state, left[0][:])
+			copy(state[DigestSize:], right[0][:])
+			assert.NoError(NewPermutation(width).Permutation(state))
+			res := c.Compress(&left[0], &right[0])
+			assert.Equal(state[:DigestSize], res[:])
+
+			batch := make([]merkletree.Digest, n)
+			assert.NoError(c.BatchCompress(batch, left, right))
+			for i := range batch {
+				assert.Equal(c.Compress(&left[i], &right[i]), batch[i], "digest %d", i)
+			}
+			assert.ErrorIs(c.BatchCompress(batch[1:], left, right), ErrInvalidNbDigests)
+
+			// the compression can be used in Merkle trees
+			tree, err := merkletree.New(left, 2, c)
+			assert.NoError(err)
+			proof, err := tree.Prove(3, 12)
+			assert.NoError(err)
+			assert.NoError(merkletree.Verify(tree.Root(), n, 2, []int{3, 12}, []merkletree.Digest{left[3], left[12]}, &proof, c))
+		})
+	}
+}
+
+func TestSponge(t *testing.T) {
+	for _, width := range widths {
+		t.Run(fmt.Sprintf("width=%d", width), func(t *testing.T) {
+			assert := require.New(t)
+			s := NewSponge(width)
+			p := NewPermutation(width)
+			rate := s.Rate()
+			assert.Equal(width-DigestSize, rate)
+
+			// the empty input is not permuted
+			assert.Equal(merkletree.Digest{}, s.Hash(nil))
+
+			for _, n := range []int{1, rate - 1, rate, rate + 1, 3*rate + 2} {
+				input := randomElements(n)
+
+				// absorption by hand
+				state := make([]koalabear.Element, width)
+				for start := 0; start < n; start += rate {
+					copy(state, input[start:min(start+rate, n)])
+					assert.NoError(p.Permutation(state))
+				}
+				res := s.Hash(input)
+				assert.Equal(state[:DigestSize], res[:], "%d elements", n)
+			}
+
+			inputs := make([][]koalabear.Element, 19)
+			for i := range inputs {
+				inputs[i] = randomElements(2*rate + 3)
+			}
+			batch := make([]merkletree.Digest, len(inputs))
+			assert.NoError(s.BatchHash(batch, inputs))
+			for i := range batch {
+				assert.Equal(s.Hash(inputs[i]), batch[i], "digest %d", i)
+			}
+
+			inputs[3] = inputs[3][1:]
+			assert.ErrorIs(s.BatchHash(batch, inputs), ErrInvalidSizebuffer)
+			assert.ErrorIs(s.BatchHash(batch[1:], inputs), ErrInvalidNbDigests)
+		})
+	}
+}
+
+func TestParameters(t *testing.T) {
+	assert := require.New(t)
+
+	_, err := NewParameters(4)
+	assert.Error(err)
+	assert.Panics(func() { NewPermutation(20) })
+
+	for _, width := range widths {
+		params, err := NewParameters(width)
+		assert.NoError(err)
+		assert.Len(params.RoundKeys, params.NbFullRounds+params.NbPartialRounds)
+		assert.Len(params.DiagInternalMatrix, width)
+
+		// the permutation with modified round keys is not the default one,
+		// in the batched implementation either
+		params.RoundKeys[0][0].SetOne()
+		p, q := NewPermutationWithParameters(params), NewPermutation(width)
+		states := randomElements(16 * width)
+		expected := make([]koalabear.Element, len(states))
+		other := make([]koalabear.Element, len(states))
+		copy(expected, states)
+		copy(other, states)
+		assert.NoError(p.BatchPermutation(states))
+		for i := 0; i < 16; i++ {
+			assert.NoError(p.Permutation(expected[i*width : (i+1)*width]))
+		}
+		assert.Equal(expected, states)
+		assert.NoError(q.BatchPermutation(other))
+		assert.NotEqual(other, states)
+	}
+}
+
+func BenchmarkPermutation(b *testing.B) {
+	for _, width := range widths {
+		b.Run(fmt.Sprintf("width=%d", width), func(b *testing.B) {
+			p := NewPermutation(width)
+			input := randomElements(width)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = p.Permutation(input)
+			}
+		})
+	}
+}
+
+func BenchmarkBatchPermutation(b *testing.B) {
+	const nbStates = 1 << 10
+	for _, width := range widths {
+		b.Run(fmt.Sprintf("width=%d/states=%d", width, nbStates), func(b *testing.B) {
+			p := NewPermutation(width)
+			states := randomElements(nbStates * width)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = p.BatchPermutation(states)
+			}
+		})
+	}
+}
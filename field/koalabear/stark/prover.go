@@ -0,0 +1,341 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package stark
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/field/koalabear"
+	"github.com/consensys/gnark-crypto/field/koalabear/extensions"
+	"github.com/consensys/gnark-crypto/field/koalabear/fft"
+	"github.com/consensys/gnark-crypto/field/koalabear/fri"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrTraceShape   = errors.New("the trace must have Width columns of the same power of 2 length")
+	ErrUnsatisfied  = errors.New("the trace does not satisfy the constraints")
+	ErrBlowupFactor = errors.New("the blowup factor is smaller than the degree of the composition polynomial")
+)
+
+// Proof STARK proof.
+type Proof struct {
+	// TraceRoot, CompositionRoot and DEEPRoot roots of the commitments to the
+	// trace, to the segments of the composition polynomial and to the DEEP
+	// composition polynomial
+	TraceRoot, CompositionRoot, DEEPRoot []byte
+
+	// TraceEvaluations tⱼ(z), NextTraceEvaluations tⱼ(g·z) and
+	// CompositionEvaluations Hₛ(z), the out-of-domain evaluations of the
+	// columns of the trace and of the segments of the composition polynomial
+	TraceEvaluations, NextTraceEvaluations, CompositionEvaluations []extensions.E4
+
+	// TraceOpenings and CompositionOpenings openings of the commitments at the
+	// leaves queried by FRI
+	TraceOpenings, CompositionOpenings []fri.RowOpening
+
+	// FRI proof of proximity of the DEEP composition polynomial
+	FRI fri.Proof
+}
+
+// newFRI returns the FRI of the polynomials of degree < n, on a coset.
+func newFRI(n int, h hash.Hash, config fri.Config) (*fri.FRI, error) {
+	return fri.NewFRI(uint64(n), h, config, fri.WithCoset())
+}
+
+func newTranscript(h hash.Hash) *fiatshamir.Transcript {
+	return fiatshamir.NewTranscript(h, alphaID, zID, betaID, friID)
+}
+
+// Prove returns a proof that the trace, given by its columns, satisfies the
+// AIR. The hash function is used for the commitments and Fiat Shamir, and the
+// configuration of FRI must have a blowup factor at least the degree of the
+// transition constraints minus one.
+func Prove(air *AIR, trace [][]koalabear.Element, h hash.Hash, config fri.Config) (*Proof, error) {
+	if len(trace) != air.Width || len(trace) == 0 {
+		return nil, ErrTraceShape
+	}
+	n := len(trace[0])
+	for _, column := range trace {
+		if len(column) != n {
+			return nil, ErrTraceShape
+		}
+	}
+	if n < 2 || bits.OnesCount(uint(n)) != 1 {
+		return nil, ErrTraceShape
+	}
+	if err := air.check(n); err != nil {
+		return nil, err
+	}
+	if err := air.checkTrace(trace); err != nil {
+		return nil, err
+	}
+	return prove(air, trace, h, config)
+}
+
+// prove returns a proof for the trace, assumed to satisfy the AIR.
+func prove(air *AIR, trace [][]koalabear.Element, h hash.Hash, config fri.Config) (*Proof, error) {
+	n := len(trace[0])
+	D := air.compositionDegree()
+	if D > config.BlowupFactor {
+		return nil, ErrBlowupFactor
+	}
+	f, err := newFRI(n, h, config)
+	if err != nil {
+		return nil, err
+	}
+	var proof Proof
+	fs := newTranscript(h)
+
+	// commitment to the trace
+	traceDomain := fft.NewDomain(uint64(n))
+	tracePolynomials := make([][]koalabear.Element, len(trace))
+	for j, column := range trace {
+		tracePolynomials[j] = make([]koalabear.Element, n)
+		copy(tracePolynomials[j], column)
+		traceDomain.FFTInverse(tracePolynomials[j], fft.DIF)
+		fft.BitReverse(tracePolynomials[j])
+	}
+	traceCommitment, err := f.Commit(tracePolynomials)
+	if err != nil {
+		return nil, err
+	}
+	proof.TraceRoot = traceCommitment.Root
+	alpha, err := challenge(fs, alphaID, air.publicBytes(n, proof.TraceRoot))
+	if err != nil {
+		return nil, err
+	}
+
+	// commitment to the segments of the composition polynomial
+	composition := compose(air, f, traceDomain, traceCommitment.Columns(), alpha)
+	segments := split(f, composition, D, n)
+	compositionCommitment, err := f.Commit(segments)
+	if err != nil {
+		return nil, err
+	}
+	proof.CompositionRoot = compositionCommitment.Root
+	z, err := challenge(fs, zID, proof.CompositionRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	// out-of-domain evaluations
+	var gz extensions.E4
+	gz.MulByElement(&z, &traceDomain.Generator)
+	proof.TraceEvaluations = make([]extensions.E4, len(tracePolynomials))
+	proof.NextTraceEvaluations = make([]extensions.E4, len(tracePolynomials))
+	for j, p := range tracePolynomials {
+		proof.TraceEvaluations[j] = evaluate(p, &z)
+		proof.NextTraceEvaluations[j] = evaluate(p, &gz)
+	}
+	proof.CompositionEvaluations = make([]extensions.E4, D)
+	for s := range proof.CompositionEvaluations {
+		proof.CompositionEvaluations[s] = evaluateCoordinates(segments[s*extensionDegree:(s+1)*extensionDegree], &z)
+	}
+	beta, err := challenge(fs, betaID, marshalExtensions(proof.TraceEvaluations, proof.NextTraceEvaluations, proof.CompositionEvaluations))
+	if err != nil {
+		return nil, err
+	}
+
+	// DEEP composition polynomial, proven with FRI
+	deep := deepComposition(f, z, gz, beta, traceCommitment.Columns(), compositionCommitment.Columns(), &proof)
+	deepCommitment, err := f.CommitEvaluations(deep)
+	if err != nil {
+		return nil, err
+	}
+	proof.DEEPRoot = deepCommitment.Root
+	if err := fs.Bind(friID, proof.DEEPRoot); err != nil {
+		return nil, err
+	}
+	seed, err := fs.ComputeChallenge(friID)
+	if err != nil {
+		return nil, err
+	}
+	var positions []int
+	if proof.FRI, positions, err = f.ProveWithSeed(deepCommitment, seed); err != nil {
+		return nil, err
+	}
+	proof.TraceOpenings = make([]fri.RowOpening, len(positions))
+	proof.CompositionOpenings = make([]fri.RowOpening, len(positions))
+	for q, l := range positions {
+		proof.TraceOpenings[q] = traceCommitment.Open(l)
+		proof.CompositionOpenings[q] = compositionCommitment.Open(l)
+	}
+	return &proof, nil
+}
+
+// compose returns the evaluations on the domain of f of the composition
+// polynomial
+//
+//	H = ∑ᵢ αⁱ·Cᵢ(t(X), t(gX))·(X - g⁻¹)/(Xⁿ - 1) + ∑ᵢ αᵐ⁺ⁱ·(t_{jᵢ}(X) - vᵢ)/(X - g^{rᵢ})
+//
+// where the Cᵢ are the m transition constraints and (jᵢ, rᵢ, vᵢ) the boundary
+// constraints.
+func compose(air *AIR, f *fri.FRI, traceDomain *fft.Domain, columns [][]koalabear.Element, alpha extensions.E4) []extensions.E4 {
+	domain := f.Domain()
+	N := int(domain.Cardinality)
+	blowup := N / int(traceDomain.Cardinality)
+	shift := f.Shift()
+	alphas := powers(alpha, air.nbConstraints())
+
+	// 1/(xⁿ - 1) only takes B values on the coset
+	zInv := make([]koalabear.Element, blowup)
+	var w koalabear.Element
+	w.Exp(domain.Generator, new(big.Int).SetUint64(traceDomain.Cardinality))
+	zInv[0].Exp(shift, new(big.Int).SetUint64(traceDomain.Cardinality))
+	for i := 1; i < blowup; i++ {
+		zInv[i].Mul(&zInv[i-1], &w)
+	}
+	one := koalabear.One()
+	for i := range zInv {
+		zInv[i].Sub(&zInv[i], &one)
+	}
+	zInv = koalabear.BatchInvert(zInv)
+
+	// 1/(x - gʳ) for the rows of the boundary constraints
+	boundaryInv := make(map[int][]koalabear.Element)
+	for _, b := range air.Boundaries {
+		if _, ok := boundaryInv[b.Row]; ok {
+			continue
+		}
+		var gr koalabear.Element
+		gr.Exp(traceDomain.Generator, big.NewInt(int64(b.Row)))
+		den := make([]koalabear.Element, N)
+		x := shift
+		for i := range den {
+			den[i].Sub(&x, &gr)
+			x.Mul(&x, &domain.Generator)
+		}
+		boundaryInv[b.Row] = koalabear.BatchInvert(den)
+	}
+
+	res := make([]extensions.E4, N)
+	parallel.Execute(N, func(start, end int) {
+		cur := make([]koalabear.Element, air.Width)
+		next := make([]koalabear.Element, air.Width)
+		var x, v, tmp koalabear.Element
+		var t extensions.E4
+		x.Exp(domain.Generator, big.NewInt(int64(start))).Mul(&x, &shift)
+		for i := start; i < end; i++ {
+			// the next row of x is gx, at i + B
+			for j := range cur {
+				cur[j], next[j] = columns[j][i], columns[j][(i+blowup)%N]
+			}
+			// (x - g⁻¹)/(xⁿ - 1)
+			tmp.Sub(&x, &traceDomain.GeneratorInv).Mul(&tmp, &zInv[i%blowup])
+			for c, constraint := range air.Transitions {
+				v = constraint.Evaluate(cur, next)
+				v.Mul(&v, &tmp)
+				t.MulByElement(&alphas[c], &v)
+				res[i].Add(&res[i], &t)
+			}
+			for c, b := range air.Boundaries {
+				v.Sub(&cur[b.Column], &b.Value).Mul(&v, &boundaryInv[b.Row][i])
+				t.MulByElement(&alphas[len(air.Transitions)+c], &v)
+				res[i].Add(&res[i], &t)
+			}
+			x.Mul(&x, &domain.Generator)
+		}
+	})
+	return res
+}
+
+// split returns the coordinates of the segments H₀, …, H_{D-1} of degree < n
+// of the composition polynomial H = ∑ₛ Xˢⁿ·Hₛ, given by its evaluations on the
+// domain of f: the coordinate c of Hₛ is at index s·extensionDegree + c.
+func split(f *fri.FRI, composition []extensions.E4, D, n int) [][]koalabear.Element {
+	res := make([][]koalabear.Element, D*extensionDegree)
+	parallel.Execute(extensionDegree, func(start, end int) {
+		for c := start; c < end; c++ {
+			coefficients := make([]koalabear.Element, len(composition))
+			for i := range composition {
+				coefficients[i] = *coordinates(&composition[i])[c]
+			}
+			f.Domain().FFTInverse(coefficients, fft.DIF, fft.OnCoset())
+			fft.BitReverse(coefficients)
+			for s := 0; s < D; s++ {
+				res[s*extensionDegree+c] = coefficients[s*n : (s+1)*n]
+			}
+		}
+	}, 1)
+	return res
+}
+
+// deepComposition returns the coordinates of the evaluations on the domain of
+// f of the DEEP composition polynomial
+//
+//	∑ⱼ βʲ·(tⱼ - tⱼ(z))/(X - z) + βʷ⁺ʲ·(tⱼ - tⱼ(gz))/(X - gz) + ∑ₛ β²ʷ⁺ˢ·(Hₛ - Hₛ(z))/(X - z)
+//
+// of degree < n, w being the width of the trace.
+func deepComposition(f *fri.FRI, z, gz, beta extensions.E4, trace, segments [][]koalabear.Element, proof *Proof) [][]koalabear.Element {
+	domain := f.Domain()
+	N := int(domain.Cardinality)
+	width := len(trace)
+	betas := powers(beta, 2*width+len(proof.CompositionEvaluations))
+
+	// 1/(x - z) and 1/(x - gz)
+	zDen := make([]extensions.E4, N)
+	gzDen := make([]extensions.E4, N)
+	x := f.Shift()
+	for i := 0; i < N; i++ {
+		e := fromElement(&x)
+		zDen[i].Sub(&e, &z)
+		gzDen[i].Sub(&e, &gz)
+		x.Mul(&x, &domain.Generator)
+	}
+	zDen, gzDen = extensions.BatchInvertE4(zDen), extensions.BatchInvertE4(gzDen)
+
+	res := make([][]koalabear.Element, extensionDegree)
+	for c := range res {
+		res[c] = make([]koalabear.Element, N)
+	}
+	parallel.Execute(N, func(start, end int) {
+		traceRow := make([]koalabear.Element, width)
+		segmentsRow := make([]koalabear.Element, len(segments))
+		for i := start; i < end; i++ {
+			for j := range traceRow {
+				traceRow[j] = trace[j][i]
+			}
+			for j := range segmentsRow {
+				segmentsRow[j] = segments[j][i]
+			}
+			v := deepValue(betas, traceRow, segmentsRow, proof, &zDen[i], &gzDen[i])
+			for c, coordinate := range coordinates(&v) {
+				res[c][i] = *coordinate
+			}
+		}
+	})
+	return res
+}
+
+// deepValue returns the value of the DEEP composition polynomial at a point x,
+// given the rows at x of the trace and of the segments, 1/(x - z) and
+// 1/(x - gz).
+func deepValue(betas []extensions.E4, traceRow, segmentsRow []koalabear.Element, proof *Proof, zInv, gzInv *extensions.E4) extensions.E4 {
+	width := len(traceRow)
+	var a, b, t, u extensions.E4
+	for j := range traceRow {
+		t = fromElement(&traceRow[j])
+		u.Sub(&t, &proof.TraceEvaluations[j]).Mul(&u, &betas[j])
+		a.Add(&a, &u)
+		u.Sub(&t, &proof.NextTraceEvaluations[j]).Mul(&u, &betas[width+j])
+		b.Add(&b, &u)
+	}
+	for s := range proof.CompositionEvaluations {
+		for c, coordinate := range coordinates(&t) {
+			*coordinate = segmentsRow[s*extensionDegree+c]
+		}
+		u.Sub(&t, &proof.CompositionEvaluations[s]).Mul(&u, &betas[2*width+s])
+		a.Add(&a, &u)
+	}
+	a.Mul(&a, zInv)
+	b.Mul(&b, gzInv)
+	return *a.Add(&a, &b)
+}
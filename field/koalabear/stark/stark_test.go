@@ -0,0 +1,187 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package stark
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/koalabear"
+	"github.com/consensys/gnark-crypto/field/koalabear/fri"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = fri.Config{
+	FoldingFactor: 4,
+	BlowupFactor:  4,
+	NbQueries:     16,
+	FinalDegree:   3,
+	GrindingBits:  4,
+}
+
+// fibonacci returns the AIR and the trace of the Fibonacci sequence in n rows,
+// with the columns (a, b) and the transitions (a, b) -> (b, a + b).
+func fibonacci(n int) (*AIR, [][]koalabear.Element) {
+	trace := [][]koalabear.Element{make([]koalabear.Element, n), make([]koalabear.Element, n)}
+	trace[0][0].SetOne()
+	trace[1][0].SetOne()
+	for i := 1; i < n; i++ {
+		trace[0][i] = trace[1][i-1]
+		trace[1][i].Add(&trace[0][i-1], &trace[1][i-1])
+	}
+	air := &AIR{
+		Width: 2,
+		Transitions: []*Expression{
+			Sub(Next(0), Cur(1)),
+			Sub(Next(1), Add(Cur(0), Cur(1))),
+		},
+		Boundaries: []Boundary{
+			{Column: 0, Row: 0, Value: koalabear.One()},
+			{Column: 1, Row: 0, Value: koalabear.One()},
+			{Column: 1, Row: n - 1, Value: trace[1][n-1]},
+		},
+	}
+	return air, trace
+}
+
+// hashChain returns the AIR and the trace of n iterations of x -> (x + k)³
+// with round constants k = 0, 1, 2...
+func hashChain(n int) (*AIR, [][]koalabear.Element) {
+	trace := [][]koalabear.Element{make([]koalabear.Element, n), make([]koalabear.Element, n)}
+	trace[0][0].SetUint64(42)
+	one := koalabear.One()
+	for i := 1; i < n; i++ {
+		var x koalabear.Element
+		x.Add(&trace[0][i-1], &trace[1][i-1])
+		trace[0][i].Square(&x).Mul(&trace[0][i], &x)
+		trace[1][i].Add(&trace[1][i-1], &one)
+	}
+	air := &AIR{
+		Width: 2,
+		Transitions: []*Expression{
+			Sub(Next(0), Pow(Add(Cur(0), Cur(1)), 3)),
+			Sub(Next(1), Add(Cur(1), Constant(one))),
+		},
+		Boundaries: []Boundary{
+			{Column: 0, Row: 0, Value: trace[0][0]},
+			{Column: 1, Row: 0, Value: koalabear.Element{}},
+			{Column: 0, Row: n - 1, Value: trace[0][n-1]},
+		},
+	}
+	return air, trace
+}
+
+func TestSTARK(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		air  func(int) (*AIR, [][]koalabear.Element)
+	}{
+		{"fibonacci", fibonacci},
+		{"hash chain", hashChain},
+	} {
+		for _, n := range []int{8, 64} {
+			t.Run(fmt.Sprintf("%s/n=%d", test.name, n), func(t *testing.T) {
+				assert := require.New(t)
+
+				air, trace := test.air(n)
+				proof, err := Prove(air, trace, sha256.New(), testConfig)
+				assert.NoError(err)
+				assert.NoError(Verify(air, n, proof, sha256.New(), testConfig))
+
+				// wrong public output
+				var wrong koalabear.Element
+				wrong.SetOne()
+				air.Boundaries[2].Value.Add(&air.Boundaries[2].Value, &wrong)
+				assert.Error(Verify(air, n, proof, sha256.New(), testConfig))
+				air.Boundaries[2].Value.Sub(&air.Boundaries[2].Value, &wrong)
+
+				// wrong length
+				assert.Error(Verify(air, 2*n, proof, sha256.New(), testConfig))
+			})
+		}
+	}
+}
+
+func TestSTARKUnsatisfied(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 32
+	air, trace := fibonacci(n)
+	trace[0][n/2].SetUint64(7)
+	_, err := Prove(air, trace, sha256.New(), testConfig)
+	assert.ErrorIs(err, ErrUnsatisfied)
+
+	// a dishonest prover skipping the check of the trace is caught by FRI, the
+	// composition polynomial not being of low degree
+	proof, err := prove(air, trace, sha256.New(), testConfig)
+	assert.NoError(err)
+	assert.Error(Verify(air, n, proof, sha256.New(), testConfig))
+
+	// the degree of the constraints is bounded by the blowup factor
+	air, trace = hashChain(n)
+	air.Transitions[0] = Sub(Next(0), Pow(Add(Cur(0), Cur(1)), 7))
+	_, err = prove(air, trace, sha256.New(), testConfig)
+	assert.ErrorIs(err, ErrBlowupFactor)
+}
+
+func TestSTARKTampering(t *testing.T) {
+	const n = 32
+	air, trace := hashChain(n)
+	honest, err := Prove(air, trace, sha256.New(), testConfig)
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name   string
+		tamper func(p *Proof)
+		err    error
+	}{
+		{"trace evaluation", func(p *Proof) {
+			p.TraceEvaluations[0].SetOne()
+		}, nil},
+		{"composition evaluation", func(p *Proof) {
+			p.CompositionEvaluations[1] = p.CompositionEvaluations[0]
+		}, nil},
+		{"trace opening", func(p *Proof) {
+			p.TraceOpenings[0].Values[0].SetUint64(1)
+		}, nil},
+		{"composition opening", func(p *Proof) {
+			p.CompositionOpenings[0].Values[1].SetUint64(1)
+		}, nil},
+		{"missing openings", func(p *Proof) {
+			p.TraceOpenings = p.TraceOpenings[1:]
+		}, ErrProofShape},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			proof := clone(honest)
+			test.tamper(proof)
+			err := Verify(air, n, proof, sha256.New(), testConfig)
+			assert.Error(err)
+			if test.err != nil {
+				assert.ErrorIs(err, test.err)
+			}
+		})
+	}
+
+	// the honest proof was not modified
+	require.NoError(t, Verify(air, n, honest, sha256.New(), testConfig))
+}
+
+// clone returns a copy of the parts of the proof which are tampered with.
+func clone(p *Proof) *Proof {
+	res := *p
+	res.TraceEvaluations = append(res.TraceEvaluations[:0:0], p.TraceEvaluations...)
+	res.CompositionEvaluations = append(res.CompositionEvaluations[:0:0], p.CompositionEvaluations...)
+	res.TraceOpenings = append(res.TraceOpenings[:0:0], p.TraceOpenings...)
+	res.CompositionOpenings = append(res.CompositionOpenings[:0:0], p.CompositionOpenings...)
+	for q := range res.TraceOpenings {
+		res.TraceOpenings[q].Values = append(res.TraceOpenings[q].Values[:0:0], p.TraceOpenings[q].Values...)
+		res.CompositionOpenings[q].Values = append(res.CompositionOpenings[q].Values[:0:0], p.CompositionOpenings[q].Values...)
+	}
+	return &res
+}
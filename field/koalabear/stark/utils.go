@@ -0,0 +1,128 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package stark
+
+import (
+	"encoding/binary"
+
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/field/koalabear"
+	"github.com/consensys/gnark-crypto/field/koalabear/extensions"
+)
+
+// extensionDegree degree of the extension E4 of koalabear
+const extensionDegree = 4
+
+// coordinates returns pointers to the coordinates of e over koalabear.
+func coordinates(e *extensions.E4) [extensionDegree]*koalabear.Element {
+	return [extensionDegree]*koalabear.Element{&e.B0.A0, &e.B0.A1, &e.B1.A0, &e.B1.A1}
+}
+
+// fromElement returns x as an element of the extension.
+func fromElement(x *koalabear.Element) extensions.E4 {
+	var res extensions.E4
+	*coordinates(&res)[0] = *x
+	return res
+}
+
+// subElement sets z = x - y, y in koalabear, and returns z.
+func subElement(z, x *extensions.E4, y *koalabear.Element) *extensions.E4 {
+	z.Set(x)
+	c := coordinates(z)[0]
+	c.Sub(c, y)
+	return z
+}
+
+// evaluate returns p(z), p being given in canonical basis over koalabear.
+func evaluate(p []koalabear.Element, z *extensions.E4) extensions.E4 {
+	var res extensions.E4
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, z)
+		c := coordinates(&res)[0]
+		c.Add(c, &p[i])
+	}
+	return res
+}
+
+// evaluateCoordinates returns p(z), for the polynomial p over E4 whose
+// coordinates are the polynomials over koalabear given in canonical basis.
+func evaluateCoordinates(p [][]koalabear.Element, z *extensions.E4) extensions.E4 {
+	// p(z) = ∑_c p_c(z)·e_c, where the e_c are the basis of the coordinates
+	var res extensions.E4
+	for c := range p {
+		var e extensions.E4
+		*coordinates(&e)[c] = koalabear.One()
+		v := evaluate(p[c], z)
+		res.Add(&res, v.Mul(&v, &e))
+	}
+	return res
+}
+
+// powers returns 1, γ, …, γⁿ⁻¹.
+func powers(gamma extensions.E4, n int) []extensions.E4 {
+	res := make([]extensions.E4, n)
+	if n == 0 {
+		return res
+	}
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &gamma)
+	}
+	return res
+}
+
+// marshalExtensions returns the concatenation of the encodings of the
+// coordinates of the elements of v.
+func marshalExtensions(v ...[]extensions.E4) []byte {
+	var res []byte
+	for _, w := range v {
+		for i := range w {
+			for _, c := range coordinates(&w[i]) {
+				b := c.Bytes()
+				res = append(res, b[:]...)
+			}
+		}
+	}
+	return res
+}
+
+const (
+	alphaID = "alpha"
+	zID     = "z"
+	betaID  = "beta"
+	friID   = "fri"
+)
+
+// challenge binds data to the challenge id and returns its value in E4,
+// each coordinate being derived from 8 bytes of the challenge.
+func challenge(fs *fiatshamir.Transcript, id string, data []byte) (extensions.E4, error) {
+	var res extensions.E4
+	if err := fs.Bind(id, data); err != nil {
+		return res, err
+	}
+	b, err := fs.ComputeChallenge(id)
+	if err != nil {
+		return res, err
+	}
+	for i, c := range coordinates(&res) {
+		c.SetUint64(binary.BigEndian.Uint64(b[8*i:]))
+	}
+	return res, nil
+}
+
+// publicBytes returns the encoding of the statement: the size of the trace,
+// the boundary constraints, and the commitment to the trace.
+func (air *AIR) publicBytes(n int, traceRoot []byte) []byte {
+	res := binary.BigEndian.AppendUint64(nil, uint64(n))
+	res = binary.BigEndian.AppendUint64(res, uint64(air.Width))
+	for _, b := range air.Boundaries {
+		res = binary.BigEndian.AppendUint64(res, uint64(b.Column))
+		res = binary.BigEndian.AppendUint64(res, uint64(b.Row))
+		v := b.Value.Bytes()
+		res = append(res, v[:]...)
+	}
+	return append(res, traceRoot...)
+}
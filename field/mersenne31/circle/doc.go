@@ -0,0 +1,20 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package circle provides the Circle FFT of [Haböck, Levit and Papini] over
+// mersenne31, on the circle group x² + y² = 1 of order q + 1 = 2³¹.
+//
+// The evaluation domains are twin-cosets D = (Q + G) ∪ -(Q + G), where G is
+// the subgroup of order |D|/2 and the group law is written additively. By
+// default Q is a generator of the subgroup of order 2·|D|, which gives the
+// standard position coset used by Circle STARKs.
+//
+// Polynomials are represented by their coefficients in the FFT basis
+//
+//	bⱼ(x, y) = y^j₀ · x^j₁ · π(x)^j₂ ⋯ π^{k-2}(x)^j_{k-1}
+//
+// where j = ∑ jₜ·2ᵗ, |D| = 2ᵏ and π(x) = 2x² - 1 is the x-coordinate of the
+// doubling map.
+//
+// [Haböck, Levit and Papini]: https://eprint.iacr.org/2024/278
+package circle
@@ -0,0 +1,199 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package circle
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+var (
+	ErrDomainSize = errors.New("the cardinality of the domain must be at most 2³⁰")
+	ErrTwinCoset  = errors.New("the shift does not define a twin-coset of the cardinality of the domain")
+)
+
+// Domain twin-coset D = (Q + G) ∪ -(Q + G) of the circle group with a power of
+// 2 cardinality n, where G is the subgroup of order n/2.
+//
+// The points are ordered as Q + i·g for i < n/2, followed by their
+// conjugates -(Q + i·g): evaluations are given in this order.
+type Domain struct {
+	Cardinality    uint64
+	CardinalityInv mersenne31.Element
+
+	// Shift Q of the twin-coset
+	Shift Point
+
+	// Generator g of G, of order Cardinality/2
+	Generator Point
+
+	// the following slices are not serialized and are (re)computed through domain.preComputeTwiddles()
+
+	// twiddles factor for each layer of the FFT: the y-coordinates of the
+	// points Q + i·g for the first layer, and then the x-coordinates of the
+	// points 2ᵗ⁻¹·(Q + j·g)
+	twiddles [][]mersenne31.Element
+
+	// inverses of the twiddles factors, for the inverse FFT
+	twiddlesInv [][]mersenne31.Element
+}
+
+// NewDomain returns a twin-coset with a power of 2 cardinality
+// cardinality >= max(m, 2)
+// shift: when specified, it's the point Q of the twin-coset, by default a
+// generator of the subgroup of order twice the cardinality.
+func NewDomain(m uint64, opts ...DomainOption) *Domain {
+	opt := domainOptions(opts...)
+	domain := &Domain{}
+	n := ecc.NextPowerOfTwo(m)
+	if n < 2 {
+		n = 2
+	}
+	if n > 1<<(LogOrder-1) {
+		panic(ErrDomainSize)
+	}
+	domain.Cardinality = n
+	domain.CardinalityInv.SetUint64(n).Inverse(&domain.CardinalityInv)
+
+	logN := uint64(bits.TrailingZeros64(n))
+	var err error
+	if domain.Generator, err = Generator(logN - 1); err != nil {
+		panic(err)
+	}
+	if opt.shift != nil {
+		domain.Shift = *opt.shift
+	} else if domain.Shift, err = Generator(logN + 1); err != nil {
+		panic(err)
+	}
+	if err := domain.checkShift(); err != nil {
+		panic(err)
+	}
+
+	domain.preComputeTwiddles()
+
+	return domain
+}
+
+// checkShift checks that the shift is a point of order larger than the
+// cardinality, so that the points of the domain are distinct and the twiddles
+// factors are not zero.
+func (d *Domain) checkShift() error {
+	var p Point
+	p.ScalarMul(&d.Shift, d.Cardinality)
+	identity := Identity()
+	if !d.Shift.IsOnCircle() || p.Equal(&identity) {
+		return ErrTwinCoset
+	}
+	return nil
+}
+
+// At returns the i-th point of the domain
+func (d *Domain) At(i uint64) Point {
+	half := d.Cardinality / 2
+	var res Point
+	res.ScalarMul(&d.Generator, i%half).Add(&res, &d.Shift)
+	if i >= half {
+		res.Neg(&res)
+	}
+	return res
+}
+
+func (d *Domain) preComputeTwiddles() {
+	n := d.Cardinality
+	nbLayers := bits.TrailingZeros64(n)
+	d.twiddles = make([][]mersenne31.Element, nbLayers)
+	d.twiddlesInv = make([][]mersenne31.Element, nbLayers)
+
+	// first layer: y-coordinates of Q + i·g
+	xs := make([]mersenne31.Element, n/2)
+	d.twiddles[0] = make([]mersenne31.Element, n/2)
+	p := d.Shift
+	for i := range xs {
+		xs[i] = p.X
+		d.twiddles[0][i] = p.Y
+		p.Add(&p, &d.Generator)
+	}
+
+	// layer t: x-coordinates of 2ᵗ⁻¹·(Q + j·g) for j < n/2ᵗ⁺¹
+	for t := 1; t < nbLayers; t++ {
+		m := n >> (t + 1)
+		d.twiddles[t] = make([]mersenne31.Element, m)
+		copy(d.twiddles[t], xs[:m])
+		for j := uint64(0); j < m/2; j++ {
+			xs[j] = projectX(&xs[j])
+		}
+	}
+
+	for t := range d.twiddles {
+		d.twiddlesInv[t] = mersenne31.BatchInvert(d.twiddles[t])
+	}
+}
+
+// WriteTo writes a binary representation of the domain (without the precomputed twiddle factors)
+// to the provided writer
+func (d *Domain) WriteTo(w io.Writer) (int64, error) {
+	var written int64
+
+	if err := binary.Write(w, binary.BigEndian, d.Cardinality); err != nil {
+		return written, err
+	}
+	written += 8
+
+	for _, v := range []*mersenne31.Element{&d.Shift.X, &d.Shift.Y} {
+		buf := v.Bytes()
+		n, err := w.Write(buf[:])
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// ReadFrom attempts to decode a domain from Reader
+func (d *Domain) ReadFrom(r io.Reader) (int64, error) {
+	var read int64
+
+	var cardinality uint64
+	if err := binary.Read(r, binary.BigEndian, &cardinality); err != nil {
+		return read, err
+	}
+	read += 8
+
+	var shift Point
+	for _, v := range []*mersenne31.Element{&shift.X, &shift.Y} {
+		var buf [mersenne31.Bytes]byte
+		n, err := io.ReadFull(r, buf[:])
+		read += int64(n)
+		if err != nil {
+			return read, err
+		}
+		if *v, err = mersenne31.BigEndian.Element(&buf); err != nil {
+			return read, err
+		}
+	}
+
+	if cardinality < 2 || cardinality > 1<<(LogOrder-1) || bits.OnesCount64(cardinality) != 1 {
+		return read, ErrDomainSize
+	}
+	d.Cardinality = cardinality
+	d.Shift = shift
+	if err := d.checkShift(); err != nil {
+		return read, err
+	}
+	d.CardinalityInv.SetUint64(cardinality).Inverse(&d.CardinalityInv)
+	var err error
+	if d.Generator, err = Generator(uint64(bits.TrailingZeros64(cardinality)) - 1); err != nil {
+		return read, err
+	}
+	d.preComputeTwiddles()
+
+	return read, nil
+}
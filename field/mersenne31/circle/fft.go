@@ -0,0 +1,139 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package circle
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// below this number of butterflies per layer, the FFT runs on a single go routine
+const minParallelButterflies = 1 << 11
+
+// FFT replaces the coefficients a, in the FFT basis, of a polynomial by its
+// evaluations on the domain, in the order of At.
+// len(a) must be the cardinality of the domain.
+func (d *Domain) FFT(a []mersenne31.Element, opts ...Option) {
+	if uint64(len(a)) != d.Cardinality {
+		panic("circle.FFT: len(a) must be the cardinality of the domain")
+	}
+	opt := fftOptions(opts...)
+
+	// the coefficients are processed from the last layer, in bit-reversed order
+	BitReverse(a)
+	for t := len(d.twiddles) - 1; t >= 0; t-- {
+		layer(a, d.twiddles[t], opt.nbTasks, ditButterfly)
+	}
+}
+
+// FFTInverse replaces the evaluations a of a polynomial on the domain, in the
+// order of At, by its coefficients in the FFT basis.
+// len(a) must be the cardinality of the domain.
+func (d *Domain) FFTInverse(a []mersenne31.Element, opts ...Option) {
+	if uint64(len(a)) != d.Cardinality {
+		panic("circle.FFTInverse: len(a) must be the cardinality of the domain")
+	}
+	opt := fftOptions(opts...)
+
+	for t := range d.twiddlesInv {
+		layer(a, d.twiddlesInv[t], opt.nbTasks, difButterfly)
+	}
+	// each layer doubles the coefficients
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			a[i].Mul(&a[i], &d.CardinalityInv)
+		}
+	}, nbTasks(len(a), opt.nbTasks))
+	BitReverse(a)
+}
+
+// Evaluate returns the value at p of the polynomial of coefficients a in the
+// FFT basis of the domain.
+// len(a) must be the cardinality of the domain.
+func (d *Domain) Evaluate(a []mersenne31.Element, p *Point) mersenne31.Element {
+	if uint64(len(a)) != d.Cardinality {
+		panic("circle.Evaluate: len(a) must be the cardinality of the domain")
+	}
+	nbLayers := len(d.twiddles)
+
+	// x, π(x), π²(x)...
+	xs := make([]mersenne31.Element, nbLayers)
+	if nbLayers > 1 {
+		xs[1] = p.X
+		for t := 2; t < nbLayers; t++ {
+			xs[t] = projectX(&xs[t-1])
+		}
+	}
+
+	// fold the coefficients from the highest bit of the index
+	c := make([]mersenne31.Element, len(a))
+	copy(c, a)
+	var tmp mersenne31.Element
+	for t := nbLayers - 1; t >= 1; t-- {
+		half := 1 << t
+		for j := 0; j < half; j++ {
+			tmp.Mul(&c[j+half], &xs[t])
+			c[j].Add(&c[j], &tmp)
+		}
+	}
+	tmp.Mul(&c[1], &p.Y)
+	return *tmp.Add(&tmp, &c[0])
+}
+
+// difButterfly sets (u, v) = (u + v, (u - v)·w)
+func difButterfly(u, v, w *mersenne31.Element) {
+	var t mersenne31.Element
+	t.Sub(u, v)
+	u.Add(u, v)
+	v.Mul(&t, w)
+}
+
+// ditButterfly sets (u, v) = (u + v·w, u - v·w)
+func ditButterfly(u, v, w *mersenne31.Element) {
+	var t mersenne31.Element
+	t.Mul(v, w)
+	v.Sub(u, &t)
+	u.Add(u, &t)
+}
+
+// layer applies the butterflies (a[i], a[i + m/2]) with twiddles[i] to the
+// consecutive blocks of size m = 2·len(twiddles) of a
+func layer(a, twiddles []mersenne31.Element, maxTasks int, butterfly func(u, v, w *mersenne31.Element)) {
+	half := len(twiddles)
+	logHalf := bits.TrailingZeros(uint(half))
+	nbButterflies := len(a) / 2
+	parallel.Execute(nbButterflies, func(start, end int) {
+		for k := start; k < end; k++ {
+			j := k & (half - 1)
+			i := (k>>logHalf)*2*half + j
+			butterfly(&a[i], &a[i+half], &twiddles[j])
+		}
+	}, nbTasks(nbButterflies, maxTasks))
+}
+
+func nbTasks(nbIterations, maxTasks int) int {
+	if nbIterations < minParallelButterflies {
+		return 1
+	}
+	return maxTasks
+}
+
+// BitReverse applies the bit-reversal permutation to v.
+// len(v) must be a power of 2
+func BitReverse(v []mersenne31.Element) {
+	n := uint64(len(v))
+	if bits.OnesCount64(n) != 1 {
+		panic("len(a) must be a power of 2")
+	}
+	nn := uint64(64 - bits.TrailingZeros64(n))
+
+	for i := uint64(0); i < n; i++ {
+		iRev := bits.Reverse64(i) >> nn
+		if iRev > i {
+			v[i], v[iRev] = v[iRev], v[i]
+		}
+	}
+}
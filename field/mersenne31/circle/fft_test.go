@@ -0,0 +1,163 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package circle
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+	"github.com/stretchr/testify/require"
+)
+
+func randomVector(n int) []mersenne31.Element {
+	res := make([]mersenne31.Element, n)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+func TestFFT(t *testing.T) {
+	for _, logN := range []uint64{1, 2, 3, 6, 13} {
+		n := uint64(1) << logN
+		shift, err := Generator(logN + 3)
+		require.NoError(t, err)
+		for name, domain := range map[string]*Domain{
+			"canonic": NewDomain(n),
+			"shifted": NewDomain(n, WithShift(shift)),
+		} {
+			t.Run(fmt.Sprintf("%s/n=%d", name, n), func(t *testing.T) {
+				assert := require.New(t)
+
+				// the points form a twin-coset
+				points := make(map[Point]bool)
+				for i := uint64(0); i < n; i++ {
+					p := domain.At(i)
+					assert.True(p.IsOnCircle())
+					points[p] = true
+				}
+				assert.Len(points, int(n))
+
+				coefficients := randomVector(int(n))
+				evaluations := make([]mersenne31.Element, n)
+				copy(evaluations, coefficients)
+				domain.FFT(evaluations)
+				for i := uint64(0); i < n; i += 1 + n/16 {
+					p := domain.At(i)
+					assert.Equal(domain.Evaluate(coefficients, &p), evaluations[i], "point %d", i)
+				}
+
+				domain.FFTInverse(evaluations, WithNbTasks(2))
+				assert.Equal(coefficients, evaluations)
+			})
+		}
+	}
+}
+
+func TestFFTBasis(t *testing.T) {
+	assert := require.New(t)
+
+	const n = 16
+	domain := NewDomain(n)
+
+	// b₁ = y and b₂ = x
+	for j, coordinate := range []func(p *Point) mersenne31.Element{
+		func(p *Point) mersenne31.Element { return p.Y },
+		func(p *Point) mersenne31.Element { return p.X },
+	} {
+		a := make([]mersenne31.Element, n)
+		a[1<<j].SetOne()
+		domain.FFT(a)
+		for i := uint64(0); i < n; i++ {
+			p := domain.At(i)
+			assert.Equal(coordinate(&p), a[i])
+		}
+	}
+}
+
+func TestLowDegreeExtension(t *testing.T) {
+	assert := require.New(t)
+
+	// the FFT basis of a domain is the beginning of the one of a larger
+	// domain: the evaluations on the larger domain of the polynomial
+	// interpolating values on the smaller one are obtained by padding
+	const n, blowup = 32, 4
+	small, large := NewDomain(n), NewDomain(n*blowup)
+
+	values := randomVector(n)
+	coefficients := make([]mersenne31.Element, n*blowup)
+	copy(coefficients, values)
+	small.FFTInverse(coefficients[:n])
+	large.FFT(coefficients)
+
+	// the points of the small domain are in the large one
+	interpolated := make([]mersenne31.Element, n)
+	copy(interpolated, values)
+	small.FFTInverse(interpolated)
+	for i := uint64(0); i < n*blowup; i++ {
+		p := large.At(i)
+		assert.Equal(small.Evaluate(interpolated, &p), coefficients[i])
+	}
+}
+
+func TestDomainShift(t *testing.T) {
+	assert := require.New(t)
+
+	// the shift must have order larger than the cardinality
+	shift, err := Generator(4)
+	assert.NoError(err)
+	assert.PanicsWithValue(ErrTwinCoset, func() { NewDomain(16, WithShift(shift)) })
+	assert.PanicsWithValue(ErrTwinCoset, func() { NewDomain(16, WithShift(Point{})) })
+	assert.PanicsWithValue(ErrDomainSize, func() { NewDomain(1 << 31) })
+
+	// the default shift is a generator of the subgroup of order 2n
+	d := NewDomain(5)
+	assert.Equal(uint64(8), d.Cardinality)
+	shift, err = Generator(4)
+	assert.NoError(err)
+	assert.Equal(shift, d.Shift)
+}
+
+func TestDomainSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	shift, err := Generator(12)
+	assert.NoError(err)
+	domain := NewDomain(1<<8, WithShift(shift))
+
+	var buf bytes.Buffer
+	written, err := domain.WriteTo(&buf)
+	assert.NoError(err)
+	encoded := append([]byte{}, buf.Bytes()...)
+
+	var reconstructed Domain
+	read, err := reconstructed.ReadFrom(&buf)
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(domain, &reconstructed)
+
+	// shift not on the circle
+	encoded[len(encoded)-1] ^= 1
+	_, err = new(Domain).ReadFrom(bytes.NewReader(encoded))
+	assert.ErrorIs(err, ErrTwinCoset)
+}
+
+func BenchmarkFFT(b *testing.B) {
+	const n = 1 << 18
+	domain := NewDomain(n)
+	a := randomVector(n)
+
+	b.Run("FFT", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			domain.FFT(a)
+		}
+	})
+	b.Run("FFTInverse", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			domain.FFTInverse(a)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package circle
+
+import (
+	"runtime"
+)
+
+// Option defines option for altering the behavior of FFT methods.
+// See the descriptions of functions returning instances of this type for
+// particular options.
+type Option func(*fftConfig)
+
+type fftConfig struct {
+	nbTasks int
+}
+
+// WithNbTasks sets the max number of task (go routine) to spawn. Must be between 1 and 512.
+func WithNbTasks(nbTasks int) Option {
+	if nbTasks < 1 {
+		nbTasks = 1
+	} else if nbTasks > 512 {
+		nbTasks = 512
+	}
+	return func(opt *fftConfig) {
+		opt.nbTasks = nbTasks
+	}
+}
+
+// default options
+func fftOptions(opts ...Option) fftConfig {
+	// apply options
+	opt := fftConfig{
+		nbTasks: runtime.NumCPU(),
+	}
+	for _, option := range opts {
+		option(&opt)
+	}
+	return opt
+}
+
+// DomainOption defines option for altering the definition of the FFT domain
+// See the descriptions of functions returning instances of this type for
+// particular options.
+type DomainOption func(*domainConfig)
+
+type domainConfig struct {
+	shift *Point
+}
+
+// WithShift sets the point Q of the twin-coset (Q + G) ∪ -(Q + G). Its order
+// must be larger than the cardinality of the domain.
+// Default is a generator of the subgroup of order twice the cardinality.
+func WithShift(shift Point) DomainOption {
+	return func(opt *domainConfig) {
+		opt.shift = &shift
+	}
+}
+
+// default options
+func domainOptions(opts ...DomainOption) domainConfig {
+	var opt domainConfig
+	for _, option := range opts {
+		option(&opt)
+	}
+	return opt
+}
@@ -0,0 +1,121 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package circle
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+// LogOrder log₂ of the order of the circle group
+const LogOrder = 31
+
+// ErrSubgroupOrder is returned when requesting a subgroup of order larger than
+// the circle group
+var ErrSubgroupOrder = errors.New("the order of the subgroup must be at most 2³¹")
+
+// Point of the circle x² + y² = 1 over mersenne31. The group law, written
+// additively, is (x₀, y₀) + (x₁, y₁) = (x₀x₁ - y₀y₁, x₀y₁ + y₀x₁), with
+// identity (1, 0) and -(x, y) = (x, -y).
+type Point struct {
+	X, Y mersenne31.Element
+}
+
+// generator of the circle group, of order 2³¹
+var generator = Point{X: mersenne31.NewElement(2), Y: mersenne31.NewElement(1268011823)}
+
+// Identity returns the identity (1, 0) of the circle group
+func Identity() Point {
+	return Point{X: mersenne31.One()}
+}
+
+// Generator returns a generator of the subgroup of order 2^logOrder
+func Generator(logOrder uint64) (Point, error) {
+	if logOrder > LogOrder {
+		return Point{}, ErrSubgroupOrder
+	}
+	res := generator
+	for i := logOrder; i < LogOrder; i++ {
+		res.Double(&res)
+	}
+	return res, nil
+}
+
+// Equal returns true if p equals a, false otherwise
+func (p *Point) Equal(a *Point) bool {
+	return p.X.Equal(&a.X) && p.Y.Equal(&a.Y)
+}
+
+// IsOnCircle returns true if x² + y² = 1
+func (p *Point) IsOnCircle() bool {
+	var a, b mersenne31.Element
+	a.Square(&p.X)
+	b.Square(&p.Y)
+	return a.Add(&a, &b).IsOne()
+}
+
+// Add sets p = a + b and returns p
+func (p *Point) Add(a, b *Point) *Point {
+	var x, y, t mersenne31.Element
+	x.Mul(&a.X, &b.X)
+	t.Mul(&a.Y, &b.Y)
+	x.Sub(&x, &t)
+	y.Mul(&a.X, &b.Y)
+	t.Mul(&a.Y, &b.X)
+	y.Add(&y, &t)
+	p.X, p.Y = x, y
+	return p
+}
+
+// Sub sets p = a - b and returns p
+func (p *Point) Sub(a, b *Point) *Point {
+	var c Point
+	c.Neg(b)
+	return p.Add(a, &c)
+}
+
+// Double sets p = 2a and returns p
+func (p *Point) Double(a *Point) *Point {
+	// (2x² - 1, 2xy)
+	x := projectX(&a.X)
+	p.Y.Mul(&a.X, &a.Y).Double(&p.Y)
+	p.X = x
+	return p
+}
+
+// Neg sets p = -a = (x, -y), the conjugate of a, and returns p
+func (p *Point) Neg(a *Point) *Point {
+	p.X = a.X
+	p.Y.Neg(&a.Y)
+	return p
+}
+
+// ScalarMul sets p = k·a and returns p
+func (p *Point) ScalarMul(a *Point, k uint64) *Point {
+	res := Identity()
+	base := *a
+	for ; k != 0; k >>= 1 {
+		if k&1 == 1 {
+			res.Add(&res, &base)
+		}
+		base.Double(&base)
+	}
+	*p = res
+	return p
+}
+
+// String returns p as (X, Y)
+func (p *Point) String() string {
+	return "(" + p.X.String() + ", " + p.Y.String() + ")"
+}
+
+// projectX returns π(x) = 2x² - 1, the x-coordinate of the double of a point
+// of x-coordinate x
+func projectX(x *mersenne31.Element) mersenne31.Element {
+	var res mersenne31.Element
+	res.Square(x).Double(&res)
+	one := mersenne31.One()
+	return *res.Sub(&res, &one)
+}
@@ -0,0 +1,55 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package circle
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPointGroupLaw(t *testing.T) {
+	assert := require.New(t)
+
+	identity := Identity()
+	assert.True(generator.IsOnCircle())
+
+	// the generator has order 2³¹
+	var p Point
+	p.ScalarMul(&generator, 1<<30)
+	assert.True(p.Equal(&Point{X: *new(mersenne31.Element).SetInt64(-1)}))
+	p.ScalarMul(&generator, 1<<31)
+	assert.True(p.Equal(&identity))
+
+	a, _ := Generator(10)
+	b, _ := Generator(20)
+	c, _ := Generator(31)
+	b.Add(&b, &c)
+	c.Double(&c).Add(&c, &a)
+
+	var l, r Point
+	// (a + b) + c = a + (b + c)
+	l.Add(&a, &b).Add(&l, &c)
+	r.Add(&b, &c).Add(&a, &r)
+	assert.True(l.Equal(&r))
+	assert.True(l.IsOnCircle())
+
+	// a + a = 2a
+	l.Add(&a, &a)
+	r.Double(&a)
+	assert.True(l.Equal(&r))
+
+	// a - a = 0
+	l.Sub(&a, &a)
+	assert.True(l.Equal(&identity))
+
+	// 3a = a + a + a
+	l.ScalarMul(&a, 3)
+	r.Add(&a, &a).Add(&r, &a)
+	assert.True(l.Equal(&r))
+
+	_, err := Generator(32)
+	assert.ErrorIs(err, ErrSubgroupOrder)
+}
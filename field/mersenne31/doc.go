@@ -0,0 +1,39 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package mersenne31 contains field arithmetic operations for modulus = 0x7fffffff = 2³¹ - 1.
+//
+// The multiplicative group of the field has no large 2-adic subgroup, but the
+// circle group x² + y² = 1 has order q + 1 = 2³¹: the package circle provides
+// the Circle FFT, and the package extensions the extensions CM31 and QM31 used
+// by Circle STARKs.
+//
+// Field elements are represented as an array, in canonical form (not Montgomery):
+//
+//	type Element [1]uint32
+//
+// # Usage
+//
+// Example API signature:
+//
+//	// Mul z = x * y (mod q)
+//	func (z *Element) Mul(x, y *Element) *Element
+//
+// and can be used like so:
+//
+//	var a, b Element
+//	a.SetUint64(2)
+//	b.SetString("984896738")
+//	a.Mul(&a, &b)
+//	a.Sub(&a, &a).Add(&a, &b).Inverse(&a)
+//	b.Exp(b, new(big.Int).SetUint64(42))
+//
+// Modulus q =
+//
+//	q[base10] = 2147483647
+//	q[base16] = 0x7fffffff
+//
+// # Warning
+//
+// There is no security guarantees such as constant time implementation or side-channel attack resistance.
+package mersenne31
@@ -0,0 +1,481 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package mersenne31
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"strconv"
+)
+
+const (
+	Limbs = 1  // number of 32 bits words needed to represent a Element
+	Bits  = 31 // number of bits needed to represent a Element
+	Bytes = 4  // number of bytes needed to represent a Element
+)
+
+// q modulus 2³¹ - 1
+const q uint32 = (1 << 31) - 1
+
+// Element represents a field element of 𝔽_q, q = 2³¹ - 1.
+//
+// Unlike the other small fields, elements are not in Montgomery form: the
+// modulus being a Mersenne prime, the reduction of a product x = x₁·2³¹ + x₀
+// is x₁ + x₀ mod q. The value of an Element is always in [0, q).
+type Element [1]uint32
+
+var _modulus big.Int // q stored as big.Int
+
+// Modulus returns q as a big.Int
+//
+//	q[base10] = 2147483647
+//	q[base16] = 0x7fffffff
+func Modulus() *big.Int {
+	return new(big.Int).Set(&_modulus)
+}
+
+func init() {
+	_modulus.SetUint64(uint64(q))
+}
+
+// reduce returns x mod q, using 2³¹ ≡ 1 mod q
+func reduce(x uint64) uint32 {
+	r := (x & uint64(q)) + (x >> 31) // < 2³⁴
+	r = (r & uint64(q)) + (r >> 31)  // < 2³¹ + 8
+	if r >= uint64(q) {
+		r -= uint64(q)
+	}
+	return uint32(r)
+}
+
+// NewElement returns a new Element from a uint64 value
+func NewElement(v uint64) Element {
+	var z Element
+	z.SetUint64(v)
+	return z
+}
+
+// SetUint64 sets z to v and returns z
+func (z *Element) SetUint64(v uint64) *Element {
+	z[0] = reduce(v)
+	return z
+}
+
+// SetInt64 sets z to v and returns z
+func (z *Element) SetInt64(v int64) *Element {
+	if v >= 0 {
+		return z.SetUint64(uint64(v))
+	}
+	z.SetUint64(uint64(-(v + 1)) + 1)
+	return z.Neg(z)
+}
+
+// Set z = x and returns z
+func (z *Element) Set(x *Element) *Element {
+	z[0] = x[0]
+	return z
+}
+
+// SetZero z = 0
+func (z *Element) SetZero() *Element {
+	z[0] = 0
+	return z
+}
+
+// SetOne z = 1
+func (z *Element) SetOne() *Element {
+	z[0] = 1
+	return z
+}
+
+// One returns 1
+func One() Element {
+	return Element{1}
+}
+
+// Equal returns z == x
+func (z *Element) Equal(x *Element) bool {
+	return z[0] == x[0]
+}
+
+// IsZero returns z == 0
+func (z *Element) IsZero() bool {
+	return z[0] == 0
+}
+
+// IsOne returns z == 1
+func (z *Element) IsOne() bool {
+	return z[0] == 1
+}
+
+// Uint64 returns the value of z as a uint64
+func (z *Element) Uint64() uint64 {
+	return uint64(z[0])
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *Element) Cmp(x *Element) int {
+	switch {
+	case z[0] < x[0]:
+		return -1
+	case z[0] > x[0]:
+		return 1
+	}
+	return 0
+}
+
+// LexicographicallyLargest returns true if this element is strictly lexicographically
+// larger than its negation, false otherwise
+func (z *Element) LexicographicallyLargest() bool {
+	return z[0] > (q-1)/2
+}
+
+// SetRandom sets z to a uniform random value in [0, q).
+//
+// This might error only if reading from crypto/rand.Reader errors,
+// in which case, value of z is undefined.
+func (z *Element) SetRandom() (*Element, error) {
+	var b [4]byte
+	for {
+		if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+			return nil, err
+		}
+		z[0] = binary.LittleEndian.Uint32(b[:]) & q
+		if z[0] != q {
+			return z, nil
+		}
+	}
+}
+
+// Add z = x + y (mod q)
+func (z *Element) Add(x, y *Element) *Element {
+	t := x[0] + y[0]
+	if t >= q {
+		t -= q
+	}
+	z[0] = t
+	return z
+}
+
+// Double z = x + x (mod q)
+func (z *Element) Double(x *Element) *Element {
+	return z.Add(x, x)
+}
+
+// Sub z = x - y (mod q)
+func (z *Element) Sub(x, y *Element) *Element {
+	t := x[0] - y[0]
+	if x[0] < y[0] {
+		t += q
+	}
+	z[0] = t
+	return z
+}
+
+// Neg z = -x (mod q)
+func (z *Element) Neg(x *Element) *Element {
+	if x[0] == 0 {
+		z[0] = 0
+	} else {
+		z[0] = q - x[0]
+	}
+	return z
+}
+
+// Mul z = x * y (mod q)
+func (z *Element) Mul(x, y *Element) *Element {
+	z[0] = reduce(uint64(x[0]) * uint64(y[0]))
+	return z
+}
+
+// Square z = x * x (mod q)
+func (z *Element) Square(x *Element) *Element {
+	return z.Mul(x, x)
+}
+
+// Halve sets z to z / 2 (mod q)
+func (z *Element) Halve() {
+	if z[0]&1 == 1 {
+		// (z + q) / 2 < 2³¹
+		z[0] = (z[0] >> 1) + (1 << 30)
+	} else {
+		z[0] >>= 1
+	}
+}
+
+// Div z = x*y⁻¹ (mod q)
+func (z *Element) Div(x, y *Element) *Element {
+	var yInv Element
+	yInv.Inverse(y)
+	return z.Mul(x, &yInv)
+}
+
+// nSquare sets z = z^(2ⁿ)
+func (z *Element) nSquare(n int) *Element {
+	for i := 0; i < n; i++ {
+		z.Square(z)
+	}
+	return z
+}
+
+// expPow2Minus1 returns x^(2²⁹ - 1) and x^(2⁴ - 1)
+func expPow2Minus1(x *Element) (a29, a4 Element) {
+	var a2, a8, a16, a24, a28 Element
+	a2.Square(x).Mul(&a2, x)
+	a4.Set(&a2).nSquare(2).Mul(&a4, &a2)
+	a8.Set(&a4).nSquare(4).Mul(&a8, &a4)
+	a16.Set(&a8).nSquare(8).Mul(&a16, &a8)
+	a24.Set(&a16).nSquare(8).Mul(&a24, &a8)
+	a28.Set(&a24).nSquare(4).Mul(&a28, &a4)
+	a29.Square(&a28).Mul(&a29, x)
+	return
+}
+
+// Inverse z = x⁻¹ (mod q)
+//
+// if x == 0, sets and returns z = x
+func (z *Element) Inverse(x *Element) *Element {
+	// x^(q-2) with q - 2 = (2²⁹ - 1)·4 + 1
+	a29, _ := expPow2Minus1(x)
+	a29.nSquare(2).Mul(&a29, x)
+	z.Set(&a29)
+	return z
+}
+
+// Exp z = xᵏ (mod q)
+func (z *Element) Exp(x Element, k *big.Int) *Element {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ (mod q) == (x⁻¹)ᵏ (mod q)
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = new(big.Int).Neg(k)
+	}
+
+	z.Set(&x)
+
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+
+	return z
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+func (z *Element) Legendre() int {
+	// z^((q-1)/2) with (q - 1)/2 = 2³⁰ - 1
+	l, _ := expPow2Minus1(z)
+	l.Square(&l).Mul(&l, z)
+
+	if l.IsZero() {
+		return 0
+	}
+	if l.IsOne() {
+		return 1
+	}
+	return -1
+}
+
+// Sqrt z = √x (mod q)
+// if the square root doesn't exist (x is not a square mod q)
+// Sqrt leaves z unchanged and returns nil
+func (z *Element) Sqrt(x *Element) *Element {
+	// q ≡ 3 (mod 4), √x = x^((q+1)/4) = x^(2²⁹)
+	var y, square Element
+	y.Set(x).nSquare(29)
+	square.Square(&y)
+	if !square.Equal(x) {
+		return nil
+	}
+	return z.Set(&y)
+}
+
+// BatchInvert returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+func BatchInvert(a []Element) []Element {
+	res := make([]Element, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	accumulator := One()
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			continue
+		}
+		res[i] = accumulator
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if a[i].IsZero() {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// String returns the decimal representation of z
+func (z *Element) String() string {
+	return z.Text(10)
+}
+
+// Text returns the string representation of z in the given base.
+// Base must be between 2 and 36, inclusive.
+func (z *Element) Text(base int) string {
+	if base < 2 || base > 36 {
+		panic("invalid base")
+	}
+	return strconv.FormatUint(uint64(z[0]), base)
+}
+
+// BigInt sets and return z as a *big.Int
+func (z *Element) BigInt(res *big.Int) *big.Int {
+	return res.SetUint64(uint64(z[0]))
+}
+
+// SetBigInt sets z to v (mod q) and returns z
+func (z *Element) SetBigInt(v *big.Int) *Element {
+	var r big.Int
+	r.Mod(v, &_modulus)
+	z[0] = uint32(r.Uint64())
+	return z
+}
+
+// SetString creates a big.Int with number and calls SetBigInt on z
+//
+// The number prefix determines the actual base: A prefix of
+// ”0b” or ”0B” selects base 2, ”0”, ”0o” or ”0O” selects base 8,
+// and ”0x” or ”0X” selects base 16. Otherwise, the selected base is 10
+// and no prefix is accepted.
+//
+// If the number is invalid this method leaves z unchanged and returns nil, error.
+func (z *Element) SetString(number string) (*Element, error) {
+	var v big.Int
+	if _, ok := v.SetString(number, 0); !ok {
+		return nil, errors.New("Element.SetString failed -> can't parse number into a big.Int " + number)
+	}
+	return z.SetBigInt(&v), nil
+}
+
+// Bytes returns the value of z as a big-endian byte array
+func (z *Element) Bytes() (res [Bytes]byte) {
+	BigEndian.PutElement(&res, *z)
+	return
+}
+
+// Marshal returns the value of z as a big-endian byte slice
+func (z *Element) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// Unmarshal is an alias for SetBytes, it sets z to the value of e.
+func (z *Element) Unmarshal(e []byte) {
+	z.SetBytes(e)
+}
+
+// SetBytes interprets e as the bytes of a big-endian unsigned integer,
+// sets z to that value (mod q), and returns z.
+func (z *Element) SetBytes(e []byte) *Element {
+	if len(e) == Bytes {
+		// fast path
+		v, err := BigEndian.Element((*[Bytes]byte)(e))
+		if err == nil {
+			*z = v
+			return z
+		}
+	}
+	var v big.Int
+	v.SetBytes(e)
+	return z.SetBigInt(&v)
+}
+
+// SetBytesCanonical interprets e as the bytes of a big-endian 4-byte integer.
+// If e is not a 4-byte slice or encodes a value higher than q,
+// SetBytesCanonical returns an error.
+func (z *Element) SetBytesCanonical(e []byte) error {
+	if len(e) != Bytes {
+		return errors.New("invalid mersenne31.Element encoding")
+	}
+	v, err := BigEndian.Element((*[Bytes]byte)(e))
+	if err != nil {
+		return err
+	}
+	*z = v
+	return nil
+}
+
+// A ByteOrder specifies how to convert byte slices into a Element
+type ByteOrder interface {
+	Element(*[Bytes]byte) (Element, error)
+	PutElement(*[Bytes]byte, Element)
+	String() string
+}
+
+var errInvalidEncoding = errors.New("invalid mersenne31.Element encoding")
+
+// BigEndian is the big-endian implementation of ByteOrder and AppendByteOrder.
+var BigEndian bigEndian
+
+type bigEndian struct{}
+
+// Element interpret b is a big-endian 4-byte slice.
+// If b encodes a value higher than q, Element returns error.
+func (bigEndian) Element(b *[Bytes]byte) (Element, error) {
+	var z Element
+	z[0] = binary.BigEndian.Uint32((*b)[0:4])
+	if z[0] >= q {
+		return Element{}, errInvalidEncoding
+	}
+	return z, nil
+}
+
+func (bigEndian) PutElement(b *[Bytes]byte, e Element) {
+	binary.BigEndian.PutUint32((*b)[0:4], e[0])
+}
+
+func (bigEndian) String() string { return "BigEndian" }
+
+// LittleEndian is the little-endian implementation of ByteOrder and AppendByteOrder.
+var LittleEndian littleEndian
+
+type littleEndian struct{}
+
+func (littleEndian) Element(b *[Bytes]byte) (Element, error) {
+	var z Element
+	z[0] = binary.LittleEndian.Uint32((*b)[0:4])
+	if z[0] >= q {
+		return Element{}, errInvalidEncoding
+	}
+	return z, nil
+}
+
+func (littleEndian) PutElement(b *[Bytes]byte, e Element) {
+	binary.LittleEndian.PutUint32((*b)[0:4], e[0])
+}
+
+func (littleEndian) String() string { return "LittleEndian" }
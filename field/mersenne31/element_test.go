@@ -0,0 +1,285 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package mersenne31
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	nbFuzzShort = 200
+	nbFuzz      = 1000
+)
+
+// special values: 0, 1, 2, q - 2, q - 1 and 2³⁰
+var staticTestValues = []Element{{0}, {1}, {2}, {q - 2}, {q - 1}, {1 << 30}}
+
+type testPairElement struct {
+	element Element
+	bigint  big.Int
+}
+
+func gen() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var g testPairElement
+		g.element[0] = uint32(genParams.NextUint64() % uint64(q))
+		g.element.BigInt(&g.bigint)
+		return gopter.NewGenResult(g, gopter.NoShrinker)
+	}
+}
+
+func testParameters() *gopter.TestParameters {
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+	return parameters
+}
+
+func TestElementBinaryOperations(t *testing.T) {
+	t.Parallel()
+
+	for _, op := range []struct {
+		name    string
+		element func(z, x, y *Element) *Element
+		bigint  func(z, x, y *big.Int) *big.Int
+	}{
+		{"Add", (*Element).Add, (*big.Int).Add},
+		{"Sub", (*Element).Sub, (*big.Int).Sub},
+		{"Mul", (*Element).Mul, (*big.Int).Mul},
+		{"Div", (*Element).Div, func(z, x, y *big.Int) *big.Int {
+			var yInv big.Int
+			if yInv.ModInverse(y, Modulus()) == nil {
+				return z.SetUint64(0)
+			}
+			return z.Mul(x, &yInv)
+		}},
+	} {
+		check := func(a, b *Element) bool {
+			var c Element
+			var aBig, bBig, d, e big.Int
+			a.BigInt(&aBig)
+			b.BigInt(&bBig)
+			op.element(&c, a, b)
+			op.bigint(&d, &aBig, &bBig).Mod(&d, Modulus())
+			return c.BigInt(&e).Cmp(&d) == 0 && c[0] < q
+		}
+
+		properties := gopter.NewProperties(testParameters())
+		properties.Property(op.name+": operation result must match big.Int result", prop.ForAll(
+			func(a, b testPairElement) bool {
+				if !check(&a.element, &b.element) {
+					return false
+				}
+				for i := range staticTestValues {
+					if !check(&a.element, &staticTestValues[i]) || !check(&staticTestValues[i], &a.element) {
+						return false
+					}
+				}
+				return true
+			},
+			gen(),
+			gen(),
+		))
+		properties.Property(op.name+": having the receiver as operand should output the same result", prop.ForAll(
+			func(a, b testPairElement) bool {
+				var c, d Element
+				op.element(&c, &a.element, &b.element)
+				d.Set(&a.element)
+				op.element(&d, &d, &b.element)
+				op.element(&b.element, &a.element, &b.element)
+				return c.Equal(&d) && c.Equal(&b.element)
+			},
+			gen(),
+			gen(),
+		))
+		properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+		for i := range staticTestValues {
+			for j := range staticTestValues {
+				if !check(&staticTestValues[i], &staticTestValues[j]) {
+					t.Fatalf("%s failed special test values", op.name)
+				}
+			}
+		}
+	}
+}
+
+func TestElementUnaryOperations(t *testing.T) {
+	t.Parallel()
+
+	exp := new(big.Int).SetUint64(123456789123)
+	for _, op := range []struct {
+		name    string
+		element func(z, x *Element) *Element
+		bigint  func(z, x *big.Int) *big.Int
+	}{
+		{"Neg", (*Element).Neg, (*big.Int).Neg},
+		{"Double", (*Element).Double, func(z, x *big.Int) *big.Int { return z.Lsh(x, 1) }},
+		{"Square", (*Element).Square, func(z, x *big.Int) *big.Int { return z.Mul(x, x) }},
+		{"Inverse", (*Element).Inverse, func(z, x *big.Int) *big.Int {
+			if z.ModInverse(x, Modulus()) == nil {
+				return z.SetUint64(0)
+			}
+			return z
+		}},
+		{"Halve", func(z, x *Element) *Element {
+			z.Set(x).Halve()
+			return z
+		}, func(z, x *big.Int) *big.Int {
+			return z.Mul(x, new(big.Int).ModInverse(big.NewInt(2), Modulus()))
+		}},
+		{"Exp", func(z, x *Element) *Element {
+			return z.Exp(*x, exp)
+		}, func(z, x *big.Int) *big.Int {
+			return z.Exp(x, exp, Modulus())
+		}},
+	} {
+		check := func(a *Element) bool {
+			var c Element
+			var aBig, d, e big.Int
+			a.BigInt(&aBig)
+			op.element(&c, a)
+			op.bigint(&d, &aBig).Mod(&d, Modulus())
+			return c.BigInt(&e).Cmp(&d) == 0 && c[0] < q
+		}
+
+		properties := gopter.NewProperties(testParameters())
+		properties.Property(op.name+": operation result must match big.Int result", prop.ForAll(
+			func(a testPairElement) bool {
+				return check(&a.element)
+			},
+			gen(),
+		))
+		properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+		for i := range staticTestValues {
+			if !check(&staticTestValues[i]) {
+				t.Fatalf("%s failed special test values", op.name)
+			}
+		}
+	}
+}
+
+func TestElementSqrtLegendre(t *testing.T) {
+	t.Parallel()
+
+	properties := gopter.NewProperties(testParameters())
+	properties.Property("Sqrt: must match big.Int result", prop.ForAll(
+		func(a testPairElement) bool {
+			var c Element
+			var d, e big.Int
+			l := a.element.Legendre()
+			if l != big.Jacobi(&a.bigint, Modulus()) {
+				return false
+			}
+			if c.Sqrt(&a.element) == nil {
+				return l == -1
+			}
+			if d.ModSqrt(&a.bigint, Modulus()) == nil {
+				return false
+			}
+			c.BigInt(&e)
+			return e.Cmp(&d) == 0 || e.Add(&e, &d).Cmp(Modulus()) == 0
+		},
+		gen(),
+	))
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestElementReduce(t *testing.T) {
+	assert := require.New(t)
+
+	for _, v := range []uint64{0, 1, uint64(q), uint64(q) + 1, 1 << 62, 1<<64 - 1, uint64(q) * uint64(q), (uint64(q) - 1) * (uint64(q) - 1)} {
+		var e, d big.Int
+		d.SetUint64(v).Mod(&d, Modulus())
+		z := NewElement(v)
+		assert.Equal(0, z.BigInt(&e).Cmp(&d), "reduce(%d)", v)
+	}
+
+	var z Element
+	z.SetInt64(-1)
+	assert.Equal(Element{q - 1}, z)
+	z.SetInt64(-1 << 63)
+	var e, d big.Int
+	d.SetInt64(-1<<63).Mod(&d, Modulus())
+	assert.Equal(0, z.BigInt(&e).Cmp(&d))
+}
+
+func TestElementBytes(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < 100; i++ {
+		var a, b Element
+		a.SetRandom()
+		buf := a.Bytes()
+		assert.NoError(b.SetBytesCanonical(buf[:]))
+		assert.True(a.Equal(&b))
+		b.SetBytes(a.Marshal())
+		assert.True(a.Equal(&b))
+
+		var s Element
+		_, err := s.SetString(a.String())
+		assert.NoError(err)
+		assert.True(a.Equal(&s))
+	}
+
+	var z Element
+	assert.Error(z.SetBytesCanonical([]byte{0x7f, 0xff, 0xff, 0xff}))
+	assert.Error(z.SetBytesCanonical([]byte{0x01}))
+	z.SetBytes([]byte{0x7f, 0xff, 0xff, 0xff})
+	assert.True(z.IsZero())
+}
+
+func TestElementBatchInvert(t *testing.T) {
+	assert := require.New(t)
+
+	a := make([]Element, 50)
+	for i := range a {
+		a[i].SetRandom()
+	}
+	a[7].SetZero()
+	inv := BatchInvert(a)
+	for i := range a {
+		var e Element
+		e.Inverse(&a[i])
+		assert.True(e.Equal(&inv[i]))
+	}
+}
+
+var benchResElement Element
+
+func BenchmarkElementMul(b *testing.B) {
+	x := Element{123456789}
+	benchResElement.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Mul(&benchResElement, &x)
+	}
+}
+
+func BenchmarkElementInverse(b *testing.B) {
+	benchResElement.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Inverse(&benchResElement)
+	}
+}
+
+func BenchmarkElementSqrt(b *testing.B) {
+	var a Element
+	a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.Sqrt(&a)
+	}
+}
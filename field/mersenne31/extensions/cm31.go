@@ -0,0 +1,202 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package extensions
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+// SizeOfCM31 number of bytes of the encoding of a CM31
+const SizeOfCM31 = 2 * mersenne31.Bytes
+
+// ErrCanonical is returned when decoding a coordinate larger than the modulus
+var ErrCanonical = errors.New("invalid encoding: coordinate is not canonical")
+
+// CM31 is the degree two extension of mersenne31, CM31 = 𝔽_q[i]/(i² + 1).
+// -1 is a non-residue since q ≡ 3 mod 4.
+type CM31 struct {
+	A0, A1 mersenne31.Element
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *CM31) Equal(x *CM31) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1)
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *CM31) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *CM31) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero()
+}
+
+// SetZero sets z to 0 and returns z
+func (z *CM31) SetZero() *CM31 {
+	z.A0.SetZero()
+	z.A1.SetZero()
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *CM31) SetOne() *CM31 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *CM31) Set(x *CM31) *CM31 {
+	z.A0 = x.A0
+	z.A1 = x.A1
+	return z
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *CM31) SetRandom() (*CM31, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Add sets z = x + y and returns z
+func (z *CM31) Add(x, y *CM31) *CM31 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *CM31) Sub(x, y *CM31) *CM31 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *CM31) Double(x *CM31) *CM31 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *CM31) Neg(x *CM31) *CM31 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Conjugate sets z = A0 - A1·i and returns z. It is also the Frobenius, iᵠ = -i.
+func (z *CM31) Conjugate(x *CM31) *CM31 {
+	z.A0 = x.A0
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *CM31) Mul(x, y *CM31) *CM31 {
+	var a, b, c mersenne31.Element
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	a.Mul(&a, &b)
+	b.Mul(&x.A0, &y.A0)
+	c.Mul(&x.A1, &y.A1)
+	z.A1.Sub(&a, &b).Sub(&z.A1, &c)
+	z.A0.Sub(&b, &c)
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *CM31) Square(x *CM31) *CM31 {
+	// (a0 + a1·i)² = (a0 + a1)(a0 - a1) + 2·a0·a1·i
+	var a, b mersenne31.Element
+	a.Add(&x.A0, &x.A1)
+	b.Sub(&x.A0, &x.A1)
+	z.A1.Mul(&x.A0, &x.A1).Double(&z.A1)
+	z.A0.Mul(&a, &b)
+	return z
+}
+
+// MulByElement sets z = x·y, y in mersenne31, and returns z
+func (z *CM31) MulByElement(x *CM31, y *mersenne31.Element) *CM31 {
+	z.A0.Mul(&x.A0, y)
+	z.A1.Mul(&x.A1, y)
+	return z
+}
+
+// norm returns the norm x·x̄ = A0² + A1² of x
+func (x *CM31) norm() mersenne31.Element {
+	var a, b mersenne31.Element
+	a.Square(&x.A0)
+	b.Square(&x.A1)
+	return *a.Add(&a, &b)
+}
+
+// Inverse sets z = 1/x, 0 if x = 0, and returns z
+func (z *CM31) Inverse(x *CM31) *CM31 {
+	n := x.norm()
+	n.Inverse(&n)
+	z.Conjugate(x)
+	return z.MulByElement(z, &n)
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *CM31) Exp(x CM31, k *big.Int) *CM31 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		x.Inverse(&x)
+		e = new(big.Int).Neg(k)
+	}
+	z.SetOne()
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+	return z
+}
+
+// Bytes returns the big endian encoding of A0 followed by the one of A1
+func (z *CM31) Bytes() (res [SizeOfCM31]byte) {
+	b := z.A0.Bytes()
+	copy(res[:], b[:])
+	b = z.A1.Bytes()
+	copy(res[mersenne31.Bytes:], b[:])
+	return
+}
+
+// SetBytesCanonical sets z from the encoding returned by Bytes. It returns an
+// error if the encoding has the wrong size or a coordinate is not canonical.
+func (z *CM31) SetBytesCanonical(e []byte) error {
+	if len(e) != SizeOfCM31 {
+		return errors.New("invalid CM31 encoding size")
+	}
+	if err := z.A0.SetBytesCanonical(e[:mersenne31.Bytes]); err != nil {
+		return ErrCanonical
+	}
+	if err := z.A1.SetBytesCanonical(e[mersenne31.Bytes:]); err != nil {
+		return ErrCanonical
+	}
+	return nil
+}
+
+// String returns z as A0+A1*i
+func (z *CM31) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*i"
+}
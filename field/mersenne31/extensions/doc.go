@@ -0,0 +1,7 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package extensions provides the extensions of mersenne31 used by Circle
+// STARKs: the complex extension CM31 = 𝔽_q[i]/(i² + 1) and the quartic
+// extension QM31 = CM31[u]/(u² - 2 - i).
+package extensions
@@ -0,0 +1,164 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+	"github.com/stretchr/testify/require"
+)
+
+const nbTests = 100
+
+func randomCM31() CM31 {
+	var res CM31
+	if _, err := res.SetRandom(); err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func randomQM31() QM31 {
+	var res QM31
+	if _, err := res.SetRandom(); err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func TestCM31Arithmetic(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < nbTests; i++ {
+		a, b, c := randomCM31(), randomCM31(), randomCM31()
+		var l, r, tmp CM31
+
+		// (a + b)·c = a·c + b·c
+		l.Add(&a, &b).Mul(&l, &c)
+		r.Mul(&a, &c)
+		tmp.Mul(&b, &c)
+		r.Add(&r, &tmp)
+		assert.True(l.Equal(&r))
+
+		// (a·b)·c = a·(b·c)
+		l.Mul(&a, &b).Mul(&l, &c)
+		r.Mul(&b, &c).Mul(&a, &r)
+		assert.True(l.Equal(&r))
+
+		l.Square(&a)
+		r.Mul(&a, &a)
+		assert.True(l.Equal(&r))
+
+		l.Double(&a)
+		r.Add(&a, &a)
+		assert.True(l.Equal(&r))
+
+		l.Sub(&a, &b).Neg(&l).Add(&l, &a)
+		assert.True(l.Equal(&b))
+
+		l.Inverse(&a).Mul(&l, &a)
+		assert.True(l.IsOne())
+
+		var s mersenne31.Element
+		s.SetRandom()
+		l.MulByElement(&a, &s)
+		r.Mul(&a, &CM31{A0: s})
+		assert.True(l.Equal(&r))
+
+		// aᵠ = ā
+		l.Exp(a, mersenne31.Modulus())
+		r.Conjugate(&a)
+		assert.True(l.Equal(&r))
+
+		buf := a.Bytes()
+		assert.NoError(l.SetBytesCanonical(buf[:]))
+		assert.True(l.Equal(&a))
+	}
+
+	// i² = -1
+	var i2 CM31
+	i2.Square(&CM31{A1: mersenne31.One()})
+	var minusOne CM31
+	minusOne.SetOne().Neg(&minusOne)
+	assert.True(i2.Equal(&minusOne))
+
+	var zero CM31
+	assert.True(zero.Inverse(&zero).IsZero())
+	assert.ErrorIs(zero.SetBytesCanonical([]byte{0x7f, 0xff, 0xff, 0xff, 0, 0, 0, 0}), ErrCanonical)
+}
+
+func TestQM31Arithmetic(t *testing.T) {
+	assert := require.New(t)
+
+	for i := 0; i < nbTests; i++ {
+		a, b, c := randomQM31(), randomQM31(), randomQM31()
+		var l, r, tmp QM31
+
+		// (a + b)·c = a·c + b·c
+		l.Add(&a, &b).Mul(&l, &c)
+		r.Mul(&a, &c)
+		tmp.Mul(&b, &c)
+		r.Add(&r, &tmp)
+		assert.True(l.Equal(&r))
+
+		// (a·b)·c = a·(b·c)
+		l.Mul(&a, &b).Mul(&l, &c)
+		r.Mul(&b, &c).Mul(&a, &r)
+		assert.True(l.Equal(&r))
+
+		l.Square(&a)
+		r.Mul(&a, &a)
+		assert.True(l.Equal(&r))
+
+		l.Sub(&a, &b).Add(&l, &b)
+		assert.True(l.Equal(&a))
+
+		l.Inverse(&a).Mul(&l, &a)
+		assert.True(l.IsOne())
+
+		var s mersenne31.Element
+		s.SetRandom()
+		l.MulByElement(&a, &s)
+		r.Mul(&a, &QM31{B0: CM31{A0: s}})
+		assert.True(l.Equal(&r))
+
+		y := randomCM31()
+		l.MulByCM31(&a, &y)
+		r.Mul(&a, &QM31{B0: y})
+		assert.True(l.Equal(&r))
+
+		// Frobenius
+		l.Frobenius(&a)
+		r.Exp(a, mersenne31.Modulus())
+		assert.True(l.Equal(&r))
+
+		// a^(q⁴-1) = 1
+		q := new(big.Int).Exp(mersenne31.Modulus(), big.NewInt(4), nil)
+		l.Exp(a, q.Sub(q, big.NewInt(1)))
+		assert.True(l.IsOne())
+
+		buf := a.Bytes()
+		assert.NoError(l.SetBytesCanonical(buf[:]))
+		assert.True(l.Equal(&a))
+	}
+
+	// u² = 2 + i
+	var u2 QM31
+	u2.Square(&QM31{B1: CM31{A0: mersenne31.One()}})
+	assert.True(u2.Equal(&QM31{B0: nonResidue}))
+
+	a := make([]QM31, 10)
+	for i := range a {
+		a[i] = randomQM31()
+	}
+	a[3].SetZero()
+	inv := BatchInvertQM31(a)
+	for i := range a {
+		var e QM31
+		e.Inverse(&a[i])
+		assert.True(e.Equal(&inv[i]))
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package extensions
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+// SizeOfQM31 number of bytes of the encoding of a QM31
+const SizeOfQM31 = 2 * SizeOfCM31
+
+// QM31 is the degree two extension of CM31, QM31 = CM31[u]/(u² - 2 - i), as
+// in Stwo. 2 + i is a non-residue of CM31 since its norm 5 is a non-residue of
+// mersenne31.
+type QM31 struct {
+	B0, B1 CM31
+}
+
+var (
+	// nonResidue u² = 2 + i
+	nonResidue = CM31{A0: mersenne31.NewElement(2), A1: mersenne31.NewElement(1)}
+
+	// frobeniusU uᵠ = (2 + i)^((q-1)/2)·u
+	frobeniusU CM31
+)
+
+func init() {
+	e := new(big.Int).Sub(mersenne31.Modulus(), big.NewInt(1))
+	frobeniusU.Exp(nonResidue, e.Rsh(e, 1))
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *QM31) Equal(x *QM31) bool {
+	return z.B0.Equal(&x.B0) && z.B1.Equal(&x.B1)
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *QM31) IsZero() bool {
+	return z.B0.IsZero() && z.B1.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *QM31) IsOne() bool {
+	return z.B0.IsOne() && z.B1.IsZero()
+}
+
+// SetZero sets z to 0 and returns z
+func (z *QM31) SetZero() *QM31 {
+	z.B0.SetZero()
+	z.B1.SetZero()
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *QM31) SetOne() *QM31 {
+	z.B0.SetOne()
+	z.B1.SetZero()
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *QM31) Set(x *QM31) *QM31 {
+	z.B0 = x.B0
+	z.B1 = x.B1
+	return z
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *QM31) SetRandom() (*QM31, error) {
+	if _, err := z.B0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.B1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// Add sets z = x + y and returns z
+func (z *QM31) Add(x, y *QM31) *QM31 {
+	z.B0.Add(&x.B0, &y.B0)
+	z.B1.Add(&x.B1, &y.B1)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *QM31) Sub(x, y *QM31) *QM31 {
+	z.B0.Sub(&x.B0, &y.B0)
+	z.B1.Sub(&x.B1, &y.B1)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *QM31) Double(x *QM31) *QM31 {
+	z.B0.Double(&x.B0)
+	z.B1.Double(&x.B1)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *QM31) Neg(x *QM31) *QM31 {
+	z.B0.Neg(&x.B0)
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// Conjugate sets z = B0 - B1·u and returns z
+func (z *QM31) Conjugate(x *QM31) *QM31 {
+	z.B0 = x.B0
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *QM31) Mul(x, y *QM31) *QM31 {
+	var a, b, c CM31
+	a.Add(&x.B0, &x.B1)
+	b.Add(&y.B0, &y.B1)
+	a.Mul(&a, &b)
+	b.Mul(&x.B0, &y.B0)
+	c.Mul(&x.B1, &y.B1)
+	z.B1.Sub(&a, &b).Sub(&z.B1, &c)
+	c.Mul(&c, &nonResidue)
+	z.B0.Add(&b, &c)
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *QM31) Square(x *QM31) *QM31 {
+	// (b0 + b1·u)² = b0² + (2 + i)·b1² + 2·b0·b1·u
+	var a, b CM31
+	a.Square(&x.B0)
+	b.Square(&x.B1).Mul(&b, &nonResidue)
+	z.B1.Mul(&x.B0, &x.B1).Double(&z.B1)
+	z.B0.Add(&a, &b)
+	return z
+}
+
+// MulByElement sets z = x·y, y in mersenne31, and returns z
+func (z *QM31) MulByElement(x *QM31, y *mersenne31.Element) *QM31 {
+	z.B0.MulByElement(&x.B0, y)
+	z.B1.MulByElement(&x.B1, y)
+	return z
+}
+
+// MulByCM31 sets z = x·y, y in CM31, and returns z
+func (z *QM31) MulByCM31(x *QM31, y *CM31) *QM31 {
+	z.B0.Mul(&x.B0, y)
+	z.B1.Mul(&x.B1, y)
+	return z
+}
+
+// Inverse sets z = 1/x, 0 if x = 0, and returns z
+func (z *QM31) Inverse(x *QM31) *QM31 {
+	// 1/x = x̄/(x·x̄), where x·x̄ = B0² - (2 + i)·B1² is in CM31
+	var a, b CM31
+	a.Square(&x.B0)
+	b.Square(&x.B1).Mul(&b, &nonResidue)
+	a.Sub(&a, &b).Inverse(&a)
+	z.Conjugate(x)
+	return z.MulByCM31(z, &a)
+}
+
+// Frobenius sets z = xᵠ and returns z
+func (z *QM31) Frobenius(x *QM31) *QM31 {
+	z.B0.Conjugate(&x.B0)
+	z.B1.Conjugate(&x.B1).Mul(&z.B1, &frobeniusU)
+	return z
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *QM31) Exp(x QM31, k *big.Int) *QM31 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		x.Inverse(&x)
+		e = new(big.Int).Neg(k)
+	}
+	z.SetOne()
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		z.Square(z)
+		if e.Bit(i) == 1 {
+			z.Mul(z, &x)
+		}
+	}
+	return z
+}
+
+// BatchInvertQM31 returns a new slice with every element inverted, using the
+// Montgomery batch inversion trick. Zero elements are mapped to zero.
+func BatchInvertQM31(a []QM31) []QM31 {
+	res := make([]QM31, len(a))
+	if len(a) == 0 {
+		return res
+	}
+	var accumulator QM31
+	accumulator.SetOne()
+	for i := range a {
+		if a[i].IsZero() {
+			continue
+		}
+		res[i] = accumulator
+		accumulator.Mul(&accumulator, &a[i])
+	}
+	accumulator.Inverse(&accumulator)
+	for i := len(a) - 1; i >= 0; i-- {
+		if a[i].IsZero() {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+	return res
+}
+
+// Bytes returns the encoding of B0 followed by the one of B1
+func (z *QM31) Bytes() (res [SizeOfQM31]byte) {
+	b := z.B0.Bytes()
+	copy(res[:], b[:])
+	b = z.B1.Bytes()
+	copy(res[SizeOfCM31:], b[:])
+	return
+}
+
+// SetBytesCanonical sets z from the encoding returned by Bytes. It returns an
+// error if the encoding has the wrong size or a coordinate is not canonical.
+func (z *QM31) SetBytesCanonical(e []byte) error {
+	if len(e) != SizeOfQM31 {
+		return errors.New("invalid QM31 encoding size")
+	}
+	if err := z.B0.SetBytesCanonical(e[:SizeOfCM31]); err != nil {
+		return err
+	}
+	return z.B1.SetBytesCanonical(e[SizeOfCM31:])
+}
+
+// String returns z as (B0)+(B1)*u
+func (z *QM31) String() string {
+	return "(" + z.B0.String() + ")+(" + z.B1.String() + ")*u"
+}
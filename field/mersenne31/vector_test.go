@@ -0,0 +1,88 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package mersenne31
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func randomVector(n int) Vector {
+	res := make(Vector, n)
+	for i := range res {
+		res[i].SetRandom()
+	}
+	return res
+}
+
+func TestVectorSort(t *testing.T) {
+	assert := require.New(t)
+
+	v := Vector{{3}, {1}, {2}}
+	sort.Sort(v)
+	assert.Equal("[1,2,3]", v.String())
+}
+
+func TestVectorRoundTrip(t *testing.T) {
+	assert := require.New(t)
+
+	v1 := randomVector(100)
+	b, err := v1.MarshalBinary()
+	assert.NoError(err)
+
+	var v2 Vector
+	assert.NoError(v2.UnmarshalBinary(b))
+	assert.Equal(v1, v2)
+
+	// non-canonical element
+	b[4], b[5], b[6], b[7] = 0x7f, 0xff, 0xff, 0xff
+	assert.Error(v2.UnmarshalBinary(b))
+}
+
+func TestVectorOps(t *testing.T) {
+	for _, n := range []int{0, 1, 3, 4, 5, 33} {
+		assert := require.New(t)
+
+		a, b := randomVector(n), randomVector(n)
+		// the largest values exercise the lazy reductions
+		if n > 2 {
+			a[0], b[0], a[1], b[1] = Element{q - 1}, Element{q - 1}, Element{q - 1}, Element{q - 1}
+		}
+		var s Element
+		s.SetRandom()
+
+		res := make(Vector, n)
+		var sum, innerProduct Element
+		for i := 0; i < n; i++ {
+			var tmp Element
+			sum.Add(&sum, &a[i])
+			innerProduct.Add(&innerProduct, tmp.Mul(&a[i], &b[i]))
+		}
+		assert.Equal(sum, a.Sum())
+		assert.Equal(innerProduct, a.InnerProduct(b))
+
+		res.Add(a, b)
+		for i := range res {
+			var e Element
+			assert.Equal(*e.Add(&a[i], &b[i]), res[i])
+		}
+		res.Sub(a, b)
+		for i := range res {
+			var e Element
+			assert.Equal(*e.Sub(&a[i], &b[i]), res[i])
+		}
+		res.Mul(a, b)
+		for i := range res {
+			var e Element
+			assert.Equal(*e.Mul(&a[i], &b[i]), res[i])
+		}
+		res.ScalarMul(a, &s)
+		for i := range res {
+			var e Element
+			assert.Equal(*e.Mul(&a[i], &s), res[i])
+		}
+	}
+}
@@ -38,19 +38,40 @@ const (
 	MIMC_BLS24_317
 	// MIMC_BW6_633 is the MiMC hash function for the BW6-633 curve.
 	MIMC_BW6_633
+	// POSEIDON2_BN254 is the Poseidon2 hash function for the BN254 curve.
+	POSEIDON2_BN254
+	// POSEIDON2_BLS12_381 is the Poseidon2 hash function for the BLS12-381 curve.
+	POSEIDON2_BLS12_381
+	// POSEIDON2_BLS12_377 is the Poseidon2 hash function for the BLS12-377 curve.
+	POSEIDON2_BLS12_377
+	// POSEIDON2_BW6_761 is the Poseidon2 hash function for the BW6-761 curve.
+	POSEIDON2_BW6_761
+	// POSEIDON2_BLS24_315 is the Poseidon2 hash function for the BLS24-315 curve.
+	POSEIDON2_BLS24_315
+	// POSEIDON2_BLS24_317 is the Poseidon2 hash function for the BLS24-317 curve.
+	POSEIDON2_BLS24_317
+	// POSEIDON2_BW6_633 is the Poseidon2 hash function for the BW6-633 curve.
+	POSEIDON2_BW6_633
 
 	maxHash
 )
 
 // size of digests in bytes
 var digestSize = []uint8{
-	MIMC_BN254:     32,
-	MIMC_BLS12_381: 48,
-	MIMC_BLS12_377: 48,
-	MIMC_BW6_761:   96,
-	MIMC_BLS24_315: 48,
-	MIMC_BLS24_317: 48,
-	MIMC_BW6_633:   80,
+	MIMC_BN254:          32,
+	MIMC_BLS12_381:      48,
+	MIMC_BLS12_377:      48,
+	MIMC_BW6_761:        96,
+	MIMC_BLS24_315:      48,
+	MIMC_BLS24_317:      48,
+	MIMC_BW6_633:        80,
+	POSEIDON2_BN254:     32,
+	POSEIDON2_BLS12_381: 32,
+	POSEIDON2_BLS12_377: 32,
+	POSEIDON2_BW6_761:   48,
+	POSEIDON2_BLS24_315: 32,
+	POSEIDON2_BLS24_317: 32,
+	POSEIDON2_BW6_633:   40,
 }
 
 // New initializes the hash function. This is a convenience function which does
@@ -62,11 +83,12 @@ func (m Hash) New() hash.Hash {
 			return f()
 		}
 	}
-	pkgname, _ := strings.CutPrefix(m.String(), "MIMC_")
+	hashname, pkgname, _ := strings.Cut(m.String(), "_")
+	hashname = strings.ToLower(hashname)
 	pkgname = strings.ToLower(pkgname)
 	pkgname = strings.ReplaceAll(pkgname, "_", "-")
 	msg := fmt.Sprintf(`requested hash function #%s not registered. Import the corresponding package to register it:
-	import _ "github.com/consensys/gnark-crypto/ecc/%s/fr/mimc"`, m.String(), pkgname)
+	import _ "github.com/consensys/gnark-crypto/ecc/%s/fr/%s"`, m.String(), pkgname, hashname)
 	panic(msg)
 }
 
@@ -87,6 +109,20 @@ func (m Hash) String() string {
 		return "MIMC_BLS24_317"
 	case MIMC_BW6_633:
 		return "MIMC_BW6_633"
+	case POSEIDON2_BN254:
+		return "POSEIDON2_BN254"
+	case POSEIDON2_BLS12_381:
+		return "POSEIDON2_BLS12_381"
+	case POSEIDON2_BLS12_377:
+		return "POSEIDON2_BLS12_377"
+	case POSEIDON2_BW6_761:
+		return "POSEIDON2_BW6_761"
+	case POSEIDON2_BLS24_315:
+		return "POSEIDON2_BLS24_315"
+	case POSEIDON2_BLS24_317:
+		return "POSEIDON2_BLS24_317"
+	case POSEIDON2_BW6_633:
+		return "POSEIDON2_BW6_633"
 	default:
 		return "unknown hash function"
 	}
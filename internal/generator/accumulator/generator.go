@@ -0,0 +1,24 @@
+package accumulator
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// bilinear-map accumulator over kzg
+	conf.Package = "accumulator"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "accumulator.go"), Templates: []string{"accumulator.go.tmpl"}},
+		{File: filepath.Join(baseDir, "accumulator_test.go"), Templates: []string{"accumulator.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "witness.go"), Templates: []string{"witness.go.tmpl"}},
+		{File: filepath.Join(baseDir, "polynomial.go"), Templates: []string{"polynomial.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./accumulator/template/", entries...)
+
+}
@@ -15,6 +15,12 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
 		{File: filepath.Join(baseDir, "fri.go"), Templates: []string{"fri.go.tmpl"}},
 		{File: filepath.Join(baseDir, "fri_test.go"), Templates: []string{"fri.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "config.go"), Templates: []string{"config.go.tmpl"}},
+		{File: filepath.Join(baseDir, "configurable.go"), Templates: []string{"configurable.go.tmpl"}},
+		{File: filepath.Join(baseDir, "configurable_test.go"), Templates: []string{"configurable.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "merkle.go"), Templates: []string{"merkle.go.tmpl"}},
+		{File: filepath.Join(baseDir, "batch.go"), Templates: []string{"batch.go.tmpl"}},
+		{File: filepath.Join(baseDir, "batch_test.go"), Templates: []string{"batch.test.go.tmpl"}},
 	}
 	return bgen.Generate(conf, conf.Package, "./fri/template/", entries...)
 
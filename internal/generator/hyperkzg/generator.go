@@ -0,0 +1,22 @@
+package hyperkzg
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// multilinear commitment scheme over kzg
+	conf.Package = "hyperkzg"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hyperkzg.go"), Templates: []string{"hyperkzg.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hyperkzg_test.go"), Templates: []string{"hyperkzg.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./hyperkzg/template/", entries...)
+
+}
@@ -0,0 +1,22 @@
+package hyrax
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// transparent multilinear commitment scheme
+	conf.Package = "hyrax"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hyrax.go"), Templates: []string{"hyrax.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hyrax_test.go"), Templates: []string{"hyrax.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./hyrax/template/", entries...)
+
+}
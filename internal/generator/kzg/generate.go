@@ -15,9 +15,40 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
 		{File: filepath.Join(baseDir, "kzg.go"), Templates: []string{"kzg.go.tmpl"}},
 		{File: filepath.Join(baseDir, "kzg_test.go"), Templates: []string{"kzg.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "degree_bound.go"), Templates: []string{"degree_bound.go.tmpl"}},
+		{File: filepath.Join(baseDir, "degree_bound_test.go"), Templates: []string{"degree_bound.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hiding.go"), Templates: []string{"hiding.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hiding_test.go"), Templates: []string{"hiding.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "lagrange.go"), Templates: []string{"lagrange.go.tmpl"}},
+		{File: filepath.Join(baseDir, "lagrange_test.go"), Templates: []string{"lagrange.test.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+		{File: filepath.Join(baseDir, "mmap.go"), Templates: []string{"mmap.go.tmpl"}},
+		{File: filepath.Join(baseDir, "mmap_test.go"), Templates: []string{"mmap.test.go.tmpl"}},
 		{File: filepath.Join(baseDir, "utils.go"), Templates: []string{"utils.go.tmpl"}},
+		{File: filepath.Join(baseDir, "validate.go"), Templates: []string{"validate.go.tmpl"}},
+		{File: filepath.Join(baseDir, "validate_test.go"), Templates: []string{"validate.test.go.tmpl"}},
 	}
-	return bgen.Generate(conf, conf.Package, "./kzg/template/", entries...)
+
+	// snarkjs and PPoT ceremonies are run on bn254 and bls12-381 only
+	if conf.Equal(config.BN254) || conf.Equal(config.BLS12_381) {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "ptau.go"), Templates: []string{"ptau.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "ptau_test.go"), Templates: []string{"ptau.test.go.tmpl"}},
+		)
+	}
+	if err := bgen.Generate(conf, conf.Package, "./kzg/template/", entries...); err != nil {
+		return err
+	}
+
+	// powers of tau ceremony
+	conf.Package = "ceremony"
+	ceremonyDir := filepath.Join(baseDir, "ceremony")
+	entries = []bavard.Entry{
+		{File: filepath.Join(ceremonyDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(ceremonyDir, "ceremony.go"), Templates: []string{"ceremony.go.tmpl"}},
+		{File: filepath.Join(ceremonyDir, "ceremony_test.go"), Templates: []string{"ceremony.test.go.tmpl"}},
+		{File: filepath.Join(ceremonyDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./kzg/template/ceremony/", entries...)
 
 }
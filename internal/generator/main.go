@@ -10,6 +10,8 @@ import (
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/field/generator"
 	fieldConfig "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/accumulator"
+	"github.com/consensys/gnark-crypto/internal/generator/bulletproofs"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/mimc"
 	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/poseidon2"
@@ -21,6 +23,8 @@ import (
 	fri "github.com/consensys/gnark-crypto/internal/generator/fri/template"
 	"github.com/consensys/gnark-crypto/internal/generator/gkr"
 	"github.com/consensys/gnark-crypto/internal/generator/hash_to_field"
+	"github.com/consensys/gnark-crypto/internal/generator/hyperkzg"
+	"github.com/consensys/gnark-crypto/internal/generator/hyrax"
 	"github.com/consensys/gnark-crypto/internal/generator/iop"
 	"github.com/consensys/gnark-crypto/internal/generator/kzg"
 	"github.com/consensys/gnark-crypto/internal/generator/pairing"
@@ -30,6 +34,7 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/polynomial"
 	"github.com/consensys/gnark-crypto/internal/generator/shplonk"
 	"github.com/consensys/gnark-crypto/internal/generator/sis"
+	"github.com/consensys/gnark-crypto/internal/generator/snarkpack"
 	"github.com/consensys/gnark-crypto/internal/generator/sumcheck"
 	"github.com/consensys/gnark-crypto/internal/generator/test_vector_utils"
 	"github.com/consensys/gnark-crypto/internal/generator/tower"
@@ -77,7 +82,7 @@ func main() {
 
 			frOpts := []generator.Option{generator.WithASM(asmConfig)}
 			if !(conf.Equal(config.STARK_CURVE) || conf.Equal(config.SECP256K1)) {
-				frOpts = append(frOpts, generator.WithFFT(fftConfig))
+				frOpts = append(frOpts, generator.WithFFT(fftConfig), generator.WithMerkleTree())
 			}
 			if conf.Equal(config.BLS12_377) {
 				frOpts = append(frOpts, generator.WithSIS())
@@ -95,6 +100,9 @@ func main() {
 			// generate G1, G2, multiExp, ...
 			assertNoError(ecc.Generate(conf, curveDir, bgen))
 
+			// generate bulletproofs on G1
+			assertNoError(bulletproofs.Generate(conf, filepath.Join(curveDir, "bulletproofs"), bgen))
+
 			if conf.Equal(config.SECP256K1) {
 				return
 			}
@@ -121,6 +129,20 @@ func main() {
 			// generate fflonk on fr
 			assertNoError(fflonk.Generate(conf, filepath.Join(curveDir, "fflonk"), bgen))
 
+			// generate hyperkzg on fr
+			assertNoError(hyperkzg.Generate(conf, filepath.Join(curveDir, "hyperkzg"), bgen))
+
+			// generate hyrax on fr
+			assertNoError(hyrax.Generate(conf, filepath.Join(curveDir, "hyrax"), bgen))
+
+			// generate accumulator on fr
+			assertNoError(accumulator.Generate(conf, filepath.Join(curveDir, "accumulator"), bgen))
+
+			// generate snarkpack on the curves used with Groth16
+			if conf.Equal(config.BN254) || conf.Equal(config.BLS12_381) {
+				assertNoError(snarkpack.Generate(conf, filepath.Join(curveDir, "snarkpack"), bgen))
+			}
+
 			// generate pedersen on fr
 			assertNoError(pedersen.Generate(conf, filepath.Join(curveDir, "fr", "pedersen"), bgen))
 
@@ -0,0 +1,23 @@
+package snarkpack
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// aggregation of Groth16 proofs
+	conf.Package = "snarkpack"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "snarkpack.go"), Templates: []string{"snarkpack.go.tmpl"}},
+		{File: filepath.Join(baseDir, "snarkpack_test.go"), Templates: []string{"snarkpack.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "tipp_mipp.go"), Templates: []string{"tipp_mipp.go.tmpl"}},
+		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./snarkpack/template/", entries...)
+
+}
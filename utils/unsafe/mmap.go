@@ -0,0 +1,79 @@
+package unsafe
+
+import (
+	"errors"
+	"os"
+	"unsafe"
+)
+
+// Mapping is a read-only view of the content of a file. On unix platforms the
+// file is memory mapped, so that its content is loaded lazily by the kernel
+// and never copied on the heap; on other platforms it is read in memory.
+type Mapping struct {
+	data   []byte
+	mapped bool
+}
+
+// Mmap maps the file at path in memory, read-only.
+func Mmap(path string) (*Mapping, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+	if size == 0 {
+		return &Mapping{}, nil
+	}
+	if int64(int(size)) != size {
+		return nil, errors.New("file too large to be mapped")
+	}
+
+	data, mapped, err := mmap(f, int(size))
+	if err != nil {
+		return nil, err
+	}
+	return &Mapping{data: data, mapped: mapped}, nil
+}
+
+// Bytes returns the content of the file. It must not be modified nor used
+// after Close.
+func (m *Mapping) Bytes() []byte {
+	return m.data
+}
+
+// Close releases the mapping. Slices returned by Bytes or derived from it
+// must not be used afterwards.
+func (m *Mapping) Close() error {
+	data := m.data
+	m.data = nil
+	if !m.mapped || data == nil {
+		return nil
+	}
+	m.mapped = false
+	return munmap(data)
+}
+
+// CastSlice returns a slice of n objects whose memory representation is
+// stored in b, without copy.
+// Use with caution: as WriteSlice, this is architecture dependent and must not
+// be used with types that contain pointers.
+func CastSlice[S ~[]E, E any](b []byte, n int) (S, error) {
+	var e E
+	size := int(unsafe.Sizeof(e))
+	if n < 0 || n > len(b)/max(size, 1) {
+		return nil, errors.New("buffer too short for the slice")
+	}
+	if n == 0 {
+		return make(S, 0), nil
+	}
+	if uintptr(unsafe.Pointer(&b[0]))%unsafe.Alignof(e) != 0 {
+		return nil, errors.New("buffer not aligned for the slice type")
+	}
+	return unsafe.Slice((*E)(unsafe.Pointer(&b[0])), n), nil
+}
@@ -0,0 +1,24 @@
+//go:build !unix
+
+package unsafe
+
+import (
+	"io"
+	"os"
+	"unsafe"
+)
+
+// mmap reads the file in memory; the buffer is allocated as a []uint64 so
+// that it is suitably aligned for CastSlice.
+func mmap(f *os.File, size int) ([]byte, bool, error) {
+	words := make([]uint64, (size+7)/8)
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&words[0])), size)
+	if _, err := io.ReadFull(f, data); err != nil {
+		return nil, false, err
+	}
+	return data, false, nil
+}
+
+func munmap([]byte) error {
+	return nil
+}
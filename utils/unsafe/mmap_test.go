@@ -0,0 +1,49 @@
+package unsafe_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/utils/unsafe"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMmap(t *testing.T) {
+	assert := require.New(t)
+	samplePoints := make([]bn254.G2Affine, 10)
+	fillBenchBasesG2(samplePoints)
+
+	var buf bytes.Buffer
+	assert.NoError(unsafe.WriteSlice(&buf, samplePoints))
+
+	path := filepath.Join(t.TempDir(), "points")
+	assert.NoError(os.WriteFile(path, buf.Bytes(), 0600))
+
+	m, err := unsafe.Mmap(path)
+	assert.NoError(err)
+	assert.Equal(buf.Bytes(), m.Bytes())
+
+	// skip the length prefix
+	points, err := unsafe.CastSlice[[]bn254.G2Affine](m.Bytes()[8:], len(samplePoints))
+	assert.NoError(err)
+	assert.Equal(samplePoints, points)
+
+	_, err = unsafe.CastSlice[[]bn254.G2Affine](m.Bytes()[8:], len(samplePoints)+1)
+	assert.Error(err, "buffer too short")
+	_, err = unsafe.CastSlice[[]bn254.G2Affine](m.Bytes()[9:], 1)
+	assert.Error(err, "buffer not aligned")
+
+	assert.NoError(m.Close())
+	assert.Nil(m.Bytes())
+	assert.NoError(m.Close())
+
+	// empty file
+	assert.NoError(os.WriteFile(path, nil, 0600))
+	m, err = unsafe.Mmap(path)
+	assert.NoError(err)
+	assert.Empty(m.Bytes())
+	assert.NoError(m.Close())
+}